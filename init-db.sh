@@ -31,7 +31,9 @@ psql -v ON_ERROR_STOP=1 --username "$POSTGRES_USER" --dbname "postgres" <<-EOSQL
 
     SELECT create_hypertable('sensor_readings', 'time', if_not_exists => TRUE);
 
-    CREATE INDEX IF NOT EXISTS idx_sensor_readings_sensor_time ON sensor_readings (sensor_id, time DESC);
+    CREATE UNIQUE INDEX IF NOT EXISTS sensor_readings_sensor_id_time_key ON sensor_readings (sensor_id, time DESC);
+
+    ALTER TABLE sensor_readings OWNER TO $DATA_SERVICE_DB_USER;
     
     GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA public TO $DATA_SERVICE_DB_USER;
 
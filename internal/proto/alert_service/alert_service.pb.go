@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,16 +24,24 @@ const (
 )
 
 type Alert struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	RuleId        int64                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
-	SensorId      int64                  `protobuf:"varint,3,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Value         float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
-	IsRead        bool                   `protobuf:"varint,6,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
-	TriggeredAt   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RuleId      int64                  `protobuf:"varint,2,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	SensorId    int64                  `protobuf:"varint,3,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Message     string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Value       float64                `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	IsRead      bool                   `protobuf:"varint,6,opt,name=is_read,json=isRead,proto3" json:"is_read,omitempty"`
+	TriggeredAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	// status is "firing", "acknowledged" or "resolved". Repeated violations of the
+	// rule while the alert is open increase count and update last_value.
+	Status          string                 `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	Count           int32                  `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
+	LastValue       float64                `protobuf:"fixed64,10,opt,name=last_value,json=lastValue,proto3" json:"last_value,omitempty"`
+	LastTriggeredAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=last_triggered_at,json=lastTriggeredAt,proto3" json:"last_triggered_at,omitempty"`
+	AcknowledgedAt  *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`
+	ResolvedAt      *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *Alert) Reset() {
@@ -114,6 +123,48 @@ func (x *Alert) GetTriggeredAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Alert) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Alert) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Alert) GetLastValue() float64 {
+	if x != nil {
+		return x.LastValue
+	}
+	return 0
+}
+
+func (x *Alert) GetLastTriggeredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTriggeredAt
+	}
+	return nil
+}
+
+func (x *Alert) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *Alert) GetResolvedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolvedAt
+	}
+	return nil
+}
+
 type GetAlertRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -350,6 +401,202 @@ func (x *MarkAlertAsReadResponse) GetSuccess() bool {
 	return false
 }
 
+// AcknowledgeAlertRequest acknowledges a firing alert. user_id, when set, has to own
+// the alert.
+type AcknowledgeAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeAlertRequest) Reset() {
+	*x = AcknowledgeAlertRequest{}
+	mi := &file_alert_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlertRequest) ProtoMessage() {}
+
+func (x *AcknowledgeAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAlertRequest.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlertRequest) Descriptor() ([]byte, []int) {
+	return file_alert_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AcknowledgeAlertRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcknowledgeAlertRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type AcknowledgeAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *Alert                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcknowledgeAlertResponse) Reset() {
+	*x = AcknowledgeAlertResponse{}
+	mi := &file_alert_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcknowledgeAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcknowledgeAlertResponse) ProtoMessage() {}
+
+func (x *AcknowledgeAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcknowledgeAlertResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeAlertResponse) Descriptor() ([]byte, []int) {
+	return file_alert_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AcknowledgeAlertResponse) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+// ResolveAlertRequest resolves an open alert and re-arms its rule. user_id, when set,
+// has to own the alert.
+type ResolveAlertRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAlertRequest) Reset() {
+	*x = ResolveAlertRequest{}
+	mi := &file_alert_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAlertRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAlertRequest) ProtoMessage() {}
+
+func (x *ResolveAlertRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAlertRequest.ProtoReflect.Descriptor instead.
+func (*ResolveAlertRequest) Descriptor() ([]byte, []int) {
+	return file_alert_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResolveAlertRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ResolveAlertRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ResolveAlertResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Alert         *Alert                 `protobuf:"bytes,1,opt,name=alert,proto3" json:"alert,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveAlertResponse) Reset() {
+	*x = ResolveAlertResponse{}
+	mi := &file_alert_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveAlertResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveAlertResponse) ProtoMessage() {}
+
+func (x *ResolveAlertResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_alert_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveAlertResponse.ProtoReflect.Descriptor instead.
+func (*ResolveAlertResponse) Descriptor() ([]byte, []int) {
+	return file_alert_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResolveAlertResponse) GetAlert() *Alert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
 type ListAlertsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Alerts        []*Alert               `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
@@ -360,7 +607,7 @@ type ListAlertsResponse struct {
 
 func (x *ListAlertsResponse) Reset() {
 	*x = ListAlertsResponse{}
-	mi := &file_alert_service_proto_msgTypes[6]
+	mi := &file_alert_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -372,7 +619,7 @@ func (x *ListAlertsResponse) String() string {
 func (*ListAlertsResponse) ProtoMessage() {}
 
 func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[6]
+	mi := &file_alert_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -385,7 +632,7 @@ func (x *ListAlertsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAlertsResponse.ProtoReflect.Descriptor instead.
 func (*ListAlertsResponse) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{6}
+	return file_alert_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ListAlertsResponse) GetAlerts() []*Alert {
@@ -413,13 +660,34 @@ type AlertRule struct {
 	IsEnabled     bool                   `protobuf:"varint,7,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UserId        int64                  `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Channel       string                 `protobuf:"bytes,10,opt,name=channel,proto3" json:"channel,omitempty"`
+	// threshold_high is the upper bound of BETWEEN and OUTSIDE rules, whose lower
+	// bound is threshold.
+	ThresholdHigh float64 `protobuf:"fixed64,11,opt,name=threshold_high,json=thresholdHigh,proto3" json:"threshold_high,omitempty"`
+	// The condition must hold for for_seconds, or for for_readings consecutive
+	// readings, before the rule fires. Zero fires on the first matching reading.
+	// A NO_DATA rule fires once its sensor sent no reading for for_seconds.
+	ForSeconds  int32 `protobuf:"varint,12,opt,name=for_seconds,json=forSeconds,proto3" json:"for_seconds,omitempty"`
+	ForReadings int32 `protobuf:"varint,13,opt,name=for_readings,json=forReadings,proto3" json:"for_readings,omitempty"`
+	// clear_threshold re-arms a fired GT, GTE, LT or LTE rule once the value gets back
+	// to it. Without it the rule re-arms as soon as the condition stops holding.
+	ClearThreshold *wrapperspb.DoubleValue `protobuf:"bytes,14,opt,name=clear_threshold,json=clearThreshold,proto3" json:"clear_threshold,omitempty"`
+	// metric is what the condition compares: VALUE (the reading itself, the default),
+	// DELTA (change over the window), DELTA_PERCENT (change over the window in percent
+	// of the oldest reading in it) or SLOPE (least-squares trend per minute over the
+	// window). window_seconds is required by every metric but VALUE.
+	Metric        string `protobuf:"bytes,15,opt,name=metric,proto3" json:"metric,omitempty"`
+	WindowSeconds int32  `protobuf:"varint,16,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// skip_bad_quality ignores readings flagged out_of_range, suspect or interpolated.
+	// Channel rules judge the quality of their channel only.
+	SkipBadQuality bool `protobuf:"varint,17,opt,name=skip_bad_quality,json=skipBadQuality,proto3" json:"skip_bad_quality,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AlertRule) Reset() {
 	*x = AlertRule{}
-	mi := &file_alert_service_proto_msgTypes[7]
+	mi := &file_alert_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -431,7 +699,7 @@ func (x *AlertRule) String() string {
 func (*AlertRule) ProtoMessage() {}
 
 func (x *AlertRule) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[7]
+	mi := &file_alert_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -444,7 +712,7 @@ func (x *AlertRule) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlertRule.ProtoReflect.Descriptor instead.
 func (*AlertRule) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{7}
+	return file_alert_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AlertRule) GetId() int64 {
@@ -510,21 +778,85 @@ func (x *AlertRule) GetUserId() int64 {
 	return 0
 }
 
+func (x *AlertRule) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *AlertRule) GetThresholdHigh() float64 {
+	if x != nil {
+		return x.ThresholdHigh
+	}
+	return 0
+}
+
+func (x *AlertRule) GetForSeconds() int32 {
+	if x != nil {
+		return x.ForSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetForReadings() int32 {
+	if x != nil {
+		return x.ForReadings
+	}
+	return 0
+}
+
+func (x *AlertRule) GetClearThreshold() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ClearThreshold
+	}
+	return nil
+}
+
+func (x *AlertRule) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *AlertRule) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *AlertRule) GetSkipBadQuality() bool {
+	if x != nil {
+		return x.SkipBadQuality
+	}
+	return false
+}
+
 type CreateAlertRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	SensorId      int64                  `protobuf:"varint,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
-	ConditionType string                 `protobuf:"bytes,3,opt,name=condition_type,json=conditionType,proto3" json:"condition_type,omitempty"`
-	Threshold     float64                `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	UserId        int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Name           string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SensorId       int64                   `protobuf:"varint,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	ConditionType  string                  `protobuf:"bytes,3,opt,name=condition_type,json=conditionType,proto3" json:"condition_type,omitempty"`
+	Threshold      float64                 `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Description    string                  `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	UserId         int64                   `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Channel        string                  `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	ThresholdHigh  float64                 `protobuf:"fixed64,8,opt,name=threshold_high,json=thresholdHigh,proto3" json:"threshold_high,omitempty"`
+	ForSeconds     int32                   `protobuf:"varint,9,opt,name=for_seconds,json=forSeconds,proto3" json:"for_seconds,omitempty"`
+	ForReadings    int32                   `protobuf:"varint,10,opt,name=for_readings,json=forReadings,proto3" json:"for_readings,omitempty"`
+	ClearThreshold *wrapperspb.DoubleValue `protobuf:"bytes,11,opt,name=clear_threshold,json=clearThreshold,proto3" json:"clear_threshold,omitempty"`
+	Metric         string                  `protobuf:"bytes,12,opt,name=metric,proto3" json:"metric,omitempty"`
+	WindowSeconds  int32                   `protobuf:"varint,13,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	SkipBadQuality bool                    `protobuf:"varint,14,opt,name=skip_bad_quality,json=skipBadQuality,proto3" json:"skip_bad_quality,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *CreateAlertRuleRequest) Reset() {
 	*x = CreateAlertRuleRequest{}
-	mi := &file_alert_service_proto_msgTypes[8]
+	mi := &file_alert_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -536,7 +868,7 @@ func (x *CreateAlertRuleRequest) String() string {
 func (*CreateAlertRuleRequest) ProtoMessage() {}
 
 func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[8]
+	mi := &file_alert_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -549,7 +881,7 @@ func (x *CreateAlertRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAlertRuleRequest.ProtoReflect.Descriptor instead.
 func (*CreateAlertRuleRequest) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{8}
+	return file_alert_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *CreateAlertRuleRequest) GetName() string {
@@ -594,6 +926,62 @@ func (x *CreateAlertRuleRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *CreateAlertRuleRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetThresholdHigh() float64 {
+	if x != nil {
+		return x.ThresholdHigh
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetForSeconds() int32 {
+	if x != nil {
+		return x.ForSeconds
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetForReadings() int32 {
+	if x != nil {
+		return x.ForReadings
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetClearThreshold() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ClearThreshold
+	}
+	return nil
+}
+
+func (x *CreateAlertRuleRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *CreateAlertRuleRequest) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *CreateAlertRuleRequest) GetSkipBadQuality() bool {
+	if x != nil {
+		return x.SkipBadQuality
+	}
+	return false
+}
+
 type CreateAlertRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AlertRule     *AlertRule             `protobuf:"bytes,1,opt,name=alert_rule,json=alertRule,proto3" json:"alert_rule,omitempty"`
@@ -603,7 +991,7 @@ type CreateAlertRuleResponse struct {
 
 func (x *CreateAlertRuleResponse) Reset() {
 	*x = CreateAlertRuleResponse{}
-	mi := &file_alert_service_proto_msgTypes[9]
+	mi := &file_alert_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -615,7 +1003,7 @@ func (x *CreateAlertRuleResponse) String() string {
 func (*CreateAlertRuleResponse) ProtoMessage() {}
 
 func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[9]
+	mi := &file_alert_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -628,7 +1016,7 @@ func (x *CreateAlertRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAlertRuleResponse.ProtoReflect.Descriptor instead.
 func (*CreateAlertRuleResponse) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{9}
+	return file_alert_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CreateAlertRuleResponse) GetAlertRule() *AlertRule {
@@ -647,7 +1035,7 @@ type GetAlertRuleRequest struct {
 
 func (x *GetAlertRuleRequest) Reset() {
 	*x = GetAlertRuleRequest{}
-	mi := &file_alert_service_proto_msgTypes[10]
+	mi := &file_alert_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -659,7 +1047,7 @@ func (x *GetAlertRuleRequest) String() string {
 func (*GetAlertRuleRequest) ProtoMessage() {}
 
 func (x *GetAlertRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[10]
+	mi := &file_alert_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -672,7 +1060,7 @@ func (x *GetAlertRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAlertRuleRequest.ProtoReflect.Descriptor instead.
 func (*GetAlertRuleRequest) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{10}
+	return file_alert_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetAlertRuleRequest) GetId() int64 {
@@ -691,7 +1079,7 @@ type GetAlertRuleResponse struct {
 
 func (x *GetAlertRuleResponse) Reset() {
 	*x = GetAlertRuleResponse{}
-	mi := &file_alert_service_proto_msgTypes[11]
+	mi := &file_alert_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -703,7 +1091,7 @@ func (x *GetAlertRuleResponse) String() string {
 func (*GetAlertRuleResponse) ProtoMessage() {}
 
 func (x *GetAlertRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[11]
+	mi := &file_alert_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -716,7 +1104,7 @@ func (x *GetAlertRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAlertRuleResponse.ProtoReflect.Descriptor instead.
 func (*GetAlertRuleResponse) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{11}
+	return file_alert_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetAlertRuleResponse) GetAlertRule() *AlertRule {
@@ -737,7 +1125,7 @@ type ListAlertRulesRequest struct {
 
 func (x *ListAlertRulesRequest) Reset() {
 	*x = ListAlertRulesRequest{}
-	mi := &file_alert_service_proto_msgTypes[12]
+	mi := &file_alert_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -749,7 +1137,7 @@ func (x *ListAlertRulesRequest) String() string {
 func (*ListAlertRulesRequest) ProtoMessage() {}
 
 func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[12]
+	mi := &file_alert_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -762,7 +1150,7 @@ func (x *ListAlertRulesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAlertRulesRequest.ProtoReflect.Descriptor instead.
 func (*ListAlertRulesRequest) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{12}
+	return file_alert_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListAlertRulesRequest) GetUserId() int64 {
@@ -796,7 +1184,7 @@ type ListAlertRulesResponse struct {
 
 func (x *ListAlertRulesResponse) Reset() {
 	*x = ListAlertRulesResponse{}
-	mi := &file_alert_service_proto_msgTypes[13]
+	mi := &file_alert_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +1196,7 @@ func (x *ListAlertRulesResponse) String() string {
 func (*ListAlertRulesResponse) ProtoMessage() {}
 
 func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[13]
+	mi := &file_alert_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +1209,7 @@ func (x *ListAlertRulesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListAlertRulesResponse.ProtoReflect.Descriptor instead.
 func (*ListAlertRulesResponse) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{13}
+	return file_alert_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListAlertRulesResponse) GetAlertRules() []*AlertRule {
@@ -839,21 +1227,29 @@ func (x *ListAlertRulesResponse) GetTotalCount() int64 {
 }
 
 type UpdateAlertRuleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	SensorId      int64                  `protobuf:"varint,3,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
-	ConditionType string                 `protobuf:"bytes,4,opt,name=condition_type,json=conditionType,proto3" json:"condition_type,omitempty"`
-	Threshold     float64                `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	IsEnabled     bool                   `protobuf:"varint,7,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Id             int64                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SensorId       int64                   `protobuf:"varint,3,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	ConditionType  string                  `protobuf:"bytes,4,opt,name=condition_type,json=conditionType,proto3" json:"condition_type,omitempty"`
+	Threshold      float64                 `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Description    string                  `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	IsEnabled      bool                    `protobuf:"varint,7,opt,name=is_enabled,json=isEnabled,proto3" json:"is_enabled,omitempty"`
+	Channel        string                  `protobuf:"bytes,8,opt,name=channel,proto3" json:"channel,omitempty"`
+	ThresholdHigh  float64                 `protobuf:"fixed64,9,opt,name=threshold_high,json=thresholdHigh,proto3" json:"threshold_high,omitempty"`
+	ForSeconds     int32                   `protobuf:"varint,10,opt,name=for_seconds,json=forSeconds,proto3" json:"for_seconds,omitempty"`
+	ForReadings    int32                   `protobuf:"varint,11,opt,name=for_readings,json=forReadings,proto3" json:"for_readings,omitempty"`
+	ClearThreshold *wrapperspb.DoubleValue `protobuf:"bytes,12,opt,name=clear_threshold,json=clearThreshold,proto3" json:"clear_threshold,omitempty"`
+	Metric         string                  `protobuf:"bytes,13,opt,name=metric,proto3" json:"metric,omitempty"`
+	WindowSeconds  int32                   `protobuf:"varint,14,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	SkipBadQuality bool                    `protobuf:"varint,15,opt,name=skip_bad_quality,json=skipBadQuality,proto3" json:"skip_bad_quality,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *UpdateAlertRuleRequest) Reset() {
 	*x = UpdateAlertRuleRequest{}
-	mi := &file_alert_service_proto_msgTypes[14]
+	mi := &file_alert_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -865,7 +1261,7 @@ func (x *UpdateAlertRuleRequest) String() string {
 func (*UpdateAlertRuleRequest) ProtoMessage() {}
 
 func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[14]
+	mi := &file_alert_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -878,7 +1274,7 @@ func (x *UpdateAlertRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAlertRuleRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAlertRuleRequest) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{14}
+	return file_alert_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateAlertRuleRequest) GetId() int64 {
@@ -930,6 +1326,62 @@ func (x *UpdateAlertRuleRequest) GetIsEnabled() bool {
 	return false
 }
 
+func (x *UpdateAlertRuleRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *UpdateAlertRuleRequest) GetThresholdHigh() float64 {
+	if x != nil {
+		return x.ThresholdHigh
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetForSeconds() int32 {
+	if x != nil {
+		return x.ForSeconds
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetForReadings() int32 {
+	if x != nil {
+		return x.ForReadings
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetClearThreshold() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.ClearThreshold
+	}
+	return nil
+}
+
+func (x *UpdateAlertRuleRequest) GetMetric() string {
+	if x != nil {
+		return x.Metric
+	}
+	return ""
+}
+
+func (x *UpdateAlertRuleRequest) GetWindowSeconds() int32 {
+	if x != nil {
+		return x.WindowSeconds
+	}
+	return 0
+}
+
+func (x *UpdateAlertRuleRequest) GetSkipBadQuality() bool {
+	if x != nil {
+		return x.SkipBadQuality
+	}
+	return false
+}
+
 type UpdateAlertRuleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	AlertRule     *AlertRule             `protobuf:"bytes,1,opt,name=alert_rule,json=alertRule,proto3" json:"alert_rule,omitempty"`
@@ -939,7 +1391,7 @@ type UpdateAlertRuleResponse struct {
 
 func (x *UpdateAlertRuleResponse) Reset() {
 	*x = UpdateAlertRuleResponse{}
-	mi := &file_alert_service_proto_msgTypes[15]
+	mi := &file_alert_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -951,7 +1403,7 @@ func (x *UpdateAlertRuleResponse) String() string {
 func (*UpdateAlertRuleResponse) ProtoMessage() {}
 
 func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[15]
+	mi := &file_alert_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1416,7 @@ func (x *UpdateAlertRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateAlertRuleResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAlertRuleResponse) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{15}
+	return file_alert_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *UpdateAlertRuleResponse) GetAlertRule() *AlertRule {
@@ -983,7 +1435,7 @@ type DeleteAlertRuleRequest struct {
 
 func (x *DeleteAlertRuleRequest) Reset() {
 	*x = DeleteAlertRuleRequest{}
-	mi := &file_alert_service_proto_msgTypes[16]
+	mi := &file_alert_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -995,7 +1447,7 @@ func (x *DeleteAlertRuleRequest) String() string {
 func (*DeleteAlertRuleRequest) ProtoMessage() {}
 
 func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[16]
+	mi := &file_alert_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1008,7 +1460,7 @@ func (x *DeleteAlertRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAlertRuleRequest.ProtoReflect.Descriptor instead.
 func (*DeleteAlertRuleRequest) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{16}
+	return file_alert_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *DeleteAlertRuleRequest) GetId() int64 {
@@ -1026,7 +1478,7 @@ type DeleteAlertRuleResponse struct {
 
 func (x *DeleteAlertRuleResponse) Reset() {
 	*x = DeleteAlertRuleResponse{}
-	mi := &file_alert_service_proto_msgTypes[17]
+	mi := &file_alert_service_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1038,7 +1490,7 @@ func (x *DeleteAlertRuleResponse) String() string {
 func (*DeleteAlertRuleResponse) ProtoMessage() {}
 
 func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_alert_service_proto_msgTypes[17]
+	mi := &file_alert_service_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1051,14 +1503,14 @@ func (x *DeleteAlertRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteAlertRuleResponse.ProtoReflect.Descriptor instead.
 func (*DeleteAlertRuleResponse) Descriptor() ([]byte, []int) {
-	return file_alert_service_proto_rawDescGZIP(), []int{17}
+	return file_alert_service_proto_rawDescGZIP(), []int{21}
 }
 
 var File_alert_service_proto protoreflect.FileDescriptor
 
 const file_alert_service_proto_rawDesc = "" +
 	"\n" +
-	"\x13alert_service.proto\x12\ralert_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd5\x01\n" +
+	"\x13alert_service.proto\x12\ralert_service\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1egoogle/protobuf/wrappers.proto\"\xec\x03\n" +
 	"\x05Alert\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\arule_id\x18\x02 \x01(\x03R\x06ruleId\x12\x1b\n" +
@@ -1066,7 +1518,16 @@ const file_alert_service_proto_rawDesc = "" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12\x14\n" +
 	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x17\n" +
 	"\ais_read\x18\x06 \x01(\bR\x06isRead\x12=\n" +
-	"\ftriggered_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vtriggeredAt\"!\n" +
+	"\ftriggered_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vtriggeredAt\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\x12\x14\n" +
+	"\x05count\x18\t \x01(\x05R\x05count\x12\x1d\n" +
+	"\n" +
+	"last_value\x18\n" +
+	" \x01(\x01R\tlastValue\x12F\n" +
+	"\x11last_triggered_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0flastTriggeredAt\x12C\n" +
+	"\x0facknowledged_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x0eacknowledgedAt\x12;\n" +
+	"\vresolved_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"resolvedAt\"!\n" +
 	"\x0fGetAlertRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\">\n" +
 	"\x10GetAlertResponse\x12*\n" +
@@ -1078,11 +1539,21 @@ const file_alert_service_proto_rawDesc = "" +
 	"\x16MarkAlertAsReadRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
 	"\x17MarkAlertAsReadResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"c\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"B\n" +
+	"\x17AcknowledgeAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"F\n" +
+	"\x18AcknowledgeAlertResponse\x12*\n" +
+	"\x05alert\x18\x01 \x01(\v2\x14.alert_service.AlertR\x05alert\">\n" +
+	"\x13ResolveAlertRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"B\n" +
+	"\x14ResolveAlertResponse\x12*\n" +
+	"\x05alert\x18\x01 \x01(\v2\x14.alert_service.AlertR\x05alert\"c\n" +
 	"\x12ListAlertsResponse\x12,\n" +
 	"\x06alerts\x18\x01 \x03(\v2\x14.alert_service.AlertR\x06alerts\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount\"\xa6\x02\n" +
+	"totalCount\"\xdb\x04\n" +
 	"\tAlertRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -1094,14 +1565,34 @@ const file_alert_service_proto_rawDesc = "" +
 	"is_enabled\x18\a \x01(\bR\tisEnabled\x129\n" +
 	"\n" +
 	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x17\n" +
-	"\auser_id\x18\t \x01(\x03R\x06userId\"\xc9\x01\n" +
+	"\auser_id\x18\t \x01(\x03R\x06userId\x12\x18\n" +
+	"\achannel\x18\n" +
+	" \x01(\tR\achannel\x12%\n" +
+	"\x0ethreshold_high\x18\v \x01(\x01R\rthresholdHigh\x12\x1f\n" +
+	"\vfor_seconds\x18\f \x01(\x05R\n" +
+	"forSeconds\x12!\n" +
+	"\ffor_readings\x18\r \x01(\x05R\vforReadings\x12E\n" +
+	"\x0fclear_threshold\x18\x0e \x01(\v2\x1c.google.protobuf.DoubleValueR\x0eclearThreshold\x12\x16\n" +
+	"\x06metric\x18\x0f \x01(\tR\x06metric\x12%\n" +
+	"\x0ewindow_seconds\x18\x10 \x01(\x05R\rwindowSeconds\x12(\n" +
+	"\x10skip_bad_quality\x18\x11 \x01(\bR\x0eskipBadQuality\"\xfe\x03\n" +
 	"\x16CreateAlertRuleRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
 	"\tsensor_id\x18\x02 \x01(\x03R\bsensorId\x12%\n" +
 	"\x0econdition_type\x18\x03 \x01(\tR\rconditionType\x12\x1c\n" +
 	"\tthreshold\x18\x04 \x01(\x01R\tthreshold\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x17\n" +
-	"\auser_id\x18\x06 \x01(\x03R\x06userId\"R\n" +
+	"\auser_id\x18\x06 \x01(\x03R\x06userId\x12\x18\n" +
+	"\achannel\x18\a \x01(\tR\achannel\x12%\n" +
+	"\x0ethreshold_high\x18\b \x01(\x01R\rthresholdHigh\x12\x1f\n" +
+	"\vfor_seconds\x18\t \x01(\x05R\n" +
+	"forSeconds\x12!\n" +
+	"\ffor_readings\x18\n" +
+	" \x01(\x05R\vforReadings\x12E\n" +
+	"\x0fclear_threshold\x18\v \x01(\v2\x1c.google.protobuf.DoubleValueR\x0eclearThreshold\x12\x16\n" +
+	"\x06metric\x18\f \x01(\tR\x06metric\x12%\n" +
+	"\x0ewindow_seconds\x18\r \x01(\x05R\rwindowSeconds\x12(\n" +
+	"\x10skip_bad_quality\x18\x0e \x01(\bR\x0eskipBadQuality\"R\n" +
 	"\x17CreateAlertRuleResponse\x127\n" +
 	"\n" +
 	"alert_rule\x18\x01 \x01(\v2\x18.alert_service.AlertRuleR\talertRule\"%\n" +
@@ -1118,7 +1609,7 @@ const file_alert_service_proto_rawDesc = "" +
 	"\valert_rules\x18\x01 \x03(\v2\x18.alert_service.AlertRuleR\n" +
 	"alertRules\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x03R\n" +
-	"totalCount\"\xdf\x01\n" +
+	"totalCount\"\x94\x04\n" +
 	"\x16UpdateAlertRuleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1b\n" +
@@ -1127,18 +1618,30 @@ const file_alert_service_proto_rawDesc = "" +
 	"\tthreshold\x18\x05 \x01(\x01R\tthreshold\x12 \n" +
 	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x1d\n" +
 	"\n" +
-	"is_enabled\x18\a \x01(\bR\tisEnabled\"R\n" +
+	"is_enabled\x18\a \x01(\bR\tisEnabled\x12\x18\n" +
+	"\achannel\x18\b \x01(\tR\achannel\x12%\n" +
+	"\x0ethreshold_high\x18\t \x01(\x01R\rthresholdHigh\x12\x1f\n" +
+	"\vfor_seconds\x18\n" +
+	" \x01(\x05R\n" +
+	"forSeconds\x12!\n" +
+	"\ffor_readings\x18\v \x01(\x05R\vforReadings\x12E\n" +
+	"\x0fclear_threshold\x18\f \x01(\v2\x1c.google.protobuf.DoubleValueR\x0eclearThreshold\x12\x16\n" +
+	"\x06metric\x18\r \x01(\tR\x06metric\x12%\n" +
+	"\x0ewindow_seconds\x18\x0e \x01(\x05R\rwindowSeconds\x12(\n" +
+	"\x10skip_bad_quality\x18\x0f \x01(\bR\x0eskipBadQuality\"R\n" +
 	"\x17UpdateAlertRuleResponse\x127\n" +
 	"\n" +
 	"alert_rule\x18\x01 \x01(\v2\x18.alert_service.AlertRuleR\talertRule\"(\n" +
 	"\x16DeleteAlertRuleRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x19\n" +
-	"\x17DeleteAlertRuleResponse2\xfe\x05\n" +
+	"\x17DeleteAlertRuleResponse2\xc0\a\n" +
 	"\fAlertService\x12M\n" +
 	"\bGetAlert\x12\x1e.alert_service.GetAlertRequest\x1a\x1f.alert_service.GetAlertResponse\"\x00\x12S\n" +
 	"\n" +
 	"ListAlerts\x12 .alert_service.ListAlertsRequest\x1a!.alert_service.ListAlertsResponse\"\x00\x12b\n" +
-	"\x0fMarkAlertAsRead\x12%.alert_service.MarkAlertAsReadRequest\x1a&.alert_service.MarkAlertAsReadResponse\"\x00\x12b\n" +
+	"\x0fMarkAlertAsRead\x12%.alert_service.MarkAlertAsReadRequest\x1a&.alert_service.MarkAlertAsReadResponse\"\x00\x12e\n" +
+	"\x10AcknowledgeAlert\x12&.alert_service.AcknowledgeAlertRequest\x1a'.alert_service.AcknowledgeAlertResponse\"\x00\x12Y\n" +
+	"\fResolveAlert\x12\".alert_service.ResolveAlertRequest\x1a#.alert_service.ResolveAlertResponse\"\x00\x12b\n" +
 	"\x0fCreateAlertRule\x12%.alert_service.CreateAlertRuleRequest\x1a&.alert_service.CreateAlertRuleResponse\"\x00\x12Y\n" +
 	"\fGetAlertRule\x12\".alert_service.GetAlertRuleRequest\x1a#.alert_service.GetAlertRuleResponse\"\x00\x12_\n" +
 	"\x0eListAlertRules\x12$.alert_service.ListAlertRulesRequest\x1a%.alert_service.ListAlertRulesResponse\"\x00\x12b\n" +
@@ -1157,58 +1660,75 @@ func file_alert_service_proto_rawDescGZIP() []byte {
 	return file_alert_service_proto_rawDescData
 }
 
-var file_alert_service_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_alert_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_alert_service_proto_goTypes = []any{
-	(*Alert)(nil),                   // 0: alert_service.Alert
-	(*GetAlertRequest)(nil),         // 1: alert_service.GetAlertRequest
-	(*GetAlertResponse)(nil),        // 2: alert_service.GetAlertResponse
-	(*ListAlertsRequest)(nil),       // 3: alert_service.ListAlertsRequest
-	(*MarkAlertAsReadRequest)(nil),  // 4: alert_service.MarkAlertAsReadRequest
-	(*MarkAlertAsReadResponse)(nil), // 5: alert_service.MarkAlertAsReadResponse
-	(*ListAlertsResponse)(nil),      // 6: alert_service.ListAlertsResponse
-	(*AlertRule)(nil),               // 7: alert_service.AlertRule
-	(*CreateAlertRuleRequest)(nil),  // 8: alert_service.CreateAlertRuleRequest
-	(*CreateAlertRuleResponse)(nil), // 9: alert_service.CreateAlertRuleResponse
-	(*GetAlertRuleRequest)(nil),     // 10: alert_service.GetAlertRuleRequest
-	(*GetAlertRuleResponse)(nil),    // 11: alert_service.GetAlertRuleResponse
-	(*ListAlertRulesRequest)(nil),   // 12: alert_service.ListAlertRulesRequest
-	(*ListAlertRulesResponse)(nil),  // 13: alert_service.ListAlertRulesResponse
-	(*UpdateAlertRuleRequest)(nil),  // 14: alert_service.UpdateAlertRuleRequest
-	(*UpdateAlertRuleResponse)(nil), // 15: alert_service.UpdateAlertRuleResponse
-	(*DeleteAlertRuleRequest)(nil),  // 16: alert_service.DeleteAlertRuleRequest
-	(*DeleteAlertRuleResponse)(nil), // 17: alert_service.DeleteAlertRuleResponse
-	(*timestamppb.Timestamp)(nil),   // 18: google.protobuf.Timestamp
+	(*Alert)(nil),                    // 0: alert_service.Alert
+	(*GetAlertRequest)(nil),          // 1: alert_service.GetAlertRequest
+	(*GetAlertResponse)(nil),         // 2: alert_service.GetAlertResponse
+	(*ListAlertsRequest)(nil),        // 3: alert_service.ListAlertsRequest
+	(*MarkAlertAsReadRequest)(nil),   // 4: alert_service.MarkAlertAsReadRequest
+	(*MarkAlertAsReadResponse)(nil),  // 5: alert_service.MarkAlertAsReadResponse
+	(*AcknowledgeAlertRequest)(nil),  // 6: alert_service.AcknowledgeAlertRequest
+	(*AcknowledgeAlertResponse)(nil), // 7: alert_service.AcknowledgeAlertResponse
+	(*ResolveAlertRequest)(nil),      // 8: alert_service.ResolveAlertRequest
+	(*ResolveAlertResponse)(nil),     // 9: alert_service.ResolveAlertResponse
+	(*ListAlertsResponse)(nil),       // 10: alert_service.ListAlertsResponse
+	(*AlertRule)(nil),                // 11: alert_service.AlertRule
+	(*CreateAlertRuleRequest)(nil),   // 12: alert_service.CreateAlertRuleRequest
+	(*CreateAlertRuleResponse)(nil),  // 13: alert_service.CreateAlertRuleResponse
+	(*GetAlertRuleRequest)(nil),      // 14: alert_service.GetAlertRuleRequest
+	(*GetAlertRuleResponse)(nil),     // 15: alert_service.GetAlertRuleResponse
+	(*ListAlertRulesRequest)(nil),    // 16: alert_service.ListAlertRulesRequest
+	(*ListAlertRulesResponse)(nil),   // 17: alert_service.ListAlertRulesResponse
+	(*UpdateAlertRuleRequest)(nil),   // 18: alert_service.UpdateAlertRuleRequest
+	(*UpdateAlertRuleResponse)(nil),  // 19: alert_service.UpdateAlertRuleResponse
+	(*DeleteAlertRuleRequest)(nil),   // 20: alert_service.DeleteAlertRuleRequest
+	(*DeleteAlertRuleResponse)(nil),  // 21: alert_service.DeleteAlertRuleResponse
+	(*timestamppb.Timestamp)(nil),    // 22: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil),   // 23: google.protobuf.DoubleValue
 }
 var file_alert_service_proto_depIdxs = []int32{
-	18, // 0: alert_service.Alert.triggered_at:type_name -> google.protobuf.Timestamp
-	0,  // 1: alert_service.GetAlertResponse.alert:type_name -> alert_service.Alert
-	0,  // 2: alert_service.ListAlertsResponse.alerts:type_name -> alert_service.Alert
-	18, // 3: alert_service.AlertRule.created_at:type_name -> google.protobuf.Timestamp
-	7,  // 4: alert_service.CreateAlertRuleResponse.alert_rule:type_name -> alert_service.AlertRule
-	7,  // 5: alert_service.GetAlertRuleResponse.alert_rule:type_name -> alert_service.AlertRule
-	7,  // 6: alert_service.ListAlertRulesResponse.alert_rules:type_name -> alert_service.AlertRule
-	7,  // 7: alert_service.UpdateAlertRuleResponse.alert_rule:type_name -> alert_service.AlertRule
-	1,  // 8: alert_service.AlertService.GetAlert:input_type -> alert_service.GetAlertRequest
-	3,  // 9: alert_service.AlertService.ListAlerts:input_type -> alert_service.ListAlertsRequest
-	4,  // 10: alert_service.AlertService.MarkAlertAsRead:input_type -> alert_service.MarkAlertAsReadRequest
-	8,  // 11: alert_service.AlertService.CreateAlertRule:input_type -> alert_service.CreateAlertRuleRequest
-	10, // 12: alert_service.AlertService.GetAlertRule:input_type -> alert_service.GetAlertRuleRequest
-	12, // 13: alert_service.AlertService.ListAlertRules:input_type -> alert_service.ListAlertRulesRequest
-	14, // 14: alert_service.AlertService.UpdateAlertRule:input_type -> alert_service.UpdateAlertRuleRequest
-	16, // 15: alert_service.AlertService.DeleteAlertRule:input_type -> alert_service.DeleteAlertRuleRequest
-	2,  // 16: alert_service.AlertService.GetAlert:output_type -> alert_service.GetAlertResponse
-	6,  // 17: alert_service.AlertService.ListAlerts:output_type -> alert_service.ListAlertsResponse
-	5,  // 18: alert_service.AlertService.MarkAlertAsRead:output_type -> alert_service.MarkAlertAsReadResponse
-	9,  // 19: alert_service.AlertService.CreateAlertRule:output_type -> alert_service.CreateAlertRuleResponse
-	11, // 20: alert_service.AlertService.GetAlertRule:output_type -> alert_service.GetAlertRuleResponse
-	13, // 21: alert_service.AlertService.ListAlertRules:output_type -> alert_service.ListAlertRulesResponse
-	15, // 22: alert_service.AlertService.UpdateAlertRule:output_type -> alert_service.UpdateAlertRuleResponse
-	17, // 23: alert_service.AlertService.DeleteAlertRule:output_type -> alert_service.DeleteAlertRuleResponse
-	16, // [16:24] is the sub-list for method output_type
-	8,  // [8:16] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	22, // 0: alert_service.Alert.triggered_at:type_name -> google.protobuf.Timestamp
+	22, // 1: alert_service.Alert.last_triggered_at:type_name -> google.protobuf.Timestamp
+	22, // 2: alert_service.Alert.acknowledged_at:type_name -> google.protobuf.Timestamp
+	22, // 3: alert_service.Alert.resolved_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: alert_service.GetAlertResponse.alert:type_name -> alert_service.Alert
+	0,  // 5: alert_service.AcknowledgeAlertResponse.alert:type_name -> alert_service.Alert
+	0,  // 6: alert_service.ResolveAlertResponse.alert:type_name -> alert_service.Alert
+	0,  // 7: alert_service.ListAlertsResponse.alerts:type_name -> alert_service.Alert
+	22, // 8: alert_service.AlertRule.created_at:type_name -> google.protobuf.Timestamp
+	23, // 9: alert_service.AlertRule.clear_threshold:type_name -> google.protobuf.DoubleValue
+	23, // 10: alert_service.CreateAlertRuleRequest.clear_threshold:type_name -> google.protobuf.DoubleValue
+	11, // 11: alert_service.CreateAlertRuleResponse.alert_rule:type_name -> alert_service.AlertRule
+	11, // 12: alert_service.GetAlertRuleResponse.alert_rule:type_name -> alert_service.AlertRule
+	11, // 13: alert_service.ListAlertRulesResponse.alert_rules:type_name -> alert_service.AlertRule
+	23, // 14: alert_service.UpdateAlertRuleRequest.clear_threshold:type_name -> google.protobuf.DoubleValue
+	11, // 15: alert_service.UpdateAlertRuleResponse.alert_rule:type_name -> alert_service.AlertRule
+	1,  // 16: alert_service.AlertService.GetAlert:input_type -> alert_service.GetAlertRequest
+	3,  // 17: alert_service.AlertService.ListAlerts:input_type -> alert_service.ListAlertsRequest
+	4,  // 18: alert_service.AlertService.MarkAlertAsRead:input_type -> alert_service.MarkAlertAsReadRequest
+	6,  // 19: alert_service.AlertService.AcknowledgeAlert:input_type -> alert_service.AcknowledgeAlertRequest
+	8,  // 20: alert_service.AlertService.ResolveAlert:input_type -> alert_service.ResolveAlertRequest
+	12, // 21: alert_service.AlertService.CreateAlertRule:input_type -> alert_service.CreateAlertRuleRequest
+	14, // 22: alert_service.AlertService.GetAlertRule:input_type -> alert_service.GetAlertRuleRequest
+	16, // 23: alert_service.AlertService.ListAlertRules:input_type -> alert_service.ListAlertRulesRequest
+	18, // 24: alert_service.AlertService.UpdateAlertRule:input_type -> alert_service.UpdateAlertRuleRequest
+	20, // 25: alert_service.AlertService.DeleteAlertRule:input_type -> alert_service.DeleteAlertRuleRequest
+	2,  // 26: alert_service.AlertService.GetAlert:output_type -> alert_service.GetAlertResponse
+	10, // 27: alert_service.AlertService.ListAlerts:output_type -> alert_service.ListAlertsResponse
+	5,  // 28: alert_service.AlertService.MarkAlertAsRead:output_type -> alert_service.MarkAlertAsReadResponse
+	7,  // 29: alert_service.AlertService.AcknowledgeAlert:output_type -> alert_service.AcknowledgeAlertResponse
+	9,  // 30: alert_service.AlertService.ResolveAlert:output_type -> alert_service.ResolveAlertResponse
+	13, // 31: alert_service.AlertService.CreateAlertRule:output_type -> alert_service.CreateAlertRuleResponse
+	15, // 32: alert_service.AlertService.GetAlertRule:output_type -> alert_service.GetAlertRuleResponse
+	17, // 33: alert_service.AlertService.ListAlertRules:output_type -> alert_service.ListAlertRulesResponse
+	19, // 34: alert_service.AlertService.UpdateAlertRule:output_type -> alert_service.UpdateAlertRuleResponse
+	21, // 35: alert_service.AlertService.DeleteAlertRule:output_type -> alert_service.DeleteAlertRuleResponse
+	26, // [26:36] is the sub-list for method output_type
+	16, // [16:26] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_alert_service_proto_init() }
@@ -1222,7 +1742,7 @@ func file_alert_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_alert_service_proto_rawDesc), len(file_alert_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   18,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,14 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AlertService_GetAlert_FullMethodName        = "/alert_service.AlertService/GetAlert"
-	AlertService_ListAlerts_FullMethodName      = "/alert_service.AlertService/ListAlerts"
-	AlertService_MarkAlertAsRead_FullMethodName = "/alert_service.AlertService/MarkAlertAsRead"
-	AlertService_CreateAlertRule_FullMethodName = "/alert_service.AlertService/CreateAlertRule"
-	AlertService_GetAlertRule_FullMethodName    = "/alert_service.AlertService/GetAlertRule"
-	AlertService_ListAlertRules_FullMethodName  = "/alert_service.AlertService/ListAlertRules"
-	AlertService_UpdateAlertRule_FullMethodName = "/alert_service.AlertService/UpdateAlertRule"
-	AlertService_DeleteAlertRule_FullMethodName = "/alert_service.AlertService/DeleteAlertRule"
+	AlertService_GetAlert_FullMethodName         = "/alert_service.AlertService/GetAlert"
+	AlertService_ListAlerts_FullMethodName       = "/alert_service.AlertService/ListAlerts"
+	AlertService_MarkAlertAsRead_FullMethodName  = "/alert_service.AlertService/MarkAlertAsRead"
+	AlertService_AcknowledgeAlert_FullMethodName = "/alert_service.AlertService/AcknowledgeAlert"
+	AlertService_ResolveAlert_FullMethodName     = "/alert_service.AlertService/ResolveAlert"
+	AlertService_CreateAlertRule_FullMethodName  = "/alert_service.AlertService/CreateAlertRule"
+	AlertService_GetAlertRule_FullMethodName     = "/alert_service.AlertService/GetAlertRule"
+	AlertService_ListAlertRules_FullMethodName   = "/alert_service.AlertService/ListAlertRules"
+	AlertService_UpdateAlertRule_FullMethodName  = "/alert_service.AlertService/UpdateAlertRule"
+	AlertService_DeleteAlertRule_FullMethodName  = "/alert_service.AlertService/DeleteAlertRule"
 )
 
 // AlertServiceClient is the client API for AlertService service.
@@ -36,6 +38,8 @@ type AlertServiceClient interface {
 	GetAlert(ctx context.Context, in *GetAlertRequest, opts ...grpc.CallOption) (*GetAlertResponse, error)
 	ListAlerts(ctx context.Context, in *ListAlertsRequest, opts ...grpc.CallOption) (*ListAlertsResponse, error)
 	MarkAlertAsRead(ctx context.Context, in *MarkAlertAsReadRequest, opts ...grpc.CallOption) (*MarkAlertAsReadResponse, error)
+	AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error)
+	ResolveAlert(ctx context.Context, in *ResolveAlertRequest, opts ...grpc.CallOption) (*ResolveAlertResponse, error)
 	CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error)
 	GetAlertRule(ctx context.Context, in *GetAlertRuleRequest, opts ...grpc.CallOption) (*GetAlertRuleResponse, error)
 	ListAlertRules(ctx context.Context, in *ListAlertRulesRequest, opts ...grpc.CallOption) (*ListAlertRulesResponse, error)
@@ -81,6 +85,26 @@ func (c *alertServiceClient) MarkAlertAsRead(ctx context.Context, in *MarkAlertA
 	return out, nil
 }
 
+func (c *alertServiceClient) AcknowledgeAlert(ctx context.Context, in *AcknowledgeAlertRequest, opts ...grpc.CallOption) (*AcknowledgeAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AcknowledgeAlertResponse)
+	err := c.cc.Invoke(ctx, AlertService_AcknowledgeAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *alertServiceClient) ResolveAlert(ctx context.Context, in *ResolveAlertRequest, opts ...grpc.CallOption) (*ResolveAlertResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveAlertResponse)
+	err := c.cc.Invoke(ctx, AlertService_ResolveAlert_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *alertServiceClient) CreateAlertRule(ctx context.Context, in *CreateAlertRuleRequest, opts ...grpc.CallOption) (*CreateAlertRuleResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateAlertRuleResponse)
@@ -138,6 +162,8 @@ type AlertServiceServer interface {
 	GetAlert(context.Context, *GetAlertRequest) (*GetAlertResponse, error)
 	ListAlerts(context.Context, *ListAlertsRequest) (*ListAlertsResponse, error)
 	MarkAlertAsRead(context.Context, *MarkAlertAsReadRequest) (*MarkAlertAsReadResponse, error)
+	AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error)
+	ResolveAlert(context.Context, *ResolveAlertRequest) (*ResolveAlertResponse, error)
 	CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error)
 	GetAlertRule(context.Context, *GetAlertRuleRequest) (*GetAlertRuleResponse, error)
 	ListAlertRules(context.Context, *ListAlertRulesRequest) (*ListAlertRulesResponse, error)
@@ -162,6 +188,12 @@ func (UnimplementedAlertServiceServer) ListAlerts(context.Context, *ListAlertsRe
 func (UnimplementedAlertServiceServer) MarkAlertAsRead(context.Context, *MarkAlertAsReadRequest) (*MarkAlertAsReadResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method MarkAlertAsRead not implemented")
 }
+func (UnimplementedAlertServiceServer) AcknowledgeAlert(context.Context, *AcknowledgeAlertRequest) (*AcknowledgeAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method AcknowledgeAlert not implemented")
+}
+func (UnimplementedAlertServiceServer) ResolveAlert(context.Context, *ResolveAlertRequest) (*ResolveAlertResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResolveAlert not implemented")
+}
 func (UnimplementedAlertServiceServer) CreateAlertRule(context.Context, *CreateAlertRuleRequest) (*CreateAlertRuleResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateAlertRule not implemented")
 }
@@ -252,6 +284,42 @@ func _AlertService_MarkAlertAsRead_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlertService_AcknowledgeAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AcknowledgeAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).AcknowledgeAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_AcknowledgeAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).AcknowledgeAlert(ctx, req.(*AcknowledgeAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlertService_ResolveAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveAlertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlertServiceServer).ResolveAlert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AlertService_ResolveAlert_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlertServiceServer).ResolveAlert(ctx, req.(*ResolveAlertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AlertService_CreateAlertRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAlertRuleRequest)
 	if err := dec(in); err != nil {
@@ -361,6 +429,14 @@ var AlertService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkAlertAsRead",
 			Handler:    _AlertService_MarkAlertAsRead_Handler,
 		},
+		{
+			MethodName: "AcknowledgeAlert",
+			Handler:    _AlertService_AcknowledgeAlert_Handler,
+		},
+		{
+			MethodName: "ResolveAlert",
+			Handler:    _AlertService_ResolveAlert_Handler,
+		},
 		{
 			MethodName: "CreateAlertRule",
 			Handler:    _AlertService_CreateAlertRule_Handler,
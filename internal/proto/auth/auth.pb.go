@@ -23,17 +23,20 @@ const (
 )
 
 type User struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	FirstName     string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
-	LastName      string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
-	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Email     string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	Username  string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	FirstName string                 `protobuf:"bytes,4,opt,name=first_name,json=firstName,proto3" json:"first_name,omitempty"`
+	LastName  string                 `protobuf:"bytes,5,opt,name=last_name,json=lastName,proto3" json:"last_name,omitempty"`
+	Active    bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// unit_preferences maps dimensions to the unit readings are shown in,
+	// e.g. temperature: °F.
+	UnitPreferences map[string]string `protobuf:"bytes,9,rep,name=unit_preferences,json=unitPreferences,proto3" json:"unit_preferences,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
 func (x *User) Reset() {
@@ -122,6 +125,13 @@ func (x *User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *User) GetUnitPreferences() map[string]string {
+	if x != nil {
+		return x.UnitPreferences
+	}
+	return nil
+}
+
 type RegisterRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Email         string                 `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
@@ -474,6 +484,59 @@ func (x *UpdateUserRequest) GetLastName() string {
 	return ""
 }
 
+// SetUnitPreferencesRequest replaces the unit preferences of a user.
+type SetUnitPreferencesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UnitPreferences map[string]string      `protobuf:"bytes,2,rep,name=unit_preferences,json=unitPreferences,proto3" json:"unit_preferences,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetUnitPreferencesRequest) Reset() {
+	*x = SetUnitPreferencesRequest{}
+	mi := &file_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUnitPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUnitPreferencesRequest) ProtoMessage() {}
+
+func (x *SetUnitPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUnitPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*SetUnitPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SetUnitPreferencesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetUnitPreferencesRequest) GetUnitPreferences() map[string]string {
+	if x != nil {
+		return x.UnitPreferences
+	}
+	return nil
+}
+
 type UserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -483,7 +546,7 @@ type UserResponse struct {
 
 func (x *UserResponse) Reset() {
 	*x = UserResponse{}
-	mi := &file_auth_proto_msgTypes[7]
+	mi := &file_auth_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +558,7 @@ func (x *UserResponse) String() string {
 func (*UserResponse) ProtoMessage() {}
 
 func (x *UserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[7]
+	mi := &file_auth_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +571,7 @@ func (x *UserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserResponse.ProtoReflect.Descriptor instead.
 func (*UserResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{7}
+	return file_auth_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserResponse) GetUser() *User {
@@ -527,7 +590,7 @@ type ForgotPasswordRequest struct {
 
 func (x *ForgotPasswordRequest) Reset() {
 	*x = ForgotPasswordRequest{}
-	mi := &file_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +602,7 @@ func (x *ForgotPasswordRequest) String() string {
 func (*ForgotPasswordRequest) ProtoMessage() {}
 
 func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[8]
+	mi := &file_auth_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +615,7 @@ func (x *ForgotPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{8}
+	return file_auth_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ForgotPasswordRequest) GetEmail() string {
@@ -572,7 +635,7 @@ type ForgotPasswordResponse struct {
 
 func (x *ForgotPasswordResponse) Reset() {
 	*x = ForgotPasswordResponse{}
-	mi := &file_auth_proto_msgTypes[9]
+	mi := &file_auth_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -584,7 +647,7 @@ func (x *ForgotPasswordResponse) String() string {
 func (*ForgotPasswordResponse) ProtoMessage() {}
 
 func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[9]
+	mi := &file_auth_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -597,7 +660,7 @@ func (x *ForgotPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ForgotPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ForgotPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{9}
+	return file_auth_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ForgotPasswordResponse) GetSuccess() bool {
@@ -624,7 +687,7 @@ type ResetPasswordRequest struct {
 
 func (x *ResetPasswordRequest) Reset() {
 	*x = ResetPasswordRequest{}
-	mi := &file_auth_proto_msgTypes[10]
+	mi := &file_auth_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +699,7 @@ func (x *ResetPasswordRequest) String() string {
 func (*ResetPasswordRequest) ProtoMessage() {}
 
 func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[10]
+	mi := &file_auth_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +712,7 @@ func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
 func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{10}
+	return file_auth_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ResetPasswordRequest) GetToken() string {
@@ -676,7 +739,7 @@ type ResetPasswordResponse struct {
 
 func (x *ResetPasswordResponse) Reset() {
 	*x = ResetPasswordResponse{}
-	mi := &file_auth_proto_msgTypes[11]
+	mi := &file_auth_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -688,7 +751,7 @@ func (x *ResetPasswordResponse) String() string {
 func (*ResetPasswordResponse) ProtoMessage() {}
 
 func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_proto_msgTypes[11]
+	mi := &file_auth_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +764,7 @@ func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
 func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_auth_proto_rawDescGZIP(), []int{11}
+	return file_auth_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ResetPasswordResponse) GetSuccess() bool {
@@ -723,7 +786,7 @@ var File_auth_proto protoreflect.FileDescriptor
 const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"auth.proto\x12\x04auth\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x02\n" +
+	"auth.proto\x12\x04auth\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x03\n" +
 	"\x04User\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05email\x18\x02 \x01(\tR\x05email\x12\x1a\n" +
@@ -735,7 +798,11 @@ const file_auth_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x9b\x01\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12J\n" +
+	"\x10unit_preferences\x18\t \x03(\v2\x1f.auth.User.UnitPreferencesEntryR\x0funitPreferences\x1aB\n" +
+	"\x14UnitPreferencesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9b\x01\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05email\x18\x01 \x01(\tR\x05email\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
@@ -764,7 +831,13 @@ const file_auth_proto_rawDesc = "" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
 	"first_name\x18\x02 \x01(\tR\tfirstName\x12\x1b\n" +
-	"\tlast_name\x18\x03 \x01(\tR\blastName\".\n" +
+	"\tlast_name\x18\x03 \x01(\tR\blastName\"\xd9\x01\n" +
+	"\x19SetUnitPreferencesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12_\n" +
+	"\x10unit_preferences\x18\x02 \x03(\v24.auth.SetUnitPreferencesRequest.UnitPreferencesEntryR\x0funitPreferences\x1aB\n" +
+	"\x14UnitPreferencesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\".\n" +
 	"\fUserResponse\x12\x1e\n" +
 	"\x04user\x18\x01 \x01(\v2\n" +
 	".auth.UserR\x04user\"-\n" +
@@ -778,13 +851,14 @@ const file_auth_proto_rawDesc = "" +
 	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"K\n" +
 	"\x15ResetPasswordResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage2\x8d\x03\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage2\xda\x03\n" +
 	"\vAuthService\x12;\n" +
 	"\bRegister\x12\x15.auth.RegisterRequest\x1a\x16.auth.RegisterResponse\"\x00\x122\n" +
 	"\x05Login\x12\x12.auth.LoginRequest\x1a\x13.auth.LoginResponse\"\x00\x125\n" +
 	"\aGetUser\x12\x14.auth.GetUserRequest\x1a\x12.auth.UserResponse\"\x00\x12;\n" +
 	"\n" +
-	"UpdateUser\x12\x17.auth.UpdateUserRequest\x1a\x12.auth.UserResponse\"\x00\x12M\n" +
+	"UpdateUser\x12\x17.auth.UpdateUserRequest\x1a\x12.auth.UserResponse\"\x00\x12K\n" +
+	"\x12SetUnitPreferences\x12\x1f.auth.SetUnitPreferencesRequest\x1a\x12.auth.UserResponse\"\x00\x12M\n" +
 	"\x0eForgotPassword\x12\x1b.auth.ForgotPasswordRequest\x1a\x1c.auth.ForgotPasswordResponse\"\x00\x12J\n" +
 	"\rResetPassword\x12\x1a.auth.ResetPasswordRequest\x1a\x1b.auth.ResetPasswordResponse\"\x00B=Z;github.com/skni-kod/iot-monitor-backend/internal/proto/authb\x06proto3"
 
@@ -800,47 +874,54 @@ func file_auth_proto_rawDescGZIP() []byte {
 	return file_auth_proto_rawDescData
 }
 
-var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_auth_proto_goTypes = []any{
-	(*User)(nil),                   // 0: auth.User
-	(*RegisterRequest)(nil),        // 1: auth.RegisterRequest
-	(*RegisterResponse)(nil),       // 2: auth.RegisterResponse
-	(*LoginRequest)(nil),           // 3: auth.LoginRequest
-	(*LoginResponse)(nil),          // 4: auth.LoginResponse
-	(*GetUserRequest)(nil),         // 5: auth.GetUserRequest
-	(*UpdateUserRequest)(nil),      // 6: auth.UpdateUserRequest
-	(*UserResponse)(nil),           // 7: auth.UserResponse
-	(*ForgotPasswordRequest)(nil),  // 8: auth.ForgotPasswordRequest
-	(*ForgotPasswordResponse)(nil), // 9: auth.ForgotPasswordResponse
-	(*ResetPasswordRequest)(nil),   // 10: auth.ResetPasswordRequest
-	(*ResetPasswordResponse)(nil),  // 11: auth.ResetPasswordResponse
-	(*timestamppb.Timestamp)(nil),  // 12: google.protobuf.Timestamp
+	(*User)(nil),                      // 0: auth.User
+	(*RegisterRequest)(nil),           // 1: auth.RegisterRequest
+	(*RegisterResponse)(nil),          // 2: auth.RegisterResponse
+	(*LoginRequest)(nil),              // 3: auth.LoginRequest
+	(*LoginResponse)(nil),             // 4: auth.LoginResponse
+	(*GetUserRequest)(nil),            // 5: auth.GetUserRequest
+	(*UpdateUserRequest)(nil),         // 6: auth.UpdateUserRequest
+	(*SetUnitPreferencesRequest)(nil), // 7: auth.SetUnitPreferencesRequest
+	(*UserResponse)(nil),              // 8: auth.UserResponse
+	(*ForgotPasswordRequest)(nil),     // 9: auth.ForgotPasswordRequest
+	(*ForgotPasswordResponse)(nil),    // 10: auth.ForgotPasswordResponse
+	(*ResetPasswordRequest)(nil),      // 11: auth.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),     // 12: auth.ResetPasswordResponse
+	nil,                               // 13: auth.User.UnitPreferencesEntry
+	nil,                               // 14: auth.SetUnitPreferencesRequest.UnitPreferencesEntry
+	(*timestamppb.Timestamp)(nil),     // 15: google.protobuf.Timestamp
 }
 var file_auth_proto_depIdxs = []int32{
-	12, // 0: auth.User.created_at:type_name -> google.protobuf.Timestamp
-	12, // 1: auth.User.updated_at:type_name -> google.protobuf.Timestamp
-	12, // 2: auth.RegisterResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 3: auth.RegisterResponse.user:type_name -> auth.User
-	12, // 4: auth.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
-	0,  // 5: auth.LoginResponse.user:type_name -> auth.User
-	0,  // 6: auth.UserResponse.user:type_name -> auth.User
-	1,  // 7: auth.AuthService.Register:input_type -> auth.RegisterRequest
-	3,  // 8: auth.AuthService.Login:input_type -> auth.LoginRequest
-	5,  // 9: auth.AuthService.GetUser:input_type -> auth.GetUserRequest
-	6,  // 10: auth.AuthService.UpdateUser:input_type -> auth.UpdateUserRequest
-	8,  // 11: auth.AuthService.ForgotPassword:input_type -> auth.ForgotPasswordRequest
-	10, // 12: auth.AuthService.ResetPassword:input_type -> auth.ResetPasswordRequest
-	2,  // 13: auth.AuthService.Register:output_type -> auth.RegisterResponse
-	4,  // 14: auth.AuthService.Login:output_type -> auth.LoginResponse
-	7,  // 15: auth.AuthService.GetUser:output_type -> auth.UserResponse
-	7,  // 16: auth.AuthService.UpdateUser:output_type -> auth.UserResponse
-	9,  // 17: auth.AuthService.ForgotPassword:output_type -> auth.ForgotPasswordResponse
-	11, // 18: auth.AuthService.ResetPassword:output_type -> auth.ResetPasswordResponse
-	13, // [13:19] is the sub-list for method output_type
-	7,  // [7:13] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	15, // 0: auth.User.created_at:type_name -> google.protobuf.Timestamp
+	15, // 1: auth.User.updated_at:type_name -> google.protobuf.Timestamp
+	13, // 2: auth.User.unit_preferences:type_name -> auth.User.UnitPreferencesEntry
+	15, // 3: auth.RegisterResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 4: auth.RegisterResponse.user:type_name -> auth.User
+	15, // 5: auth.LoginResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0,  // 6: auth.LoginResponse.user:type_name -> auth.User
+	14, // 7: auth.SetUnitPreferencesRequest.unit_preferences:type_name -> auth.SetUnitPreferencesRequest.UnitPreferencesEntry
+	0,  // 8: auth.UserResponse.user:type_name -> auth.User
+	1,  // 9: auth.AuthService.Register:input_type -> auth.RegisterRequest
+	3,  // 10: auth.AuthService.Login:input_type -> auth.LoginRequest
+	5,  // 11: auth.AuthService.GetUser:input_type -> auth.GetUserRequest
+	6,  // 12: auth.AuthService.UpdateUser:input_type -> auth.UpdateUserRequest
+	7,  // 13: auth.AuthService.SetUnitPreferences:input_type -> auth.SetUnitPreferencesRequest
+	9,  // 14: auth.AuthService.ForgotPassword:input_type -> auth.ForgotPasswordRequest
+	11, // 15: auth.AuthService.ResetPassword:input_type -> auth.ResetPasswordRequest
+	2,  // 16: auth.AuthService.Register:output_type -> auth.RegisterResponse
+	4,  // 17: auth.AuthService.Login:output_type -> auth.LoginResponse
+	8,  // 18: auth.AuthService.GetUser:output_type -> auth.UserResponse
+	8,  // 19: auth.AuthService.UpdateUser:output_type -> auth.UserResponse
+	8,  // 20: auth.AuthService.SetUnitPreferences:output_type -> auth.UserResponse
+	10, // 21: auth.AuthService.ForgotPassword:output_type -> auth.ForgotPasswordResponse
+	12, // 22: auth.AuthService.ResetPassword:output_type -> auth.ResetPasswordResponse
+	16, // [16:23] is the sub-list for method output_type
+	9,  // [9:16] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_auth_proto_init() }
@@ -854,7 +935,7 @@ func file_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_proto_rawDesc), len(file_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
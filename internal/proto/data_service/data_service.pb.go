@@ -23,10 +23,14 @@ const (
 )
 
 type StoreReadingRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SensorId      int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
-	Value         float32                `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SensorId       int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Value          float32                `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Quality        string                 `protobuf:"bytes,4,opt,name=quality,proto3" json:"quality,omitempty"`
+	IdempotencyKey string                 `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	// values holds one value per channel for multi-channel sensor types.
+	Values        map[string]float32 `protobuf:"bytes,6,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -82,8 +86,30 @@ func (x *StoreReadingRequest) GetTimestamp() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *StoreReadingRequest) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *StoreReadingRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *StoreReadingRequest) GetValues() map[string]float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
 type StoreReadingResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Duplicate     bool                   `protobuf:"varint,1,opt,name=duplicate,proto3" json:"duplicate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -118,30 +144,34 @@ func (*StoreReadingResponse) Descriptor() ([]byte, []int) {
 	return file_data_service_proto_rawDescGZIP(), []int{1}
 }
 
-type QueryReadingsRequest struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	SensorId            int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
-	StartTime           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime             *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
-	AggregationInterval string                 `protobuf:"bytes,4,opt,name=aggregation_interval,json=aggregationInterval,proto3" json:"aggregation_interval,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+func (x *StoreReadingResponse) GetDuplicate() bool {
+	if x != nil {
+		return x.Duplicate
+	}
+	return false
 }
 
-func (x *QueryReadingsRequest) Reset() {
-	*x = QueryReadingsRequest{}
+type StoreReadingsBatchRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Readings      []*StoreReadingRequest `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StoreReadingsBatchRequest) Reset() {
+	*x = StoreReadingsBatchRequest{}
 	mi := &file_data_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryReadingsRequest) String() string {
+func (x *StoreReadingsBatchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReadingsRequest) ProtoMessage() {}
+func (*StoreReadingsBatchRequest) ProtoMessage() {}
 
-func (x *QueryReadingsRequest) ProtoReflect() protoreflect.Message {
+func (x *StoreReadingsBatchRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_data_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -153,61 +183,41 @@ func (x *QueryReadingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryReadingsRequest.ProtoReflect.Descriptor instead.
-func (*QueryReadingsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StoreReadingsBatchRequest.ProtoReflect.Descriptor instead.
+func (*StoreReadingsBatchRequest) Descriptor() ([]byte, []int) {
 	return file_data_service_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *QueryReadingsRequest) GetSensorId() int64 {
-	if x != nil {
-		return x.SensorId
-	}
-	return 0
-}
-
-func (x *QueryReadingsRequest) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *QueryReadingsRequest) GetEndTime() *timestamppb.Timestamp {
+func (x *StoreReadingsBatchRequest) GetReadings() []*StoreReadingRequest {
 	if x != nil {
-		return x.EndTime
+		return x.Readings
 	}
 	return nil
 }
 
-func (x *QueryReadingsRequest) GetAggregationInterval() string {
-	if x != nil {
-		return x.AggregationInterval
-	}
-	return ""
-}
-
-type DataPoint struct {
+type ReadingError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
-	Value         float32                `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	Index         int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	SensorId      int64                  `protobuf:"varint,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DataPoint) Reset() {
-	*x = DataPoint{}
+func (x *ReadingError) Reset() {
+	*x = ReadingError{}
 	mi := &file_data_service_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DataPoint) String() string {
+func (x *ReadingError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DataPoint) ProtoMessage() {}
+func (*ReadingError) ProtoMessage() {}
 
-func (x *DataPoint) ProtoReflect() protoreflect.Message {
+func (x *ReadingError) ProtoReflect() protoreflect.Message {
 	mi := &file_data_service_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -219,46 +229,56 @@ func (x *DataPoint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DataPoint.ProtoReflect.Descriptor instead.
-func (*DataPoint) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReadingError.ProtoReflect.Descriptor instead.
+func (*ReadingError) Descriptor() ([]byte, []int) {
 	return file_data_service_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *DataPoint) GetTime() *timestamppb.Timestamp {
+func (x *ReadingError) GetIndex() int32 {
 	if x != nil {
-		return x.Time
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-func (x *DataPoint) GetValue() float32 {
+func (x *ReadingError) GetSensorId() int64 {
 	if x != nil {
-		return x.Value
+		return x.SensorId
 	}
 	return 0
 }
 
-type QueryReadingsResponse struct {
+func (x *ReadingError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StoreReadingsBatchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DataPoints    []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	Accepted      int32                  `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Rejected      int32                  `protobuf:"varint,2,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Errors        []*ReadingError        `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Duplicates    int32                  `protobuf:"varint,4,opt,name=duplicates,proto3" json:"duplicates,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueryReadingsResponse) Reset() {
-	*x = QueryReadingsResponse{}
+func (x *StoreReadingsBatchResponse) Reset() {
+	*x = StoreReadingsBatchResponse{}
 	mi := &file_data_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueryReadingsResponse) String() string {
+func (x *StoreReadingsBatchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryReadingsResponse) ProtoMessage() {}
+func (*StoreReadingsBatchResponse) ProtoMessage() {}
 
-func (x *QueryReadingsResponse) ProtoReflect() protoreflect.Message {
+func (x *StoreReadingsBatchResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_data_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -270,89 +290,79 @@ func (x *QueryReadingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueryReadingsResponse.ProtoReflect.Descriptor instead.
-func (*QueryReadingsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StoreReadingsBatchResponse.ProtoReflect.Descriptor instead.
+func (*StoreReadingsBatchResponse) Descriptor() ([]byte, []int) {
 	return file_data_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *QueryReadingsResponse) GetDataPoints() []*DataPoint {
+func (x *StoreReadingsBatchResponse) GetAccepted() int32 {
 	if x != nil {
-		return x.DataPoints
+		return x.Accepted
 	}
-	return nil
-}
-
-type StreamReadingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SensorIds     []int64                `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *StreamReadingsRequest) Reset() {
-	*x = StreamReadingsRequest{}
-	mi := &file_data_service_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *StreamReadingsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return 0
 }
 
-func (*StreamReadingsRequest) ProtoMessage() {}
-
-func (x *StreamReadingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_data_service_proto_msgTypes[5]
+func (x *StoreReadingsBatchResponse) GetRejected() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Rejected
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use StreamReadingsRequest.ProtoReflect.Descriptor instead.
-func (*StreamReadingsRequest) Descriptor() ([]byte, []int) {
-	return file_data_service_proto_rawDescGZIP(), []int{5}
+func (x *StoreReadingsBatchResponse) GetErrors() []*ReadingError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
 }
 
-func (x *StreamReadingsRequest) GetSensorIds() []int64 {
+func (x *StoreReadingsBatchResponse) GetDuplicates() int32 {
 	if x != nil {
-		return x.SensorIds
+		return x.Duplicates
 	}
-	return nil
+	return 0
 }
 
-type ReadingUpdate struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SensorId      int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
-	Value         float32                `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
-	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	SensorName    string                 `protobuf:"bytes,4,opt,name=sensor_name,json=sensorName,proto3" json:"sensor_name,omitempty"`
-	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
-	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+type QueryReadingsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	SensorId            int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	StartTime           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime             *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	AggregationInterval string                 `protobuf:"bytes,4,opt,name=aggregation_interval,json=aggregationInterval,proto3" json:"aggregation_interval,omitempty"`
+	Aggregation         string                 `protobuf:"bytes,5,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// channel selects a channel of a multi-channel sensor; empty reads the primary value.
+	Channel string `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	// fill emits every bucket of an aggregated query, filling buckets without readings
+	// with null, the previous value or a linear interpolation: null, previous or linear.
+	Fill string `protobuf:"bytes,7,opt,name=fill,proto3" json:"fill,omitempty"`
+	// target_unit converts values to other units, e.g. "°F" or "°F,psi" with at most
+	// one unit per dimension. Values whose unit has another dimension are not converted.
+	TargetUnit string `protobuf:"bytes,8,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
+	// page_size bounds the raw readings returned at once. It defaults to 1000 and is
+	// capped at 10000. Aggregated queries are not paginated.
+	PageSize int32 `protobuf:"varint,9,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// page_token is the next_page_token of the previous page. The other fields have to
+	// stay the same between pages.
+	PageToken     string `protobuf:"bytes,10,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReadingUpdate) Reset() {
-	*x = ReadingUpdate{}
-	mi := &file_data_service_proto_msgTypes[6]
+func (x *QueryReadingsRequest) Reset() {
+	*x = QueryReadingsRequest{}
+	mi := &file_data_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReadingUpdate) String() string {
+func (x *QueryReadingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadingUpdate) ProtoMessage() {}
+func (*QueryReadingsRequest) ProtoMessage() {}
 
-func (x *ReadingUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_data_service_proto_msgTypes[6]
+func (x *QueryReadingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -363,119 +373,119 @@ func (x *ReadingUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadingUpdate.ProtoReflect.Descriptor instead.
-func (*ReadingUpdate) Descriptor() ([]byte, []int) {
-	return file_data_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryReadingsRequest.ProtoReflect.Descriptor instead.
+func (*QueryReadingsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *ReadingUpdate) GetSensorId() int64 {
+func (x *QueryReadingsRequest) GetSensorId() int64 {
 	if x != nil {
 		return x.SensorId
 	}
 	return 0
 }
 
-func (x *ReadingUpdate) GetValue() float32 {
+func (x *QueryReadingsRequest) GetStartTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Value
+		return x.StartTime
 	}
-	return 0
+	return nil
 }
 
-func (x *ReadingUpdate) GetTimestamp() *timestamppb.Timestamp {
+func (x *QueryReadingsRequest) GetEndTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Timestamp
+		return x.EndTime
 	}
 	return nil
 }
 
-func (x *ReadingUpdate) GetSensorName() string {
+func (x *QueryReadingsRequest) GetAggregationInterval() string {
 	if x != nil {
-		return x.SensorName
+		return x.AggregationInterval
 	}
 	return ""
 }
 
-func (x *ReadingUpdate) GetLocation() string {
+func (x *QueryReadingsRequest) GetAggregation() string {
 	if x != nil {
-		return x.Location
+		return x.Aggregation
 	}
 	return ""
 }
 
-func (x *ReadingUpdate) GetUnit() string {
+func (x *QueryReadingsRequest) GetChannel() string {
 	if x != nil {
-		return x.Unit
+		return x.Channel
 	}
 	return ""
 }
 
-type LatestReadingsBatchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SensorIds     []int64                `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *LatestReadingsBatchRequest) Reset() {
-	*x = LatestReadingsBatchRequest{}
-	mi := &file_data_service_proto_msgTypes[7]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *LatestReadingsBatchRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QueryReadingsRequest) GetFill() string {
+	if x != nil {
+		return x.Fill
+	}
+	return ""
 }
 
-func (*LatestReadingsBatchRequest) ProtoMessage() {}
-
-func (x *LatestReadingsBatchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_data_service_proto_msgTypes[7]
+func (x *QueryReadingsRequest) GetTargetUnit() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TargetUnit
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use LatestReadingsBatchRequest.ProtoReflect.Descriptor instead.
-func (*LatestReadingsBatchRequest) Descriptor() ([]byte, []int) {
-	return file_data_service_proto_rawDescGZIP(), []int{7}
+func (x *QueryReadingsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
-func (x *LatestReadingsBatchRequest) GetSensorIds() []int64 {
+func (x *QueryReadingsRequest) GetPageToken() string {
 	if x != nil {
-		return x.SensorIds
+		return x.PageToken
 	}
-	return nil
+	return ""
 }
 
-type LatestReadingsBatchResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Readings      []*ReadingUpdate       `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
+type DataPoint struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Time    *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Value   float32                `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	Min     float32                `protobuf:"fixed32,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max     float32                `protobuf:"fixed32,4,opt,name=max,proto3" json:"max,omitempty"`
+	Avg     float32                `protobuf:"fixed32,5,opt,name=avg,proto3" json:"avg,omitempty"`
+	Count   int64                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Quality string                 `protobuf:"bytes,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	Values  map[string]float32     `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	// gap marks a filled bucket without readings.
+	Gap bool `protobuf:"varint,9,opt,name=gap,proto3" json:"gap,omitempty"`
+	// no_value is set when the bucket has no value, e.g. gaps with fill=null.
+	NoValue bool `protobuf:"varint,10,opt,name=no_value,json=noValue,proto3" json:"no_value,omitempty"`
+	// raw_value and raw_values are the values as reported, before calibration. They
+	// are set on raw readings only, calibrated tells whether a calibration applied.
+	RawValue      float32            `protobuf:"fixed32,11,opt,name=raw_value,json=rawValue,proto3" json:"raw_value,omitempty"`
+	RawValues     map[string]float32 `protobuf:"bytes,12,rep,name=raw_values,json=rawValues,proto3" json:"raw_values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	Calibrated    bool               `protobuf:"varint,13,opt,name=calibrated,proto3" json:"calibrated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LatestReadingsBatchResponse) Reset() {
-	*x = LatestReadingsBatchResponse{}
-	mi := &file_data_service_proto_msgTypes[8]
+func (x *DataPoint) Reset() {
+	*x = DataPoint{}
+	mi := &file_data_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LatestReadingsBatchResponse) String() string {
+func (x *DataPoint) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestReadingsBatchResponse) ProtoMessage() {}
+func (*DataPoint) ProtoMessage() {}
 
-func (x *LatestReadingsBatchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_data_service_proto_msgTypes[8]
+func (x *DataPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -486,41 +496,2305 @@ func (x *LatestReadingsBatchResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestReadingsBatchResponse.ProtoReflect.Descriptor instead.
-func (*LatestReadingsBatchResponse) Descriptor() ([]byte, []int) {
-	return file_data_service_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DataPoint.ProtoReflect.Descriptor instead.
+func (*DataPoint) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *LatestReadingsBatchResponse) GetReadings() []*ReadingUpdate {
+func (x *DataPoint) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *DataPoint) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *DataPoint) GetMin() float32 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *DataPoint) GetMax() float32 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *DataPoint) GetAvg() float32 {
+	if x != nil {
+		return x.Avg
+	}
+	return 0
+}
+
+func (x *DataPoint) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DataPoint) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *DataPoint) GetValues() map[string]float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DataPoint) GetGap() bool {
+	if x != nil {
+		return x.Gap
+	}
+	return false
+}
+
+func (x *DataPoint) GetNoValue() bool {
+	if x != nil {
+		return x.NoValue
+	}
+	return false
+}
+
+func (x *DataPoint) GetRawValue() float32 {
+	if x != nil {
+		return x.RawValue
+	}
+	return 0
+}
+
+func (x *DataPoint) GetRawValues() map[string]float32 {
+	if x != nil {
+		return x.RawValues
+	}
+	return nil
+}
+
+func (x *DataPoint) GetCalibrated() bool {
+	if x != nil {
+		return x.Calibrated
+	}
+	return false
+}
+
+type QueryReadingsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	DataPoints []*DataPoint           `protobuf:"bytes,1,rep,name=data_points,json=dataPoints,proto3" json:"data_points,omitempty"`
+	// unit is the unit of the returned values. It is set when a channel or a
+	// target_unit is requested.
+	Unit string `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	// next_page_token fetches the following page of raw readings. It is empty on the
+	// last page.
+	NextPageToken string `protobuf:"bytes,3,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryReadingsResponse) Reset() {
+	*x = QueryReadingsResponse{}
+	mi := &file_data_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryReadingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryReadingsResponse) ProtoMessage() {}
+
+func (x *QueryReadingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryReadingsResponse.ProtoReflect.Descriptor instead.
+func (*QueryReadingsResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryReadingsResponse) GetDataPoints() []*DataPoint {
+	if x != nil {
+		return x.DataPoints
+	}
+	return nil
+}
+
+func (x *QueryReadingsResponse) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *QueryReadingsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type MultiSensorReadingsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SensorIds []int64                `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
+	// sensor_group_id adds every sensor of the group to sensor_ids.
+	SensorGroupId int64                  `protobuf:"varint,2,opt,name=sensor_group_id,json=sensorGroupId,proto3" json:"sensor_group_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// aggregation_interval is the bucket width and is required.
+	AggregationInterval string `protobuf:"bytes,5,opt,name=aggregation_interval,json=aggregationInterval,proto3" json:"aggregation_interval,omitempty"`
+	Aggregation         string `protobuf:"bytes,6,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	Channel             string `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	Fill                string `protobuf:"bytes,8,opt,name=fill,proto3" json:"fill,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MultiSensorReadingsRequest) Reset() {
+	*x = MultiSensorReadingsRequest{}
+	mi := &file_data_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiSensorReadingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSensorReadingsRequest) ProtoMessage() {}
+
+func (x *MultiSensorReadingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSensorReadingsRequest.ProtoReflect.Descriptor instead.
+func (*MultiSensorReadingsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MultiSensorReadingsRequest) GetSensorIds() []int64 {
+	if x != nil {
+		return x.SensorIds
+	}
+	return nil
+}
+
+func (x *MultiSensorReadingsRequest) GetSensorGroupId() int64 {
+	if x != nil {
+		return x.SensorGroupId
+	}
+	return 0
+}
+
+func (x *MultiSensorReadingsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *MultiSensorReadingsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *MultiSensorReadingsRequest) GetAggregationInterval() string {
+	if x != nil {
+		return x.AggregationInterval
+	}
+	return ""
+}
+
+func (x *MultiSensorReadingsRequest) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *MultiSensorReadingsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *MultiSensorReadingsRequest) GetFill() string {
+	if x != nil {
+		return x.Fill
+	}
+	return ""
+}
+
+// MultiSensorRow is one bucket of a multi-sensor query. values is keyed by sensor id;
+// sensors without a value in the bucket are missing.
+type MultiSensorRow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Values        map[int64]float32      `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"varint,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiSensorRow) Reset() {
+	*x = MultiSensorRow{}
+	mi := &file_data_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiSensorRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSensorRow) ProtoMessage() {}
+
+func (x *MultiSensorRow) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSensorRow.ProtoReflect.Descriptor instead.
+func (*MultiSensorRow) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MultiSensorRow) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *MultiSensorRow) GetValues() map[int64]float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type MultiSensorReadingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// sensor_ids are the queried sensors in column order.
+	SensorIds     []int64           `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
+	Rows          []*MultiSensorRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MultiSensorReadingsResponse) Reset() {
+	*x = MultiSensorReadingsResponse{}
+	mi := &file_data_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MultiSensorReadingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MultiSensorReadingsResponse) ProtoMessage() {}
+
+func (x *MultiSensorReadingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MultiSensorReadingsResponse.ProtoReflect.Descriptor instead.
+func (*MultiSensorReadingsResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MultiSensorReadingsResponse) GetSensorIds() []int64 {
+	if x != nil {
+		return x.SensorIds
+	}
+	return nil
+}
+
+func (x *MultiSensorReadingsResponse) GetRows() []*MultiSensorRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+type StreamReadingsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SensorIds []int64                `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
+	Channel   string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	// target_unit converts values like QueryReadingsRequest.target_unit.
+	TargetUnit string `protobuf:"bytes,3,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
+	// min_interval limits updates to one per sensor per interval, e.g. "500ms" or "5s".
+	// Updates arriving in between are combined by downsample.
+	MinInterval string `protobuf:"bytes,4,opt,name=min_interval,json=minInterval,proto3" json:"min_interval,omitempty"`
+	// deadband drops updates whose value, and every channel value, changed by at most
+	// deadband since the last update sent for the sensor. Zero sends every update.
+	Deadband float64 `protobuf:"fixed64,5,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	// downsample combines the updates of a min_interval window: latest (default), avg,
+	// min or max.
+	Downsample    string `protobuf:"bytes,6,opt,name=downsample,proto3" json:"downsample,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamReadingsRequest) Reset() {
+	*x = StreamReadingsRequest{}
+	mi := &file_data_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamReadingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamReadingsRequest) ProtoMessage() {}
+
+func (x *StreamReadingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamReadingsRequest.ProtoReflect.Descriptor instead.
+func (*StreamReadingsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *StreamReadingsRequest) GetSensorIds() []int64 {
+	if x != nil {
+		return x.SensorIds
+	}
+	return nil
+}
+
+func (x *StreamReadingsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *StreamReadingsRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
+func (x *StreamReadingsRequest) GetMinInterval() string {
+	if x != nil {
+		return x.MinInterval
+	}
+	return ""
+}
+
+func (x *StreamReadingsRequest) GetDeadband() float64 {
+	if x != nil {
+		return x.Deadband
+	}
+	return 0
+}
+
+func (x *StreamReadingsRequest) GetDownsample() string {
+	if x != nil {
+		return x.Downsample
+	}
+	return ""
+}
+
+type ReadingUpdate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SensorId      int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Value         float32                `protobuf:"fixed32,2,opt,name=value,proto3" json:"value,omitempty"`
+	Timestamp     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	SensorName    string                 `protobuf:"bytes,4,opt,name=sensor_name,json=sensorName,proto3" json:"sensor_name,omitempty"`
+	Location      string                 `protobuf:"bytes,5,opt,name=location,proto3" json:"location,omitempty"`
+	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	Quality       string                 `protobuf:"bytes,7,opt,name=quality,proto3" json:"quality,omitempty"`
+	Values        map[string]float32     `protobuf:"bytes,8,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed32,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingUpdate) Reset() {
+	*x = ReadingUpdate{}
+	mi := &file_data_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingUpdate) ProtoMessage() {}
+
+func (x *ReadingUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingUpdate.ProtoReflect.Descriptor instead.
+func (*ReadingUpdate) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ReadingUpdate) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *ReadingUpdate) GetValue() float32 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ReadingUpdate) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *ReadingUpdate) GetSensorName() string {
+	if x != nil {
+		return x.SensorName
+	}
+	return ""
+}
+
+func (x *ReadingUpdate) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ReadingUpdate) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *ReadingUpdate) GetQuality() string {
+	if x != nil {
+		return x.Quality
+	}
+	return ""
+}
+
+func (x *ReadingUpdate) GetValues() map[string]float32 {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type LatestReadingsBatchRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SensorIds []int64                `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
+	// target_unit converts values like QueryReadingsRequest.target_unit.
+	TargetUnit    string `protobuf:"bytes,2,opt,name=target_unit,json=targetUnit,proto3" json:"target_unit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatestReadingsBatchRequest) Reset() {
+	*x = LatestReadingsBatchRequest{}
+	mi := &file_data_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatestReadingsBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestReadingsBatchRequest) ProtoMessage() {}
+
+func (x *LatestReadingsBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestReadingsBatchRequest.ProtoReflect.Descriptor instead.
+func (*LatestReadingsBatchRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LatestReadingsBatchRequest) GetSensorIds() []int64 {
+	if x != nil {
+		return x.SensorIds
+	}
+	return nil
+}
+
+func (x *LatestReadingsBatchRequest) GetTargetUnit() string {
+	if x != nil {
+		return x.TargetUnit
+	}
+	return ""
+}
+
+type LatestReadingsBatchResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Readings      []*ReadingUpdate       `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatestReadingsBatchResponse) Reset() {
+	*x = LatestReadingsBatchResponse{}
+	mi := &file_data_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatestReadingsBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestReadingsBatchResponse) ProtoMessage() {}
+
+func (x *LatestReadingsBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestReadingsBatchResponse.ProtoReflect.Descriptor instead.
+func (*LatestReadingsBatchResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LatestReadingsBatchResponse) GetReadings() []*ReadingUpdate {
+	if x != nil {
+		return x.Readings
+	}
+	return nil
+}
+
+type LatestReadingsBySensorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SensorId      int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatestReadingsBySensorRequest) Reset() {
+	*x = LatestReadingsBySensorRequest{}
+	mi := &file_data_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatestReadingsBySensorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestReadingsBySensorRequest) ProtoMessage() {}
+
+func (x *LatestReadingsBySensorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestReadingsBySensorRequest.ProtoReflect.Descriptor instead.
+func (*LatestReadingsBySensorRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LatestReadingsBySensorRequest) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *LatestReadingsBySensorRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type LatestReadingsBySensorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Readings      []*ReadingUpdate       `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LatestReadingsBySensorResponse) Reset() {
+	*x = LatestReadingsBySensorResponse{}
+	mi := &file_data_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LatestReadingsBySensorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LatestReadingsBySensorResponse) ProtoMessage() {}
+
+func (x *LatestReadingsBySensorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LatestReadingsBySensorResponse.ProtoReflect.Descriptor instead.
+func (*LatestReadingsBySensorResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LatestReadingsBySensorResponse) GetReadings() []*ReadingUpdate {
 	if x != nil {
 		return x.Readings
 	}
 	return nil
 }
 
-type LatestReadingsBySensorRequest struct {
+type RetentionPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Scope         string                 `protobuf:"bytes,2,opt,name=scope,proto3" json:"scope,omitempty"`
+	TargetId      int64                  `protobuf:"varint,3,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	RawRetention  string                 `protobuf:"bytes,4,opt,name=raw_retention,json=rawRetention,proto3" json:"raw_retention,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
+	mi := &file_data_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RetentionPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetentionPolicy) ProtoMessage() {}
+
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *RetentionPolicy) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *RetentionPolicy) GetRawRetention() string {
+	if x != nil {
+		return x.RawRetention
+	}
+	return ""
+}
+
+func (x *RetentionPolicy) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RetentionPolicy) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type SetRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Scope         string                 `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	TargetId      int64                  `protobuf:"varint,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	RawRetention  string                 `protobuf:"bytes,3,opt,name=raw_retention,json=rawRetention,proto3" json:"raw_retention,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRetentionPolicyRequest) Reset() {
+	*x = SetRetentionPolicyRequest{}
+	mi := &file_data_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *SetRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetRetentionPolicyRequest) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+func (x *SetRetentionPolicyRequest) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *SetRetentionPolicyRequest) GetRawRetention() string {
+	if x != nil {
+		return x.RawRetention
+	}
+	return ""
+}
+
+type SetRetentionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        *RetentionPolicy       `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRetentionPolicyResponse) Reset() {
+	*x = SetRetentionPolicyResponse{}
+	mi := &file_data_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *SetRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*SetRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SetRetentionPolicyResponse) GetPolicy() *RetentionPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+type ListRetentionPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetentionPoliciesRequest) Reset() {
+	*x = ListRetentionPoliciesRequest{}
+	mi := &file_data_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetentionPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesRequest) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{20}
+}
+
+type ListRetentionPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*RetentionPolicy     `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRetentionPoliciesResponse) Reset() {
+	*x = ListRetentionPoliciesResponse{}
+	mi := &file_data_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRetentionPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRetentionPoliciesResponse) ProtoMessage() {}
+
+func (x *ListRetentionPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRetentionPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*ListRetentionPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListRetentionPoliciesResponse) GetPolicies() []*RetentionPolicy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type DeleteRetentionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRetentionPolicyRequest) Reset() {
+	*x = DeleteRetentionPolicyRequest{}
+	mi := &file_data_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRetentionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetentionPolicyRequest) ProtoMessage() {}
+
+func (x *DeleteRetentionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetentionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeleteRetentionPolicyRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteRetentionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRetentionPolicyResponse) Reset() {
+	*x = DeleteRetentionPolicyResponse{}
+	mi := &file_data_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRetentionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRetentionPolicyResponse) ProtoMessage() {}
+
+func (x *DeleteRetentionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRetentionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{23}
+}
+
+// RecalibrateReadingsRequest recomputes the stored values of a sensor between
+// start_time and end_time from their raw values with the current calibrations. A
+// missing start_time starts at the first reading, a missing end_time ends now.
+type RecalibrateReadingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SensorId      int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalibrateReadingsRequest) Reset() {
+	*x = RecalibrateReadingsRequest{}
+	mi := &file_data_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalibrateReadingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalibrateReadingsRequest) ProtoMessage() {}
+
+func (x *RecalibrateReadingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalibrateReadingsRequest.ProtoReflect.Descriptor instead.
+func (*RecalibrateReadingsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecalibrateReadingsRequest) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *RecalibrateReadingsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *RecalibrateReadingsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+type RecalibrateReadingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// updated counts the readings whose values changed.
+	Updated int64 `protobuf:"varint,1,opt,name=updated,proto3" json:"updated,omitempty"`
+	// skipped counts the readings left alone because the recalibrated value would be
+	// rejected by the range policy of the sensor type.
+	Skipped       int64 `protobuf:"varint,2,opt,name=skipped,proto3" json:"skipped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecalibrateReadingsResponse) Reset() {
+	*x = RecalibrateReadingsResponse{}
+	mi := &file_data_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecalibrateReadingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecalibrateReadingsResponse) ProtoMessage() {}
+
+func (x *RecalibrateReadingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecalibrateReadingsResponse.ProtoReflect.Descriptor instead.
+func (*RecalibrateReadingsResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *RecalibrateReadingsResponse) GetUpdated() int64 {
+	if x != nil {
+		return x.Updated
+	}
+	return 0
+}
+
+func (x *RecalibrateReadingsResponse) GetSkipped() int64 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+// DeleteReadingsRequest deletes the readings of a sensor between start_time and
+// end_time, both inclusive. A missing start_time starts at the first reading, a
+// missing end_time ends now. With a value_operator only readings whose value, or the
+// value of channel, compares true against value are deleted, e.g. gt 1000 removes
+// spikes above 1000.
+type DeleteReadingsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SensorId  int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// value_operator is one of lt, lte, gt, gte, eq or ne.
+	ValueOperator string  `protobuf:"bytes,4,opt,name=value_operator,json=valueOperator,proto3" json:"value_operator,omitempty"`
+	Value         float64 `protobuf:"fixed64,5,opt,name=value,proto3" json:"value,omitempty"`
+	// channel compares a channel of a multi-channel sensor, it requires value_operator.
+	Channel string `protobuf:"bytes,6,opt,name=channel,proto3" json:"channel,omitempty"`
+	// dry_run counts the matching readings without deleting them.
+	DryRun bool `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// user_id and reason are kept in the audit record of the deletion.
+	UserId        int64  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReadingsRequest) Reset() {
+	*x = DeleteReadingsRequest{}
+	mi := &file_data_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReadingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReadingsRequest) ProtoMessage() {}
+
+func (x *DeleteReadingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReadingsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteReadingsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeleteReadingsRequest) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *DeleteReadingsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *DeleteReadingsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *DeleteReadingsRequest) GetValueOperator() string {
+	if x != nil {
+		return x.ValueOperator
+	}
+	return ""
+}
+
+func (x *DeleteReadingsRequest) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *DeleteReadingsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *DeleteReadingsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeleteReadingsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *DeleteReadingsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteReadingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deleted counts the deleted readings, or the matching ones with dry_run.
+	Deleted int64 `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// deletion is the audit record, it is not set for dry runs.
+	Deletion      *ReadingDeletion `protobuf:"bytes,2,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteReadingsResponse) Reset() {
+	*x = DeleteReadingsResponse{}
+	mi := &file_data_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteReadingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteReadingsResponse) ProtoMessage() {}
+
+func (x *DeleteReadingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteReadingsResponse.ProtoReflect.Descriptor instead.
+func (*DeleteReadingsResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *DeleteReadingsResponse) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *DeleteReadingsResponse) GetDeletion() *ReadingDeletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+// ReadingDeletion is the audit record of a DeleteReadings call.
+type ReadingDeletion struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SensorId      int64                  `protobuf:"varint,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	ValueOperator string                 `protobuf:"bytes,5,opt,name=value_operator,json=valueOperator,proto3" json:"value_operator,omitempty"`
+	Value         float64                `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Channel       string                 `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	Deleted       int64                  `protobuf:"varint,8,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	UserId        int64                  `protobuf:"varint,9,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingDeletion) Reset() {
+	*x = ReadingDeletion{}
+	mi := &file_data_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingDeletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingDeletion) ProtoMessage() {}
+
+func (x *ReadingDeletion) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingDeletion.ProtoReflect.Descriptor instead.
+func (*ReadingDeletion) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ReadingDeletion) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReadingDeletion) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *ReadingDeletion) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ReadingDeletion) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ReadingDeletion) GetValueOperator() string {
+	if x != nil {
+		return x.ValueOperator
+	}
+	return ""
+}
+
+func (x *ReadingDeletion) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *ReadingDeletion) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *ReadingDeletion) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *ReadingDeletion) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ReadingDeletion) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ReadingDeletion) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListReadingDeletionsRequest lists audit records, newest first. A sensor_id of 0
+// lists the deletions of every sensor.
+type ListReadingDeletionsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SensorId int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	// limit defaults to 100 and is capped at 1000.
+	Limit         int64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReadingDeletionsRequest) Reset() {
+	*x = ListReadingDeletionsRequest{}
+	mi := &file_data_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReadingDeletionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReadingDeletionsRequest) ProtoMessage() {}
+
+func (x *ListReadingDeletionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReadingDeletionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReadingDeletionsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListReadingDeletionsRequest) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *ListReadingDeletionsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListReadingDeletionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deletions     []*ReadingDeletion     `protobuf:"bytes,1,rep,name=deletions,proto3" json:"deletions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReadingDeletionsResponse) Reset() {
+	*x = ListReadingDeletionsResponse{}
+	mi := &file_data_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReadingDeletionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReadingDeletionsResponse) ProtoMessage() {}
+
+func (x *ListReadingDeletionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReadingDeletionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReadingDeletionsResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *ListReadingDeletionsResponse) GetDeletions() []*ReadingDeletion {
+	if x != nil {
+		return x.Deletions
+	}
+	return nil
+}
+
+type ExportReadingsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SensorIds []int64                `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
+	// sensor_group_id adds every sensor of the group to sensor_ids.
+	SensorGroupId int64                  `protobuf:"varint,2,opt,name=sensor_group_id,json=sensorGroupId,proto3" json:"sensor_group_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// format is one of csv, ndjson or parquet.
+	Format        string `protobuf:"bytes,5,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReadingsRequest) Reset() {
+	*x = ExportReadingsRequest{}
+	mi := &file_data_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReadingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReadingsRequest) ProtoMessage() {}
+
+func (x *ExportReadingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReadingsRequest.ProtoReflect.Descriptor instead.
+func (*ExportReadingsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ExportReadingsRequest) GetSensorIds() []int64 {
+	if x != nil {
+		return x.SensorIds
+	}
+	return nil
+}
+
+func (x *ExportReadingsRequest) GetSensorGroupId() int64 {
+	if x != nil {
+		return x.SensorGroupId
+	}
+	return 0
+}
+
+func (x *ExportReadingsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ExportReadingsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ExportReadingsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+// ExportChunk is one piece of an export file. filename and content_type are only set
+// on the first chunk.
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filename      string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	ContentType   string                 `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Data          []byte                 `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_data_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ExportChunk) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// ImportOptions describes how the columns of an import CSV map to readings. Columns
+// are referenced by their header name.
+type ImportOptions struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Filename string                 `protobuf:"bytes,1,opt,name=filename,proto3" json:"filename,omitempty"`
+	// sensor_id imports every row for one sensor; otherwise sensor_column names the
+	// column holding the sensor id of each row.
+	SensorId     int64  `protobuf:"varint,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	SensorColumn string `protobuf:"bytes,3,opt,name=sensor_column,json=sensorColumn,proto3" json:"sensor_column,omitempty"`
+	// time_column defaults to "time" and value_column to "value".
+	TimeColumn  string `protobuf:"bytes,4,opt,name=time_column,json=timeColumn,proto3" json:"time_column,omitempty"`
+	ValueColumn string `protobuf:"bytes,5,opt,name=value_column,json=valueColumn,proto3" json:"value_column,omitempty"`
+	// channel_columns maps channel names of multi-channel sensors to columns.
+	ChannelColumns map[string]string `protobuf:"bytes,6,rep,name=channel_columns,json=channelColumns,proto3" json:"channel_columns,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// quality_column is optional; rows without it are imported as good.
+	QualityColumn string `protobuf:"bytes,7,opt,name=quality_column,json=qualityColumn,proto3" json:"quality_column,omitempty"`
+	// time_format is rfc3339 (default), unix, unix_ms or a Go time layout.
+	TimeFormat string `protobuf:"bytes,8,opt,name=time_format,json=timeFormat,proto3" json:"time_format,omitempty"`
+	// timezone is the IANA zone of timestamps without an offset, UTC by default.
+	Timezone string `protobuf:"bytes,9,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// delimiter is a single character, "," by default.
+	Delimiter string `protobuf:"bytes,10,opt,name=delimiter,proto3" json:"delimiter,omitempty"`
+	// user_id owns the job; every sensor of the file has to belong to this user.
+	UserId        int64 `protobuf:"varint,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportOptions) Reset() {
+	*x = ImportOptions{}
+	mi := &file_data_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportOptions) ProtoMessage() {}
+
+func (x *ImportOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportOptions.ProtoReflect.Descriptor instead.
+func (*ImportOptions) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportOptions) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *ImportOptions) GetSensorColumn() string {
+	if x != nil {
+		return x.SensorColumn
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetTimeColumn() string {
+	if x != nil {
+		return x.TimeColumn
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetValueColumn() string {
+	if x != nil {
+		return x.ValueColumn
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetChannelColumns() map[string]string {
+	if x != nil {
+		return x.ChannelColumns
+	}
+	return nil
+}
+
+func (x *ImportOptions) GetQualityColumn() string {
+	if x != nil {
+		return x.QualityColumn
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetTimeFormat() string {
+	if x != nil {
+		return x.TimeFormat
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetDelimiter() string {
+	if x != nil {
+		return x.Delimiter
+	}
+	return ""
+}
+
+func (x *ImportOptions) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// ImportReadingsRequest is one message of an import upload. options is only set on
+// the first message, the following messages carry the CSV file in order.
+type ImportReadingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Options       *ImportOptions         `protobuf:"bytes,1,opt,name=options,proto3" json:"options,omitempty"`
+	Data          []byte                 `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportReadingsRequest) Reset() {
+	*x = ImportReadingsRequest{}
+	mi := &file_data_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportReadingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportReadingsRequest) ProtoMessage() {}
+
+func (x *ImportReadingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportReadingsRequest.ProtoReflect.Descriptor instead.
+func (*ImportReadingsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ImportReadingsRequest) GetOptions() *ImportOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *ImportReadingsRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ImportRowError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// row is the 1-based line number in the file, the header being line 1.
+	Row           int64  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_data_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ImportRowError) GetRow() int64 {
+	if x != nil {
+		return x.Row
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type ImportJob struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// status is one of pending, running, completed or failed.
+	Status        string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Filename      string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	RowsProcessed int64  `protobuf:"varint,4,opt,name=rows_processed,json=rowsProcessed,proto3" json:"rows_processed,omitempty"`
+	RowsImported  int64  `protobuf:"varint,5,opt,name=rows_imported,json=rowsImported,proto3" json:"rows_imported,omitempty"`
+	RowsDuplicate int64  `protobuf:"varint,6,opt,name=rows_duplicate,json=rowsDuplicate,proto3" json:"rows_duplicate,omitempty"`
+	RowsFailed    int64  `protobuf:"varint,7,opt,name=rows_failed,json=rowsFailed,proto3" json:"rows_failed,omitempty"`
+	// errors holds the first row errors of the job.
+	Errors []*ImportRowError `protobuf:"bytes,8,rep,name=errors,proto3" json:"errors,omitempty"`
+	// error is set when the job as a whole failed.
+	Error         string                 `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartedAt     *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportJob) Reset() {
+	*x = ImportJob{}
+	mi := &file_data_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportJob) ProtoMessage() {}
+
+func (x *ImportJob) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportJob.ProtoReflect.Descriptor instead.
+func (*ImportJob) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ImportJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ImportJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ImportJob) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *ImportJob) GetRowsProcessed() int64 {
+	if x != nil {
+		return x.RowsProcessed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetRowsImported() int64 {
+	if x != nil {
+		return x.RowsImported
+	}
+	return 0
+}
+
+func (x *ImportJob) GetRowsDuplicate() int64 {
+	if x != nil {
+		return x.RowsDuplicate
+	}
+	return 0
+}
+
+func (x *ImportJob) GetRowsFailed() int64 {
+	if x != nil {
+		return x.RowsFailed
+	}
+	return 0
+}
+
+func (x *ImportJob) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *ImportJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *ImportJob) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *ImportJob) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+// GetImportJobRequest returns a job only to the user that started it.
+type GetImportJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetImportJobRequest) Reset() {
+	*x = GetImportJobRequest{}
+	mi := &file_data_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetImportJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetImportJobRequest) ProtoMessage() {}
+
+func (x *GetImportJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetImportJobRequest.ProtoReflect.Descriptor instead.
+func (*GetImportJobRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetImportJobRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetImportJobRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ReadingStatisticsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SensorIds []int64                `protobuf:"varint,1,rep,packed,name=sensor_ids,json=sensorIds,proto3" json:"sensor_ids,omitempty"`
+	// sensor_group_id adds every sensor of the group to sensor_ids.
+	SensorGroupId int64                  `protobuf:"varint,2,opt,name=sensor_group_id,json=sensorGroupId,proto3" json:"sensor_group_id,omitempty"`
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// channel selects a channel of multi-channel sensors; empty uses the primary value.
+	Channel       string `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReadingStatisticsRequest) Reset() {
+	*x = ReadingStatisticsRequest{}
+	mi := &file_data_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReadingStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadingStatisticsRequest) ProtoMessage() {}
+
+func (x *ReadingStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadingStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*ReadingStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ReadingStatisticsRequest) GetSensorIds() []int64 {
+	if x != nil {
+		return x.SensorIds
+	}
+	return nil
+}
+
+func (x *ReadingStatisticsRequest) GetSensorGroupId() int64 {
+	if x != nil {
+		return x.SensorGroupId
+	}
+	return 0
+}
+
+func (x *ReadingStatisticsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ReadingStatisticsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ReadingStatisticsRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+// ReadingStatistics summarises the readings of a sensor over a time range. Everything
+// but count is unset when there are no readings.
+type ReadingStatistics struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SensorId      int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
-	Limit         int64                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Min           float64                `protobuf:"fixed64,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max           float64                `protobuf:"fixed64,4,opt,name=max,proto3" json:"max,omitempty"`
+	Mean          float64                `protobuf:"fixed64,5,opt,name=mean,proto3" json:"mean,omitempty"`
+	Stddev        float64                `protobuf:"fixed64,6,opt,name=stddev,proto3" json:"stddev,omitempty"`
+	P50           float64                `protobuf:"fixed64,7,opt,name=p50,proto3" json:"p50,omitempty"`
+	P90           float64                `protobuf:"fixed64,8,opt,name=p90,proto3" json:"p90,omitempty"`
+	P95           float64                `protobuf:"fixed64,9,opt,name=p95,proto3" json:"p95,omitempty"`
+	P99           float64                `protobuf:"fixed64,10,opt,name=p99,proto3" json:"p99,omitempty"`
+	FirstValue    float64                `protobuf:"fixed64,11,opt,name=first_value,json=firstValue,proto3" json:"first_value,omitempty"`
+	FirstTime     *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=first_time,json=firstTime,proto3" json:"first_time,omitempty"`
+	LastValue     float64                `protobuf:"fixed64,13,opt,name=last_value,json=lastValue,proto3" json:"last_value,omitempty"`
+	LastTime      *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=last_time,json=lastTime,proto3" json:"last_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LatestReadingsBySensorRequest) Reset() {
-	*x = LatestReadingsBySensorRequest{}
-	mi := &file_data_service_proto_msgTypes[9]
+func (x *ReadingStatistics) Reset() {
+	*x = ReadingStatistics{}
+	mi := &file_data_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LatestReadingsBySensorRequest) String() string {
+func (x *ReadingStatistics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestReadingsBySensorRequest) ProtoMessage() {}
+func (*ReadingStatistics) ProtoMessage() {}
 
-func (x *LatestReadingsBySensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_data_service_proto_msgTypes[9]
+func (x *ReadingStatistics) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -531,47 +2805,134 @@ func (x *LatestReadingsBySensorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestReadingsBySensorRequest.ProtoReflect.Descriptor instead.
-func (*LatestReadingsBySensorRequest) Descriptor() ([]byte, []int) {
-	return file_data_service_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ReadingStatistics.ProtoReflect.Descriptor instead.
+func (*ReadingStatistics) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *LatestReadingsBySensorRequest) GetSensorId() int64 {
+func (x *ReadingStatistics) GetSensorId() int64 {
 	if x != nil {
 		return x.SensorId
 	}
 	return 0
 }
 
-func (x *LatestReadingsBySensorRequest) GetLimit() int64 {
+func (x *ReadingStatistics) GetCount() int64 {
 	if x != nil {
-		return x.Limit
+		return x.Count
 	}
 	return 0
 }
 
-type LatestReadingsBySensorResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Readings      []*ReadingUpdate       `protobuf:"bytes,1,rep,name=readings,proto3" json:"readings,omitempty"`
+func (x *ReadingStatistics) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetStddev() float64 {
+	if x != nil {
+		return x.Stddev
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetP50() float64 {
+	if x != nil {
+		return x.P50
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetP90() float64 {
+	if x != nil {
+		return x.P90
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetP95() float64 {
+	if x != nil {
+		return x.P95
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetP99() float64 {
+	if x != nil {
+		return x.P99
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetFirstValue() float64 {
+	if x != nil {
+		return x.FirstValue
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetFirstTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstTime
+	}
+	return nil
+}
+
+func (x *ReadingStatistics) GetLastValue() float64 {
+	if x != nil {
+		return x.LastValue
+	}
+	return 0
+}
+
+func (x *ReadingStatistics) GetLastTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastTime
+	}
+	return nil
+}
+
+type ReadingStatisticsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// statistics holds one entry per requested sensor, ordered by sensor id.
+	Statistics []*ReadingStatistics `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	// overall covers the readings of all requested sensors together; its sensor_id is 0.
+	Overall       *ReadingStatistics `protobuf:"bytes,2,opt,name=overall,proto3" json:"overall,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LatestReadingsBySensorResponse) Reset() {
-	*x = LatestReadingsBySensorResponse{}
-	mi := &file_data_service_proto_msgTypes[10]
+func (x *ReadingStatisticsResponse) Reset() {
+	*x = ReadingStatisticsResponse{}
+	mi := &file_data_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LatestReadingsBySensorResponse) String() string {
+func (x *ReadingStatisticsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LatestReadingsBySensorResponse) ProtoMessage() {}
+func (*ReadingStatisticsResponse) ProtoMessage() {}
 
-func (x *LatestReadingsBySensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_data_service_proto_msgTypes[10]
+func (x *ReadingStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -582,43 +2943,326 @@ func (x *LatestReadingsBySensorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LatestReadingsBySensorResponse.ProtoReflect.Descriptor instead.
-func (*LatestReadingsBySensorResponse) Descriptor() ([]byte, []int) {
-	return file_data_service_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ReadingStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*ReadingStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *LatestReadingsBySensorResponse) GetReadings() []*ReadingUpdate {
+func (x *ReadingStatisticsResponse) GetStatistics() []*ReadingStatistics {
 	if x != nil {
-		return x.Readings
+		return x.Statistics
+	}
+	return nil
+}
+
+func (x *ReadingStatisticsResponse) GetOverall() *ReadingStatistics {
+	if x != nil {
+		return x.Overall
+	}
+	return nil
+}
+
+type ListDataGapsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SensorId  int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// expected_interval is the reporting period of the sensor, e.g. 1m. When empty it
+	// is estimated as the median spacing of the readings in the range.
+	ExpectedInterval string `protobuf:"bytes,4,opt,name=expected_interval,json=expectedInterval,proto3" json:"expected_interval,omitempty"`
+	// threshold is the multiple of expected_interval a silence has to exceed to count
+	// as a gap, 3 by default.
+	Threshold     float64 `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDataGapsRequest) Reset() {
+	*x = ListDataGapsRequest{}
+	mi := &file_data_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDataGapsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataGapsRequest) ProtoMessage() {}
+
+func (x *ListDataGapsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataGapsRequest.ProtoReflect.Descriptor instead.
+func (*ListDataGapsRequest) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDataGapsRequest) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *ListDataGapsRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ListDataGapsRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *ListDataGapsRequest) GetExpectedInterval() string {
+	if x != nil {
+		return x.ExpectedInterval
+	}
+	return ""
+}
+
+func (x *ListDataGapsRequest) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+type DataGap struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// start is the last reading before the gap, or start_time.
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// end is the first reading after the gap, or end_time.
+	End             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	DurationSeconds int64                  `protobuf:"varint,3,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DataGap) Reset() {
+	*x = DataGap{}
+	mi := &file_data_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DataGap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataGap) ProtoMessage() {}
+
+func (x *DataGap) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataGap.ProtoReflect.Descriptor instead.
+func (*DataGap) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DataGap) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *DataGap) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *DataGap) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type ListDataGapsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Gaps  []*DataGap             `protobuf:"bytes,1,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	// expected_interval is the reporting period used, as given or estimated.
+	ExpectedInterval string `protobuf:"bytes,2,opt,name=expected_interval,json=expectedInterval,proto3" json:"expected_interval,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ListDataGapsResponse) Reset() {
+	*x = ListDataGapsResponse{}
+	mi := &file_data_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDataGapsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDataGapsResponse) ProtoMessage() {}
+
+func (x *ListDataGapsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_data_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDataGapsResponse.ProtoReflect.Descriptor instead.
+func (*ListDataGapsResponse) Descriptor() ([]byte, []int) {
+	return file_data_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ListDataGapsResponse) GetGaps() []*DataGap {
+	if x != nil {
+		return x.Gaps
 	}
 	return nil
 }
 
+func (x *ListDataGapsResponse) GetExpectedInterval() string {
+	if x != nil {
+		return x.ExpectedInterval
+	}
+	return ""
+}
+
 var File_data_service_proto protoreflect.FileDescriptor
 
 const file_data_service_proto_rawDesc = "" +
 	"\n" +
-	"\x12data_service.proto\x12\fdata_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\x82\x01\n" +
+	"\x12data_service.proto\x12\fdata_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\xc7\x02\n" +
 	"\x13StoreReadingRequest\x12\x1b\n" +
 	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x02R\x05value\x128\n" +
-	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\"\x16\n" +
-	"\x14StoreReadingResponse\"\xd8\x01\n" +
+	"\ttimestamp\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x12\x18\n" +
+	"\aquality\x18\x04 \x01(\tR\aquality\x12'\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tR\x0eidempotencyKey\x12E\n" +
+	"\x06values\x18\x06 \x03(\v2-.data_service.StoreReadingRequest.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"4\n" +
+	"\x14StoreReadingResponse\x12\x1c\n" +
+	"\tduplicate\x18\x01 \x01(\bR\tduplicate\"Z\n" +
+	"\x19StoreReadingsBatchRequest\x12=\n" +
+	"\breadings\x18\x01 \x03(\v2!.data_service.StoreReadingRequestR\breadings\"[\n" +
+	"\fReadingError\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x1b\n" +
+	"\tsensor_id\x18\x02 \x01(\x03R\bsensorId\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\xa8\x01\n" +
+	"\x1aStoreReadingsBatchResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x05R\baccepted\x12\x1a\n" +
+	"\brejected\x18\x02 \x01(\x05R\brejected\x122\n" +
+	"\x06errors\x18\x03 \x03(\v2\x1a.data_service.ReadingErrorR\x06errors\x12\x1e\n" +
+	"\n" +
+	"duplicates\x18\x04 \x01(\x05R\n" +
+	"duplicates\"\x85\x03\n" +
 	"\x14QueryReadingsRequest\x12\x1b\n" +
 	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x129\n" +
 	"\n" +
 	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
 	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x121\n" +
-	"\x14aggregation_interval\x18\x04 \x01(\tR\x13aggregationInterval\"Q\n" +
+	"\x14aggregation_interval\x18\x04 \x01(\tR\x13aggregationInterval\x12 \n" +
+	"\vaggregation\x18\x05 \x01(\tR\vaggregation\x12\x18\n" +
+	"\achannel\x18\x06 \x01(\tR\achannel\x12\x12\n" +
+	"\x04fill\x18\a \x01(\tR\x04fill\x12\x1f\n" +
+	"\vtarget_unit\x18\b \x01(\tR\n" +
+	"targetUnit\x12\x1b\n" +
+	"\tpage_size\x18\t \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\n" +
+	" \x01(\tR\tpageToken\"\x9e\x04\n" +
 	"\tDataPoint\x12.\n" +
 	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\x02R\x05value\"Q\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value\x12\x10\n" +
+	"\x03min\x18\x03 \x01(\x02R\x03min\x12\x10\n" +
+	"\x03max\x18\x04 \x01(\x02R\x03max\x12\x10\n" +
+	"\x03avg\x18\x05 \x01(\x02R\x03avg\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x03R\x05count\x12\x18\n" +
+	"\aquality\x18\a \x01(\tR\aquality\x12;\n" +
+	"\x06values\x18\b \x03(\v2#.data_service.DataPoint.ValuesEntryR\x06values\x12\x10\n" +
+	"\x03gap\x18\t \x01(\bR\x03gap\x12\x19\n" +
+	"\bno_value\x18\n" +
+	" \x01(\bR\anoValue\x12\x1b\n" +
+	"\traw_value\x18\v \x01(\x02R\brawValue\x12E\n" +
+	"\n" +
+	"raw_values\x18\f \x03(\v2&.data_service.DataPoint.RawValuesEntryR\trawValues\x12\x1e\n" +
+	"\n" +
+	"calibrated\x18\r \x01(\bR\n" +
+	"calibrated\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\x1a<\n" +
+	"\x0eRawValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"\x8d\x01\n" +
 	"\x15QueryReadingsResponse\x128\n" +
 	"\vdata_points\x18\x01 \x03(\v2\x17.data_service.DataPointR\n" +
-	"dataPoints\"6\n" +
+	"dataPoints\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12&\n" +
+	"\x0fnext_page_token\x18\x03 \x01(\tR\rnextPageToken\"\xd8\x02\n" +
+	"\x1aMultiSensorReadingsRequest\x12\x1d\n" +
+	"\n" +
+	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\x12&\n" +
+	"\x0fsensor_group_id\x18\x02 \x01(\x03R\rsensorGroupId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x121\n" +
+	"\x14aggregation_interval\x18\x05 \x01(\tR\x13aggregationInterval\x12 \n" +
+	"\vaggregation\x18\x06 \x01(\tR\vaggregation\x12\x18\n" +
+	"\achannel\x18\a \x01(\tR\achannel\x12\x12\n" +
+	"\x04fill\x18\b \x01(\tR\x04fill\"\xbd\x01\n" +
+	"\x0eMultiSensorRow\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12@\n" +
+	"\x06values\x18\x02 \x03(\v2(.data_service.MultiSensorRow.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\x03R\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"n\n" +
+	"\x1bMultiSensorReadingsResponse\x12\x1d\n" +
+	"\n" +
+	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\x120\n" +
+	"\x04rows\x18\x02 \x03(\v2\x1c.data_service.MultiSensorRowR\x04rows\"\xd0\x01\n" +
 	"\x15StreamReadingsRequest\x12\x1d\n" +
 	"\n" +
-	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\"\xcd\x01\n" +
+	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x1f\n" +
+	"\vtarget_unit\x18\x03 \x01(\tR\n" +
+	"targetUnit\x12!\n" +
+	"\fmin_interval\x18\x04 \x01(\tR\vminInterval\x12\x1a\n" +
+	"\bdeadband\x18\x05 \x01(\x01R\bdeadband\x12\x1e\n" +
+	"\n" +
+	"downsample\x18\x06 \x01(\tR\n" +
+	"downsample\"\xe3\x02\n" +
 	"\rReadingUpdate\x12\x1b\n" +
 	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\x02R\x05value\x128\n" +
@@ -626,23 +3270,210 @@ const file_data_service_proto_rawDesc = "" +
 	"\vsensor_name\x18\x04 \x01(\tR\n" +
 	"sensorName\x12\x1a\n" +
 	"\blocation\x18\x05 \x01(\tR\blocation\x12\x12\n" +
-	"\x04unit\x18\x06 \x01(\tR\x04unit\";\n" +
+	"\x04unit\x18\x06 \x01(\tR\x04unit\x12\x18\n" +
+	"\aquality\x18\a \x01(\tR\aquality\x12?\n" +
+	"\x06values\x18\b \x03(\v2'.data_service.ReadingUpdate.ValuesEntryR\x06values\x1a9\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x02R\x05value:\x028\x01\"\\\n" +
 	"\x1aLatestReadingsBatchRequest\x12\x1d\n" +
 	"\n" +
-	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\"V\n" +
+	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\x12\x1f\n" +
+	"\vtarget_unit\x18\x02 \x01(\tR\n" +
+	"targetUnit\"V\n" +
 	"\x1bLatestReadingsBatchResponse\x127\n" +
 	"\breadings\x18\x01 \x03(\v2\x1b.data_service.ReadingUpdateR\breadings\"R\n" +
 	"\x1dLatestReadingsBySensorRequest\x12\x1b\n" +
 	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x03R\x05limit\"Y\n" +
 	"\x1eLatestReadingsBySensorResponse\x127\n" +
-	"\breadings\x18\x01 \x03(\v2\x1b.data_service.ReadingUpdateR\breadings2\x85\x04\n" +
+	"\breadings\x18\x01 \x03(\v2\x1b.data_service.ReadingUpdateR\breadings\"\xef\x01\n" +
+	"\x0fRetentionPolicy\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05scope\x18\x02 \x01(\tR\x05scope\x12\x1b\n" +
+	"\ttarget_id\x18\x03 \x01(\x03R\btargetId\x12#\n" +
+	"\rraw_retention\x18\x04 \x01(\tR\frawRetention\x129\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"s\n" +
+	"\x19SetRetentionPolicyRequest\x12\x14\n" +
+	"\x05scope\x18\x01 \x01(\tR\x05scope\x12\x1b\n" +
+	"\ttarget_id\x18\x02 \x01(\x03R\btargetId\x12#\n" +
+	"\rraw_retention\x18\x03 \x01(\tR\frawRetention\"S\n" +
+	"\x1aSetRetentionPolicyResponse\x125\n" +
+	"\x06policy\x18\x01 \x01(\v2\x1d.data_service.RetentionPolicyR\x06policy\"\x1e\n" +
+	"\x1cListRetentionPoliciesRequest\"Z\n" +
+	"\x1dListRetentionPoliciesResponse\x129\n" +
+	"\bpolicies\x18\x01 \x03(\v2\x1d.data_service.RetentionPolicyR\bpolicies\".\n" +
+	"\x1cDeleteRetentionPolicyRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1f\n" +
+	"\x1dDeleteRetentionPolicyResponse\"\xab\x01\n" +
+	"\x1aRecalibrateReadingsRequest\x12\x1b\n" +
+	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\"Q\n" +
+	"\x1bRecalibrateReadingsResponse\x12\x18\n" +
+	"\aupdated\x18\x01 \x01(\x03R\aupdated\x12\x18\n" +
+	"\askipped\x18\x02 \x01(\x03R\askipped\"\xc7\x02\n" +
+	"\x15DeleteReadingsRequest\x12\x1b\n" +
+	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12%\n" +
+	"\x0evalue_operator\x18\x04 \x01(\tR\rvalueOperator\x12\x14\n" +
+	"\x05value\x18\x05 \x01(\x01R\x05value\x12\x18\n" +
+	"\achannel\x18\x06 \x01(\tR\achannel\x12\x17\n" +
+	"\adry_run\x18\a \x01(\bR\x06dryRun\x12\x17\n" +
+	"\auser_id\x18\b \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\"m\n" +
+	"\x16DeleteReadingsResponse\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\x129\n" +
+	"\bdeletion\x18\x02 \x01(\v2\x1d.data_service.ReadingDeletionR\bdeletion\"\x8d\x03\n" +
+	"\x0fReadingDeletion\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tsensor_id\x18\x02 \x01(\x03R\bsensorId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12%\n" +
+	"\x0evalue_operator\x18\x05 \x01(\tR\rvalueOperator\x12\x14\n" +
+	"\x05value\x18\x06 \x01(\x01R\x05value\x12\x18\n" +
+	"\achannel\x18\a \x01(\tR\achannel\x12\x18\n" +
+	"\adeleted\x18\b \x01(\x03R\adeleted\x12\x17\n" +
+	"\auser_id\x18\t \x01(\x03R\x06userId\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"P\n" +
+	"\x1bListReadingDeletionsRequest\x12\x1b\n" +
+	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x03R\x05limit\"[\n" +
+	"\x1cListReadingDeletionsResponse\x12;\n" +
+	"\tdeletions\x18\x01 \x03(\v2\x1d.data_service.ReadingDeletionR\tdeletions\"\xe8\x01\n" +
+	"\x15ExportReadingsRequest\x12\x1d\n" +
+	"\n" +
+	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\x12&\n" +
+	"\x0fsensor_group_id\x18\x02 \x01(\x03R\rsensorGroupId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06format\x18\x05 \x01(\tR\x06format\"`\n" +
+	"\vExportChunk\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12!\n" +
+	"\fcontent_type\x18\x02 \x01(\tR\vcontentType\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xe9\x03\n" +
+	"\rImportOptions\x12\x1a\n" +
+	"\bfilename\x18\x01 \x01(\tR\bfilename\x12\x1b\n" +
+	"\tsensor_id\x18\x02 \x01(\x03R\bsensorId\x12#\n" +
+	"\rsensor_column\x18\x03 \x01(\tR\fsensorColumn\x12\x1f\n" +
+	"\vtime_column\x18\x04 \x01(\tR\n" +
+	"timeColumn\x12!\n" +
+	"\fvalue_column\x18\x05 \x01(\tR\vvalueColumn\x12X\n" +
+	"\x0fchannel_columns\x18\x06 \x03(\v2/.data_service.ImportOptions.ChannelColumnsEntryR\x0echannelColumns\x12%\n" +
+	"\x0equality_column\x18\a \x01(\tR\rqualityColumn\x12\x1f\n" +
+	"\vtime_format\x18\b \x01(\tR\n" +
+	"timeFormat\x12\x1a\n" +
+	"\btimezone\x18\t \x01(\tR\btimezone\x12\x1c\n" +
+	"\tdelimiter\x18\n" +
+	" \x01(\tR\tdelimiter\x12\x17\n" +
+	"\auser_id\x18\v \x01(\x03R\x06userId\x1aA\n" +
+	"\x13ChannelColumnsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"b\n" +
+	"\x15ImportReadingsRequest\x125\n" +
+	"\aoptions\x18\x01 \x01(\v2\x1b.data_service.ImportOptionsR\aoptions\x12\x12\n" +
+	"\x04data\x18\x02 \x01(\fR\x04data\"<\n" +
+	"\x0eImportRowError\x12\x10\n" +
+	"\x03row\x18\x01 \x01(\x03R\x03row\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe2\x03\n" +
+	"\tImportJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1a\n" +
+	"\bfilename\x18\x03 \x01(\tR\bfilename\x12%\n" +
+	"\x0erows_processed\x18\x04 \x01(\x03R\rrowsProcessed\x12#\n" +
+	"\rrows_imported\x18\x05 \x01(\x03R\frowsImported\x12%\n" +
+	"\x0erows_duplicate\x18\x06 \x01(\x03R\rrowsDuplicate\x12\x1f\n" +
+	"\vrows_failed\x18\a \x01(\x03R\n" +
+	"rowsFailed\x124\n" +
+	"\x06errors\x18\b \x03(\v2\x1c.data_service.ImportRowErrorR\x06errors\x12\x14\n" +
+	"\x05error\x18\t \x01(\tR\x05error\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"started_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\">\n" +
+	"\x13GetImportJobRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"\xed\x01\n" +
+	"\x18ReadingStatisticsRequest\x12\x1d\n" +
+	"\n" +
+	"sensor_ids\x18\x01 \x03(\x03R\tsensorIds\x12&\n" +
+	"\x0fsensor_group_id\x18\x02 \x01(\x03R\rsensorGroupId\x129\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\"\x92\x03\n" +
+	"\x11ReadingStatistics\x12\x1b\n" +
+	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12\x10\n" +
+	"\x03min\x18\x03 \x01(\x01R\x03min\x12\x10\n" +
+	"\x03max\x18\x04 \x01(\x01R\x03max\x12\x12\n" +
+	"\x04mean\x18\x05 \x01(\x01R\x04mean\x12\x16\n" +
+	"\x06stddev\x18\x06 \x01(\x01R\x06stddev\x12\x10\n" +
+	"\x03p50\x18\a \x01(\x01R\x03p50\x12\x10\n" +
+	"\x03p90\x18\b \x01(\x01R\x03p90\x12\x10\n" +
+	"\x03p95\x18\t \x01(\x01R\x03p95\x12\x10\n" +
+	"\x03p99\x18\n" +
+	" \x01(\x01R\x03p99\x12\x1f\n" +
+	"\vfirst_value\x18\v \x01(\x01R\n" +
+	"firstValue\x129\n" +
+	"\n" +
+	"first_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tfirstTime\x12\x1d\n" +
+	"\n" +
+	"last_value\x18\r \x01(\x01R\tlastValue\x127\n" +
+	"\tlast_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\blastTime\"\x97\x01\n" +
+	"\x19ReadingStatisticsResponse\x12?\n" +
+	"\n" +
+	"statistics\x18\x01 \x03(\v2\x1f.data_service.ReadingStatisticsR\n" +
+	"statistics\x129\n" +
+	"\aoverall\x18\x02 \x01(\v2\x1f.data_service.ReadingStatisticsR\aoverall\"\xef\x01\n" +
+	"\x13ListDataGapsRequest\x12\x1b\n" +
+	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x129\n" +
+	"\n" +
+	"start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x125\n" +
+	"\bend_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12+\n" +
+	"\x11expected_interval\x18\x04 \x01(\tR\x10expectedInterval\x12\x1c\n" +
+	"\tthreshold\x18\x05 \x01(\x01R\tthreshold\"\x94\x01\n" +
+	"\aDataGap\x120\n" +
+	"\x05start\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x05start\x12,\n" +
+	"\x03end\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x03end\x12)\n" +
+	"\x10duration_seconds\x18\x03 \x01(\x03R\x0fdurationSeconds\"n\n" +
+	"\x14ListDataGapsResponse\x12)\n" +
+	"\x04gaps\x18\x01 \x03(\v2\x15.data_service.DataGapR\x04gaps\x12+\n" +
+	"\x11expected_interval\x18\x02 \x01(\tR\x10expectedInterval2\x93\x0f\n" +
 	"\vDataService\x12W\n" +
-	"\fStoreReading\x12!.data_service.StoreReadingRequest\x1a\".data_service.StoreReadingResponse\"\x00\x12Z\n" +
-	"\rQueryReadings\x12\".data_service.QueryReadingsRequest\x1a#.data_service.QueryReadingsResponse\"\x00\x12V\n" +
+	"\fStoreReading\x12!.data_service.StoreReadingRequest\x1a\".data_service.StoreReadingResponse\"\x00\x12i\n" +
+	"\x12StoreReadingsBatch\x12'.data_service.StoreReadingsBatchRequest\x1a(.data_service.StoreReadingsBatchResponse\"\x00\x12a\n" +
+	"\x0eIngestReadings\x12!.data_service.StoreReadingRequest\x1a(.data_service.StoreReadingsBatchResponse\"\x00(\x01\x12Z\n" +
+	"\rQueryReadings\x12\".data_service.QueryReadingsRequest\x1a#.data_service.QueryReadingsResponse\"\x00\x12q\n" +
+	"\x18QueryMultiSensorReadings\x12(.data_service.MultiSensorReadingsRequest\x1a).data_service.MultiSensorReadingsResponse\"\x00\x12V\n" +
 	"\x0eStreamReadings\x12#.data_service.StreamReadingsRequest\x1a\x1b.data_service.ReadingUpdate\"\x000\x01\x12o\n" +
 	"\x16GetLatestReadingsBatch\x12(.data_service.LatestReadingsBatchRequest\x1a).data_service.LatestReadingsBatchResponse\"\x00\x12x\n" +
-	"\x19GetLatestReadingsBySensor\x12+.data_service.LatestReadingsBySensorRequest\x1a,.data_service.LatestReadingsBySensorResponse\"\x00BEZCgithub.com/skni-kod/iot-monitor-backend/internal/proto/data_serviceb\x06proto3"
+	"\x19GetLatestReadingsBySensor\x12+.data_service.LatestReadingsBySensorRequest\x1a,.data_service.LatestReadingsBySensorResponse\"\x00\x12W\n" +
+	"\fListDataGaps\x12!.data_service.ListDataGapsRequest\x1a\".data_service.ListDataGapsResponse\"\x00\x12i\n" +
+	"\x14GetReadingStatistics\x12&.data_service.ReadingStatisticsRequest\x1a'.data_service.ReadingStatisticsResponse\"\x00\x12T\n" +
+	"\x0eExportReadings\x12#.data_service.ExportReadingsRequest\x1a\x19.data_service.ExportChunk\"\x000\x01\x12R\n" +
+	"\x0eImportReadings\x12#.data_service.ImportReadingsRequest\x1a\x17.data_service.ImportJob\"\x00(\x01\x12L\n" +
+	"\fGetImportJob\x12!.data_service.GetImportJobRequest\x1a\x17.data_service.ImportJob\"\x00\x12i\n" +
+	"\x12SetRetentionPolicy\x12'.data_service.SetRetentionPolicyRequest\x1a(.data_service.SetRetentionPolicyResponse\"\x00\x12r\n" +
+	"\x15ListRetentionPolicies\x12*.data_service.ListRetentionPoliciesRequest\x1a+.data_service.ListRetentionPoliciesResponse\"\x00\x12r\n" +
+	"\x15DeleteRetentionPolicy\x12*.data_service.DeleteRetentionPolicyRequest\x1a+.data_service.DeleteRetentionPolicyResponse\"\x00\x12l\n" +
+	"\x13RecalibrateReadings\x12(.data_service.RecalibrateReadingsRequest\x1a).data_service.RecalibrateReadingsResponse\"\x00\x12]\n" +
+	"\x0eDeleteReadings\x12#.data_service.DeleteReadingsRequest\x1a$.data_service.DeleteReadingsResponse\"\x00\x12o\n" +
+	"\x14ListReadingDeletions\x12).data_service.ListReadingDeletionsRequest\x1a*.data_service.ListReadingDeletionsResponse\"\x00BEZCgithub.com/skni-kod/iot-monitor-backend/internal/proto/data_serviceb\x06proto3"
 
 var (
 	file_data_service_proto_rawDescOnce sync.Once
@@ -656,45 +3487,155 @@ func file_data_service_proto_rawDescGZIP() []byte {
 	return file_data_service_proto_rawDescData
 }
 
-var file_data_service_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_data_service_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
 var file_data_service_proto_goTypes = []any{
 	(*StoreReadingRequest)(nil),            // 0: data_service.StoreReadingRequest
 	(*StoreReadingResponse)(nil),           // 1: data_service.StoreReadingResponse
-	(*QueryReadingsRequest)(nil),           // 2: data_service.QueryReadingsRequest
-	(*DataPoint)(nil),                      // 3: data_service.DataPoint
-	(*QueryReadingsResponse)(nil),          // 4: data_service.QueryReadingsResponse
-	(*StreamReadingsRequest)(nil),          // 5: data_service.StreamReadingsRequest
-	(*ReadingUpdate)(nil),                  // 6: data_service.ReadingUpdate
-	(*LatestReadingsBatchRequest)(nil),     // 7: data_service.LatestReadingsBatchRequest
-	(*LatestReadingsBatchResponse)(nil),    // 8: data_service.LatestReadingsBatchResponse
-	(*LatestReadingsBySensorRequest)(nil),  // 9: data_service.LatestReadingsBySensorRequest
-	(*LatestReadingsBySensorResponse)(nil), // 10: data_service.LatestReadingsBySensorResponse
-	(*timestamppb.Timestamp)(nil),          // 11: google.protobuf.Timestamp
+	(*StoreReadingsBatchRequest)(nil),      // 2: data_service.StoreReadingsBatchRequest
+	(*ReadingError)(nil),                   // 3: data_service.ReadingError
+	(*StoreReadingsBatchResponse)(nil),     // 4: data_service.StoreReadingsBatchResponse
+	(*QueryReadingsRequest)(nil),           // 5: data_service.QueryReadingsRequest
+	(*DataPoint)(nil),                      // 6: data_service.DataPoint
+	(*QueryReadingsResponse)(nil),          // 7: data_service.QueryReadingsResponse
+	(*MultiSensorReadingsRequest)(nil),     // 8: data_service.MultiSensorReadingsRequest
+	(*MultiSensorRow)(nil),                 // 9: data_service.MultiSensorRow
+	(*MultiSensorReadingsResponse)(nil),    // 10: data_service.MultiSensorReadingsResponse
+	(*StreamReadingsRequest)(nil),          // 11: data_service.StreamReadingsRequest
+	(*ReadingUpdate)(nil),                  // 12: data_service.ReadingUpdate
+	(*LatestReadingsBatchRequest)(nil),     // 13: data_service.LatestReadingsBatchRequest
+	(*LatestReadingsBatchResponse)(nil),    // 14: data_service.LatestReadingsBatchResponse
+	(*LatestReadingsBySensorRequest)(nil),  // 15: data_service.LatestReadingsBySensorRequest
+	(*LatestReadingsBySensorResponse)(nil), // 16: data_service.LatestReadingsBySensorResponse
+	(*RetentionPolicy)(nil),                // 17: data_service.RetentionPolicy
+	(*SetRetentionPolicyRequest)(nil),      // 18: data_service.SetRetentionPolicyRequest
+	(*SetRetentionPolicyResponse)(nil),     // 19: data_service.SetRetentionPolicyResponse
+	(*ListRetentionPoliciesRequest)(nil),   // 20: data_service.ListRetentionPoliciesRequest
+	(*ListRetentionPoliciesResponse)(nil),  // 21: data_service.ListRetentionPoliciesResponse
+	(*DeleteRetentionPolicyRequest)(nil),   // 22: data_service.DeleteRetentionPolicyRequest
+	(*DeleteRetentionPolicyResponse)(nil),  // 23: data_service.DeleteRetentionPolicyResponse
+	(*RecalibrateReadingsRequest)(nil),     // 24: data_service.RecalibrateReadingsRequest
+	(*RecalibrateReadingsResponse)(nil),    // 25: data_service.RecalibrateReadingsResponse
+	(*DeleteReadingsRequest)(nil),          // 26: data_service.DeleteReadingsRequest
+	(*DeleteReadingsResponse)(nil),         // 27: data_service.DeleteReadingsResponse
+	(*ReadingDeletion)(nil),                // 28: data_service.ReadingDeletion
+	(*ListReadingDeletionsRequest)(nil),    // 29: data_service.ListReadingDeletionsRequest
+	(*ListReadingDeletionsResponse)(nil),   // 30: data_service.ListReadingDeletionsResponse
+	(*ExportReadingsRequest)(nil),          // 31: data_service.ExportReadingsRequest
+	(*ExportChunk)(nil),                    // 32: data_service.ExportChunk
+	(*ImportOptions)(nil),                  // 33: data_service.ImportOptions
+	(*ImportReadingsRequest)(nil),          // 34: data_service.ImportReadingsRequest
+	(*ImportRowError)(nil),                 // 35: data_service.ImportRowError
+	(*ImportJob)(nil),                      // 36: data_service.ImportJob
+	(*GetImportJobRequest)(nil),            // 37: data_service.GetImportJobRequest
+	(*ReadingStatisticsRequest)(nil),       // 38: data_service.ReadingStatisticsRequest
+	(*ReadingStatistics)(nil),              // 39: data_service.ReadingStatistics
+	(*ReadingStatisticsResponse)(nil),      // 40: data_service.ReadingStatisticsResponse
+	(*ListDataGapsRequest)(nil),            // 41: data_service.ListDataGapsRequest
+	(*DataGap)(nil),                        // 42: data_service.DataGap
+	(*ListDataGapsResponse)(nil),           // 43: data_service.ListDataGapsResponse
+	nil,                                    // 44: data_service.StoreReadingRequest.ValuesEntry
+	nil,                                    // 45: data_service.DataPoint.ValuesEntry
+	nil,                                    // 46: data_service.DataPoint.RawValuesEntry
+	nil,                                    // 47: data_service.MultiSensorRow.ValuesEntry
+	nil,                                    // 48: data_service.ReadingUpdate.ValuesEntry
+	nil,                                    // 49: data_service.ImportOptions.ChannelColumnsEntry
+	(*timestamppb.Timestamp)(nil),          // 50: google.protobuf.Timestamp
 }
 var file_data_service_proto_depIdxs = []int32{
-	11, // 0: data_service.StoreReadingRequest.timestamp:type_name -> google.protobuf.Timestamp
-	11, // 1: data_service.QueryReadingsRequest.start_time:type_name -> google.protobuf.Timestamp
-	11, // 2: data_service.QueryReadingsRequest.end_time:type_name -> google.protobuf.Timestamp
-	11, // 3: data_service.DataPoint.time:type_name -> google.protobuf.Timestamp
-	3,  // 4: data_service.QueryReadingsResponse.data_points:type_name -> data_service.DataPoint
-	11, // 5: data_service.ReadingUpdate.timestamp:type_name -> google.protobuf.Timestamp
-	6,  // 6: data_service.LatestReadingsBatchResponse.readings:type_name -> data_service.ReadingUpdate
-	6,  // 7: data_service.LatestReadingsBySensorResponse.readings:type_name -> data_service.ReadingUpdate
-	0,  // 8: data_service.DataService.StoreReading:input_type -> data_service.StoreReadingRequest
-	2,  // 9: data_service.DataService.QueryReadings:input_type -> data_service.QueryReadingsRequest
-	5,  // 10: data_service.DataService.StreamReadings:input_type -> data_service.StreamReadingsRequest
-	7,  // 11: data_service.DataService.GetLatestReadingsBatch:input_type -> data_service.LatestReadingsBatchRequest
-	9,  // 12: data_service.DataService.GetLatestReadingsBySensor:input_type -> data_service.LatestReadingsBySensorRequest
-	1,  // 13: data_service.DataService.StoreReading:output_type -> data_service.StoreReadingResponse
-	4,  // 14: data_service.DataService.QueryReadings:output_type -> data_service.QueryReadingsResponse
-	6,  // 15: data_service.DataService.StreamReadings:output_type -> data_service.ReadingUpdate
-	8,  // 16: data_service.DataService.GetLatestReadingsBatch:output_type -> data_service.LatestReadingsBatchResponse
-	10, // 17: data_service.DataService.GetLatestReadingsBySensor:output_type -> data_service.LatestReadingsBySensorResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	50, // 0: data_service.StoreReadingRequest.timestamp:type_name -> google.protobuf.Timestamp
+	44, // 1: data_service.StoreReadingRequest.values:type_name -> data_service.StoreReadingRequest.ValuesEntry
+	0,  // 2: data_service.StoreReadingsBatchRequest.readings:type_name -> data_service.StoreReadingRequest
+	3,  // 3: data_service.StoreReadingsBatchResponse.errors:type_name -> data_service.ReadingError
+	50, // 4: data_service.QueryReadingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 5: data_service.QueryReadingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	50, // 6: data_service.DataPoint.time:type_name -> google.protobuf.Timestamp
+	45, // 7: data_service.DataPoint.values:type_name -> data_service.DataPoint.ValuesEntry
+	46, // 8: data_service.DataPoint.raw_values:type_name -> data_service.DataPoint.RawValuesEntry
+	6,  // 9: data_service.QueryReadingsResponse.data_points:type_name -> data_service.DataPoint
+	50, // 10: data_service.MultiSensorReadingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 11: data_service.MultiSensorReadingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	50, // 12: data_service.MultiSensorRow.time:type_name -> google.protobuf.Timestamp
+	47, // 13: data_service.MultiSensorRow.values:type_name -> data_service.MultiSensorRow.ValuesEntry
+	9,  // 14: data_service.MultiSensorReadingsResponse.rows:type_name -> data_service.MultiSensorRow
+	50, // 15: data_service.ReadingUpdate.timestamp:type_name -> google.protobuf.Timestamp
+	48, // 16: data_service.ReadingUpdate.values:type_name -> data_service.ReadingUpdate.ValuesEntry
+	12, // 17: data_service.LatestReadingsBatchResponse.readings:type_name -> data_service.ReadingUpdate
+	12, // 18: data_service.LatestReadingsBySensorResponse.readings:type_name -> data_service.ReadingUpdate
+	50, // 19: data_service.RetentionPolicy.created_at:type_name -> google.protobuf.Timestamp
+	50, // 20: data_service.RetentionPolicy.updated_at:type_name -> google.protobuf.Timestamp
+	17, // 21: data_service.SetRetentionPolicyResponse.policy:type_name -> data_service.RetentionPolicy
+	17, // 22: data_service.ListRetentionPoliciesResponse.policies:type_name -> data_service.RetentionPolicy
+	50, // 23: data_service.RecalibrateReadingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 24: data_service.RecalibrateReadingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	50, // 25: data_service.DeleteReadingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 26: data_service.DeleteReadingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	28, // 27: data_service.DeleteReadingsResponse.deletion:type_name -> data_service.ReadingDeletion
+	50, // 28: data_service.ReadingDeletion.start_time:type_name -> google.protobuf.Timestamp
+	50, // 29: data_service.ReadingDeletion.end_time:type_name -> google.protobuf.Timestamp
+	50, // 30: data_service.ReadingDeletion.created_at:type_name -> google.protobuf.Timestamp
+	28, // 31: data_service.ListReadingDeletionsResponse.deletions:type_name -> data_service.ReadingDeletion
+	50, // 32: data_service.ExportReadingsRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 33: data_service.ExportReadingsRequest.end_time:type_name -> google.protobuf.Timestamp
+	49, // 34: data_service.ImportOptions.channel_columns:type_name -> data_service.ImportOptions.ChannelColumnsEntry
+	33, // 35: data_service.ImportReadingsRequest.options:type_name -> data_service.ImportOptions
+	35, // 36: data_service.ImportJob.errors:type_name -> data_service.ImportRowError
+	50, // 37: data_service.ImportJob.created_at:type_name -> google.protobuf.Timestamp
+	50, // 38: data_service.ImportJob.started_at:type_name -> google.protobuf.Timestamp
+	50, // 39: data_service.ImportJob.finished_at:type_name -> google.protobuf.Timestamp
+	50, // 40: data_service.ReadingStatisticsRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 41: data_service.ReadingStatisticsRequest.end_time:type_name -> google.protobuf.Timestamp
+	50, // 42: data_service.ReadingStatistics.first_time:type_name -> google.protobuf.Timestamp
+	50, // 43: data_service.ReadingStatistics.last_time:type_name -> google.protobuf.Timestamp
+	39, // 44: data_service.ReadingStatisticsResponse.statistics:type_name -> data_service.ReadingStatistics
+	39, // 45: data_service.ReadingStatisticsResponse.overall:type_name -> data_service.ReadingStatistics
+	50, // 46: data_service.ListDataGapsRequest.start_time:type_name -> google.protobuf.Timestamp
+	50, // 47: data_service.ListDataGapsRequest.end_time:type_name -> google.protobuf.Timestamp
+	50, // 48: data_service.DataGap.start:type_name -> google.protobuf.Timestamp
+	50, // 49: data_service.DataGap.end:type_name -> google.protobuf.Timestamp
+	42, // 50: data_service.ListDataGapsResponse.gaps:type_name -> data_service.DataGap
+	0,  // 51: data_service.DataService.StoreReading:input_type -> data_service.StoreReadingRequest
+	2,  // 52: data_service.DataService.StoreReadingsBatch:input_type -> data_service.StoreReadingsBatchRequest
+	0,  // 53: data_service.DataService.IngestReadings:input_type -> data_service.StoreReadingRequest
+	5,  // 54: data_service.DataService.QueryReadings:input_type -> data_service.QueryReadingsRequest
+	8,  // 55: data_service.DataService.QueryMultiSensorReadings:input_type -> data_service.MultiSensorReadingsRequest
+	11, // 56: data_service.DataService.StreamReadings:input_type -> data_service.StreamReadingsRequest
+	13, // 57: data_service.DataService.GetLatestReadingsBatch:input_type -> data_service.LatestReadingsBatchRequest
+	15, // 58: data_service.DataService.GetLatestReadingsBySensor:input_type -> data_service.LatestReadingsBySensorRequest
+	41, // 59: data_service.DataService.ListDataGaps:input_type -> data_service.ListDataGapsRequest
+	38, // 60: data_service.DataService.GetReadingStatistics:input_type -> data_service.ReadingStatisticsRequest
+	31, // 61: data_service.DataService.ExportReadings:input_type -> data_service.ExportReadingsRequest
+	34, // 62: data_service.DataService.ImportReadings:input_type -> data_service.ImportReadingsRequest
+	37, // 63: data_service.DataService.GetImportJob:input_type -> data_service.GetImportJobRequest
+	18, // 64: data_service.DataService.SetRetentionPolicy:input_type -> data_service.SetRetentionPolicyRequest
+	20, // 65: data_service.DataService.ListRetentionPolicies:input_type -> data_service.ListRetentionPoliciesRequest
+	22, // 66: data_service.DataService.DeleteRetentionPolicy:input_type -> data_service.DeleteRetentionPolicyRequest
+	24, // 67: data_service.DataService.RecalibrateReadings:input_type -> data_service.RecalibrateReadingsRequest
+	26, // 68: data_service.DataService.DeleteReadings:input_type -> data_service.DeleteReadingsRequest
+	29, // 69: data_service.DataService.ListReadingDeletions:input_type -> data_service.ListReadingDeletionsRequest
+	1,  // 70: data_service.DataService.StoreReading:output_type -> data_service.StoreReadingResponse
+	4,  // 71: data_service.DataService.StoreReadingsBatch:output_type -> data_service.StoreReadingsBatchResponse
+	4,  // 72: data_service.DataService.IngestReadings:output_type -> data_service.StoreReadingsBatchResponse
+	7,  // 73: data_service.DataService.QueryReadings:output_type -> data_service.QueryReadingsResponse
+	10, // 74: data_service.DataService.QueryMultiSensorReadings:output_type -> data_service.MultiSensorReadingsResponse
+	12, // 75: data_service.DataService.StreamReadings:output_type -> data_service.ReadingUpdate
+	14, // 76: data_service.DataService.GetLatestReadingsBatch:output_type -> data_service.LatestReadingsBatchResponse
+	16, // 77: data_service.DataService.GetLatestReadingsBySensor:output_type -> data_service.LatestReadingsBySensorResponse
+	43, // 78: data_service.DataService.ListDataGaps:output_type -> data_service.ListDataGapsResponse
+	40, // 79: data_service.DataService.GetReadingStatistics:output_type -> data_service.ReadingStatisticsResponse
+	32, // 80: data_service.DataService.ExportReadings:output_type -> data_service.ExportChunk
+	36, // 81: data_service.DataService.ImportReadings:output_type -> data_service.ImportJob
+	36, // 82: data_service.DataService.GetImportJob:output_type -> data_service.ImportJob
+	19, // 83: data_service.DataService.SetRetentionPolicy:output_type -> data_service.SetRetentionPolicyResponse
+	21, // 84: data_service.DataService.ListRetentionPolicies:output_type -> data_service.ListRetentionPoliciesResponse
+	23, // 85: data_service.DataService.DeleteRetentionPolicy:output_type -> data_service.DeleteRetentionPolicyResponse
+	25, // 86: data_service.DataService.RecalibrateReadings:output_type -> data_service.RecalibrateReadingsResponse
+	27, // 87: data_service.DataService.DeleteReadings:output_type -> data_service.DeleteReadingsResponse
+	30, // 88: data_service.DataService.ListReadingDeletions:output_type -> data_service.ListReadingDeletionsResponse
+	70, // [70:89] is the sub-list for method output_type
+	51, // [51:70] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_data_service_proto_init() }
@@ -708,7 +3649,7 @@ func file_data_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_data_service_proto_rawDesc), len(file_data_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   50,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
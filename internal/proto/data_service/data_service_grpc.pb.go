@@ -20,10 +20,24 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	DataService_StoreReading_FullMethodName              = "/data_service.DataService/StoreReading"
+	DataService_StoreReadingsBatch_FullMethodName        = "/data_service.DataService/StoreReadingsBatch"
+	DataService_IngestReadings_FullMethodName            = "/data_service.DataService/IngestReadings"
 	DataService_QueryReadings_FullMethodName             = "/data_service.DataService/QueryReadings"
+	DataService_QueryMultiSensorReadings_FullMethodName  = "/data_service.DataService/QueryMultiSensorReadings"
 	DataService_StreamReadings_FullMethodName            = "/data_service.DataService/StreamReadings"
 	DataService_GetLatestReadingsBatch_FullMethodName    = "/data_service.DataService/GetLatestReadingsBatch"
 	DataService_GetLatestReadingsBySensor_FullMethodName = "/data_service.DataService/GetLatestReadingsBySensor"
+	DataService_ListDataGaps_FullMethodName              = "/data_service.DataService/ListDataGaps"
+	DataService_GetReadingStatistics_FullMethodName      = "/data_service.DataService/GetReadingStatistics"
+	DataService_ExportReadings_FullMethodName            = "/data_service.DataService/ExportReadings"
+	DataService_ImportReadings_FullMethodName            = "/data_service.DataService/ImportReadings"
+	DataService_GetImportJob_FullMethodName              = "/data_service.DataService/GetImportJob"
+	DataService_SetRetentionPolicy_FullMethodName        = "/data_service.DataService/SetRetentionPolicy"
+	DataService_ListRetentionPolicies_FullMethodName     = "/data_service.DataService/ListRetentionPolicies"
+	DataService_DeleteRetentionPolicy_FullMethodName     = "/data_service.DataService/DeleteRetentionPolicy"
+	DataService_RecalibrateReadings_FullMethodName       = "/data_service.DataService/RecalibrateReadings"
+	DataService_DeleteReadings_FullMethodName            = "/data_service.DataService/DeleteReadings"
+	DataService_ListReadingDeletions_FullMethodName      = "/data_service.DataService/ListReadingDeletions"
 )
 
 // DataServiceClient is the client API for DataService service.
@@ -31,10 +45,24 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DataServiceClient interface {
 	StoreReading(ctx context.Context, in *StoreReadingRequest, opts ...grpc.CallOption) (*StoreReadingResponse, error)
+	StoreReadingsBatch(ctx context.Context, in *StoreReadingsBatchRequest, opts ...grpc.CallOption) (*StoreReadingsBatchResponse, error)
+	IngestReadings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StoreReadingRequest, StoreReadingsBatchResponse], error)
 	QueryReadings(ctx context.Context, in *QueryReadingsRequest, opts ...grpc.CallOption) (*QueryReadingsResponse, error)
+	QueryMultiSensorReadings(ctx context.Context, in *MultiSensorReadingsRequest, opts ...grpc.CallOption) (*MultiSensorReadingsResponse, error)
 	StreamReadings(ctx context.Context, in *StreamReadingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadingUpdate], error)
 	GetLatestReadingsBatch(ctx context.Context, in *LatestReadingsBatchRequest, opts ...grpc.CallOption) (*LatestReadingsBatchResponse, error)
 	GetLatestReadingsBySensor(ctx context.Context, in *LatestReadingsBySensorRequest, opts ...grpc.CallOption) (*LatestReadingsBySensorResponse, error)
+	ListDataGaps(ctx context.Context, in *ListDataGapsRequest, opts ...grpc.CallOption) (*ListDataGapsResponse, error)
+	GetReadingStatistics(ctx context.Context, in *ReadingStatisticsRequest, opts ...grpc.CallOption) (*ReadingStatisticsResponse, error)
+	ExportReadings(ctx context.Context, in *ExportReadingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	ImportReadings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReadingsRequest, ImportJob], error)
+	GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+	ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error)
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error)
+	RecalibrateReadings(ctx context.Context, in *RecalibrateReadingsRequest, opts ...grpc.CallOption) (*RecalibrateReadingsResponse, error)
+	DeleteReadings(ctx context.Context, in *DeleteReadingsRequest, opts ...grpc.CallOption) (*DeleteReadingsResponse, error)
+	ListReadingDeletions(ctx context.Context, in *ListReadingDeletionsRequest, opts ...grpc.CallOption) (*ListReadingDeletionsResponse, error)
 }
 
 type dataServiceClient struct {
@@ -55,6 +83,29 @@ func (c *dataServiceClient) StoreReading(ctx context.Context, in *StoreReadingRe
 	return out, nil
 }
 
+func (c *dataServiceClient) StoreReadingsBatch(ctx context.Context, in *StoreReadingsBatchRequest, opts ...grpc.CallOption) (*StoreReadingsBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StoreReadingsBatchResponse)
+	err := c.cc.Invoke(ctx, DataService_StoreReadingsBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) IngestReadings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[StoreReadingRequest, StoreReadingsBatchResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataService_ServiceDesc.Streams[0], DataService_IngestReadings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StoreReadingRequest, StoreReadingsBatchResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_IngestReadingsClient = grpc.ClientStreamingClient[StoreReadingRequest, StoreReadingsBatchResponse]
+
 func (c *dataServiceClient) QueryReadings(ctx context.Context, in *QueryReadingsRequest, opts ...grpc.CallOption) (*QueryReadingsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(QueryReadingsResponse)
@@ -65,9 +116,19 @@ func (c *dataServiceClient) QueryReadings(ctx context.Context, in *QueryReadings
 	return out, nil
 }
 
+func (c *dataServiceClient) QueryMultiSensorReadings(ctx context.Context, in *MultiSensorReadingsRequest, opts ...grpc.CallOption) (*MultiSensorReadingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MultiSensorReadingsResponse)
+	err := c.cc.Invoke(ctx, DataService_QueryMultiSensorReadings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataServiceClient) StreamReadings(ctx context.Context, in *StreamReadingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ReadingUpdate], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &DataService_ServiceDesc.Streams[0], DataService_StreamReadings_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &DataService_ServiceDesc.Streams[1], DataService_StreamReadings_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -104,15 +165,151 @@ func (c *dataServiceClient) GetLatestReadingsBySensor(ctx context.Context, in *L
 	return out, nil
 }
 
+func (c *dataServiceClient) ListDataGaps(ctx context.Context, in *ListDataGapsRequest, opts ...grpc.CallOption) (*ListDataGapsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDataGapsResponse)
+	err := c.cc.Invoke(ctx, DataService_ListDataGaps_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) GetReadingStatistics(ctx context.Context, in *ReadingStatisticsRequest, opts ...grpc.CallOption) (*ReadingStatisticsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReadingStatisticsResponse)
+	err := c.cc.Invoke(ctx, DataService_GetReadingStatistics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ExportReadings(ctx context.Context, in *ExportReadingsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataService_ServiceDesc.Streams[2], DataService_ExportReadings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReadingsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_ExportReadingsClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *dataServiceClient) ImportReadings(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ImportReadingsRequest, ImportJob], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DataService_ServiceDesc.Streams[3], DataService_ImportReadings_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ImportReadingsRequest, ImportJob]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_ImportReadingsClient = grpc.ClientStreamingClient[ImportReadingsRequest, ImportJob]
+
+func (c *dataServiceClient) GetImportJob(ctx context.Context, in *GetImportJobRequest, opts ...grpc.CallOption) (*ImportJob, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportJob)
+	err := c.cc.Invoke(ctx, DataService_GetImportJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, DataService_SetRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, DataService_ListRetentionPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*DeleteRetentionPolicyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, DataService_DeleteRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) RecalibrateReadings(ctx context.Context, in *RecalibrateReadingsRequest, opts ...grpc.CallOption) (*RecalibrateReadingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecalibrateReadingsResponse)
+	err := c.cc.Invoke(ctx, DataService_RecalibrateReadings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) DeleteReadings(ctx context.Context, in *DeleteReadingsRequest, opts ...grpc.CallOption) (*DeleteReadingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteReadingsResponse)
+	err := c.cc.Invoke(ctx, DataService_DeleteReadings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataServiceClient) ListReadingDeletions(ctx context.Context, in *ListReadingDeletionsRequest, opts ...grpc.CallOption) (*ListReadingDeletionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReadingDeletionsResponse)
+	err := c.cc.Invoke(ctx, DataService_ListReadingDeletions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataServiceServer is the server API for DataService service.
 // All implementations must embed UnimplementedDataServiceServer
 // for forward compatibility.
 type DataServiceServer interface {
 	StoreReading(context.Context, *StoreReadingRequest) (*StoreReadingResponse, error)
+	StoreReadingsBatch(context.Context, *StoreReadingsBatchRequest) (*StoreReadingsBatchResponse, error)
+	IngestReadings(grpc.ClientStreamingServer[StoreReadingRequest, StoreReadingsBatchResponse]) error
 	QueryReadings(context.Context, *QueryReadingsRequest) (*QueryReadingsResponse, error)
+	QueryMultiSensorReadings(context.Context, *MultiSensorReadingsRequest) (*MultiSensorReadingsResponse, error)
 	StreamReadings(*StreamReadingsRequest, grpc.ServerStreamingServer[ReadingUpdate]) error
 	GetLatestReadingsBatch(context.Context, *LatestReadingsBatchRequest) (*LatestReadingsBatchResponse, error)
 	GetLatestReadingsBySensor(context.Context, *LatestReadingsBySensorRequest) (*LatestReadingsBySensorResponse, error)
+	ListDataGaps(context.Context, *ListDataGapsRequest) (*ListDataGapsResponse, error)
+	GetReadingStatistics(context.Context, *ReadingStatisticsRequest) (*ReadingStatisticsResponse, error)
+	ExportReadings(*ExportReadingsRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	ImportReadings(grpc.ClientStreamingServer[ImportReadingsRequest, ImportJob]) error
+	GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
+	ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error)
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error)
+	RecalibrateReadings(context.Context, *RecalibrateReadingsRequest) (*RecalibrateReadingsResponse, error)
+	DeleteReadings(context.Context, *DeleteReadingsRequest) (*DeleteReadingsResponse, error)
+	ListReadingDeletions(context.Context, *ListReadingDeletionsRequest) (*ListReadingDeletionsResponse, error)
 	mustEmbedUnimplementedDataServiceServer()
 }
 
@@ -126,9 +323,18 @@ type UnimplementedDataServiceServer struct{}
 func (UnimplementedDataServiceServer) StoreReading(context.Context, *StoreReadingRequest) (*StoreReadingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method StoreReading not implemented")
 }
+func (UnimplementedDataServiceServer) StoreReadingsBatch(context.Context, *StoreReadingsBatchRequest) (*StoreReadingsBatchResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method StoreReadingsBatch not implemented")
+}
+func (UnimplementedDataServiceServer) IngestReadings(grpc.ClientStreamingServer[StoreReadingRequest, StoreReadingsBatchResponse]) error {
+	return status.Error(codes.Unimplemented, "method IngestReadings not implemented")
+}
 func (UnimplementedDataServiceServer) QueryReadings(context.Context, *QueryReadingsRequest) (*QueryReadingsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryReadings not implemented")
 }
+func (UnimplementedDataServiceServer) QueryMultiSensorReadings(context.Context, *MultiSensorReadingsRequest) (*MultiSensorReadingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method QueryMultiSensorReadings not implemented")
+}
 func (UnimplementedDataServiceServer) StreamReadings(*StreamReadingsRequest, grpc.ServerStreamingServer[ReadingUpdate]) error {
 	return status.Error(codes.Unimplemented, "method StreamReadings not implemented")
 }
@@ -138,6 +344,39 @@ func (UnimplementedDataServiceServer) GetLatestReadingsBatch(context.Context, *L
 func (UnimplementedDataServiceServer) GetLatestReadingsBySensor(context.Context, *LatestReadingsBySensorRequest) (*LatestReadingsBySensorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetLatestReadingsBySensor not implemented")
 }
+func (UnimplementedDataServiceServer) ListDataGaps(context.Context, *ListDataGapsRequest) (*ListDataGapsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDataGaps not implemented")
+}
+func (UnimplementedDataServiceServer) GetReadingStatistics(context.Context, *ReadingStatisticsRequest) (*ReadingStatisticsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetReadingStatistics not implemented")
+}
+func (UnimplementedDataServiceServer) ExportReadings(*ExportReadingsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Error(codes.Unimplemented, "method ExportReadings not implemented")
+}
+func (UnimplementedDataServiceServer) ImportReadings(grpc.ClientStreamingServer[ImportReadingsRequest, ImportJob]) error {
+	return status.Error(codes.Unimplemented, "method ImportReadings not implemented")
+}
+func (UnimplementedDataServiceServer) GetImportJob(context.Context, *GetImportJobRequest) (*ImportJob, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetImportJob not implemented")
+}
+func (UnimplementedDataServiceServer) SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetRetentionPolicy not implemented")
+}
+func (UnimplementedDataServiceServer) ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListRetentionPolicies not implemented")
+}
+func (UnimplementedDataServiceServer) DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*DeleteRetentionPolicyResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
+func (UnimplementedDataServiceServer) RecalibrateReadings(context.Context, *RecalibrateReadingsRequest) (*RecalibrateReadingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecalibrateReadings not implemented")
+}
+func (UnimplementedDataServiceServer) DeleteReadings(context.Context, *DeleteReadingsRequest) (*DeleteReadingsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteReadings not implemented")
+}
+func (UnimplementedDataServiceServer) ListReadingDeletions(context.Context, *ListReadingDeletionsRequest) (*ListReadingDeletionsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListReadingDeletions not implemented")
+}
 func (UnimplementedDataServiceServer) mustEmbedUnimplementedDataServiceServer() {}
 func (UnimplementedDataServiceServer) testEmbeddedByValue()                     {}
 
@@ -177,6 +416,31 @@ func _DataService_StoreReading_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataService_StoreReadingsBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreReadingsBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).StoreReadingsBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_StoreReadingsBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).StoreReadingsBatch(ctx, req.(*StoreReadingsBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_IngestReadings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServiceServer).IngestReadings(&grpc.GenericServerStream[StoreReadingRequest, StoreReadingsBatchResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_IngestReadingsServer = grpc.ClientStreamingServer[StoreReadingRequest, StoreReadingsBatchResponse]
+
 func _DataService_QueryReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryReadingsRequest)
 	if err := dec(in); err != nil {
@@ -195,6 +459,24 @@ func _DataService_QueryReadings_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataService_QueryMultiSensorReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MultiSensorReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).QueryMultiSensorReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_QueryMultiSensorReadings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).QueryMultiSensorReadings(ctx, req.(*MultiSensorReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataService_StreamReadings_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(StreamReadingsRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -242,6 +524,186 @@ func _DataService_GetLatestReadingsBySensor_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataService_ListDataGaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDataGapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListDataGaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListDataGaps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListDataGaps(ctx, req.(*ListDataGapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_GetReadingStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReadingStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetReadingStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetReadingStatistics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetReadingStatistics(ctx, req.(*ReadingStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ExportReadings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReadingsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataServiceServer).ExportReadings(m, &grpc.GenericServerStream[ExportReadingsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_ExportReadingsServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _DataService_ImportReadings_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DataServiceServer).ImportReadings(&grpc.GenericServerStream[ImportReadingsRequest, ImportJob]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DataService_ImportReadingsServer = grpc.ClientStreamingServer[ImportReadingsRequest, ImportJob]
+
+func _DataService_GetImportJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).GetImportJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_GetImportJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).GetImportJob(ctx, req.(*GetImportJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).SetRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_SetRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListRetentionPolicies(ctx, req.(*ListRetentionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).DeleteRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_DeleteRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_RecalibrateReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalibrateReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).RecalibrateReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_RecalibrateReadings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).RecalibrateReadings(ctx, req.(*RecalibrateReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_DeleteReadings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteReadingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).DeleteReadings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_DeleteReadings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).DeleteReadings(ctx, req.(*DeleteReadingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataService_ListReadingDeletions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReadingDeletionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataServiceServer).ListReadingDeletions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DataService_ListReadingDeletions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataServiceServer).ListReadingDeletions(ctx, req.(*ListReadingDeletionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DataService_ServiceDesc is the grpc.ServiceDesc for DataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,10 +715,18 @@ var DataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StoreReading",
 			Handler:    _DataService_StoreReading_Handler,
 		},
+		{
+			MethodName: "StoreReadingsBatch",
+			Handler:    _DataService_StoreReadingsBatch_Handler,
+		},
 		{
 			MethodName: "QueryReadings",
 			Handler:    _DataService_QueryReadings_Handler,
 		},
+		{
+			MethodName: "QueryMultiSensorReadings",
+			Handler:    _DataService_QueryMultiSensorReadings_Handler,
+		},
 		{
 			MethodName: "GetLatestReadingsBatch",
 			Handler:    _DataService_GetLatestReadingsBatch_Handler,
@@ -265,13 +735,64 @@ var DataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLatestReadingsBySensor",
 			Handler:    _DataService_GetLatestReadingsBySensor_Handler,
 		},
+		{
+			MethodName: "ListDataGaps",
+			Handler:    _DataService_ListDataGaps_Handler,
+		},
+		{
+			MethodName: "GetReadingStatistics",
+			Handler:    _DataService_GetReadingStatistics_Handler,
+		},
+		{
+			MethodName: "GetImportJob",
+			Handler:    _DataService_GetImportJob_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _DataService_SetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "ListRetentionPolicies",
+			Handler:    _DataService_ListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _DataService_DeleteRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "RecalibrateReadings",
+			Handler:    _DataService_RecalibrateReadings_Handler,
+		},
+		{
+			MethodName: "DeleteReadings",
+			Handler:    _DataService_DeleteReadings_Handler,
+		},
+		{
+			MethodName: "ListReadingDeletions",
+			Handler:    _DataService_ListReadingDeletions_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "IngestReadings",
+			Handler:       _DataService_IngestReadings_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "StreamReadings",
 			Handler:       _DataService_StreamReadings_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportReadings",
+			Handler:       _DataService_ExportReadings_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportReadings",
+			Handler:       _DataService_ImportReadings_Handler,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "data_service.proto",
 }
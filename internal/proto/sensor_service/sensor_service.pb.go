@@ -23,18 +23,20 @@ const (
 )
 
 type SensorType struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	Manufacturer  string                 `protobuf:"bytes,4,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
-	MinValue      float32                `protobuf:"fixed32,7,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
-	MaxValue      float32                `protobuf:"fixed32,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Model            string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Manufacturer     string                 `protobuf:"bytes,4,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Unit             string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	MinValue         float32                `protobuf:"fixed32,7,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue         float32                `protobuf:"fixed32,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	OutOfRangePolicy string                 `protobuf:"bytes,10,opt,name=out_of_range_policy,json=outOfRangePolicy,proto3" json:"out_of_range_policy,omitempty"`
+	Channels         []*SensorChannel       `protobuf:"bytes,11,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *SensorType) Reset() {
@@ -130,25 +132,118 @@ func (x *SensorType) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
-type Sensor struct {
+func (x *SensorType) GetOutOfRangePolicy() string {
+	if x != nil {
+		return x.OutOfRangePolicy
+	}
+	return ""
+}
+
+func (x *SensorType) GetChannels() []*SensorChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+// SensorChannel is one named value reported in every sample of a multi-channel
+// sensor type. The first channel of a type is its primary channel.
+type SensorChannel struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
-	LastUpdated   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	SensorTypeId  int64                  `protobuf:"varint,9,opt,name=sensor_type_id,json=sensorTypeId,proto3" json:"sensor_type_id,omitempty"`
-	SensorType    *SensorType            `protobuf:"bytes,10,opt,name=sensor_type,json=sensorType,proto3" json:"sensor_type,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Unit          string                 `protobuf:"bytes,2,opt,name=unit,proto3" json:"unit,omitempty"`
+	MinValue      float32                `protobuf:"fixed32,3,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue      float32                `protobuf:"fixed32,4,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SensorChannel) Reset() {
+	*x = SensorChannel{}
+	mi := &file_sensor_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SensorChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SensorChannel) ProtoMessage() {}
+
+func (x *SensorChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SensorChannel.ProtoReflect.Descriptor instead.
+func (*SensorChannel) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SensorChannel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SensorChannel) GetUnit() string {
+	if x != nil {
+		return x.Unit
+	}
+	return ""
+}
+
+func (x *SensorChannel) GetMinValue() float32 {
+	if x != nil {
+		return x.MinValue
+	}
+	return 0
+}
+
+func (x *SensorChannel) GetMaxValue() float32 {
+	if x != nil {
+		return x.MaxValue
+	}
+	return 0
+}
+
+type Sensor struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location     string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Description  string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Active       bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	LastUpdated  *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SensorTypeId int64                  `protobuf:"varint,9,opt,name=sensor_type_id,json=sensorTypeId,proto3" json:"sensor_type_id,omitempty"`
+	SensorType   *SensorType            `protobuf:"bytes,10,opt,name=sensor_type,json=sensorType,proto3" json:"sensor_type,omitempty"`
+	UserId       int64                  `protobuf:"varint,11,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// kind is physical for sensors that report readings and virtual for sensors whose
+	// readings are computed from expression.
+	Kind       string `protobuf:"bytes,12,opt,name=kind,proto3" json:"kind,omitempty"`
+	Expression string `protobuf:"bytes,13,opt,name=expression,proto3" json:"expression,omitempty"`
+	// input_sensor_ids are the sensors expression reads, in ascending order.
+	InputSensorIds []int64 `protobuf:"varint,14,rep,packed,name=input_sensor_ids,json=inputSensorIds,proto3" json:"input_sensor_ids,omitempty"`
+	// calibrations are loaded by GetSensor only, ordered by channel and valid_from.
+	Calibrations  []*Calibration `protobuf:"bytes,15,rep,name=calibrations,proto3" json:"calibrations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Sensor) Reset() {
 	*x = Sensor{}
-	mi := &file_sensor_service_proto_msgTypes[1]
+	mi := &file_sensor_service_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -160,7 +255,7 @@ func (x *Sensor) String() string {
 func (*Sensor) ProtoMessage() {}
 
 func (x *Sensor) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[1]
+	mi := &file_sensor_service_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -173,7 +268,7 @@ func (x *Sensor) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Sensor.ProtoReflect.Descriptor instead.
 func (*Sensor) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{1}
+	return file_sensor_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *Sensor) GetId() int64 {
@@ -246,22 +341,179 @@ func (x *Sensor) GetSensorType() *SensorType {
 	return nil
 }
 
-type CreateSensorTypeRequest struct {
+func (x *Sensor) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Sensor) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Sensor) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+func (x *Sensor) GetInputSensorIds() []int64 {
+	if x != nil {
+		return x.InputSensorIds
+	}
+	return nil
+}
+
+func (x *Sensor) GetCalibrations() []*Calibration {
+	if x != nil {
+		return x.Calibrations
+	}
+	return nil
+}
+
+// Calibration corrects the raw values of a sensor channel reported within
+// [valid_from, valid_to): value = gain * p(raw) + offset, where p is the polynomial
+// with coefficients (c0 + c1*raw + c2*raw^2 ...) or raw itself when there are none.
+// An empty channel calibrates the primary value. A missing valid_to is open-ended.
+type Calibration struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
-	Manufacturer  string                 `protobuf:"bytes,3,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Unit          string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
-	MinValue      float32                `protobuf:"fixed32,6,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
-	MaxValue      float32                `protobuf:"fixed32,7,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	SensorId      int64                  `protobuf:"varint,2,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Offset        float64                `protobuf:"fixed64,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Gain          float64                `protobuf:"fixed64,5,opt,name=gain,proto3" json:"gain,omitempty"`
+	Coefficients  []float64              `protobuf:"fixed64,6,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	Certificate   string                 `protobuf:"bytes,9,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *Calibration) Reset() {
+	*x = Calibration{}
+	mi := &file_sensor_service_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Calibration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Calibration) ProtoMessage() {}
+
+func (x *Calibration) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Calibration.ProtoReflect.Descriptor instead.
+func (*Calibration) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Calibration) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Calibration) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *Calibration) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *Calibration) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *Calibration) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *Calibration) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *Calibration) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *Calibration) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *Calibration) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+func (x *Calibration) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type CreateSensorTypeRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Model            string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	Manufacturer     string                 `protobuf:"bytes,3,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Description      string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Unit             string                 `protobuf:"bytes,5,opt,name=unit,proto3" json:"unit,omitempty"`
+	MinValue         float32                `protobuf:"fixed32,6,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue         float32                `protobuf:"fixed32,7,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	OutOfRangePolicy string                 `protobuf:"bytes,8,opt,name=out_of_range_policy,json=outOfRangePolicy,proto3" json:"out_of_range_policy,omitempty"`
+	Channels         []*SensorChannel       `protobuf:"bytes,9,rep,name=channels,proto3" json:"channels,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
 func (x *CreateSensorTypeRequest) Reset() {
 	*x = CreateSensorTypeRequest{}
-	mi := &file_sensor_service_proto_msgTypes[2]
+	mi := &file_sensor_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -273,7 +525,7 @@ func (x *CreateSensorTypeRequest) String() string {
 func (*CreateSensorTypeRequest) ProtoMessage() {}
 
 func (x *CreateSensorTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[2]
+	mi := &file_sensor_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,7 +538,7 @@ func (x *CreateSensorTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSensorTypeRequest.ProtoReflect.Descriptor instead.
 func (*CreateSensorTypeRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{2}
+	return file_sensor_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateSensorTypeRequest) GetName() string {
@@ -338,6 +590,20 @@ func (x *CreateSensorTypeRequest) GetMaxValue() float32 {
 	return 0
 }
 
+func (x *CreateSensorTypeRequest) GetOutOfRangePolicy() string {
+	if x != nil {
+		return x.OutOfRangePolicy
+	}
+	return ""
+}
+
+func (x *CreateSensorTypeRequest) GetChannels() []*SensorChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
 type CreateSensorTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SensorType    *SensorType            `protobuf:"bytes,1,opt,name=sensor_type,json=sensorType,proto3" json:"sensor_type,omitempty"`
@@ -347,7 +613,7 @@ type CreateSensorTypeResponse struct {
 
 func (x *CreateSensorTypeResponse) Reset() {
 	*x = CreateSensorTypeResponse{}
-	mi := &file_sensor_service_proto_msgTypes[3]
+	mi := &file_sensor_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -359,7 +625,7 @@ func (x *CreateSensorTypeResponse) String() string {
 func (*CreateSensorTypeResponse) ProtoMessage() {}
 
 func (x *CreateSensorTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[3]
+	mi := &file_sensor_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,7 +638,7 @@ func (x *CreateSensorTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSensorTypeResponse.ProtoReflect.Descriptor instead.
 func (*CreateSensorTypeResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{3}
+	return file_sensor_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CreateSensorTypeResponse) GetSensorType() *SensorType {
@@ -391,7 +657,7 @@ type GetSensorTypeRequest struct {
 
 func (x *GetSensorTypeRequest) Reset() {
 	*x = GetSensorTypeRequest{}
-	mi := &file_sensor_service_proto_msgTypes[4]
+	mi := &file_sensor_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -403,7 +669,7 @@ func (x *GetSensorTypeRequest) String() string {
 func (*GetSensorTypeRequest) ProtoMessage() {}
 
 func (x *GetSensorTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[4]
+	mi := &file_sensor_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -416,7 +682,7 @@ func (x *GetSensorTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSensorTypeRequest.ProtoReflect.Descriptor instead.
 func (*GetSensorTypeRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{4}
+	return file_sensor_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetSensorTypeRequest) GetId() int64 {
@@ -435,7 +701,7 @@ type GetSensorTypeResponse struct {
 
 func (x *GetSensorTypeResponse) Reset() {
 	*x = GetSensorTypeResponse{}
-	mi := &file_sensor_service_proto_msgTypes[5]
+	mi := &file_sensor_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -447,7 +713,7 @@ func (x *GetSensorTypeResponse) String() string {
 func (*GetSensorTypeResponse) ProtoMessage() {}
 
 func (x *GetSensorTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[5]
+	mi := &file_sensor_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -460,7 +726,7 @@ func (x *GetSensorTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSensorTypeResponse.ProtoReflect.Descriptor instead.
 func (*GetSensorTypeResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{5}
+	return file_sensor_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetSensorTypeResponse) GetSensorType() *SensorType {
@@ -478,7 +744,7 @@ type ListSensorTypesRequest struct {
 
 func (x *ListSensorTypesRequest) Reset() {
 	*x = ListSensorTypesRequest{}
-	mi := &file_sensor_service_proto_msgTypes[6]
+	mi := &file_sensor_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -490,7 +756,7 @@ func (x *ListSensorTypesRequest) String() string {
 func (*ListSensorTypesRequest) ProtoMessage() {}
 
 func (x *ListSensorTypesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[6]
+	mi := &file_sensor_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -503,7 +769,7 @@ func (x *ListSensorTypesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSensorTypesRequest.ProtoReflect.Descriptor instead.
 func (*ListSensorTypesRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{6}
+	return file_sensor_service_proto_rawDescGZIP(), []int{8}
 }
 
 type ListSensorTypesResponse struct {
@@ -515,7 +781,7 @@ type ListSensorTypesResponse struct {
 
 func (x *ListSensorTypesResponse) Reset() {
 	*x = ListSensorTypesResponse{}
-	mi := &file_sensor_service_proto_msgTypes[7]
+	mi := &file_sensor_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -527,7 +793,7 @@ func (x *ListSensorTypesResponse) String() string {
 func (*ListSensorTypesResponse) ProtoMessage() {}
 
 func (x *ListSensorTypesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[7]
+	mi := &file_sensor_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -540,7 +806,7 @@ func (x *ListSensorTypesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSensorTypesResponse.ProtoReflect.Descriptor instead.
 func (*ListSensorTypesResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{7}
+	return file_sensor_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListSensorTypesResponse) GetSensorTypes() []*SensorType {
@@ -551,20 +817,23 @@ func (x *ListSensorTypesResponse) GetSensorTypes() []*SensorType {
 }
 
 type CreateSensorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Location      string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Active        bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
-	SensorTypeId  int64                  `protobuf:"varint,5,opt,name=sensor_type_id,json=sensorTypeId,proto3" json:"sensor_type_id,omitempty"`
-	UserId        int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Name         string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Location     string                 `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Active       bool                   `protobuf:"varint,4,opt,name=active,proto3" json:"active,omitempty"`
+	SensorTypeId int64                  `protobuf:"varint,5,opt,name=sensor_type_id,json=sensorTypeId,proto3" json:"sensor_type_id,omitempty"`
+	UserId       int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// kind is physical (default) or virtual; virtual sensors require an expression.
+	Kind          string `protobuf:"bytes,7,opt,name=kind,proto3" json:"kind,omitempty"`
+	Expression    string `protobuf:"bytes,8,opt,name=expression,proto3" json:"expression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateSensorRequest) Reset() {
 	*x = CreateSensorRequest{}
-	mi := &file_sensor_service_proto_msgTypes[8]
+	mi := &file_sensor_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -576,7 +845,7 @@ func (x *CreateSensorRequest) String() string {
 func (*CreateSensorRequest) ProtoMessage() {}
 
 func (x *CreateSensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[8]
+	mi := &file_sensor_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +858,7 @@ func (x *CreateSensorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSensorRequest.ProtoReflect.Descriptor instead.
 func (*CreateSensorRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{8}
+	return file_sensor_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CreateSensorRequest) GetName() string {
@@ -634,6 +903,20 @@ func (x *CreateSensorRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *CreateSensorRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CreateSensorRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
 type CreateSensorResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sensor        *Sensor                `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty"`
@@ -643,7 +926,7 @@ type CreateSensorResponse struct {
 
 func (x *CreateSensorResponse) Reset() {
 	*x = CreateSensorResponse{}
-	mi := &file_sensor_service_proto_msgTypes[9]
+	mi := &file_sensor_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -655,7 +938,7 @@ func (x *CreateSensorResponse) String() string {
 func (*CreateSensorResponse) ProtoMessage() {}
 
 func (x *CreateSensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[9]
+	mi := &file_sensor_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -668,7 +951,7 @@ func (x *CreateSensorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSensorResponse.ProtoReflect.Descriptor instead.
 func (*CreateSensorResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{9}
+	return file_sensor_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CreateSensorResponse) GetSensor() *Sensor {
@@ -687,7 +970,7 @@ type GetSensorRequest struct {
 
 func (x *GetSensorRequest) Reset() {
 	*x = GetSensorRequest{}
-	mi := &file_sensor_service_proto_msgTypes[10]
+	mi := &file_sensor_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -699,7 +982,7 @@ func (x *GetSensorRequest) String() string {
 func (*GetSensorRequest) ProtoMessage() {}
 
 func (x *GetSensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[10]
+	mi := &file_sensor_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -712,7 +995,7 @@ func (x *GetSensorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSensorRequest.ProtoReflect.Descriptor instead.
 func (*GetSensorRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{10}
+	return file_sensor_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetSensorRequest) GetId() int64 {
@@ -731,7 +1014,7 @@ type GetSensorResponse struct {
 
 func (x *GetSensorResponse) Reset() {
 	*x = GetSensorResponse{}
-	mi := &file_sensor_service_proto_msgTypes[11]
+	mi := &file_sensor_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -743,7 +1026,7 @@ func (x *GetSensorResponse) String() string {
 func (*GetSensorResponse) ProtoMessage() {}
 
 func (x *GetSensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[11]
+	mi := &file_sensor_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,7 +1039,7 @@ func (x *GetSensorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSensorResponse.ProtoReflect.Descriptor instead.
 func (*GetSensorResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{11}
+	return file_sensor_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetSensorResponse) GetSensor() *Sensor {
@@ -766,16 +1049,110 @@ func (x *GetSensorResponse) GetSensor() *Sensor {
 	return nil
 }
 
-type ListSensorsRequest struct {
+// GetSensorsRequest looks up to 1000 sensors at once.
+type GetSensorsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ids           []int64                `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSensorsRequest) Reset() {
+	*x = GetSensorsRequest{}
+	mi := &file_sensor_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSensorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSensorsRequest) ProtoMessage() {}
+
+func (x *GetSensorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSensorsRequest.ProtoReflect.Descriptor instead.
+func (*GetSensorsRequest) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetSensorsRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+// GetSensorsResponse holds the sensors loaded like GetSensor, ordered by id. Ids that
+// do not exist are skipped.
+type GetSensorsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sensors       []*Sensor              `protobuf:"bytes,1,rep,name=sensors,proto3" json:"sensors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSensorsResponse) Reset() {
+	*x = GetSensorsResponse{}
+	mi := &file_sensor_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSensorsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSensorsResponse) ProtoMessage() {}
+
+func (x *GetSensorsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSensorsResponse.ProtoReflect.Descriptor instead.
+func (*GetSensorsResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetSensorsResponse) GetSensors() []*Sensor {
+	if x != nil {
+		return x.Sensors
+	}
+	return nil
+}
+
+type ListSensorsRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	UserId       int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	SensorTypeId int64                  `protobuf:"varint,2,opt,name=sensor_type_id,json=sensorTypeId,proto3" json:"sensor_type_id,omitempty"`
+	// kind lists the sensors of one kind. Without user_id it covers every user.
+	Kind          string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ListSensorsRequest) Reset() {
 	*x = ListSensorsRequest{}
-	mi := &file_sensor_service_proto_msgTypes[12]
+	mi := &file_sensor_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -787,7 +1164,7 @@ func (x *ListSensorsRequest) String() string {
 func (*ListSensorsRequest) ProtoMessage() {}
 
 func (x *ListSensorsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[12]
+	mi := &file_sensor_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +1177,7 @@ func (x *ListSensorsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSensorsRequest.ProtoReflect.Descriptor instead.
 func (*ListSensorsRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{12}
+	return file_sensor_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ListSensorsRequest) GetUserId() int64 {
@@ -810,6 +1187,20 @@ func (x *ListSensorsRequest) GetUserId() int64 {
 	return 0
 }
 
+func (x *ListSensorsRequest) GetSensorTypeId() int64 {
+	if x != nil {
+		return x.SensorTypeId
+	}
+	return 0
+}
+
+func (x *ListSensorsRequest) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
 type ListSensorsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sensors       []*Sensor              `protobuf:"bytes,1,rep,name=sensors,proto3" json:"sensors,omitempty"`
@@ -819,7 +1210,7 @@ type ListSensorsResponse struct {
 
 func (x *ListSensorsResponse) Reset() {
 	*x = ListSensorsResponse{}
-	mi := &file_sensor_service_proto_msgTypes[13]
+	mi := &file_sensor_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -831,7 +1222,7 @@ func (x *ListSensorsResponse) String() string {
 func (*ListSensorsResponse) ProtoMessage() {}
 
 func (x *ListSensorsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[13]
+	mi := &file_sensor_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +1235,7 @@ func (x *ListSensorsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSensorsResponse.ProtoReflect.Descriptor instead.
 func (*ListSensorsResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{13}
+	return file_sensor_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *ListSensorsResponse) GetSensors() []*Sensor {
@@ -855,20 +1246,22 @@ func (x *ListSensorsResponse) GetSensors() []*Sensor {
 }
 
 type UpdateSensorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Location      string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
-	SensorTypeId  int64                  `protobuf:"varint,6,opt,name=sensor_type_id,json=sensorTypeId,proto3" json:"sensor_type_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Location     string                 `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	Description  string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Active       bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	SensorTypeId int64                  `protobuf:"varint,6,opt,name=sensor_type_id,json=sensorTypeId,proto3" json:"sensor_type_id,omitempty"`
+	// expression replaces the expression of a virtual sensor when set.
+	Expression    string `protobuf:"bytes,7,opt,name=expression,proto3" json:"expression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSensorRequest) Reset() {
 	*x = UpdateSensorRequest{}
-	mi := &file_sensor_service_proto_msgTypes[14]
+	mi := &file_sensor_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -880,7 +1273,7 @@ func (x *UpdateSensorRequest) String() string {
 func (*UpdateSensorRequest) ProtoMessage() {}
 
 func (x *UpdateSensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[14]
+	mi := &file_sensor_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -893,7 +1286,7 @@ func (x *UpdateSensorRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSensorRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSensorRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{14}
+	return file_sensor_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateSensorRequest) GetId() int64 {
@@ -917,49 +1310,485 @@ func (x *UpdateSensorRequest) GetLocation() string {
 	return ""
 }
 
-func (x *UpdateSensorRequest) GetDescription() string {
+func (x *UpdateSensorRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSensorRequest) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *UpdateSensorRequest) GetSensorTypeId() int64 {
+	if x != nil {
+		return x.SensorTypeId
+	}
+	return 0
+}
+
+func (x *UpdateSensorRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type UpdateSensorResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sensor        *Sensor                `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSensorResponse) Reset() {
+	*x = UpdateSensorResponse{}
+	mi := &file_sensor_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSensorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSensorResponse) ProtoMessage() {}
+
+func (x *UpdateSensorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSensorResponse.ProtoReflect.Descriptor instead.
+func (*UpdateSensorResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *UpdateSensorResponse) GetSensor() *Sensor {
+	if x != nil {
+		return x.Sensor
+	}
+	return nil
+}
+
+type DeleteSensorRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// delete_readings also deletes the stored readings of the sensor through the data
+	// service. The sensor is kept when that fails.
+	DeleteReadings bool `protobuf:"varint,2,opt,name=delete_readings,json=deleteReadings,proto3" json:"delete_readings,omitempty"`
+	// user_id is recorded as the user deleting the readings.
+	UserId        int64 `protobuf:"varint,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSensorRequest) Reset() {
+	*x = DeleteSensorRequest{}
+	mi := &file_sensor_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSensorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSensorRequest) ProtoMessage() {}
+
+func (x *DeleteSensorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSensorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSensorRequest) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteSensorRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeleteSensorRequest) GetDeleteReadings() bool {
+	if x != nil {
+		return x.DeleteReadings
+	}
+	return false
+}
+
+func (x *DeleteSensorRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DeleteSensorResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// deleted_readings counts the readings deleted with delete_readings.
+	DeletedReadings int64 `protobuf:"varint,1,opt,name=deleted_readings,json=deletedReadings,proto3" json:"deleted_readings,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteSensorResponse) Reset() {
+	*x = DeleteSensorResponse{}
+	mi := &file_sensor_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSensorResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSensorResponse) ProtoMessage() {}
+
+func (x *DeleteSensorResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSensorResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSensorResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteSensorResponse) GetDeletedReadings() int64 {
+	if x != nil {
+		return x.DeletedReadings
+	}
+	return 0
+}
+
+type SetSensorActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSensorActiveRequest) Reset() {
+	*x = SetSensorActiveRequest{}
+	mi := &file_sensor_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSensorActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSensorActiveRequest) ProtoMessage() {}
+
+func (x *SetSensorActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSensorActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetSensorActiveRequest) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetSensorActiveRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type SetSensorActiveResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sensor        *Sensor                `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSensorActiveResponse) Reset() {
+	*x = SetSensorActiveResponse{}
+	mi := &file_sensor_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSensorActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSensorActiveResponse) ProtoMessage() {}
+
+func (x *SetSensorActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSensorActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetSensorActiveResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetSensorActiveResponse) GetSensor() *Sensor {
+	if x != nil {
+		return x.Sensor
+	}
+	return nil
+}
+
+type CreateCalibrationRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SensorId int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	Channel  string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Offset   float64                `protobuf:"fixed64,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// gain defaults to 1 when zero.
+	Gain          float64                `protobuf:"fixed64,4,opt,name=gain,proto3" json:"gain,omitempty"`
+	Coefficients  []float64              `protobuf:"fixed64,5,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	Certificate   string                 `protobuf:"bytes,8,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalibrationRequest) Reset() {
+	*x = CreateCalibrationRequest{}
+	mi := &file_sensor_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalibrationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalibrationRequest) ProtoMessage() {}
+
+func (x *CreateCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*CreateCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateCalibrationRequest) GetSensorId() int64 {
+	if x != nil {
+		return x.SensorId
+	}
+	return 0
+}
+
+func (x *CreateCalibrationRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CreateCalibrationRequest) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *CreateCalibrationRequest) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *CreateCalibrationRequest) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *CreateCalibrationRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *CreateCalibrationRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *CreateCalibrationRequest) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type CreateCalibrationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calibration   *Calibration           `protobuf:"bytes,1,opt,name=calibration,proto3" json:"calibration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateCalibrationResponse) Reset() {
+	*x = CreateCalibrationResponse{}
+	mi := &file_sensor_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateCalibrationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateCalibrationResponse) ProtoMessage() {}
+
+func (x *CreateCalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateCalibrationResponse.ProtoReflect.Descriptor instead.
+func (*CreateCalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateCalibrationResponse) GetCalibration() *Calibration {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+type ListCalibrationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SensorId      int64                  `protobuf:"varint,1,opt,name=sensor_id,json=sensorId,proto3" json:"sensor_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCalibrationsRequest) Reset() {
+	*x = ListCalibrationsRequest{}
+	mi := &file_sensor_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCalibrationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCalibrationsRequest) ProtoMessage() {}
+
+func (x *ListCalibrationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[26]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateSensorRequest) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
+// Deprecated: Use ListCalibrationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCalibrationsRequest) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *UpdateSensorRequest) GetSensorTypeId() int64 {
+func (x *ListCalibrationsRequest) GetSensorId() int64 {
 	if x != nil {
-		return x.SensorTypeId
+		return x.SensorId
 	}
 	return 0
 }
 
-type UpdateSensorResponse struct {
+type ListCalibrationsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sensor        *Sensor                `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty"`
+	Calibrations  []*Calibration         `protobuf:"bytes,1,rep,name=calibrations,proto3" json:"calibrations,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateSensorResponse) Reset() {
-	*x = UpdateSensorResponse{}
-	mi := &file_sensor_service_proto_msgTypes[15]
+func (x *ListCalibrationsResponse) Reset() {
+	*x = ListCalibrationsResponse{}
+	mi := &file_sensor_service_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSensorResponse) String() string {
+func (x *ListCalibrationsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSensorResponse) ProtoMessage() {}
+func (*ListCalibrationsResponse) ProtoMessage() {}
 
-func (x *UpdateSensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[15]
+func (x *ListCalibrationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -970,40 +1799,49 @@ func (x *UpdateSensorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSensorResponse.ProtoReflect.Descriptor instead.
-func (*UpdateSensorResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListCalibrationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCalibrationsResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *UpdateSensorResponse) GetSensor() *Sensor {
+func (x *ListCalibrationsResponse) GetCalibrations() []*Calibration {
 	if x != nil {
-		return x.Sensor
+		return x.Calibrations
 	}
 	return nil
 }
 
-type DeleteSensorRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+// UpdateCalibrationRequest replaces every field of a calibration except its sensor.
+type UpdateCalibrationRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Channel string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Offset  float64                `protobuf:"fixed64,3,opt,name=offset,proto3" json:"offset,omitempty"`
+	// gain defaults to 1 when zero.
+	Gain          float64                `protobuf:"fixed64,4,opt,name=gain,proto3" json:"gain,omitempty"`
+	Coefficients  []float64              `protobuf:"fixed64,5,rep,packed,name=coefficients,proto3" json:"coefficients,omitempty"`
+	ValidFrom     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=valid_from,json=validFrom,proto3" json:"valid_from,omitempty"`
+	ValidTo       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=valid_to,json=validTo,proto3" json:"valid_to,omitempty"`
+	Certificate   string                 `protobuf:"bytes,8,opt,name=certificate,proto3" json:"certificate,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSensorRequest) Reset() {
-	*x = DeleteSensorRequest{}
-	mi := &file_sensor_service_proto_msgTypes[16]
+func (x *UpdateCalibrationRequest) Reset() {
+	*x = UpdateCalibrationRequest{}
+	mi := &file_sensor_service_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSensorRequest) String() string {
+func (x *UpdateCalibrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSensorRequest) ProtoMessage() {}
+func (*UpdateCalibrationRequest) ProtoMessage() {}
 
-func (x *DeleteSensorRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[16]
+func (x *UpdateCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1014,39 +1852,89 @@ func (x *DeleteSensorRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSensorRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSensorRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *DeleteSensorRequest) GetId() int64 {
+func (x *UpdateCalibrationRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type DeleteSensorResponse struct {
+func (x *UpdateCalibrationRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *UpdateCalibrationRequest) GetOffset() float64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UpdateCalibrationRequest) GetGain() float64 {
+	if x != nil {
+		return x.Gain
+	}
+	return 0
+}
+
+func (x *UpdateCalibrationRequest) GetCoefficients() []float64 {
+	if x != nil {
+		return x.Coefficients
+	}
+	return nil
+}
+
+func (x *UpdateCalibrationRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *UpdateCalibrationRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *UpdateCalibrationRequest) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
+type UpdateCalibrationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Calibration   *Calibration           `protobuf:"bytes,1,opt,name=calibration,proto3" json:"calibration,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSensorResponse) Reset() {
-	*x = DeleteSensorResponse{}
-	mi := &file_sensor_service_proto_msgTypes[17]
+func (x *UpdateCalibrationResponse) Reset() {
+	*x = UpdateCalibrationResponse{}
+	mi := &file_sensor_service_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSensorResponse) String() string {
+func (x *UpdateCalibrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSensorResponse) ProtoMessage() {}
+func (*UpdateCalibrationResponse) ProtoMessage() {}
 
-func (x *DeleteSensorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[17]
+func (x *UpdateCalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1057,33 +1945,40 @@ func (x *DeleteSensorResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSensorResponse.ProtoReflect.Descriptor instead.
-func (*DeleteSensorResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use UpdateCalibrationResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{29}
 }
 
-type SetSensorActiveRequest struct {
+func (x *UpdateCalibrationResponse) GetCalibration() *Calibration {
+	if x != nil {
+		return x.Calibration
+	}
+	return nil
+}
+
+type DeleteCalibrationRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSensorActiveRequest) Reset() {
-	*x = SetSensorActiveRequest{}
-	mi := &file_sensor_service_proto_msgTypes[18]
+func (x *DeleteCalibrationRequest) Reset() {
+	*x = DeleteCalibrationRequest{}
+	mi := &file_sensor_service_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSensorActiveRequest) String() string {
+func (x *DeleteCalibrationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSensorActiveRequest) ProtoMessage() {}
+func (*DeleteCalibrationRequest) ProtoMessage() {}
 
-func (x *SetSensorActiveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[18]
+func (x *DeleteCalibrationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1094,40 +1989,39 @@ func (x *SetSensorActiveRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSensorActiveRequest.ProtoReflect.Descriptor instead.
-func (*SetSensorActiveRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteCalibrationRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCalibrationRequest) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *SetSensorActiveRequest) GetId() int64 {
+func (x *DeleteCalibrationRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-type SetSensorActiveResponse struct {
+type DeleteCalibrationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sensor        *Sensor                `protobuf:"bytes,1,opt,name=sensor,proto3" json:"sensor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SetSensorActiveResponse) Reset() {
-	*x = SetSensorActiveResponse{}
-	mi := &file_sensor_service_proto_msgTypes[19]
+func (x *DeleteCalibrationResponse) Reset() {
+	*x = DeleteCalibrationResponse{}
+	mi := &file_sensor_service_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SetSensorActiveResponse) String() string {
+func (x *DeleteCalibrationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetSensorActiveResponse) ProtoMessage() {}
+func (*DeleteCalibrationResponse) ProtoMessage() {}
 
-func (x *SetSensorActiveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[19]
+func (x *DeleteCalibrationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_sensor_service_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,35 +2032,33 @@ func (x *SetSensorActiveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SetSensorActiveResponse.ProtoReflect.Descriptor instead.
-func (*SetSensorActiveResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{19}
-}
-
-func (x *SetSensorActiveResponse) GetSensor() *Sensor {
-	if x != nil {
-		return x.Sensor
-	}
-	return nil
+// Deprecated: Use DeleteCalibrationResponse.ProtoReflect.Descriptor instead.
+func (*DeleteCalibrationResponse) Descriptor() ([]byte, []int) {
+	return file_sensor_service_proto_rawDescGZIP(), []int{31}
 }
 
 type UpdateSensorTypeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Model         string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
-	Manufacturer  string                 `protobuf:"bytes,4,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
-	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
-	Unit          string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
-	MinValue      float32                `protobuf:"fixed32,7,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
-	MaxValue      float32                `protobuf:"fixed32,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Model            string                 `protobuf:"bytes,3,opt,name=model,proto3" json:"model,omitempty"`
+	Manufacturer     string                 `protobuf:"bytes,4,opt,name=manufacturer,proto3" json:"manufacturer,omitempty"`
+	Description      string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`
+	Unit             string                 `protobuf:"bytes,6,opt,name=unit,proto3" json:"unit,omitempty"`
+	MinValue         float32                `protobuf:"fixed32,7,opt,name=min_value,json=minValue,proto3" json:"min_value,omitempty"`
+	MaxValue         float32                `protobuf:"fixed32,8,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	OutOfRangePolicy string                 `protobuf:"bytes,9,opt,name=out_of_range_policy,json=outOfRangePolicy,proto3" json:"out_of_range_policy,omitempty"`
+	// channels replaces the channels of the type. Leaving it empty keeps them, unless
+	// clear_channels is set, which removes them.
+	Channels      []*SensorChannel `protobuf:"bytes,10,rep,name=channels,proto3" json:"channels,omitempty"`
+	ClearChannels bool             `protobuf:"varint,11,opt,name=clear_channels,json=clearChannels,proto3" json:"clear_channels,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateSensorTypeRequest) Reset() {
 	*x = UpdateSensorTypeRequest{}
-	mi := &file_sensor_service_proto_msgTypes[20]
+	mi := &file_sensor_service_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1178,7 +2070,7 @@ func (x *UpdateSensorTypeRequest) String() string {
 func (*UpdateSensorTypeRequest) ProtoMessage() {}
 
 func (x *UpdateSensorTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[20]
+	mi := &file_sensor_service_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1191,7 +2083,7 @@ func (x *UpdateSensorTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSensorTypeRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSensorTypeRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{20}
+	return file_sensor_service_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateSensorTypeRequest) GetId() int64 {
@@ -1250,6 +2142,27 @@ func (x *UpdateSensorTypeRequest) GetMaxValue() float32 {
 	return 0
 }
 
+func (x *UpdateSensorTypeRequest) GetOutOfRangePolicy() string {
+	if x != nil {
+		return x.OutOfRangePolicy
+	}
+	return ""
+}
+
+func (x *UpdateSensorTypeRequest) GetChannels() []*SensorChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+func (x *UpdateSensorTypeRequest) GetClearChannels() bool {
+	if x != nil {
+		return x.ClearChannels
+	}
+	return false
+}
+
 type UpdateSensorTypeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	SensorType    *SensorType            `protobuf:"bytes,1,opt,name=sensor_type,json=sensorType,proto3" json:"sensor_type,omitempty"`
@@ -1259,7 +2172,7 @@ type UpdateSensorTypeResponse struct {
 
 func (x *UpdateSensorTypeResponse) Reset() {
 	*x = UpdateSensorTypeResponse{}
-	mi := &file_sensor_service_proto_msgTypes[21]
+	mi := &file_sensor_service_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1271,7 +2184,7 @@ func (x *UpdateSensorTypeResponse) String() string {
 func (*UpdateSensorTypeResponse) ProtoMessage() {}
 
 func (x *UpdateSensorTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[21]
+	mi := &file_sensor_service_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +2197,7 @@ func (x *UpdateSensorTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSensorTypeResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSensorTypeResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{21}
+	return file_sensor_service_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *UpdateSensorTypeResponse) GetSensorType() *SensorType {
@@ -1303,7 +2216,7 @@ type DeleteSensorTypeRequest struct {
 
 func (x *DeleteSensorTypeRequest) Reset() {
 	*x = DeleteSensorTypeRequest{}
-	mi := &file_sensor_service_proto_msgTypes[22]
+	mi := &file_sensor_service_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1315,7 +2228,7 @@ func (x *DeleteSensorTypeRequest) String() string {
 func (*DeleteSensorTypeRequest) ProtoMessage() {}
 
 func (x *DeleteSensorTypeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[22]
+	mi := &file_sensor_service_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1328,7 +2241,7 @@ func (x *DeleteSensorTypeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSensorTypeRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSensorTypeRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{22}
+	return file_sensor_service_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *DeleteSensorTypeRequest) GetId() int64 {
@@ -1346,7 +2259,7 @@ type DeleteSensorTypeResponse struct {
 
 func (x *DeleteSensorTypeResponse) Reset() {
 	*x = DeleteSensorTypeResponse{}
-	mi := &file_sensor_service_proto_msgTypes[23]
+	mi := &file_sensor_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1358,7 +2271,7 @@ func (x *DeleteSensorTypeResponse) String() string {
 func (*DeleteSensorTypeResponse) ProtoMessage() {}
 
 func (x *DeleteSensorTypeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[23]
+	mi := &file_sensor_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1371,7 +2284,7 @@ func (x *DeleteSensorTypeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSensorTypeResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSensorTypeResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{23}
+	return file_sensor_service_proto_rawDescGZIP(), []int{35}
 }
 
 type SensorGroup struct {
@@ -1391,7 +2304,7 @@ type SensorGroup struct {
 
 func (x *SensorGroup) Reset() {
 	*x = SensorGroup{}
-	mi := &file_sensor_service_proto_msgTypes[24]
+	mi := &file_sensor_service_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1403,7 +2316,7 @@ func (x *SensorGroup) String() string {
 func (*SensorGroup) ProtoMessage() {}
 
 func (x *SensorGroup) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[24]
+	mi := &file_sensor_service_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +2329,7 @@ func (x *SensorGroup) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SensorGroup.ProtoReflect.Descriptor instead.
 func (*SensorGroup) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{24}
+	return file_sensor_service_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *SensorGroup) GetId() int64 {
@@ -1496,7 +2409,7 @@ type CreateSensorGroupRequest struct {
 
 func (x *CreateSensorGroupRequest) Reset() {
 	*x = CreateSensorGroupRequest{}
-	mi := &file_sensor_service_proto_msgTypes[25]
+	mi := &file_sensor_service_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1508,7 +2421,7 @@ func (x *CreateSensorGroupRequest) String() string {
 func (*CreateSensorGroupRequest) ProtoMessage() {}
 
 func (x *CreateSensorGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[25]
+	mi := &file_sensor_service_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1521,7 +2434,7 @@ func (x *CreateSensorGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSensorGroupRequest.ProtoReflect.Descriptor instead.
 func (*CreateSensorGroupRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{25}
+	return file_sensor_service_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *CreateSensorGroupRequest) GetName() string {
@@ -1575,7 +2488,7 @@ type CreateSensorGroupResponse struct {
 
 func (x *CreateSensorGroupResponse) Reset() {
 	*x = CreateSensorGroupResponse{}
-	mi := &file_sensor_service_proto_msgTypes[26]
+	mi := &file_sensor_service_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1587,7 +2500,7 @@ func (x *CreateSensorGroupResponse) String() string {
 func (*CreateSensorGroupResponse) ProtoMessage() {}
 
 func (x *CreateSensorGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[26]
+	mi := &file_sensor_service_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1600,7 +2513,7 @@ func (x *CreateSensorGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateSensorGroupResponse.ProtoReflect.Descriptor instead.
 func (*CreateSensorGroupResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{26}
+	return file_sensor_service_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *CreateSensorGroupResponse) GetGroup() *SensorGroup {
@@ -1619,7 +2532,7 @@ type GetSensorGroupRequest struct {
 
 func (x *GetSensorGroupRequest) Reset() {
 	*x = GetSensorGroupRequest{}
-	mi := &file_sensor_service_proto_msgTypes[27]
+	mi := &file_sensor_service_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1631,7 +2544,7 @@ func (x *GetSensorGroupRequest) String() string {
 func (*GetSensorGroupRequest) ProtoMessage() {}
 
 func (x *GetSensorGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[27]
+	mi := &file_sensor_service_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1644,7 +2557,7 @@ func (x *GetSensorGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSensorGroupRequest.ProtoReflect.Descriptor instead.
 func (*GetSensorGroupRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{27}
+	return file_sensor_service_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *GetSensorGroupRequest) GetId() int64 {
@@ -1664,7 +2577,7 @@ type GetSensorGroupResponse struct {
 
 func (x *GetSensorGroupResponse) Reset() {
 	*x = GetSensorGroupResponse{}
-	mi := &file_sensor_service_proto_msgTypes[28]
+	mi := &file_sensor_service_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1676,7 +2589,7 @@ func (x *GetSensorGroupResponse) String() string {
 func (*GetSensorGroupResponse) ProtoMessage() {}
 
 func (x *GetSensorGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[28]
+	mi := &file_sensor_service_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1689,7 +2602,7 @@ func (x *GetSensorGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetSensorGroupResponse.ProtoReflect.Descriptor instead.
 func (*GetSensorGroupResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{28}
+	return file_sensor_service_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetSensorGroupResponse) GetGroup() *SensorGroup {
@@ -1715,7 +2628,7 @@ type ListSensorGroupsRequest struct {
 
 func (x *ListSensorGroupsRequest) Reset() {
 	*x = ListSensorGroupsRequest{}
-	mi := &file_sensor_service_proto_msgTypes[29]
+	mi := &file_sensor_service_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1727,7 +2640,7 @@ func (x *ListSensorGroupsRequest) String() string {
 func (*ListSensorGroupsRequest) ProtoMessage() {}
 
 func (x *ListSensorGroupsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[29]
+	mi := &file_sensor_service_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1740,7 +2653,7 @@ func (x *ListSensorGroupsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSensorGroupsRequest.ProtoReflect.Descriptor instead.
 func (*ListSensorGroupsRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{29}
+	return file_sensor_service_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *ListSensorGroupsRequest) GetUserId() int64 {
@@ -1760,7 +2673,7 @@ type SensorGroupWithSensors struct {
 
 func (x *SensorGroupWithSensors) Reset() {
 	*x = SensorGroupWithSensors{}
-	mi := &file_sensor_service_proto_msgTypes[30]
+	mi := &file_sensor_service_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1772,7 +2685,7 @@ func (x *SensorGroupWithSensors) String() string {
 func (*SensorGroupWithSensors) ProtoMessage() {}
 
 func (x *SensorGroupWithSensors) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[30]
+	mi := &file_sensor_service_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1785,7 +2698,7 @@ func (x *SensorGroupWithSensors) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SensorGroupWithSensors.ProtoReflect.Descriptor instead.
 func (*SensorGroupWithSensors) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{30}
+	return file_sensor_service_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *SensorGroupWithSensors) GetGroup() *SensorGroup {
@@ -1811,7 +2724,7 @@ type ListSensorGroupsResponse struct {
 
 func (x *ListSensorGroupsResponse) Reset() {
 	*x = ListSensorGroupsResponse{}
-	mi := &file_sensor_service_proto_msgTypes[31]
+	mi := &file_sensor_service_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1823,7 +2736,7 @@ func (x *ListSensorGroupsResponse) String() string {
 func (*ListSensorGroupsResponse) ProtoMessage() {}
 
 func (x *ListSensorGroupsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[31]
+	mi := &file_sensor_service_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,7 +2749,7 @@ func (x *ListSensorGroupsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListSensorGroupsResponse.ProtoReflect.Descriptor instead.
 func (*ListSensorGroupsResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{31}
+	return file_sensor_service_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ListSensorGroupsResponse) GetGroups() []*SensorGroupWithSensors {
@@ -1860,7 +2773,7 @@ type UpdateSensorGroupRequest struct {
 
 func (x *UpdateSensorGroupRequest) Reset() {
 	*x = UpdateSensorGroupRequest{}
-	mi := &file_sensor_service_proto_msgTypes[32]
+	mi := &file_sensor_service_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1872,7 +2785,7 @@ func (x *UpdateSensorGroupRequest) String() string {
 func (*UpdateSensorGroupRequest) ProtoMessage() {}
 
 func (x *UpdateSensorGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[32]
+	mi := &file_sensor_service_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1885,7 +2798,7 @@ func (x *UpdateSensorGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSensorGroupRequest.ProtoReflect.Descriptor instead.
 func (*UpdateSensorGroupRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{32}
+	return file_sensor_service_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *UpdateSensorGroupRequest) GetId() int64 {
@@ -1939,7 +2852,7 @@ type UpdateSensorGroupResponse struct {
 
 func (x *UpdateSensorGroupResponse) Reset() {
 	*x = UpdateSensorGroupResponse{}
-	mi := &file_sensor_service_proto_msgTypes[33]
+	mi := &file_sensor_service_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1951,7 +2864,7 @@ func (x *UpdateSensorGroupResponse) String() string {
 func (*UpdateSensorGroupResponse) ProtoMessage() {}
 
 func (x *UpdateSensorGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[33]
+	mi := &file_sensor_service_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1964,7 +2877,7 @@ func (x *UpdateSensorGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateSensorGroupResponse.ProtoReflect.Descriptor instead.
 func (*UpdateSensorGroupResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{33}
+	return file_sensor_service_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *UpdateSensorGroupResponse) GetGroup() *SensorGroup {
@@ -1983,7 +2896,7 @@ type DeleteSensorGroupRequest struct {
 
 func (x *DeleteSensorGroupRequest) Reset() {
 	*x = DeleteSensorGroupRequest{}
-	mi := &file_sensor_service_proto_msgTypes[34]
+	mi := &file_sensor_service_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1995,7 +2908,7 @@ func (x *DeleteSensorGroupRequest) String() string {
 func (*DeleteSensorGroupRequest) ProtoMessage() {}
 
 func (x *DeleteSensorGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[34]
+	mi := &file_sensor_service_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2008,7 +2921,7 @@ func (x *DeleteSensorGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSensorGroupRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSensorGroupRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{34}
+	return file_sensor_service_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *DeleteSensorGroupRequest) GetId() int64 {
@@ -2026,7 +2939,7 @@ type DeleteSensorGroupResponse struct {
 
 func (x *DeleteSensorGroupResponse) Reset() {
 	*x = DeleteSensorGroupResponse{}
-	mi := &file_sensor_service_proto_msgTypes[35]
+	mi := &file_sensor_service_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2038,7 +2951,7 @@ func (x *DeleteSensorGroupResponse) String() string {
 func (*DeleteSensorGroupResponse) ProtoMessage() {}
 
 func (x *DeleteSensorGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[35]
+	mi := &file_sensor_service_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2051,7 +2964,7 @@ func (x *DeleteSensorGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSensorGroupResponse.ProtoReflect.Descriptor instead.
 func (*DeleteSensorGroupResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{35}
+	return file_sensor_service_proto_rawDescGZIP(), []int{47}
 }
 
 type AddSensorsToGroupRequest struct {
@@ -2064,7 +2977,7 @@ type AddSensorsToGroupRequest struct {
 
 func (x *AddSensorsToGroupRequest) Reset() {
 	*x = AddSensorsToGroupRequest{}
-	mi := &file_sensor_service_proto_msgTypes[36]
+	mi := &file_sensor_service_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2076,7 +2989,7 @@ func (x *AddSensorsToGroupRequest) String() string {
 func (*AddSensorsToGroupRequest) ProtoMessage() {}
 
 func (x *AddSensorsToGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[36]
+	mi := &file_sensor_service_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2089,7 +3002,7 @@ func (x *AddSensorsToGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSensorsToGroupRequest.ProtoReflect.Descriptor instead.
 func (*AddSensorsToGroupRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{36}
+	return file_sensor_service_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *AddSensorsToGroupRequest) GetGroupId() int64 {
@@ -2115,7 +3028,7 @@ type AddSensorsToGroupResponse struct {
 
 func (x *AddSensorsToGroupResponse) Reset() {
 	*x = AddSensorsToGroupResponse{}
-	mi := &file_sensor_service_proto_msgTypes[37]
+	mi := &file_sensor_service_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2127,7 +3040,7 @@ func (x *AddSensorsToGroupResponse) String() string {
 func (*AddSensorsToGroupResponse) ProtoMessage() {}
 
 func (x *AddSensorsToGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[37]
+	mi := &file_sensor_service_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2140,7 +3053,7 @@ func (x *AddSensorsToGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddSensorsToGroupResponse.ProtoReflect.Descriptor instead.
 func (*AddSensorsToGroupResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{37}
+	return file_sensor_service_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AddSensorsToGroupResponse) GetGroup() *SensorGroup {
@@ -2160,7 +3073,7 @@ type RemoveSensorsFromGroupRequest struct {
 
 func (x *RemoveSensorsFromGroupRequest) Reset() {
 	*x = RemoveSensorsFromGroupRequest{}
-	mi := &file_sensor_service_proto_msgTypes[38]
+	mi := &file_sensor_service_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2172,7 +3085,7 @@ func (x *RemoveSensorsFromGroupRequest) String() string {
 func (*RemoveSensorsFromGroupRequest) ProtoMessage() {}
 
 func (x *RemoveSensorsFromGroupRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[38]
+	mi := &file_sensor_service_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2185,7 +3098,7 @@ func (x *RemoveSensorsFromGroupRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSensorsFromGroupRequest.ProtoReflect.Descriptor instead.
 func (*RemoveSensorsFromGroupRequest) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{38}
+	return file_sensor_service_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *RemoveSensorsFromGroupRequest) GetGroupId() int64 {
@@ -2211,7 +3124,7 @@ type RemoveSensorsFromGroupResponse struct {
 
 func (x *RemoveSensorsFromGroupResponse) Reset() {
 	*x = RemoveSensorsFromGroupResponse{}
-	mi := &file_sensor_service_proto_msgTypes[39]
+	mi := &file_sensor_service_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2223,7 +3136,7 @@ func (x *RemoveSensorsFromGroupResponse) String() string {
 func (*RemoveSensorsFromGroupResponse) ProtoMessage() {}
 
 func (x *RemoveSensorsFromGroupResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_sensor_service_proto_msgTypes[39]
+	mi := &file_sensor_service_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2236,7 +3149,7 @@ func (x *RemoveSensorsFromGroupResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveSensorsFromGroupResponse.ProtoReflect.Descriptor instead.
 func (*RemoveSensorsFromGroupResponse) Descriptor() ([]byte, []int) {
-	return file_sensor_service_proto_rawDescGZIP(), []int{39}
+	return file_sensor_service_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *RemoveSensorsFromGroupResponse) GetGroup() *SensorGroup {
@@ -2250,7 +3163,7 @@ var File_sensor_service_proto protoreflect.FileDescriptor
 
 const file_sensor_service_proto_rawDesc = "" +
 	"\n" +
-	"\x14sensor_service.proto\x12\x0esensor_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\x95\x02\n" +
+	"\x14sensor_service.proto\x12\x0esensor_service\x1a\x1fgoogle/protobuf/timestamp.proto\"\xff\x02\n" +
 	"\n" +
 	"SensorType\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
@@ -2262,7 +3175,15 @@ const file_sensor_service_proto_rawDesc = "" +
 	"\tmin_value\x18\a \x01(\x02R\bminValue\x12\x1b\n" +
 	"\tmax_value\x18\b \x01(\x02R\bmaxValue\x129\n" +
 	"\n" +
-	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9a\x03\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12-\n" +
+	"\x13out_of_range_policy\x18\n" +
+	" \x01(\tR\x10outOfRangePolicy\x129\n" +
+	"\bchannels\x18\v \x03(\v2\x1d.sensor_service.SensorChannelR\bchannels\"q\n" +
+	"\rSensorChannel\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04unit\x18\x02 \x01(\tR\x04unit\x12\x1b\n" +
+	"\tmin_value\x18\x03 \x01(\x02R\bminValue\x12\x1b\n" +
+	"\tmax_value\x18\x04 \x01(\x02R\bmaxValue\"\xd2\x04\n" +
 	"\x06Sensor\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
@@ -2277,7 +3198,28 @@ const file_sensor_service_proto_rawDesc = "" +
 	"\x0esensor_type_id\x18\t \x01(\x03R\fsensorTypeId\x12;\n" +
 	"\vsensor_type\x18\n" +
 	" \x01(\v2\x1a.sensor_service.SensorTypeR\n" +
-	"sensorType\"\xd7\x01\n" +
+	"sensorType\x12\x17\n" +
+	"\auser_id\x18\v \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\f \x01(\tR\x04kind\x12\x1e\n" +
+	"\n" +
+	"expression\x18\r \x01(\tR\n" +
+	"expression\x12(\n" +
+	"\x10input_sensor_ids\x18\x0e \x03(\x03R\x0einputSensorIds\x12?\n" +
+	"\fcalibrations\x18\x0f \x03(\v2\x1b.sensor_service.CalibrationR\fcalibrations\"\xf3\x02\n" +
+	"\vCalibration\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tsensor_id\x18\x02 \x01(\x03R\bsensorId\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x01R\x06offset\x12\x12\n" +
+	"\x04gain\x18\x05 \x01(\x01R\x04gain\x12\"\n" +
+	"\fcoefficients\x18\x06 \x03(\x01R\fcoefficients\x129\n" +
+	"\n" +
+	"valid_from\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12 \n" +
+	"\vcertificate\x18\t \x01(\tR\vcertificate\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xc1\x02\n" +
 	"\x17CreateSensorTypeRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
 	"\x05model\x18\x02 \x01(\tR\x05model\x12\"\n" +
@@ -2285,7 +3227,9 @@ const file_sensor_service_proto_rawDesc = "" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04unit\x18\x05 \x01(\tR\x04unit\x12\x1b\n" +
 	"\tmin_value\x18\x06 \x01(\x02R\bminValue\x12\x1b\n" +
-	"\tmax_value\x18\a \x01(\x02R\bmaxValue\"W\n" +
+	"\tmax_value\x18\a \x01(\x02R\bmaxValue\x12-\n" +
+	"\x13out_of_range_policy\x18\b \x01(\tR\x10outOfRangePolicy\x129\n" +
+	"\bchannels\x18\t \x03(\v2\x1d.sensor_service.SensorChannelR\bchannels\"W\n" +
 	"\x18CreateSensorTypeResponse\x12;\n" +
 	"\vsensor_type\x18\x01 \x01(\v2\x1a.sensor_service.SensorTypeR\n" +
 	"sensorType\"&\n" +
@@ -2296,40 +3240,87 @@ const file_sensor_service_proto_rawDesc = "" +
 	"sensorType\"\x18\n" +
 	"\x16ListSensorTypesRequest\"X\n" +
 	"\x17ListSensorTypesResponse\x12=\n" +
-	"\fsensor_types\x18\x01 \x03(\v2\x1a.sensor_service.SensorTypeR\vsensorTypes\"\xbe\x01\n" +
+	"\fsensor_types\x18\x01 \x03(\v2\x1a.sensor_service.SensorTypeR\vsensorTypes\"\xf2\x01\n" +
 	"\x13CreateSensorRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
 	"\blocation\x18\x02 \x01(\tR\blocation\x12 \n" +
 	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
 	"\x06active\x18\x04 \x01(\bR\x06active\x12$\n" +
 	"\x0esensor_type_id\x18\x05 \x01(\x03R\fsensorTypeId\x12\x17\n" +
-	"\auser_id\x18\x06 \x01(\x03R\x06userId\"F\n" +
+	"\auser_id\x18\x06 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04kind\x18\a \x01(\tR\x04kind\x12\x1e\n" +
+	"\n" +
+	"expression\x18\b \x01(\tR\n" +
+	"expression\"F\n" +
 	"\x14CreateSensorResponse\x12.\n" +
 	"\x06sensor\x18\x01 \x01(\v2\x16.sensor_service.SensorR\x06sensor\"\"\n" +
 	"\x10GetSensorRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"C\n" +
 	"\x11GetSensorResponse\x12.\n" +
-	"\x06sensor\x18\x01 \x01(\v2\x16.sensor_service.SensorR\x06sensor\"-\n" +
+	"\x06sensor\x18\x01 \x01(\v2\x16.sensor_service.SensorR\x06sensor\"%\n" +
+	"\x11GetSensorsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\"F\n" +
+	"\x12GetSensorsResponse\x120\n" +
+	"\asensors\x18\x01 \x03(\v2\x16.sensor_service.SensorR\asensors\"g\n" +
 	"\x12ListSensorsRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"G\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12$\n" +
+	"\x0esensor_type_id\x18\x02 \x01(\x03R\fsensorTypeId\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\"G\n" +
 	"\x13ListSensorsResponse\x120\n" +
-	"\asensors\x18\x01 \x03(\v2\x16.sensor_service.SensorR\asensors\"\xb5\x01\n" +
+	"\asensors\x18\x01 \x03(\v2\x16.sensor_service.SensorR\asensors\"\xd5\x01\n" +
 	"\x13UpdateSensorRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
 	"\blocation\x18\x03 \x01(\tR\blocation\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x16\n" +
 	"\x06active\x18\x05 \x01(\bR\x06active\x12$\n" +
-	"\x0esensor_type_id\x18\x06 \x01(\x03R\fsensorTypeId\"F\n" +
+	"\x0esensor_type_id\x18\x06 \x01(\x03R\fsensorTypeId\x12\x1e\n" +
+	"\n" +
+	"expression\x18\a \x01(\tR\n" +
+	"expression\"F\n" +
 	"\x14UpdateSensorResponse\x12.\n" +
-	"\x06sensor\x18\x01 \x01(\v2\x16.sensor_service.SensorR\x06sensor\"%\n" +
+	"\x06sensor\x18\x01 \x01(\v2\x16.sensor_service.SensorR\x06sensor\"g\n" +
 	"\x13DeleteSensorRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x16\n" +
-	"\x14DeleteSensorResponse\"(\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12'\n" +
+	"\x0fdelete_readings\x18\x02 \x01(\bR\x0edeleteReadings\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\x03R\x06userId\"A\n" +
+	"\x14DeleteSensorResponse\x12)\n" +
+	"\x10deleted_readings\x18\x01 \x01(\x03R\x0fdeletedReadings\"(\n" +
 	"\x16SetSensorActiveRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"I\n" +
 	"\x17SetSensorActiveResponse\x12.\n" +
-	"\x06sensor\x18\x01 \x01(\v2\x16.sensor_service.SensorR\x06sensor\"\xe7\x01\n" +
+	"\x06sensor\x18\x01 \x01(\v2\x16.sensor_service.SensorR\x06sensor\"\xb5\x02\n" +
+	"\x18CreateCalibrationRequest\x12\x1b\n" +
+	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x01R\x06offset\x12\x12\n" +
+	"\x04gain\x18\x04 \x01(\x01R\x04gain\x12\"\n" +
+	"\fcoefficients\x18\x05 \x03(\x01R\fcoefficients\x129\n" +
+	"\n" +
+	"valid_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12 \n" +
+	"\vcertificate\x18\b \x01(\tR\vcertificate\"Z\n" +
+	"\x19CreateCalibrationResponse\x12=\n" +
+	"\vcalibration\x18\x01 \x01(\v2\x1b.sensor_service.CalibrationR\vcalibration\"6\n" +
+	"\x17ListCalibrationsRequest\x12\x1b\n" +
+	"\tsensor_id\x18\x01 \x01(\x03R\bsensorId\"[\n" +
+	"\x18ListCalibrationsResponse\x12?\n" +
+	"\fcalibrations\x18\x01 \x03(\v2\x1b.sensor_service.CalibrationR\fcalibrations\"\xa8\x02\n" +
+	"\x18UpdateCalibrationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12\x16\n" +
+	"\x06offset\x18\x03 \x01(\x01R\x06offset\x12\x12\n" +
+	"\x04gain\x18\x04 \x01(\x01R\x04gain\x12\"\n" +
+	"\fcoefficients\x18\x05 \x03(\x01R\fcoefficients\x129\n" +
+	"\n" +
+	"valid_from\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tvalidFrom\x125\n" +
+	"\bvalid_to\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\avalidTo\x12 \n" +
+	"\vcertificate\x18\b \x01(\tR\vcertificate\"Z\n" +
+	"\x19UpdateCalibrationResponse\x12=\n" +
+	"\vcalibration\x18\x01 \x01(\v2\x1b.sensor_service.CalibrationR\vcalibration\"*\n" +
+	"\x18DeleteCalibrationRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\x1b\n" +
+	"\x19DeleteCalibrationResponse\"\xf8\x02\n" +
 	"\x17UpdateSensorTypeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
@@ -2338,7 +3329,11 @@ const file_sensor_service_proto_rawDesc = "" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12\x12\n" +
 	"\x04unit\x18\x06 \x01(\tR\x04unit\x12\x1b\n" +
 	"\tmin_value\x18\a \x01(\x02R\bminValue\x12\x1b\n" +
-	"\tmax_value\x18\b \x01(\x02R\bmaxValue\"W\n" +
+	"\tmax_value\x18\b \x01(\x02R\bmaxValue\x12-\n" +
+	"\x13out_of_range_policy\x18\t \x01(\tR\x10outOfRangePolicy\x129\n" +
+	"\bchannels\x18\n" +
+	" \x03(\v2\x1d.sensor_service.SensorChannelR\bchannels\x12%\n" +
+	"\x0eclear_channels\x18\v \x01(\bR\rclearChannels\"W\n" +
 	"\x18UpdateSensorTypeResponse\x12;\n" +
 	"\vsensor_type\x18\x01 \x01(\v2\x1a.sensor_service.SensorTypeR\n" +
 	"sensorType\")\n" +
@@ -2404,7 +3399,7 @@ const file_sensor_service_proto_rawDesc = "" +
 	"\n" +
 	"sensor_ids\x18\x02 \x03(\x03R\tsensorIds\"S\n" +
 	"\x1eRemoveSensorsFromGroupResponse\x121\n" +
-	"\x05group\x18\x01 \x01(\v2\x1b.sensor_service.SensorGroupR\x05group2\xb2\x0e\n" +
+	"\x05group\x18\x01 \x01(\v2\x1b.sensor_service.SensorGroupR\x05group2\xb6\x12\n" +
 	"\rSensorService\x12g\n" +
 	"\x10CreateSensorType\x12'.sensor_service.CreateSensorTypeRequest\x1a(.sensor_service.CreateSensorTypeResponse\"\x00\x12^\n" +
 	"\rGetSensorType\x12$.sensor_service.GetSensorTypeRequest\x1a%.sensor_service.GetSensorTypeResponse\"\x00\x12d\n" +
@@ -2412,11 +3407,17 @@ const file_sensor_service_proto_rawDesc = "" +
 	"\x10UpdateSensorType\x12'.sensor_service.UpdateSensorTypeRequest\x1a(.sensor_service.UpdateSensorTypeResponse\"\x00\x12g\n" +
 	"\x10DeleteSensorType\x12'.sensor_service.DeleteSensorTypeRequest\x1a(.sensor_service.DeleteSensorTypeResponse\"\x00\x12[\n" +
 	"\fCreateSensor\x12#.sensor_service.CreateSensorRequest\x1a$.sensor_service.CreateSensorResponse\"\x00\x12R\n" +
-	"\tGetSensor\x12 .sensor_service.GetSensorRequest\x1a!.sensor_service.GetSensorResponse\"\x00\x12X\n" +
+	"\tGetSensor\x12 .sensor_service.GetSensorRequest\x1a!.sensor_service.GetSensorResponse\"\x00\x12U\n" +
+	"\n" +
+	"GetSensors\x12!.sensor_service.GetSensorsRequest\x1a\".sensor_service.GetSensorsResponse\"\x00\x12X\n" +
 	"\vListSensors\x12\".sensor_service.ListSensorsRequest\x1a#.sensor_service.ListSensorsResponse\"\x00\x12[\n" +
 	"\fUpdateSensor\x12#.sensor_service.UpdateSensorRequest\x1a$.sensor_service.UpdateSensorResponse\"\x00\x12[\n" +
 	"\fDeleteSensor\x12#.sensor_service.DeleteSensorRequest\x1a$.sensor_service.DeleteSensorResponse\"\x00\x12d\n" +
 	"\x0fSetSensorActive\x12&.sensor_service.SetSensorActiveRequest\x1a'.sensor_service.SetSensorActiveResponse\"\x00\x12j\n" +
+	"\x11CreateCalibration\x12(.sensor_service.CreateCalibrationRequest\x1a).sensor_service.CreateCalibrationResponse\"\x00\x12g\n" +
+	"\x10ListCalibrations\x12'.sensor_service.ListCalibrationsRequest\x1a(.sensor_service.ListCalibrationsResponse\"\x00\x12j\n" +
+	"\x11UpdateCalibration\x12(.sensor_service.UpdateCalibrationRequest\x1a).sensor_service.UpdateCalibrationResponse\"\x00\x12j\n" +
+	"\x11DeleteCalibration\x12(.sensor_service.DeleteCalibrationRequest\x1a).sensor_service.DeleteCalibrationResponse\"\x00\x12j\n" +
 	"\x11CreateSensorGroup\x12(.sensor_service.CreateSensorGroupRequest\x1a).sensor_service.CreateSensorGroupResponse\"\x00\x12a\n" +
 	"\x0eGetSensorGroup\x12%.sensor_service.GetSensorGroupRequest\x1a&.sensor_service.GetSensorGroupResponse\"\x00\x12g\n" +
 	"\x10ListSensorGroups\x12'.sensor_service.ListSensorGroupsRequest\x1a(.sensor_service.ListSensorGroupsResponse\"\x00\x12j\n" +
@@ -2437,117 +3438,154 @@ func file_sensor_service_proto_rawDescGZIP() []byte {
 	return file_sensor_service_proto_rawDescData
 }
 
-var file_sensor_service_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_sensor_service_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_sensor_service_proto_goTypes = []any{
 	(*SensorType)(nil),                     // 0: sensor_service.SensorType
-	(*Sensor)(nil),                         // 1: sensor_service.Sensor
-	(*CreateSensorTypeRequest)(nil),        // 2: sensor_service.CreateSensorTypeRequest
-	(*CreateSensorTypeResponse)(nil),       // 3: sensor_service.CreateSensorTypeResponse
-	(*GetSensorTypeRequest)(nil),           // 4: sensor_service.GetSensorTypeRequest
-	(*GetSensorTypeResponse)(nil),          // 5: sensor_service.GetSensorTypeResponse
-	(*ListSensorTypesRequest)(nil),         // 6: sensor_service.ListSensorTypesRequest
-	(*ListSensorTypesResponse)(nil),        // 7: sensor_service.ListSensorTypesResponse
-	(*CreateSensorRequest)(nil),            // 8: sensor_service.CreateSensorRequest
-	(*CreateSensorResponse)(nil),           // 9: sensor_service.CreateSensorResponse
-	(*GetSensorRequest)(nil),               // 10: sensor_service.GetSensorRequest
-	(*GetSensorResponse)(nil),              // 11: sensor_service.GetSensorResponse
-	(*ListSensorsRequest)(nil),             // 12: sensor_service.ListSensorsRequest
-	(*ListSensorsResponse)(nil),            // 13: sensor_service.ListSensorsResponse
-	(*UpdateSensorRequest)(nil),            // 14: sensor_service.UpdateSensorRequest
-	(*UpdateSensorResponse)(nil),           // 15: sensor_service.UpdateSensorResponse
-	(*DeleteSensorRequest)(nil),            // 16: sensor_service.DeleteSensorRequest
-	(*DeleteSensorResponse)(nil),           // 17: sensor_service.DeleteSensorResponse
-	(*SetSensorActiveRequest)(nil),         // 18: sensor_service.SetSensorActiveRequest
-	(*SetSensorActiveResponse)(nil),        // 19: sensor_service.SetSensorActiveResponse
-	(*UpdateSensorTypeRequest)(nil),        // 20: sensor_service.UpdateSensorTypeRequest
-	(*UpdateSensorTypeResponse)(nil),       // 21: sensor_service.UpdateSensorTypeResponse
-	(*DeleteSensorTypeRequest)(nil),        // 22: sensor_service.DeleteSensorTypeRequest
-	(*DeleteSensorTypeResponse)(nil),       // 23: sensor_service.DeleteSensorTypeResponse
-	(*SensorGroup)(nil),                    // 24: sensor_service.SensorGroup
-	(*CreateSensorGroupRequest)(nil),       // 25: sensor_service.CreateSensorGroupRequest
-	(*CreateSensorGroupResponse)(nil),      // 26: sensor_service.CreateSensorGroupResponse
-	(*GetSensorGroupRequest)(nil),          // 27: sensor_service.GetSensorGroupRequest
-	(*GetSensorGroupResponse)(nil),         // 28: sensor_service.GetSensorGroupResponse
-	(*ListSensorGroupsRequest)(nil),        // 29: sensor_service.ListSensorGroupsRequest
-	(*SensorGroupWithSensors)(nil),         // 30: sensor_service.SensorGroupWithSensors
-	(*ListSensorGroupsResponse)(nil),       // 31: sensor_service.ListSensorGroupsResponse
-	(*UpdateSensorGroupRequest)(nil),       // 32: sensor_service.UpdateSensorGroupRequest
-	(*UpdateSensorGroupResponse)(nil),      // 33: sensor_service.UpdateSensorGroupResponse
-	(*DeleteSensorGroupRequest)(nil),       // 34: sensor_service.DeleteSensorGroupRequest
-	(*DeleteSensorGroupResponse)(nil),      // 35: sensor_service.DeleteSensorGroupResponse
-	(*AddSensorsToGroupRequest)(nil),       // 36: sensor_service.AddSensorsToGroupRequest
-	(*AddSensorsToGroupResponse)(nil),      // 37: sensor_service.AddSensorsToGroupResponse
-	(*RemoveSensorsFromGroupRequest)(nil),  // 38: sensor_service.RemoveSensorsFromGroupRequest
-	(*RemoveSensorsFromGroupResponse)(nil), // 39: sensor_service.RemoveSensorsFromGroupResponse
-	(*timestamppb.Timestamp)(nil),          // 40: google.protobuf.Timestamp
+	(*SensorChannel)(nil),                  // 1: sensor_service.SensorChannel
+	(*Sensor)(nil),                         // 2: sensor_service.Sensor
+	(*Calibration)(nil),                    // 3: sensor_service.Calibration
+	(*CreateSensorTypeRequest)(nil),        // 4: sensor_service.CreateSensorTypeRequest
+	(*CreateSensorTypeResponse)(nil),       // 5: sensor_service.CreateSensorTypeResponse
+	(*GetSensorTypeRequest)(nil),           // 6: sensor_service.GetSensorTypeRequest
+	(*GetSensorTypeResponse)(nil),          // 7: sensor_service.GetSensorTypeResponse
+	(*ListSensorTypesRequest)(nil),         // 8: sensor_service.ListSensorTypesRequest
+	(*ListSensorTypesResponse)(nil),        // 9: sensor_service.ListSensorTypesResponse
+	(*CreateSensorRequest)(nil),            // 10: sensor_service.CreateSensorRequest
+	(*CreateSensorResponse)(nil),           // 11: sensor_service.CreateSensorResponse
+	(*GetSensorRequest)(nil),               // 12: sensor_service.GetSensorRequest
+	(*GetSensorResponse)(nil),              // 13: sensor_service.GetSensorResponse
+	(*GetSensorsRequest)(nil),              // 14: sensor_service.GetSensorsRequest
+	(*GetSensorsResponse)(nil),             // 15: sensor_service.GetSensorsResponse
+	(*ListSensorsRequest)(nil),             // 16: sensor_service.ListSensorsRequest
+	(*ListSensorsResponse)(nil),            // 17: sensor_service.ListSensorsResponse
+	(*UpdateSensorRequest)(nil),            // 18: sensor_service.UpdateSensorRequest
+	(*UpdateSensorResponse)(nil),           // 19: sensor_service.UpdateSensorResponse
+	(*DeleteSensorRequest)(nil),            // 20: sensor_service.DeleteSensorRequest
+	(*DeleteSensorResponse)(nil),           // 21: sensor_service.DeleteSensorResponse
+	(*SetSensorActiveRequest)(nil),         // 22: sensor_service.SetSensorActiveRequest
+	(*SetSensorActiveResponse)(nil),        // 23: sensor_service.SetSensorActiveResponse
+	(*CreateCalibrationRequest)(nil),       // 24: sensor_service.CreateCalibrationRequest
+	(*CreateCalibrationResponse)(nil),      // 25: sensor_service.CreateCalibrationResponse
+	(*ListCalibrationsRequest)(nil),        // 26: sensor_service.ListCalibrationsRequest
+	(*ListCalibrationsResponse)(nil),       // 27: sensor_service.ListCalibrationsResponse
+	(*UpdateCalibrationRequest)(nil),       // 28: sensor_service.UpdateCalibrationRequest
+	(*UpdateCalibrationResponse)(nil),      // 29: sensor_service.UpdateCalibrationResponse
+	(*DeleteCalibrationRequest)(nil),       // 30: sensor_service.DeleteCalibrationRequest
+	(*DeleteCalibrationResponse)(nil),      // 31: sensor_service.DeleteCalibrationResponse
+	(*UpdateSensorTypeRequest)(nil),        // 32: sensor_service.UpdateSensorTypeRequest
+	(*UpdateSensorTypeResponse)(nil),       // 33: sensor_service.UpdateSensorTypeResponse
+	(*DeleteSensorTypeRequest)(nil),        // 34: sensor_service.DeleteSensorTypeRequest
+	(*DeleteSensorTypeResponse)(nil),       // 35: sensor_service.DeleteSensorTypeResponse
+	(*SensorGroup)(nil),                    // 36: sensor_service.SensorGroup
+	(*CreateSensorGroupRequest)(nil),       // 37: sensor_service.CreateSensorGroupRequest
+	(*CreateSensorGroupResponse)(nil),      // 38: sensor_service.CreateSensorGroupResponse
+	(*GetSensorGroupRequest)(nil),          // 39: sensor_service.GetSensorGroupRequest
+	(*GetSensorGroupResponse)(nil),         // 40: sensor_service.GetSensorGroupResponse
+	(*ListSensorGroupsRequest)(nil),        // 41: sensor_service.ListSensorGroupsRequest
+	(*SensorGroupWithSensors)(nil),         // 42: sensor_service.SensorGroupWithSensors
+	(*ListSensorGroupsResponse)(nil),       // 43: sensor_service.ListSensorGroupsResponse
+	(*UpdateSensorGroupRequest)(nil),       // 44: sensor_service.UpdateSensorGroupRequest
+	(*UpdateSensorGroupResponse)(nil),      // 45: sensor_service.UpdateSensorGroupResponse
+	(*DeleteSensorGroupRequest)(nil),       // 46: sensor_service.DeleteSensorGroupRequest
+	(*DeleteSensorGroupResponse)(nil),      // 47: sensor_service.DeleteSensorGroupResponse
+	(*AddSensorsToGroupRequest)(nil),       // 48: sensor_service.AddSensorsToGroupRequest
+	(*AddSensorsToGroupResponse)(nil),      // 49: sensor_service.AddSensorsToGroupResponse
+	(*RemoveSensorsFromGroupRequest)(nil),  // 50: sensor_service.RemoveSensorsFromGroupRequest
+	(*RemoveSensorsFromGroupResponse)(nil), // 51: sensor_service.RemoveSensorsFromGroupResponse
+	(*timestamppb.Timestamp)(nil),          // 52: google.protobuf.Timestamp
 }
 var file_sensor_service_proto_depIdxs = []int32{
-	40, // 0: sensor_service.SensorType.created_at:type_name -> google.protobuf.Timestamp
-	40, // 1: sensor_service.Sensor.last_updated:type_name -> google.protobuf.Timestamp
-	40, // 2: sensor_service.Sensor.created_at:type_name -> google.protobuf.Timestamp
-	40, // 3: sensor_service.Sensor.updated_at:type_name -> google.protobuf.Timestamp
-	0,  // 4: sensor_service.Sensor.sensor_type:type_name -> sensor_service.SensorType
-	0,  // 5: sensor_service.CreateSensorTypeResponse.sensor_type:type_name -> sensor_service.SensorType
-	0,  // 6: sensor_service.GetSensorTypeResponse.sensor_type:type_name -> sensor_service.SensorType
-	0,  // 7: sensor_service.ListSensorTypesResponse.sensor_types:type_name -> sensor_service.SensorType
-	1,  // 8: sensor_service.CreateSensorResponse.sensor:type_name -> sensor_service.Sensor
-	1,  // 9: sensor_service.GetSensorResponse.sensor:type_name -> sensor_service.Sensor
-	1,  // 10: sensor_service.ListSensorsResponse.sensors:type_name -> sensor_service.Sensor
-	1,  // 11: sensor_service.UpdateSensorResponse.sensor:type_name -> sensor_service.Sensor
-	1,  // 12: sensor_service.SetSensorActiveResponse.sensor:type_name -> sensor_service.Sensor
-	0,  // 13: sensor_service.UpdateSensorTypeResponse.sensor_type:type_name -> sensor_service.SensorType
-	40, // 14: sensor_service.SensorGroup.created_at:type_name -> google.protobuf.Timestamp
-	40, // 15: sensor_service.SensorGroup.updated_at:type_name -> google.protobuf.Timestamp
-	24, // 16: sensor_service.CreateSensorGroupResponse.group:type_name -> sensor_service.SensorGroup
-	24, // 17: sensor_service.GetSensorGroupResponse.group:type_name -> sensor_service.SensorGroup
-	1,  // 18: sensor_service.GetSensorGroupResponse.sensors:type_name -> sensor_service.Sensor
-	24, // 19: sensor_service.SensorGroupWithSensors.group:type_name -> sensor_service.SensorGroup
-	1,  // 20: sensor_service.SensorGroupWithSensors.sensors:type_name -> sensor_service.Sensor
-	30, // 21: sensor_service.ListSensorGroupsResponse.groups:type_name -> sensor_service.SensorGroupWithSensors
-	24, // 22: sensor_service.UpdateSensorGroupResponse.group:type_name -> sensor_service.SensorGroup
-	24, // 23: sensor_service.AddSensorsToGroupResponse.group:type_name -> sensor_service.SensorGroup
-	24, // 24: sensor_service.RemoveSensorsFromGroupResponse.group:type_name -> sensor_service.SensorGroup
-	2,  // 25: sensor_service.SensorService.CreateSensorType:input_type -> sensor_service.CreateSensorTypeRequest
-	4,  // 26: sensor_service.SensorService.GetSensorType:input_type -> sensor_service.GetSensorTypeRequest
-	6,  // 27: sensor_service.SensorService.ListSensorTypes:input_type -> sensor_service.ListSensorTypesRequest
-	20, // 28: sensor_service.SensorService.UpdateSensorType:input_type -> sensor_service.UpdateSensorTypeRequest
-	22, // 29: sensor_service.SensorService.DeleteSensorType:input_type -> sensor_service.DeleteSensorTypeRequest
-	8,  // 30: sensor_service.SensorService.CreateSensor:input_type -> sensor_service.CreateSensorRequest
-	10, // 31: sensor_service.SensorService.GetSensor:input_type -> sensor_service.GetSensorRequest
-	12, // 32: sensor_service.SensorService.ListSensors:input_type -> sensor_service.ListSensorsRequest
-	14, // 33: sensor_service.SensorService.UpdateSensor:input_type -> sensor_service.UpdateSensorRequest
-	16, // 34: sensor_service.SensorService.DeleteSensor:input_type -> sensor_service.DeleteSensorRequest
-	18, // 35: sensor_service.SensorService.SetSensorActive:input_type -> sensor_service.SetSensorActiveRequest
-	25, // 36: sensor_service.SensorService.CreateSensorGroup:input_type -> sensor_service.CreateSensorGroupRequest
-	27, // 37: sensor_service.SensorService.GetSensorGroup:input_type -> sensor_service.GetSensorGroupRequest
-	29, // 38: sensor_service.SensorService.ListSensorGroups:input_type -> sensor_service.ListSensorGroupsRequest
-	32, // 39: sensor_service.SensorService.UpdateSensorGroup:input_type -> sensor_service.UpdateSensorGroupRequest
-	34, // 40: sensor_service.SensorService.DeleteSensorGroup:input_type -> sensor_service.DeleteSensorGroupRequest
-	36, // 41: sensor_service.SensorService.AddSensorsToGroup:input_type -> sensor_service.AddSensorsToGroupRequest
-	38, // 42: sensor_service.SensorService.RemoveSensorsFromGroup:input_type -> sensor_service.RemoveSensorsFromGroupRequest
-	3,  // 43: sensor_service.SensorService.CreateSensorType:output_type -> sensor_service.CreateSensorTypeResponse
-	5,  // 44: sensor_service.SensorService.GetSensorType:output_type -> sensor_service.GetSensorTypeResponse
-	7,  // 45: sensor_service.SensorService.ListSensorTypes:output_type -> sensor_service.ListSensorTypesResponse
-	21, // 46: sensor_service.SensorService.UpdateSensorType:output_type -> sensor_service.UpdateSensorTypeResponse
-	23, // 47: sensor_service.SensorService.DeleteSensorType:output_type -> sensor_service.DeleteSensorTypeResponse
-	9,  // 48: sensor_service.SensorService.CreateSensor:output_type -> sensor_service.CreateSensorResponse
-	11, // 49: sensor_service.SensorService.GetSensor:output_type -> sensor_service.GetSensorResponse
-	13, // 50: sensor_service.SensorService.ListSensors:output_type -> sensor_service.ListSensorsResponse
-	15, // 51: sensor_service.SensorService.UpdateSensor:output_type -> sensor_service.UpdateSensorResponse
-	17, // 52: sensor_service.SensorService.DeleteSensor:output_type -> sensor_service.DeleteSensorResponse
-	19, // 53: sensor_service.SensorService.SetSensorActive:output_type -> sensor_service.SetSensorActiveResponse
-	26, // 54: sensor_service.SensorService.CreateSensorGroup:output_type -> sensor_service.CreateSensorGroupResponse
-	28, // 55: sensor_service.SensorService.GetSensorGroup:output_type -> sensor_service.GetSensorGroupResponse
-	31, // 56: sensor_service.SensorService.ListSensorGroups:output_type -> sensor_service.ListSensorGroupsResponse
-	33, // 57: sensor_service.SensorService.UpdateSensorGroup:output_type -> sensor_service.UpdateSensorGroupResponse
-	35, // 58: sensor_service.SensorService.DeleteSensorGroup:output_type -> sensor_service.DeleteSensorGroupResponse
-	37, // 59: sensor_service.SensorService.AddSensorsToGroup:output_type -> sensor_service.AddSensorsToGroupResponse
-	39, // 60: sensor_service.SensorService.RemoveSensorsFromGroup:output_type -> sensor_service.RemoveSensorsFromGroupResponse
-	43, // [43:61] is the sub-list for method output_type
-	25, // [25:43] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	52, // 0: sensor_service.SensorType.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 1: sensor_service.SensorType.channels:type_name -> sensor_service.SensorChannel
+	52, // 2: sensor_service.Sensor.last_updated:type_name -> google.protobuf.Timestamp
+	52, // 3: sensor_service.Sensor.created_at:type_name -> google.protobuf.Timestamp
+	52, // 4: sensor_service.Sensor.updated_at:type_name -> google.protobuf.Timestamp
+	0,  // 5: sensor_service.Sensor.sensor_type:type_name -> sensor_service.SensorType
+	3,  // 6: sensor_service.Sensor.calibrations:type_name -> sensor_service.Calibration
+	52, // 7: sensor_service.Calibration.valid_from:type_name -> google.protobuf.Timestamp
+	52, // 8: sensor_service.Calibration.valid_to:type_name -> google.protobuf.Timestamp
+	52, // 9: sensor_service.Calibration.created_at:type_name -> google.protobuf.Timestamp
+	1,  // 10: sensor_service.CreateSensorTypeRequest.channels:type_name -> sensor_service.SensorChannel
+	0,  // 11: sensor_service.CreateSensorTypeResponse.sensor_type:type_name -> sensor_service.SensorType
+	0,  // 12: sensor_service.GetSensorTypeResponse.sensor_type:type_name -> sensor_service.SensorType
+	0,  // 13: sensor_service.ListSensorTypesResponse.sensor_types:type_name -> sensor_service.SensorType
+	2,  // 14: sensor_service.CreateSensorResponse.sensor:type_name -> sensor_service.Sensor
+	2,  // 15: sensor_service.GetSensorResponse.sensor:type_name -> sensor_service.Sensor
+	2,  // 16: sensor_service.GetSensorsResponse.sensors:type_name -> sensor_service.Sensor
+	2,  // 17: sensor_service.ListSensorsResponse.sensors:type_name -> sensor_service.Sensor
+	2,  // 18: sensor_service.UpdateSensorResponse.sensor:type_name -> sensor_service.Sensor
+	2,  // 19: sensor_service.SetSensorActiveResponse.sensor:type_name -> sensor_service.Sensor
+	52, // 20: sensor_service.CreateCalibrationRequest.valid_from:type_name -> google.protobuf.Timestamp
+	52, // 21: sensor_service.CreateCalibrationRequest.valid_to:type_name -> google.protobuf.Timestamp
+	3,  // 22: sensor_service.CreateCalibrationResponse.calibration:type_name -> sensor_service.Calibration
+	3,  // 23: sensor_service.ListCalibrationsResponse.calibrations:type_name -> sensor_service.Calibration
+	52, // 24: sensor_service.UpdateCalibrationRequest.valid_from:type_name -> google.protobuf.Timestamp
+	52, // 25: sensor_service.UpdateCalibrationRequest.valid_to:type_name -> google.protobuf.Timestamp
+	3,  // 26: sensor_service.UpdateCalibrationResponse.calibration:type_name -> sensor_service.Calibration
+	1,  // 27: sensor_service.UpdateSensorTypeRequest.channels:type_name -> sensor_service.SensorChannel
+	0,  // 28: sensor_service.UpdateSensorTypeResponse.sensor_type:type_name -> sensor_service.SensorType
+	52, // 29: sensor_service.SensorGroup.created_at:type_name -> google.protobuf.Timestamp
+	52, // 30: sensor_service.SensorGroup.updated_at:type_name -> google.protobuf.Timestamp
+	36, // 31: sensor_service.CreateSensorGroupResponse.group:type_name -> sensor_service.SensorGroup
+	36, // 32: sensor_service.GetSensorGroupResponse.group:type_name -> sensor_service.SensorGroup
+	2,  // 33: sensor_service.GetSensorGroupResponse.sensors:type_name -> sensor_service.Sensor
+	36, // 34: sensor_service.SensorGroupWithSensors.group:type_name -> sensor_service.SensorGroup
+	2,  // 35: sensor_service.SensorGroupWithSensors.sensors:type_name -> sensor_service.Sensor
+	42, // 36: sensor_service.ListSensorGroupsResponse.groups:type_name -> sensor_service.SensorGroupWithSensors
+	36, // 37: sensor_service.UpdateSensorGroupResponse.group:type_name -> sensor_service.SensorGroup
+	36, // 38: sensor_service.AddSensorsToGroupResponse.group:type_name -> sensor_service.SensorGroup
+	36, // 39: sensor_service.RemoveSensorsFromGroupResponse.group:type_name -> sensor_service.SensorGroup
+	4,  // 40: sensor_service.SensorService.CreateSensorType:input_type -> sensor_service.CreateSensorTypeRequest
+	6,  // 41: sensor_service.SensorService.GetSensorType:input_type -> sensor_service.GetSensorTypeRequest
+	8,  // 42: sensor_service.SensorService.ListSensorTypes:input_type -> sensor_service.ListSensorTypesRequest
+	32, // 43: sensor_service.SensorService.UpdateSensorType:input_type -> sensor_service.UpdateSensorTypeRequest
+	34, // 44: sensor_service.SensorService.DeleteSensorType:input_type -> sensor_service.DeleteSensorTypeRequest
+	10, // 45: sensor_service.SensorService.CreateSensor:input_type -> sensor_service.CreateSensorRequest
+	12, // 46: sensor_service.SensorService.GetSensor:input_type -> sensor_service.GetSensorRequest
+	14, // 47: sensor_service.SensorService.GetSensors:input_type -> sensor_service.GetSensorsRequest
+	16, // 48: sensor_service.SensorService.ListSensors:input_type -> sensor_service.ListSensorsRequest
+	18, // 49: sensor_service.SensorService.UpdateSensor:input_type -> sensor_service.UpdateSensorRequest
+	20, // 50: sensor_service.SensorService.DeleteSensor:input_type -> sensor_service.DeleteSensorRequest
+	22, // 51: sensor_service.SensorService.SetSensorActive:input_type -> sensor_service.SetSensorActiveRequest
+	24, // 52: sensor_service.SensorService.CreateCalibration:input_type -> sensor_service.CreateCalibrationRequest
+	26, // 53: sensor_service.SensorService.ListCalibrations:input_type -> sensor_service.ListCalibrationsRequest
+	28, // 54: sensor_service.SensorService.UpdateCalibration:input_type -> sensor_service.UpdateCalibrationRequest
+	30, // 55: sensor_service.SensorService.DeleteCalibration:input_type -> sensor_service.DeleteCalibrationRequest
+	37, // 56: sensor_service.SensorService.CreateSensorGroup:input_type -> sensor_service.CreateSensorGroupRequest
+	39, // 57: sensor_service.SensorService.GetSensorGroup:input_type -> sensor_service.GetSensorGroupRequest
+	41, // 58: sensor_service.SensorService.ListSensorGroups:input_type -> sensor_service.ListSensorGroupsRequest
+	44, // 59: sensor_service.SensorService.UpdateSensorGroup:input_type -> sensor_service.UpdateSensorGroupRequest
+	46, // 60: sensor_service.SensorService.DeleteSensorGroup:input_type -> sensor_service.DeleteSensorGroupRequest
+	48, // 61: sensor_service.SensorService.AddSensorsToGroup:input_type -> sensor_service.AddSensorsToGroupRequest
+	50, // 62: sensor_service.SensorService.RemoveSensorsFromGroup:input_type -> sensor_service.RemoveSensorsFromGroupRequest
+	5,  // 63: sensor_service.SensorService.CreateSensorType:output_type -> sensor_service.CreateSensorTypeResponse
+	7,  // 64: sensor_service.SensorService.GetSensorType:output_type -> sensor_service.GetSensorTypeResponse
+	9,  // 65: sensor_service.SensorService.ListSensorTypes:output_type -> sensor_service.ListSensorTypesResponse
+	33, // 66: sensor_service.SensorService.UpdateSensorType:output_type -> sensor_service.UpdateSensorTypeResponse
+	35, // 67: sensor_service.SensorService.DeleteSensorType:output_type -> sensor_service.DeleteSensorTypeResponse
+	11, // 68: sensor_service.SensorService.CreateSensor:output_type -> sensor_service.CreateSensorResponse
+	13, // 69: sensor_service.SensorService.GetSensor:output_type -> sensor_service.GetSensorResponse
+	15, // 70: sensor_service.SensorService.GetSensors:output_type -> sensor_service.GetSensorsResponse
+	17, // 71: sensor_service.SensorService.ListSensors:output_type -> sensor_service.ListSensorsResponse
+	19, // 72: sensor_service.SensorService.UpdateSensor:output_type -> sensor_service.UpdateSensorResponse
+	21, // 73: sensor_service.SensorService.DeleteSensor:output_type -> sensor_service.DeleteSensorResponse
+	23, // 74: sensor_service.SensorService.SetSensorActive:output_type -> sensor_service.SetSensorActiveResponse
+	25, // 75: sensor_service.SensorService.CreateCalibration:output_type -> sensor_service.CreateCalibrationResponse
+	27, // 76: sensor_service.SensorService.ListCalibrations:output_type -> sensor_service.ListCalibrationsResponse
+	29, // 77: sensor_service.SensorService.UpdateCalibration:output_type -> sensor_service.UpdateCalibrationResponse
+	31, // 78: sensor_service.SensorService.DeleteCalibration:output_type -> sensor_service.DeleteCalibrationResponse
+	38, // 79: sensor_service.SensorService.CreateSensorGroup:output_type -> sensor_service.CreateSensorGroupResponse
+	40, // 80: sensor_service.SensorService.GetSensorGroup:output_type -> sensor_service.GetSensorGroupResponse
+	43, // 81: sensor_service.SensorService.ListSensorGroups:output_type -> sensor_service.ListSensorGroupsResponse
+	45, // 82: sensor_service.SensorService.UpdateSensorGroup:output_type -> sensor_service.UpdateSensorGroupResponse
+	47, // 83: sensor_service.SensorService.DeleteSensorGroup:output_type -> sensor_service.DeleteSensorGroupResponse
+	49, // 84: sensor_service.SensorService.AddSensorsToGroup:output_type -> sensor_service.AddSensorsToGroupResponse
+	51, // 85: sensor_service.SensorService.RemoveSensorsFromGroup:output_type -> sensor_service.RemoveSensorsFromGroupResponse
+	63, // [63:86] is the sub-list for method output_type
+	40, // [40:63] is the sub-list for method input_type
+	40, // [40:40] is the sub-list for extension type_name
+	40, // [40:40] is the sub-list for extension extendee
+	0,  // [0:40] is the sub-list for field type_name
 }
 
 func init() { file_sensor_service_proto_init() }
@@ -2561,7 +3599,7 @@ func file_sensor_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sensor_service_proto_rawDesc), len(file_sensor_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   40,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -26,10 +26,15 @@ const (
 	SensorService_DeleteSensorType_FullMethodName       = "/sensor_service.SensorService/DeleteSensorType"
 	SensorService_CreateSensor_FullMethodName           = "/sensor_service.SensorService/CreateSensor"
 	SensorService_GetSensor_FullMethodName              = "/sensor_service.SensorService/GetSensor"
+	SensorService_GetSensors_FullMethodName             = "/sensor_service.SensorService/GetSensors"
 	SensorService_ListSensors_FullMethodName            = "/sensor_service.SensorService/ListSensors"
 	SensorService_UpdateSensor_FullMethodName           = "/sensor_service.SensorService/UpdateSensor"
 	SensorService_DeleteSensor_FullMethodName           = "/sensor_service.SensorService/DeleteSensor"
 	SensorService_SetSensorActive_FullMethodName        = "/sensor_service.SensorService/SetSensorActive"
+	SensorService_CreateCalibration_FullMethodName      = "/sensor_service.SensorService/CreateCalibration"
+	SensorService_ListCalibrations_FullMethodName       = "/sensor_service.SensorService/ListCalibrations"
+	SensorService_UpdateCalibration_FullMethodName      = "/sensor_service.SensorService/UpdateCalibration"
+	SensorService_DeleteCalibration_FullMethodName      = "/sensor_service.SensorService/DeleteCalibration"
 	SensorService_CreateSensorGroup_FullMethodName      = "/sensor_service.SensorService/CreateSensorGroup"
 	SensorService_GetSensorGroup_FullMethodName         = "/sensor_service.SensorService/GetSensorGroup"
 	SensorService_ListSensorGroups_FullMethodName       = "/sensor_service.SensorService/ListSensorGroups"
@@ -50,10 +55,15 @@ type SensorServiceClient interface {
 	DeleteSensorType(ctx context.Context, in *DeleteSensorTypeRequest, opts ...grpc.CallOption) (*DeleteSensorTypeResponse, error)
 	CreateSensor(ctx context.Context, in *CreateSensorRequest, opts ...grpc.CallOption) (*CreateSensorResponse, error)
 	GetSensor(ctx context.Context, in *GetSensorRequest, opts ...grpc.CallOption) (*GetSensorResponse, error)
+	GetSensors(ctx context.Context, in *GetSensorsRequest, opts ...grpc.CallOption) (*GetSensorsResponse, error)
 	ListSensors(ctx context.Context, in *ListSensorsRequest, opts ...grpc.CallOption) (*ListSensorsResponse, error)
 	UpdateSensor(ctx context.Context, in *UpdateSensorRequest, opts ...grpc.CallOption) (*UpdateSensorResponse, error)
 	DeleteSensor(ctx context.Context, in *DeleteSensorRequest, opts ...grpc.CallOption) (*DeleteSensorResponse, error)
 	SetSensorActive(ctx context.Context, in *SetSensorActiveRequest, opts ...grpc.CallOption) (*SetSensorActiveResponse, error)
+	CreateCalibration(ctx context.Context, in *CreateCalibrationRequest, opts ...grpc.CallOption) (*CreateCalibrationResponse, error)
+	ListCalibrations(ctx context.Context, in *ListCalibrationsRequest, opts ...grpc.CallOption) (*ListCalibrationsResponse, error)
+	UpdateCalibration(ctx context.Context, in *UpdateCalibrationRequest, opts ...grpc.CallOption) (*UpdateCalibrationResponse, error)
+	DeleteCalibration(ctx context.Context, in *DeleteCalibrationRequest, opts ...grpc.CallOption) (*DeleteCalibrationResponse, error)
 	CreateSensorGroup(ctx context.Context, in *CreateSensorGroupRequest, opts ...grpc.CallOption) (*CreateSensorGroupResponse, error)
 	GetSensorGroup(ctx context.Context, in *GetSensorGroupRequest, opts ...grpc.CallOption) (*GetSensorGroupResponse, error)
 	ListSensorGroups(ctx context.Context, in *ListSensorGroupsRequest, opts ...grpc.CallOption) (*ListSensorGroupsResponse, error)
@@ -141,6 +151,16 @@ func (c *sensorServiceClient) GetSensor(ctx context.Context, in *GetSensorReques
 	return out, nil
 }
 
+func (c *sensorServiceClient) GetSensors(ctx context.Context, in *GetSensorsRequest, opts ...grpc.CallOption) (*GetSensorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSensorsResponse)
+	err := c.cc.Invoke(ctx, SensorService_GetSensors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sensorServiceClient) ListSensors(ctx context.Context, in *ListSensorsRequest, opts ...grpc.CallOption) (*ListSensorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListSensorsResponse)
@@ -181,6 +201,46 @@ func (c *sensorServiceClient) SetSensorActive(ctx context.Context, in *SetSensor
 	return out, nil
 }
 
+func (c *sensorServiceClient) CreateCalibration(ctx context.Context, in *CreateCalibrationRequest, opts ...grpc.CallOption) (*CreateCalibrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateCalibrationResponse)
+	err := c.cc.Invoke(ctx, SensorService_CreateCalibration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorServiceClient) ListCalibrations(ctx context.Context, in *ListCalibrationsRequest, opts ...grpc.CallOption) (*ListCalibrationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCalibrationsResponse)
+	err := c.cc.Invoke(ctx, SensorService_ListCalibrations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorServiceClient) UpdateCalibration(ctx context.Context, in *UpdateCalibrationRequest, opts ...grpc.CallOption) (*UpdateCalibrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateCalibrationResponse)
+	err := c.cc.Invoke(ctx, SensorService_UpdateCalibration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sensorServiceClient) DeleteCalibration(ctx context.Context, in *DeleteCalibrationRequest, opts ...grpc.CallOption) (*DeleteCalibrationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteCalibrationResponse)
+	err := c.cc.Invoke(ctx, SensorService_DeleteCalibration_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *sensorServiceClient) CreateSensorGroup(ctx context.Context, in *CreateSensorGroupRequest, opts ...grpc.CallOption) (*CreateSensorGroupResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateSensorGroupResponse)
@@ -262,10 +322,15 @@ type SensorServiceServer interface {
 	DeleteSensorType(context.Context, *DeleteSensorTypeRequest) (*DeleteSensorTypeResponse, error)
 	CreateSensor(context.Context, *CreateSensorRequest) (*CreateSensorResponse, error)
 	GetSensor(context.Context, *GetSensorRequest) (*GetSensorResponse, error)
+	GetSensors(context.Context, *GetSensorsRequest) (*GetSensorsResponse, error)
 	ListSensors(context.Context, *ListSensorsRequest) (*ListSensorsResponse, error)
 	UpdateSensor(context.Context, *UpdateSensorRequest) (*UpdateSensorResponse, error)
 	DeleteSensor(context.Context, *DeleteSensorRequest) (*DeleteSensorResponse, error)
 	SetSensorActive(context.Context, *SetSensorActiveRequest) (*SetSensorActiveResponse, error)
+	CreateCalibration(context.Context, *CreateCalibrationRequest) (*CreateCalibrationResponse, error)
+	ListCalibrations(context.Context, *ListCalibrationsRequest) (*ListCalibrationsResponse, error)
+	UpdateCalibration(context.Context, *UpdateCalibrationRequest) (*UpdateCalibrationResponse, error)
+	DeleteCalibration(context.Context, *DeleteCalibrationRequest) (*DeleteCalibrationResponse, error)
 	CreateSensorGroup(context.Context, *CreateSensorGroupRequest) (*CreateSensorGroupResponse, error)
 	GetSensorGroup(context.Context, *GetSensorGroupRequest) (*GetSensorGroupResponse, error)
 	ListSensorGroups(context.Context, *ListSensorGroupsRequest) (*ListSensorGroupsResponse, error)
@@ -304,6 +369,9 @@ func (UnimplementedSensorServiceServer) CreateSensor(context.Context, *CreateSen
 func (UnimplementedSensorServiceServer) GetSensor(context.Context, *GetSensorRequest) (*GetSensorResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetSensor not implemented")
 }
+func (UnimplementedSensorServiceServer) GetSensors(context.Context, *GetSensorsRequest) (*GetSensorsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetSensors not implemented")
+}
 func (UnimplementedSensorServiceServer) ListSensors(context.Context, *ListSensorsRequest) (*ListSensorsResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListSensors not implemented")
 }
@@ -316,6 +384,18 @@ func (UnimplementedSensorServiceServer) DeleteSensor(context.Context, *DeleteSen
 func (UnimplementedSensorServiceServer) SetSensorActive(context.Context, *SetSensorActiveRequest) (*SetSensorActiveResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method SetSensorActive not implemented")
 }
+func (UnimplementedSensorServiceServer) CreateCalibration(context.Context, *CreateCalibrationRequest) (*CreateCalibrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateCalibration not implemented")
+}
+func (UnimplementedSensorServiceServer) ListCalibrations(context.Context, *ListCalibrationsRequest) (*ListCalibrationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCalibrations not implemented")
+}
+func (UnimplementedSensorServiceServer) UpdateCalibration(context.Context, *UpdateCalibrationRequest) (*UpdateCalibrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateCalibration not implemented")
+}
+func (UnimplementedSensorServiceServer) DeleteCalibration(context.Context, *DeleteCalibrationRequest) (*DeleteCalibrationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DeleteCalibration not implemented")
+}
 func (UnimplementedSensorServiceServer) CreateSensorGroup(context.Context, *CreateSensorGroupRequest) (*CreateSensorGroupResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CreateSensorGroup not implemented")
 }
@@ -484,6 +564,24 @@ func _SensorService_GetSensor_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SensorService_GetSensors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSensorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorServiceServer).GetSensors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SensorService_GetSensors_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorServiceServer).GetSensors(ctx, req.(*GetSensorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SensorService_ListSensors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSensorsRequest)
 	if err := dec(in); err != nil {
@@ -556,6 +654,78 @@ func _SensorService_SetSensorActive_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SensorService_CreateCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorServiceServer).CreateCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SensorService_CreateCalibration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorServiceServer).CreateCalibration(ctx, req.(*CreateCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorService_ListCalibrations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCalibrationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorServiceServer).ListCalibrations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SensorService_ListCalibrations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorServiceServer).ListCalibrations(ctx, req.(*ListCalibrationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorService_UpdateCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorServiceServer).UpdateCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SensorService_UpdateCalibration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorServiceServer).UpdateCalibration(ctx, req.(*UpdateCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SensorService_DeleteCalibration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCalibrationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SensorServiceServer).DeleteCalibration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SensorService_DeleteCalibration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SensorServiceServer).DeleteCalibration(ctx, req.(*DeleteCalibrationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SensorService_CreateSensorGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateSensorGroupRequest)
 	if err := dec(in); err != nil {
@@ -717,6 +887,10 @@ var SensorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSensor",
 			Handler:    _SensorService_GetSensor_Handler,
 		},
+		{
+			MethodName: "GetSensors",
+			Handler:    _SensorService_GetSensors_Handler,
+		},
 		{
 			MethodName: "ListSensors",
 			Handler:    _SensorService_ListSensors_Handler,
@@ -733,6 +907,22 @@ var SensorService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetSensorActive",
 			Handler:    _SensorService_SetSensorActive_Handler,
 		},
+		{
+			MethodName: "CreateCalibration",
+			Handler:    _SensorService_CreateCalibration_Handler,
+		},
+		{
+			MethodName: "ListCalibrations",
+			Handler:    _SensorService_ListCalibrations_Handler,
+		},
+		{
+			MethodName: "UpdateCalibration",
+			Handler:    _SensorService_UpdateCalibration_Handler,
+		},
+		{
+			MethodName: "DeleteCalibration",
+			Handler:    _SensorService_DeleteCalibration_Handler,
+		},
 		{
 			MethodName: "CreateSensorGroup",
 			Handler:    _SensorService_CreateSensorGroup_Handler,
@@ -7,13 +7,19 @@ import (
 )
 
 type AlertResponse struct {
-	ID          int64     `json:"id"`
-	RuleID      int64     `json:"rule_id"`
-	SensorID    int64     `json:"sensor_id"`
-	Message     string    `json:"message"`
-	Value       float64   `json:"value"`
-	IsRead      bool      `json:"is_read"`
-	TriggeredAt time.Time `json:"triggered_at"`
+	ID              int64      `json:"id"`
+	RuleID          int64      `json:"rule_id"`
+	SensorID        int64      `json:"sensor_id"`
+	Message         string     `json:"message"`
+	Value           float64    `json:"value"`
+	IsRead          bool       `json:"is_read"`
+	TriggeredAt     time.Time  `json:"triggered_at"`
+	Status          string     `json:"status"`
+	Count           int32      `json:"count"`
+	LastValue       float64    `json:"last_value"`
+	LastTriggeredAt *time.Time `json:"last_triggered_at,omitempty"`
+	AcknowledgedAt  *time.Time `json:"acknowledged_at,omitempty"`
+	ResolvedAt      *time.Time `json:"resolved_at,omitempty"`
 }
 
 type PaginatedAlertResponse struct {
@@ -24,7 +30,7 @@ type PaginatedAlertResponse struct {
 }
 
 func MapAlertFromProto(a *pb.Alert) AlertResponse {
-	return AlertResponse{
+	response := AlertResponse{
 		ID:          a.Id,
 		RuleID:      a.RuleId,
 		SensorID:    a.SensorId,
@@ -32,5 +38,21 @@ func MapAlertFromProto(a *pb.Alert) AlertResponse {
 		Value:       a.Value,
 		IsRead:      a.IsRead,
 		TriggeredAt: a.TriggeredAt.AsTime(),
+		Status:      a.Status,
+		Count:       a.Count,
+		LastValue:   a.LastValue,
 	}
+	if a.LastTriggeredAt != nil {
+		t := a.LastTriggeredAt.AsTime()
+		response.LastTriggeredAt = &t
+	}
+	if a.AcknowledgedAt != nil {
+		t := a.AcknowledgedAt.AsTime()
+		response.AcknowledgedAt = &t
+	}
+	if a.ResolvedAt != nil {
+		t := a.ResolvedAt.AsTime()
+		response.ResolvedAt = &t
+	}
+	return response
 }
@@ -11,8 +11,16 @@ type AlertRuleResponse struct {
 	Name           string    `json:"name"`
 	UserID         int64     `json:"user_id"`
 	SensorID       int64     `json:"sensor_id"`
+	Channel        string    `json:"channel,omitempty"`
 	Condition_Type string    `json:"condition_type"`
 	Threshold      float64   `json:"threshold"`
+	ThresholdHigh  float64   `json:"threshold_high,omitempty"`
+	ForSeconds     int32     `json:"for_seconds,omitempty"`
+	ForReadings    int32     `json:"for_readings,omitempty"`
+	ClearThreshold *float64  `json:"clear_threshold,omitempty"`
+	Metric         string    `json:"metric,omitempty"`
+	WindowSeconds  int32     `json:"window_seconds,omitempty"`
+	SkipBadQuality bool      `json:"skip_bad_quality"`
 	Description    string    `json:"description"`
 	IsEnabled      bool      `json:"is_enabled"`
 	CreatedAt      time.Time `json:"created_at"`
@@ -26,21 +34,37 @@ type PaginatedAlertRuleResponse struct {
 }
 
 type AlertRuleRequest struct {
-	Name           string  `json:"name"`
-	SensorID       int64   `json:"sensor_id"`
-	Condition_Type string  `json:"condition_type"`
-	Threshold      float64 `json:"threshold"`
-	Description    string  `json:"description"`
+	Name           string   `json:"name"`
+	SensorID       int64    `json:"sensor_id"`
+	Channel        string   `json:"channel,omitempty"`
+	Condition_Type string   `json:"condition_type"`
+	Threshold      float64  `json:"threshold"`
+	ThresholdHigh  float64  `json:"threshold_high,omitempty"`
+	ForSeconds     int32    `json:"for_seconds,omitempty"`
+	ForReadings    int32    `json:"for_readings,omitempty"`
+	ClearThreshold *float64 `json:"clear_threshold,omitempty"`
+	Metric         string   `json:"metric,omitempty"`
+	WindowSeconds  int32    `json:"window_seconds,omitempty"`
+	SkipBadQuality bool     `json:"skip_bad_quality,omitempty"`
+	Description    string   `json:"description"`
 }
 
 type UpdateAlertRuleRequest struct {
-	ID             int64   `json:"id"`
-	Name           string  `json:"name"`
-	SensorID       int64   `json:"sensor_id"`
-	Condition_Type string  `json:"condition_type"`
-	Threshold      float64 `json:"threshold"`
-	Description    string  `json:"description"`
-	IsEnabled      bool    `json:"is_enabled"`
+	ID             int64    `json:"id"`
+	Name           string   `json:"name"`
+	SensorID       int64    `json:"sensor_id"`
+	Channel        string   `json:"channel,omitempty"`
+	Condition_Type string   `json:"condition_type"`
+	Threshold      float64  `json:"threshold"`
+	ThresholdHigh  float64  `json:"threshold_high,omitempty"`
+	ForSeconds     int32    `json:"for_seconds,omitempty"`
+	ForReadings    int32    `json:"for_readings,omitempty"`
+	ClearThreshold *float64 `json:"clear_threshold,omitempty"`
+	Metric         string   `json:"metric,omitempty"`
+	WindowSeconds  int32    `json:"window_seconds,omitempty"`
+	SkipBadQuality bool     `json:"skip_bad_quality,omitempty"`
+	Description    string   `json:"description"`
+	IsEnabled      bool     `json:"is_enabled"`
 }
 
 type DeleteAlertRuleRequest struct {
@@ -52,15 +76,27 @@ type GetAlertRulesRequest struct {
 }
 
 func MapAlertRuleFromProto(r *pb.AlertRule) AlertRuleResponse {
-	return AlertRuleResponse{
+	rule := AlertRuleResponse{
 		ID:             r.Id,
 		Name:           r.Name,
 		UserID:         r.UserId,
 		SensorID:       r.SensorId,
+		Channel:        r.Channel,
 		Condition_Type: r.ConditionType,
 		Threshold:      r.Threshold,
+		ThresholdHigh:  r.ThresholdHigh,
+		ForSeconds:     r.ForSeconds,
+		ForReadings:    r.ForReadings,
+		Metric:         r.Metric,
+		WindowSeconds:  r.WindowSeconds,
+		SkipBadQuality: r.SkipBadQuality,
 		Description:    r.Description,
 		IsEnabled:      r.IsEnabled,
 		CreatedAt:      r.CreatedAt.AsTime(),
 	}
+	if r.ClearThreshold != nil {
+		clearThreshold := r.ClearThreshold.Value
+		rule.ClearThreshold = &clearThreshold
+	}
+	return rule
 }
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+)
+
+// CalibrationRequest creates or replaces a calibration. A raw value x is calibrated as
+// gain * (c0 + c1*x + c2*x^2 + ...) + offset, or gain * x + offset without coefficients.
+type CalibrationRequest struct {
+	// Channel is required for multi-channel sensors and must be empty otherwise.
+	Channel string  `json:"channel,omitempty"`
+	Offset  float64 `json:"offset"`
+	// Gain defaults to 1.
+	Gain         float64   `json:"gain,omitempty"`
+	Coefficients []float64 `json:"coefficients,omitempty"`
+	ValidFrom    time.Time `json:"valid_from"`
+	// ValidTo is exclusive; the calibration is open-ended without it.
+	ValidTo     *time.Time `json:"valid_to,omitempty"`
+	Certificate string     `json:"certificate,omitempty"`
+}
+
+type CalibrationResponse struct {
+	ID           int64      `json:"id"`
+	SensorID     int64      `json:"sensor_id"`
+	Channel      string     `json:"channel,omitempty"`
+	Offset       float64    `json:"offset"`
+	Gain         float64    `json:"gain"`
+	Coefficients []float64  `json:"coefficients,omitempty"`
+	ValidFrom    time.Time  `json:"valid_from"`
+	ValidTo      *time.Time `json:"valid_to,omitempty"`
+	Certificate  string     `json:"certificate,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// ValidityToProto converts the validity period of the request. A zero ValidFrom is
+// left unset so the sensor service reports it as missing.
+func (r CalibrationRequest) ValidityToProto() (*timestamppb.Timestamp, *timestamppb.Timestamp) {
+	var from, to *timestamppb.Timestamp
+	if !r.ValidFrom.IsZero() {
+		from = timestamppb.New(r.ValidFrom)
+	}
+	if r.ValidTo != nil {
+		to = timestamppb.New(*r.ValidTo)
+	}
+	return from, to
+}
+
+func MapCalibrationFromProto(c *pb.Calibration) CalibrationResponse {
+	response := CalibrationResponse{
+		ID:           c.Id,
+		SensorID:     c.SensorId,
+		Channel:      c.Channel,
+		Offset:       c.Offset,
+		Gain:         c.Gain,
+		Coefficients: c.Coefficients,
+		ValidFrom:    c.ValidFrom.AsTime(),
+		Certificate:  c.Certificate,
+		CreatedAt:    c.CreatedAt.AsTime(),
+	}
+	if c.ValidTo != nil {
+		t := c.ValidTo.AsTime()
+		response.ValidTo = &t
+	}
+	return response
+}
+
+func MapCalibrationsFromProto(calibrations []*pb.Calibration) []CalibrationResponse {
+	if len(calibrations) == 0 {
+		return nil
+	}
+	result := make([]CalibrationResponse, 0, len(calibrations))
+	for _, c := range calibrations {
+		result = append(result, MapCalibrationFromProto(c))
+	}
+	return result
+}
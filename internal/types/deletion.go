@@ -0,0 +1,60 @@
+package types
+
+import (
+	"time"
+
+	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+type ReadingDeletionResponse struct {
+	ID       int64 `json:"id"`
+	SensorID int64 `json:"sensor_id"`
+	// StartTime is not set for deletions reaching back to the first reading.
+	StartTime     *time.Time `json:"start_time,omitempty"`
+	EndTime       time.Time  `json:"end_time"`
+	ValueOperator string     `json:"value_operator,omitempty"`
+	Value         *float64   `json:"value,omitempty"`
+	Channel       string     `json:"channel,omitempty"`
+	Deleted       int64      `json:"deleted"`
+	UserID        int64      `json:"user_id,omitempty"`
+	Reason        string     `json:"reason,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+}
+
+type DeleteReadingsResponse struct {
+	// Deleted counts the deleted readings, or the matching ones for a dry run.
+	Deleted  int64                    `json:"deleted"`
+	DryRun   bool                     `json:"dry_run"`
+	Deletion *ReadingDeletionResponse `json:"deletion,omitempty"`
+}
+
+func MapReadingDeletionFromProto(d *pb.ReadingDeletion) ReadingDeletionResponse {
+	res := ReadingDeletionResponse{
+		ID:            d.Id,
+		SensorID:      d.SensorId,
+		EndTime:       d.EndTime.AsTime(),
+		ValueOperator: d.ValueOperator,
+		Channel:       d.Channel,
+		Deleted:       d.Deleted,
+		UserID:        d.UserId,
+		Reason:        d.Reason,
+		CreatedAt:     d.CreatedAt.AsTime(),
+	}
+	if d.StartTime != nil {
+		start := d.StartTime.AsTime()
+		res.StartTime = &start
+	}
+	if d.ValueOperator != "" {
+		value := d.Value
+		res.Value = &value
+	}
+	return res
+}
+
+func MapReadingDeletionsFromProto(deletions []*pb.ReadingDeletion) []ReadingDeletionResponse {
+	res := make([]ReadingDeletionResponse, 0, len(deletions))
+	for _, d := range deletions {
+		res = append(res, MapReadingDeletionFromProto(d))
+	}
+	return res
+}
@@ -0,0 +1,286 @@
+package types
+
+import (
+	"time"
+
+	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+)
+
+type DataPointResponse struct {
+	Time time.Time `json:"time"`
+	// Value is null for gap filled buckets left without a value.
+	Value *float32 `json:"value"`
+	// Min, Max and Avg are only set for buckets holding readings.
+	Min   *float32 `json:"min,omitempty"`
+	Max   *float32 `json:"max,omitempty"`
+	Avg   *float32 `json:"avg,omitempty"`
+	Count int64    `json:"count,omitempty"`
+	// Quality is only set for raw readings, aggregated buckets leave it empty.
+	Quality string `json:"quality,omitempty"`
+	// Values holds every channel of a raw multi-channel sample.
+	Values map[string]float32 `json:"values,omitempty"`
+	// Gap marks a bucket without readings added by gap filling.
+	Gap bool `json:"gap,omitempty"`
+	// Calibrated marks raw readings changed by a calibration; RawValue and RawValues
+	// then hold the values as reported.
+	Calibrated bool               `json:"calibrated,omitempty"`
+	RawValue   *float32           `json:"raw_value,omitempty"`
+	RawValues  map[string]float32 `json:"raw_values,omitempty"`
+}
+
+type HistoricalReadingsResponse struct {
+	SensorID    int64  `json:"sensor_id"`
+	Channel     string `json:"channel,omitempty"`
+	Interval    string `json:"interval,omitempty"`
+	Aggregation string `json:"aggregation,omitempty"`
+	Fill        string `json:"fill,omitempty"`
+	// Unit is the unit of the values, set when a channel or a target unit applies.
+	Unit       string              `json:"unit,omitempty"`
+	DataPoints []DataPointResponse `json:"data_points"`
+	// NextPageToken requests the following page of raw readings as page_token. It is
+	// empty on the last page.
+	NextPageToken string `json:"next_page_token,omitempty"`
+}
+
+func MapDataPointFromProto(p *pb.DataPoint) DataPointResponse {
+	res := DataPointResponse{
+		Time:    p.Time.AsTime(),
+		Count:   p.Count,
+		Quality: p.Quality,
+		Values:  p.Values,
+		Gap:     p.Gap,
+	}
+	if !p.NoValue {
+		v := p.Value
+		res.Value = &v
+	}
+	if p.Count > 0 {
+		minV, maxV, avg := p.Min, p.Max, p.Avg
+		res.Min, res.Max, res.Avg = &minV, &maxV, &avg
+	}
+	if p.Calibrated {
+		raw := p.RawValue
+		res.Calibrated = true
+		res.RawValue = &raw
+		res.RawValues = p.RawValues
+	}
+	return res
+}
+
+type DataGapResponse struct {
+	Start           time.Time `json:"start"`
+	End             time.Time `json:"end"`
+	DurationSeconds int64     `json:"duration_seconds"`
+}
+
+type DataGapsResponse struct {
+	SensorID         int64             `json:"sensor_id"`
+	ExpectedInterval string            `json:"expected_interval"`
+	Gaps             []DataGapResponse `json:"gaps"`
+}
+
+func MapDataGapsFromProto(sensorID int64, res *pb.ListDataGapsResponse) DataGapsResponse {
+	gaps := make([]DataGapResponse, 0, len(res.Gaps))
+	for _, g := range res.Gaps {
+		gaps = append(gaps, DataGapResponse{
+			Start:           g.Start.AsTime(),
+			End:             g.End.AsTime(),
+			DurationSeconds: g.DurationSeconds,
+		})
+	}
+	return DataGapsResponse{
+		SensorID:         sensorID,
+		ExpectedInterval: res.ExpectedInterval,
+		Gaps:             gaps,
+	}
+}
+
+type StoreReadingsBatchRequest struct {
+	Readings []StoreReadingRequest `json:"readings"`
+}
+
+type ReadingErrorResponse struct {
+	Index    int32  `json:"index"`
+	SensorID int64  `json:"sensor_id"`
+	Message  string `json:"message"`
+}
+
+type StoreReadingsBatchResponse struct {
+	Accepted   int32                  `json:"accepted"`
+	Rejected   int32                  `json:"rejected"`
+	Duplicates int32                  `json:"duplicates"`
+	Errors     []ReadingErrorResponse `json:"errors"`
+}
+
+func MapStoreReadingsBatchFromProto(res *pb.StoreReadingsBatchResponse) StoreReadingsBatchResponse {
+	errs := make([]ReadingErrorResponse, 0, len(res.Errors))
+	for _, e := range res.Errors {
+		errs = append(errs, ReadingErrorResponse{
+			Index:    e.Index,
+			SensorID: e.SensorId,
+			Message:  e.Message,
+		})
+	}
+
+	return StoreReadingsBatchResponse{
+		Accepted:   res.Accepted,
+		Rejected:   res.Rejected,
+		Duplicates: res.Duplicates,
+		Errors:     errs,
+	}
+}
+
+type ImportRowErrorResponse struct {
+	Row     int64  `json:"row"`
+	Message string `json:"message"`
+}
+
+type ImportJobResponse struct {
+	ID            int64                    `json:"id"`
+	Status        string                   `json:"status"`
+	Filename      string                   `json:"filename,omitempty"`
+	RowsProcessed int64                    `json:"rows_processed"`
+	RowsImported  int64                    `json:"rows_imported"`
+	RowsDuplicate int64                    `json:"rows_duplicate"`
+	RowsFailed    int64                    `json:"rows_failed"`
+	Errors        []ImportRowErrorResponse `json:"errors"`
+	Error         string                   `json:"error,omitempty"`
+	CreatedAt     time.Time                `json:"created_at"`
+	StartedAt     *time.Time               `json:"started_at,omitempty"`
+	FinishedAt    *time.Time               `json:"finished_at,omitempty"`
+}
+
+func MapImportJobFromProto(job *pb.ImportJob) ImportJobResponse {
+	res := ImportJobResponse{
+		ID:            job.Id,
+		Status:        job.Status,
+		Filename:      job.Filename,
+		RowsProcessed: job.RowsProcessed,
+		RowsImported:  job.RowsImported,
+		RowsDuplicate: job.RowsDuplicate,
+		RowsFailed:    job.RowsFailed,
+		Errors:        make([]ImportRowErrorResponse, 0, len(job.Errors)),
+		Error:         job.Error,
+		CreatedAt:     job.CreatedAt.AsTime(),
+	}
+	if job.StartedAt != nil {
+		t := job.StartedAt.AsTime()
+		res.StartedAt = &t
+	}
+	if job.FinishedAt != nil {
+		t := job.FinishedAt.AsTime()
+		res.FinishedAt = &t
+	}
+	for _, e := range job.Errors {
+		res.Errors = append(res.Errors, ImportRowErrorResponse{Row: e.Row, Message: e.Message})
+	}
+	return res
+}
+
+// ReadingStatisticsResponse summarises readings over a time range. Only Count is
+// meaningful when there are no readings.
+type ReadingStatisticsResponse struct {
+	SensorID   int64      `json:"sensor_id,omitempty"`
+	Count      int64      `json:"count"`
+	Min        float64    `json:"min"`
+	Max        float64    `json:"max"`
+	Mean       float64    `json:"mean"`
+	StdDev     float64    `json:"stddev"`
+	P50        float64    `json:"p50"`
+	P90        float64    `json:"p90"`
+	P95        float64    `json:"p95"`
+	P99        float64    `json:"p99"`
+	FirstValue float64    `json:"first_value"`
+	FirstTime  *time.Time `json:"first_time,omitempty"`
+	LastValue  float64    `json:"last_value"`
+	LastTime   *time.Time `json:"last_time,omitempty"`
+}
+
+type SensorStatisticsResponse struct {
+	Channel string `json:"channel,omitempty"`
+	ReadingStatisticsResponse
+}
+
+type GroupStatisticsResponse struct {
+	GroupID int64                       `json:"group_id"`
+	Channel string                      `json:"channel,omitempty"`
+	Overall ReadingStatisticsResponse   `json:"overall"`
+	Sensors []ReadingStatisticsResponse `json:"sensors"`
+}
+
+func MapReadingStatisticsFromProto(s *pb.ReadingStatistics) ReadingStatisticsResponse {
+	res := ReadingStatisticsResponse{
+		SensorID:   s.GetSensorId(),
+		Count:      s.GetCount(),
+		Min:        s.GetMin(),
+		Max:        s.GetMax(),
+		Mean:       s.GetMean(),
+		StdDev:     s.GetStddev(),
+		P50:        s.GetP50(),
+		P90:        s.GetP90(),
+		P95:        s.GetP95(),
+		P99:        s.GetP99(),
+		FirstValue: s.GetFirstValue(),
+		LastValue:  s.GetLastValue(),
+	}
+	if s.GetFirstTime() != nil {
+		t := s.FirstTime.AsTime()
+		res.FirstTime = &t
+	}
+	if s.GetLastTime() != nil {
+		t := s.LastTime.AsTime()
+		res.LastTime = &t
+	}
+	return res
+}
+
+type MultiSensorColumnResponse struct {
+	SensorID int64  `json:"sensor_id"`
+	Name     string `json:"name"`
+	Unit     string `json:"unit,omitempty"`
+}
+
+// MultiSensorRowResponse is one bucket of a comparison. Values follows the order of
+// the columns and is null for sensors without a value in the bucket.
+type MultiSensorRowResponse struct {
+	Time   time.Time  `json:"time"`
+	Values []*float32 `json:"values"`
+}
+
+type MultiSensorReadingsResponse struct {
+	Channel     string                      `json:"channel,omitempty"`
+	Interval    string                      `json:"interval"`
+	Aggregation string                      `json:"aggregation"`
+	Fill        string                      `json:"fill,omitempty"`
+	Columns     []MultiSensorColumnResponse `json:"columns"`
+	Rows        []MultiSensorRowResponse    `json:"rows"`
+}
+
+// MapMultiSensorReadingsFromProto turns the keyed rows of res into positional rows.
+// sensors provides the column names and units.
+func MapMultiSensorReadingsFromProto(res *pb.MultiSensorReadingsResponse, sensors map[int64]*pb_sensor.Sensor) MultiSensorReadingsResponse {
+	response := MultiSensorReadingsResponse{
+		Columns: make([]MultiSensorColumnResponse, 0, len(res.SensorIds)),
+		Rows:    make([]MultiSensorRowResponse, 0, len(res.Rows)),
+	}
+	for _, id := range res.SensorIds {
+		column := MultiSensorColumnResponse{SensorID: id}
+		if s := sensors[id]; s != nil {
+			column.Name = s.Name
+			column.Unit = s.GetSensorType().GetUnit()
+		}
+		response.Columns = append(response.Columns, column)
+	}
+
+	for _, row := range res.Rows {
+		values := make([]*float32, len(res.SensorIds))
+		for i, id := range res.SensorIds {
+			if v, ok := row.Values[id]; ok {
+				values[i] = &v
+			}
+		}
+		response.Rows = append(response.Rows, MultiSensorRowResponse{Time: row.Time.AsTime(), Values: values})
+	}
+	return response
+}
@@ -11,6 +11,10 @@ type CreateSensorRequest struct {
 	Location     string `json:"location"`
 	Description  string `json:"description"`
 	SensorTypeId int64  `json:"sensor_type_id"`
+	// Kind is physical (default) or virtual. Virtual sensors compute their readings
+	// from Expression, e.g. "s10 - s11".
+	Kind       string `json:"kind,omitempty"`
+	Expression string `json:"expression,omitempty"`
 }
 
 type UpdateSensorRequest struct {
@@ -19,6 +23,8 @@ type UpdateSensorRequest struct {
 	Description  *string `json:"description,omitempty"`
 	SensorTypeId *int64  `json:"sensor_type_id,omitempty"`
 	Active       *bool   `json:"active,omitempty"`
+	// Expression replaces the expression of a virtual sensor.
+	Expression *string `json:"expression,omitempty"`
 }
 
 type SensorResponse struct {
@@ -31,29 +37,65 @@ type SensorResponse struct {
 	CreatedAt   time.Time           `json:"created_at"`
 	UpdatedAt   time.Time           `json:"updated_at"`
 	SensorType  *SensorTypeResponse `json:"sensor_type,omitempty"`
+	Kind        string              `json:"kind,omitempty"`
+	Expression  string              `json:"expression,omitempty"`
+	// InputSensorIDs are the sensors the expression of a virtual sensor reads.
+	InputSensorIDs []int64 `json:"input_sensor_ids,omitempty"`
+	// Calibrations are included when a single sensor is fetched.
+	Calibrations []CalibrationResponse `json:"calibrations,omitempty"`
 }
 
 type SensorTypeResponse struct {
-	ID           int64     `json:"id"`
-	Name         string    `json:"name"`
-	Model        string    `json:"model"`
-	Manufacturer string    `json:"manufacturer,omitempty"`
-	Description  string    `json:"description,omitempty"`
-	Unit         string    `json:"unit,omitempty"`
-	MinValue     float32   `json:"min_value,omitempty"`
-	MaxValue     float32   `json:"max_value,omitempty"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID               int64   `json:"id"`
+	Name             string  `json:"name"`
+	Model            string  `json:"model"`
+	Manufacturer     string  `json:"manufacturer,omitempty"`
+	Description      string  `json:"description,omitempty"`
+	Unit             string  `json:"unit,omitempty"`
+	MinValue         float32 `json:"min_value,omitempty"`
+	MaxValue         float32 `json:"max_value,omitempty"`
+	OutOfRangePolicy string  `json:"out_of_range_policy,omitempty"`
+	// Channels are the named values of a multi-channel sensor type; the first one is primary.
+	Channels  []SensorChannelResponse `json:"channels,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+}
+
+type SensorChannelResponse struct {
+	Name     string  `json:"name"`
+	Unit     string  `json:"unit,omitempty"`
+	MinValue float32 `json:"min_value,omitempty"`
+	MaxValue float32 `json:"max_value,omitempty"`
+}
+
+func MapSensorChannelsFromProto(channels []*pb.SensorChannel) []SensorChannelResponse {
+	if len(channels) == 0 {
+		return nil
+	}
+	result := make([]SensorChannelResponse, 0, len(channels))
+	for _, c := range channels {
+		result = append(result, SensorChannelResponse{
+			Name:     c.Name,
+			Unit:     c.Unit,
+			MinValue: c.MinValue,
+			MaxValue: c.MaxValue,
+		})
+	}
+	return result
 }
 
 func MapSensorFromProto(s *pb.Sensor) SensorResponse {
 	response := SensorResponse{
-		ID:          s.Id,
-		Name:        s.Name,
-		Location:    s.Location,
-		Description: s.Description,
-		Active:      s.Active,
-		CreatedAt:   s.CreatedAt.AsTime(),
-		UpdatedAt:   s.UpdatedAt.AsTime(),
+		ID:             s.Id,
+		Name:           s.Name,
+		Location:       s.Location,
+		Description:    s.Description,
+		Active:         s.Active,
+		CreatedAt:      s.CreatedAt.AsTime(),
+		UpdatedAt:      s.UpdatedAt.AsTime(),
+		Kind:           s.Kind,
+		Expression:     s.Expression,
+		InputSensorIDs: s.InputSensorIds,
+		Calibrations:   MapCalibrationsFromProto(s.Calibrations),
 	}
 
 	if s.LastUpdated != nil {
@@ -63,15 +105,17 @@ func MapSensorFromProto(s *pb.Sensor) SensorResponse {
 
 	if s.SensorType != nil {
 		response.SensorType = &SensorTypeResponse{
-			ID:           s.SensorType.Id,
-			Name:         s.SensorType.Name,
-			Model:        s.SensorType.Model,
-			Manufacturer: s.SensorType.Manufacturer,
-			Description:  s.SensorType.Description,
-			Unit:         s.SensorType.Unit,
-			MinValue:     s.SensorType.MinValue,
-			MaxValue:     s.SensorType.MaxValue,
-			CreatedAt:    s.SensorType.CreatedAt.AsTime(),
+			ID:               s.SensorType.Id,
+			Name:             s.SensorType.Name,
+			Model:            s.SensorType.Model,
+			Manufacturer:     s.SensorType.Manufacturer,
+			Description:      s.SensorType.Description,
+			Unit:             s.SensorType.Unit,
+			MinValue:         s.SensorType.MinValue,
+			MaxValue:         s.SensorType.MaxValue,
+			OutOfRangePolicy: s.SensorType.OutOfRangePolicy,
+			Channels:         MapSensorChannelsFromProto(s.SensorType.Channels),
+			CreatedAt:        s.SensorType.CreatedAt.AsTime(),
 		}
 	} else if s.SensorTypeId > 0 {
 		response.SensorType = &SensorTypeResponse{
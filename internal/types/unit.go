@@ -0,0 +1,16 @@
+package types
+
+import "github.com/skni-kod/iot-monitor-backend/pkg/units"
+
+type UnitResponse struct {
+	Symbol    string `json:"symbol"`
+	Dimension string `json:"dimension"`
+}
+
+func MapUnits(list []*units.Unit) []UnitResponse {
+	res := make([]UnitResponse, 0, len(list))
+	for _, u := range list {
+		res = append(res, UnitResponse{Symbol: u.Symbol, Dimension: string(u.Dimension)})
+	}
+	return res
+}
@@ -9,17 +9,35 @@ type ReadingMessage struct {
 	SensorName string    `json:"sensor_name"`
 	Location   string    `json:"location"`
 	Unit       string    `json:"unit"`
+	Quality    string    `json:"quality,omitempty"`
+	// Values carries every channel of a multi-channel sample.
+	Values map[string]float32 `json:"values,omitempty"`
 }
 
 type SubscribeMessage struct {
 	Type      string  `json:"type"`
 	SensorIDs []int64 `json:"sensor_ids"`
+	// Channel streams a single channel of multi-channel sensors as the value.
+	Channel string `json:"channel,omitempty"`
+	// TargetUnit converts values like the target_unit query parameter and overrides
+	// the units of the connection.
+	TargetUnit string `json:"target_unit,omitempty"`
+	// MinInterval, Deadband and Downsample throttle the stream like the query
+	// parameters of the same name and override those of the connection.
+	MinInterval string  `json:"min_interval,omitempty"`
+	Deadband    float64 `json:"deadband,omitempty"`
+	Downsample  string  `json:"downsample,omitempty" enums:"latest,avg,min,max"`
 }
 
 type StoreReadingRequest struct {
 	SensorID  int64     `json:"sensor_id"`
 	Value     float32   `json:"value"`
 	Timestamp time.Time `json:"timestamp"`
+	Quality   string    `json:"quality,omitempty" enums:"good,suspect,interpolated"`
+	// Values maps channel names to values for multi-channel sensor types and replaces Value.
+	Values map[string]float32 `json:"values,omitempty"`
+	// IdempotencyKey lets clients retry safely; the Idempotency-Key header takes precedence.
+	IdempotencyKey string `json:"idempotency_key,omitempty"`
 }
 
 type ReadingResponse struct {
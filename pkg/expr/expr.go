@@ -0,0 +1,210 @@
+// Package expr parses and evaluates the arithmetic expressions of virtual sensors.
+//
+// An expression combines sensor values with numbers, the operators + - * / % ^,
+// parentheses and functions. A sensor is referenced as s<ID>, e.g. s12, which reads
+// its primary value, or s<ID>.<channel>, e.g. s12.humidity, which reads a channel of
+// a multi-channel sensor:
+//
+//	dewpoint(s4.temperature, s4.humidity)
+//	s10 - s11
+//	sum(s20, s21, s22) / 1000
+package expr
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+)
+
+// MaxLength bounds the source of an expression.
+const MaxLength = 1024
+
+// Input is a value an expression reads: the primary value of a sensor when Channel is
+// empty, otherwise the named channel.
+type Input struct {
+	SensorID int64
+	Channel  string
+}
+
+func (in Input) String() string {
+	if in.Channel == "" {
+		return "s" + strconv.FormatInt(in.SensorID, 10)
+	}
+	return "s" + strconv.FormatInt(in.SensorID, 10) + "." + in.Channel
+}
+
+// Expr is a parsed expression. It is immutable and safe for concurrent use.
+type Expr struct {
+	src    string
+	root   node
+	inputs []Input
+}
+
+// Parse parses src. Every expression has to reference at least one sensor.
+func Parse(src string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", MaxLength)
+	}
+
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	root, err := p.expression()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos+1)
+	}
+
+	e := &Expr{src: src, root: root}
+	seen := make(map[Input]bool)
+	walk(root, func(n node) {
+		if v, ok := n.(variable); ok && !seen[v.input] {
+			seen[v.input] = true
+			e.inputs = append(e.inputs, v.input)
+		}
+	})
+	if len(e.inputs) == 0 {
+		return nil, fmt.Errorf("expression does not reference any sensor")
+	}
+	return e, nil
+}
+
+// String returns the source of the expression.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Inputs returns the distinct values the expression reads, in order of appearance.
+func (e *Expr) Inputs() []Input {
+	return slices.Clone(e.inputs)
+}
+
+// SensorIDs returns the distinct sensors the expression reads, in ascending order.
+func (e *Expr) SensorIDs() []int64 {
+	ids := make([]int64, 0, len(e.inputs))
+	for _, in := range e.inputs {
+		ids = append(ids, in.SensorID)
+	}
+	slices.Sort(ids)
+	return slices.Compact(ids)
+}
+
+// Eval evaluates the expression. lookup returns the current value of an input, or
+// false when it has none. Missing inputs, division by zero and results that are not
+// finite are errors.
+func (e *Expr) Eval(lookup func(Input) (float64, bool)) (float64, error) {
+	v, err := e.root.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("result is not a finite number")
+	}
+	return v, nil
+}
+
+type node interface {
+	eval(lookup func(Input) (float64, bool)) (float64, error)
+}
+
+type number float64
+
+func (n number) eval(func(Input) (float64, bool)) (float64, error) {
+	return float64(n), nil
+}
+
+type variable struct {
+	input Input
+}
+
+func (v variable) eval(lookup func(Input) (float64, bool)) (float64, error) {
+	value, ok := lookup(v.input)
+	if !ok {
+		return 0, fmt.Errorf("no value for %s", v.input)
+	}
+	return value, nil
+}
+
+type unary struct {
+	operand node
+}
+
+func (u unary) eval(lookup func(Input) (float64, bool)) (float64, error) {
+	v, err := u.operand.eval(lookup)
+	return -v, err
+}
+
+type binary struct {
+	op          byte
+	left, right node
+}
+
+func (b binary) eval(lookup func(Input) (float64, bool)) (float64, error) {
+	l, err := b.left.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+	r, err := b.right.eval(lookup)
+	if err != nil {
+		return 0, err
+	}
+
+	switch b.op {
+	case '+':
+		return l + r, nil
+	case '-':
+		return l - r, nil
+	case '*':
+		return l * r, nil
+	case '/':
+		if r == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	case '%':
+		if r == 0 {
+			return 0, fmt.Errorf("division by zero")
+		}
+		return math.Mod(l, r), nil
+	default:
+		return math.Pow(l, r), nil
+	}
+}
+
+type call struct {
+	fn   *function
+	args []node
+}
+
+func (c call) eval(lookup func(Input) (float64, bool)) (float64, error) {
+	args := make([]float64, len(c.args))
+	for i, arg := range c.args {
+		v, err := arg.eval(lookup)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = v
+	}
+	return c.fn.call(args)
+}
+
+// walk calls fn for n and every node below it.
+func walk(n node, fn func(node)) {
+	fn(n)
+	switch n := n.(type) {
+	case unary:
+		walk(n.operand, fn)
+	case binary:
+		walk(n.left, fn)
+		walk(n.right, fn)
+	case call:
+		for _, arg := range n.args {
+			walk(arg, fn)
+		}
+	}
+}
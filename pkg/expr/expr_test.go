@@ -0,0 +1,103 @@
+package expr
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEval(t *testing.T) {
+	values := map[Input]float64{
+		{SensorID: 1}:                         10,
+		{SensorID: 2}:                         4,
+		{SensorID: 3, Channel: "temperature"}: 20,
+		{SensorID: 3, Channel: "humidity"}:    50,
+	}
+	lookup := func(in Input) (float64, bool) {
+		v, ok := values[in]
+		return v, ok
+	}
+
+	tests := []struct {
+		src      string
+		expected float64
+	}{
+		{"s1 - s2", 6},
+		{"s1 + s2 * 2", 18},
+		{"(s1 + s2) * 2", 28},
+		{"-s1 + 1", -9},
+		{"s2 ^ 2 ^ 0.5", math.Pow(4, math.Sqrt2)},
+		{"-s2 ^ 2", -16},
+		{"s1 % 3", 1},
+		{"sum(s1, s2, 1e1)", 24},
+		{"avg(s1, s2)", 7},
+		{"max(s1, s2) - min(s1, s2)", 6},
+		{"round(s1 / 3, 2)", 3.33},
+		{"abs(s2 - s1)", 6},
+		{"s3.temperature * 9 / 5 + 32", 68},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.src, func(t *testing.T) {
+			e, err := Parse(tt.src)
+			require.NoError(t, err)
+			v, err := e.Eval(lookup)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, v, 1e-9)
+		})
+	}
+
+	e, err := Parse("dewpoint(s3.temperature, s3.humidity)")
+	require.NoError(t, err)
+	v, err := e.Eval(lookup)
+	require.NoError(t, err)
+	assert.InDelta(t, 9.25, v, 0.01)
+}
+
+func TestEvalErrors(t *testing.T) {
+	lookup := func(in Input) (float64, bool) {
+		return 0, in.SensorID == 1
+	}
+
+	for _, src := range []string{"1 / s1", "s1 % s1", "s2 + s1", "ln(s1)", "dewpoint(20, s1)"} {
+		t.Run(src, func(t *testing.T) {
+			e, err := Parse(src)
+			require.NoError(t, err)
+			_, err = e.Eval(lookup)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{
+		"",
+		"1 + 2",
+		"s1 +",
+		"s1 s2",
+		"(s1",
+		"s1)",
+		"s0",
+		"s1.",
+		"foo(s1)",
+		"sqrt(s1, s2)",
+		"pow(s1)",
+		"s1 & s2",
+		"1..2 + s1",
+	} {
+		t.Run(src, func(t *testing.T) {
+			_, err := Parse(src)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestInputs(t *testing.T) {
+	e, err := Parse("s12.temperature - s3 + s12.temperature * s12 / s3")
+	require.NoError(t, err)
+	assert.Equal(t, []Input{{SensorID: 12, Channel: "temperature"}, {SensorID: 3}, {SensorID: 12}}, e.Inputs())
+	assert.Equal(t, []int64{3, 12}, e.SensorIDs())
+	assert.Equal(t, "s12.temperature", e.Inputs()[0].String())
+}
@@ -0,0 +1,94 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+)
+
+type function struct {
+	minArgs int
+	// maxArgs is -1 for functions taking any number of arguments.
+	maxArgs int
+	call    func(args []float64) (float64, error)
+}
+
+func (f *function) arity() string {
+	switch {
+	case f.maxArgs < 0:
+		return fmt.Sprintf("at least %d arguments", f.minArgs)
+	case f.minArgs == f.maxArgs && f.minArgs == 1:
+		return "1 argument"
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("%d arguments", f.minArgs)
+	}
+	return fmt.Sprintf("%d to %d arguments", f.minArgs, f.maxArgs)
+}
+
+func unaryFunction(fn func(float64) float64) *function {
+	return &function{minArgs: 1, maxArgs: 1, call: func(args []float64) (float64, error) {
+		return fn(args[0]), nil
+	}}
+}
+
+// functions are the functions expressions can call.
+var functions = map[string]*function{
+	"abs":   unaryFunction(math.Abs),
+	"sqrt":  unaryFunction(math.Sqrt),
+	"exp":   unaryFunction(math.Exp),
+	"ln":    unaryFunction(math.Log),
+	"log10": unaryFunction(math.Log10),
+	"floor": unaryFunction(math.Floor),
+	"ceil":  unaryFunction(math.Ceil),
+	"round": {minArgs: 1, maxArgs: 2, call: func(args []float64) (float64, error) {
+		if len(args) == 1 {
+			return math.Round(args[0]), nil
+		}
+		scale := math.Pow(10, math.Round(args[1]))
+		return math.Round(args[0]*scale) / scale, nil
+	}},
+	"pow": {minArgs: 2, maxArgs: 2, call: func(args []float64) (float64, error) {
+		return math.Pow(args[0], args[1]), nil
+	}},
+	"min": {minArgs: 1, maxArgs: -1, call: func(args []float64) (float64, error) {
+		m := args[0]
+		for _, v := range args[1:] {
+			m = math.Min(m, v)
+		}
+		return m, nil
+	}},
+	"max": {minArgs: 1, maxArgs: -1, call: func(args []float64) (float64, error) {
+		m := args[0]
+		for _, v := range args[1:] {
+			m = math.Max(m, v)
+		}
+		return m, nil
+	}},
+	"sum": {minArgs: 1, maxArgs: -1, call: func(args []float64) (float64, error) {
+		var s float64
+		for _, v := range args {
+			s += v
+		}
+		return s, nil
+	}},
+	"avg": {minArgs: 1, maxArgs: -1, call: func(args []float64) (float64, error) {
+		var s float64
+		for _, v := range args {
+			s += v
+		}
+		return s / float64(len(args)), nil
+	}},
+	"dewpoint": {minArgs: 2, maxArgs: 2, call: func(args []float64) (float64, error) {
+		return dewPoint(args[0], args[1])
+	}},
+}
+
+// dewPoint returns the dew point in °C for a temperature in °C and a relative humidity
+// in percent, using the Magnus formula with the Sonntag (1990) constants.
+func dewPoint(temperature, humidity float64) (float64, error) {
+	if humidity <= 0 || humidity > 100 {
+		return 0, fmt.Errorf("dewpoint: relative humidity %v is outside (0, 100]", humidity)
+	}
+	const b, c = 17.62, 243.12
+	gamma := math.Log(humidity/100) + b*temperature/(c+temperature)
+	return c * gamma / (b - gamma), nil
+}
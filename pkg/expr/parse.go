@@ -0,0 +1,306 @@
+package expr
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenIdent
+	tokenSensor
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenComma
+)
+
+type token struct {
+	kind  tokenKind
+	text  string
+	pos   int
+	num   float64
+	input Input
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+
+		case isDigit(c) || c == '.':
+			for i < len(src) && (isDigit(src[i]) || src[i] == '.') {
+				i++
+			}
+			// Exponent, e.g. 1e-3.
+			if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+				j := i + 1
+				if j < len(src) && (src[j] == '+' || src[j] == '-') {
+					j++
+				}
+				if j < len(src) && isDigit(src[j]) {
+					for i = j; i < len(src) && isDigit(src[i]); i++ {
+					}
+				}
+			}
+			v, err := strconv.ParseFloat(src[start:i], 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q at position %d", src[start:i], start+1)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: src[start:i], pos: start, num: v})
+
+		case isLetter(c):
+			for i < len(src) && (isLetter(src[i]) || isDigit(src[i])) {
+				i++
+			}
+			name := src[start:i]
+			if name[0] != 's' || len(name) == 1 || !isAllDigits(name[1:]) {
+				tokens = append(tokens, token{kind: tokenIdent, text: name, pos: start})
+				continue
+			}
+
+			id, err := strconv.ParseInt(name[1:], 10, 64)
+			if err != nil || id <= 0 {
+				return nil, fmt.Errorf("invalid sensor reference %q at position %d", name, start+1)
+			}
+			in := Input{SensorID: id}
+			if i < len(src) && src[i] == '.' {
+				j := i + 1
+				for j < len(src) && (isLetter(src[j]) || isDigit(src[j])) {
+					j++
+				}
+				if j == i+1 {
+					return nil, fmt.Errorf("missing channel name after %q at position %d", name, start+1)
+				}
+				in.Channel = src[i+1 : j]
+				i = j
+			}
+			tokens = append(tokens, token{kind: tokenSensor, text: src[start:i], pos: start, input: in})
+
+		case c == '+' || c == '-' || c == '*' || c == '/' || c == '%' || c == '^':
+			i++
+			tokens = append(tokens, token{kind: tokenOperator, text: src[start:i], pos: start})
+		case c == '(':
+			i++
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: start})
+		case c == ')':
+			i++
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: start})
+		case c == ',':
+			i++
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: start})
+
+		default:
+			return nil, fmt.Errorf("unexpected character %q at position %d", c, start+1)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(src)}), nil
+}
+
+func isAllDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// parser is a recursive descent parser over the grammar
+//
+//	expression = term { ("+" | "-") term }
+//	term       = unary { ("*" | "/" | "%") unary }
+//	unary      = "-" unary | power
+//	power      = primary [ "^" unary ]
+//	primary    = number | sensor | ident "(" [ expression { "," expression } ] ")" | "(" expression ")"
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+// maxDepth bounds the nesting of an expression.
+const maxDepth = 64
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) isOperator(ops string) (byte, bool) {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return 0, false
+	}
+	for i := 0; i < len(ops); i++ {
+		if t.text[0] == ops[i] {
+			return ops[i], true
+		}
+	}
+	return 0, false
+}
+
+func (p *parser) expression() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > maxDepth {
+		return nil, fmt.Errorf("expression is nested deeper than %d levels", maxDepth)
+	}
+
+	left, err := p.term()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.isOperator("+-")
+		if !ok {
+			return left, nil
+		}
+		p.next()
+		right, err := p.term()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) term() (node, error) {
+	left, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := p.isOperator("*/%")
+		if !ok {
+			return left, nil
+		}
+		p.next()
+		right, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+}
+
+func (p *parser) unary() (node, error) {
+	if _, ok := p.isOperator("-"); ok {
+		p.depth++
+		defer func() { p.depth-- }()
+		if p.depth > maxDepth {
+			return nil, fmt.Errorf("expression is nested deeper than %d levels", maxDepth)
+		}
+		p.next()
+		operand, err := p.unary()
+		if err != nil {
+			return nil, err
+		}
+		return unary{operand: operand}, nil
+	}
+	return p.power()
+}
+
+func (p *parser) power() (node, error) {
+	base, err := p.primary()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := p.isOperator("^"); !ok {
+		return base, nil
+	}
+	p.next()
+	exp, err := p.unary()
+	if err != nil {
+		return nil, err
+	}
+	return binary{op: '^', left: base, right: exp}, nil
+}
+
+func (p *parser) primary() (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		return number(t.num), nil
+	case tokenSensor:
+		return variable{input: t.input}, nil
+	case tokenLParen:
+		n, err := p.expression()
+		if err != nil {
+			return nil, err
+		}
+		if p.next().kind != tokenRParen {
+			return nil, fmt.Errorf("missing ) for ( at position %d", t.pos+1)
+		}
+		return n, nil
+	case tokenIdent:
+		return p.call(t)
+	}
+	return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos+1)
+}
+
+func (p *parser) call(name token) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at position %d", name.text, name.pos+1)
+	}
+	if p.next().kind != tokenLParen {
+		return nil, fmt.Errorf("expected ( after %s at position %d", name.text, name.pos+1)
+	}
+
+	var args []node
+	if p.peek().kind == tokenRParen {
+		p.next()
+	} else {
+		for {
+			arg, err := p.expression()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+
+			t := p.next()
+			if t.kind == tokenRParen {
+				break
+			}
+			if t.kind != tokenComma {
+				return nil, fmt.Errorf("unexpected %s at position %d", t, t.pos+1)
+			}
+		}
+	}
+
+	if len(args) < fn.minArgs || (fn.maxArgs >= 0 && len(args) > fn.maxArgs) {
+		return nil, fmt.Errorf("%s expects %s", name.text, fn.arity())
+	}
+	return call{fn: fn, args: args}, nil
+}
@@ -0,0 +1,55 @@
+// Package outbox publishes events that were written to a database outbox table in
+// the same transaction as the state change they describe. A Relay drains the table
+// to RabbitMQ with publisher confirms and only removes an event once the broker has
+// confirmed it, so events survive broker outages and service restarts.
+// Delivery is at least once: consumers may see an event again after a relay crash
+// between the confirm and MarkPublished, and can use the message ID to detect it.
+// Relays of several replicas can drain the same table, each message is leased to one
+// of them at a time.
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Message is an event waiting in an outbox table.
+type Message struct {
+	ID         int64
+	Exchange   string
+	RoutingKey string
+	Payload    []byte
+	Attempts   int
+}
+
+// Store is implemented by every service on top of its own outbox table.
+type Store interface {
+	// Claim leases up to limit messages that are due for publishing and returns them
+	// oldest first. A leased message is not due again until lease has passed, so other
+	// relays skip it unless this one stops before marking it.
+	Claim(ctx context.Context, limit int, lease time.Duration) ([]Message, error)
+	// MarkPublished removes a message the broker has confirmed.
+	MarkPublished(ctx context.Context, id int64) error
+	// MarkFailed records a failed attempt and defers the next one until retryAt.
+	MarkFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed
+// attempts. It doubles from one second and is capped at max.
+func Backoff(attempts int, max time.Duration) time.Duration {
+	d := time.Second
+	for i := 1; i < attempts && d < max; i++ {
+		d *= 2
+	}
+	return min(d, max)
+}
+
+// NewClaimToken returns a random token stores can tag the rows of one claim with, to
+// tell them apart from the rows claimed concurrently by other relays.
+func NewClaimToken() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,18 @@
+package outbox
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, time.Second, Backoff(0, time.Minute))
+	assert.Equal(t, time.Second, Backoff(1, time.Minute))
+	assert.Equal(t, 2*time.Second, Backoff(2, time.Minute))
+	assert.Equal(t, 32*time.Second, Backoff(6, time.Minute))
+	assert.Equal(t, time.Minute, Backoff(7, time.Minute))
+	assert.Equal(t, time.Minute, Backoff(1000, time.Minute))
+	assert.Equal(t, 500*time.Millisecond, Backoff(1, 500*time.Millisecond))
+}
@@ -0,0 +1,241 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+type Config struct {
+	URL string
+	// Exchanges are declared as durable fanout exchanges on every (re)connect.
+	Exchanges []string
+	// PollInterval is how often the outbox is checked when nobody calls Notify.
+	PollInterval time.Duration
+	// BatchSize caps the messages published between two confirm waits.
+	BatchSize int
+	// ConfirmTimeout bounds the wait for the broker to confirm a batch.
+	ConfirmTimeout time.Duration
+	// MaxBackoff caps the delay between attempts to publish the same message.
+	MaxBackoff time.Duration
+	// Lease is how long claimed messages are reserved for this relay. It has to cover
+	// publishing a batch and waiting for its confirms.
+	Lease time.Duration
+}
+
+// Relay publishes pending outbox messages. It keeps its own connection and reconnects
+// with backoff when the broker goes away, so the rest of the service keeps accepting
+// writes during an outage.
+type Relay struct {
+	store  Store
+	cfg    Config
+	notify chan struct{}
+
+	conn *amqp.Connection
+	ch   *amqp.Channel
+}
+
+func NewRelay(store Store, cfg Config) *Relay {
+	if cfg.PollInterval <= 0 {
+		cfg.PollInterval = time.Second
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = 100
+	}
+	if cfg.ConfirmTimeout <= 0 {
+		cfg.ConfirmTimeout = 5 * time.Second
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = time.Minute
+	}
+	if cfg.Lease <= 0 {
+		cfg.Lease = 30 * time.Second
+	}
+	cfg.Lease = max(cfg.Lease, 2*cfg.ConfirmTimeout)
+	return &Relay{
+		store:  store,
+		cfg:    cfg,
+		notify: make(chan struct{}, 1),
+	}
+}
+
+// Notify wakes the relay after new messages were committed. It never blocks.
+func (r *Relay) Notify() {
+	select {
+	case r.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (r *Relay) Start(ctx context.Context) {
+	go func() {
+		ticker := time.NewTicker(r.cfg.PollInterval)
+		defer ticker.Stop()
+		defer r.disconnect()
+		logger.Info("Started outbox relay", zap.Strings("exchanges", r.cfg.Exchanges))
+
+		reconnectAttempts := 0
+		var retryAt time.Time
+
+		for {
+			select {
+			case <-ctx.Done():
+				logger.Info("Context cancelled, stopping outbox relay")
+				return
+			case <-ticker.C:
+			case <-r.notify:
+			}
+
+			if r.ch == nil || r.ch.IsClosed() {
+				if time.Now().Before(retryAt) {
+					continue
+				}
+				if err := r.connect(); err != nil {
+					reconnectAttempts++
+					delay := Backoff(reconnectAttempts, r.cfg.MaxBackoff)
+					retryAt = time.Now().Add(delay)
+					logger.Warn("Outbox relay cannot reach RabbitMQ", zap.Duration("retry_in", delay), zap.Error(err))
+					continue
+				}
+				reconnectAttempts = 0
+				logger.Info("Outbox relay connected to RabbitMQ")
+			}
+
+			r.drain(ctx)
+		}
+	}()
+}
+
+// drain publishes batches until the outbox has nothing due or publishing fails.
+func (r *Relay) drain(ctx context.Context) {
+	for ctx.Err() == nil {
+		msgs, err := r.store.Claim(ctx, r.cfg.BatchSize, r.cfg.Lease)
+		if err != nil {
+			logger.Error("Failed to claim pending outbox messages", zap.Error(err))
+			return
+		}
+		if len(msgs) == 0 {
+			return
+		}
+
+		if published := r.publishBatch(ctx, msgs); published < len(msgs) {
+			return
+		}
+	}
+}
+
+// publishBatch publishes msgs, waits for their confirms and records the outcome of each
+// message. It returns how many messages were confirmed.
+func (r *Relay) publishBatch(ctx context.Context, msgs []Message) int {
+	confirms := make([]*amqp.DeferredConfirmation, len(msgs))
+	failures := make([]error, len(msgs))
+
+	for i, m := range msgs {
+		dc, err := r.ch.PublishWithDeferredConfirmWithContext(ctx, m.Exchange, m.RoutingKey, false, false, amqp.Publishing{
+			ContentType:  "application/json",
+			DeliveryMode: amqp.Persistent,
+			MessageId:    strconv.FormatInt(m.ID, 10),
+			Timestamp:    time.Now(),
+			Body:         m.Payload,
+		})
+		if err != nil {
+			// The channel is unusable; everything after this message fails the same way.
+			for j := i; j < len(msgs); j++ {
+				failures[j] = err
+			}
+			break
+		}
+		confirms[i] = dc
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, r.cfg.ConfirmTimeout)
+	defer cancel()
+
+	published := 0
+	for i, m := range msgs {
+		if failures[i] == nil {
+			acked, err := confirms[i].WaitContext(waitCtx)
+			switch {
+			case err != nil:
+				failures[i] = fmt.Errorf("waiting for confirm: %w", err)
+			case !acked:
+				failures[i] = fmt.Errorf("broker nacked the message")
+			}
+		}
+
+		if failures[i] != nil {
+			r.markFailed(ctx, m, failures[i])
+			continue
+		}
+		if err := r.store.MarkPublished(ctx, m.ID); err != nil {
+			logger.Error("Failed to mark outbox message as published", zap.Int64("id", m.ID), zap.Error(err))
+			continue
+		}
+		published++
+	}
+
+	if published < len(msgs) {
+		// Drop a channel that failed mid-batch so the next round starts from a clean one.
+		r.disconnect()
+	}
+	return published
+}
+
+func (r *Relay) markFailed(ctx context.Context, m Message, cause error) {
+	retryAt := time.Now().Add(Backoff(m.Attempts+1, r.cfg.MaxBackoff))
+	logger.Warn("Failed to publish outbox message",
+		zap.Int64("id", m.ID),
+		zap.String("exchange", m.Exchange),
+		zap.Int("attempts", m.Attempts+1),
+		zap.Time("retry_at", retryAt),
+		zap.Error(cause),
+	)
+	if err := r.store.MarkFailed(ctx, m.ID, retryAt, cause.Error()); err != nil {
+		logger.Error("Failed to record outbox publish failure", zap.Int64("id", m.ID), zap.Error(err))
+	}
+}
+
+func (r *Relay) connect() error {
+	r.disconnect()
+
+	conn, err := amqp.Dial(r.cfg.URL)
+	if err != nil {
+		return fmt.Errorf("dial: %w", err)
+	}
+
+	ch, err := conn.Channel()
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("open channel: %w", err)
+	}
+
+	if err := ch.Confirm(false); err != nil {
+		conn.Close()
+		return fmt.Errorf("enable publisher confirms: %w", err)
+	}
+
+	for _, name := range r.cfg.Exchanges {
+		if err := ch.ExchangeDeclare(name, "fanout", true, false, false, false, nil); err != nil {
+			conn.Close()
+			return fmt.Errorf("declare exchange %s: %w", name, err)
+		}
+	}
+
+	r.conn = conn
+	r.ch = ch
+	return nil
+}
+
+func (r *Relay) disconnect() {
+	if r.conn != nil {
+		r.conn.Close()
+	}
+	r.conn = nil
+	r.ch = nil
+}
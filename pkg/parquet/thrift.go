@@ -0,0 +1,99 @@
+package parquet
+
+import "bytes"
+
+// Thrift compact protocol type ids used by the Parquet metadata structures.
+const (
+	thriftI32    = 5
+	thriftI64    = 6
+	thriftBinary = 8
+	thriftList   = 9
+	thriftStruct = 12
+)
+
+// compactWriter encodes the subset of the Thrift compact protocol needed for Parquet
+// page headers and file metadata: i32, i64, binary, lists and nested structs.
+type compactWriter struct {
+	buf     bytes.Buffer
+	lastIDs []int16
+	lastID  int16
+}
+
+func (w *compactWriter) fieldHeader(id int16, typ byte) {
+	if delta := id - w.lastID; delta > 0 && delta <= 15 {
+		w.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		w.buf.WriteByte(typ)
+		w.varint(zigzag(int64(id)))
+	}
+	w.lastID = id
+}
+
+func (w *compactWriter) i32(id int16, v int32) {
+	w.fieldHeader(id, thriftI32)
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) i64(id int16, v int64) {
+	w.fieldHeader(id, thriftI64)
+	w.varint(zigzag(v))
+}
+
+func (w *compactWriter) binary(id int16, v string) {
+	w.fieldHeader(id, thriftBinary)
+	w.varint(uint64(len(v)))
+	w.buf.WriteString(v)
+}
+
+// listHeader starts a list field; the caller writes size elements of elemType next.
+func (w *compactWriter) listHeader(id int16, elemType byte, size int) {
+	w.fieldHeader(id, thriftList)
+	if size < 15 {
+		w.buf.WriteByte(byte(size)<<4 | elemType)
+	} else {
+		w.buf.WriteByte(0xf0 | elemType)
+		w.varint(uint64(size))
+	}
+}
+
+func (w *compactWriter) listI32(v int32) {
+	w.varint(zigzag(int64(v)))
+}
+
+func (w *compactWriter) listBinary(v string) {
+	w.varint(uint64(len(v)))
+	w.buf.WriteString(v)
+}
+
+// structField starts a nested struct field and returns once it is entered.
+func (w *compactWriter) structField(id int16) {
+	w.fieldHeader(id, thriftStruct)
+	w.beginStruct()
+}
+
+// beginStruct enters a struct that is written as a list element or nested field.
+func (w *compactWriter) beginStruct() {
+	w.lastIDs = append(w.lastIDs, w.lastID)
+	w.lastID = 0
+}
+
+// endStruct writes the stop field of the current struct.
+func (w *compactWriter) endStruct() {
+	w.buf.WriteByte(0)
+	if n := len(w.lastIDs); n > 0 {
+		w.lastID = w.lastIDs[n-1]
+		w.lastIDs = w.lastIDs[:n-1]
+	}
+}
+
+func (w *compactWriter) varint(v uint64) {
+	for v >= 0x80 {
+		w.buf.WriteByte(byte(v) | 0x80)
+		v >>= 7
+	}
+	w.buf.WriteByte(byte(v))
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
@@ -0,0 +1,311 @@
+// Package parquet writes flat Parquet files with required columns, PLAIN encoding and
+// no compression. It covers what tabular exports need and streams row groups to the
+// underlying writer as they fill up, so only one row group is held in memory.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"time"
+)
+
+// ColumnType is the logical type of a column.
+type ColumnType int
+
+const (
+	Int64 ColumnType = iota
+	Float
+	Double
+	String
+	// Timestamp is stored as INT64 microseconds since the Unix epoch in UTC.
+	Timestamp
+)
+
+// Physical, converted and encoding ids from parquet.thrift.
+const (
+	physicalInt64     = 2
+	physicalFloat     = 4
+	physicalDouble    = 5
+	physicalByteArray = 6
+
+	convertedUTF8            = 0
+	convertedTimestampMicros = 10
+
+	repetitionRequired = 0
+	encodingPlain      = 0
+	encodingRLE        = 3
+	codecUncompressed  = 0
+	pageTypeData       = 0
+)
+
+var magic = []byte("PAR1")
+
+// DefaultRowGroupSize is used when NewWriter is given a non-positive row group size.
+const DefaultRowGroupSize = 10000
+
+// Column describes one column of the file.
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+func (c Column) physicalType() int32 {
+	switch c.Type {
+	case Float:
+		return physicalFloat
+	case Double:
+		return physicalDouble
+	case String:
+		return physicalByteArray
+	default:
+		return physicalInt64
+	}
+}
+
+type columnChunk struct {
+	offset int64
+	size   int64
+}
+
+type rowGroup struct {
+	numRows int64
+	size    int64
+	chunks  []columnChunk
+}
+
+// Writer writes rows to a Parquet file.
+type Writer struct {
+	w            io.Writer
+	columns      []Column
+	rowGroupSize int
+
+	offset    int64
+	data      []bytes.Buffer
+	rows      int
+	numRows   int64
+	rowGroups []rowGroup
+	closed    bool
+}
+
+// NewWriter writes the file header to w and returns a writer for the given columns.
+func NewWriter(w io.Writer, columns []Column, rowGroupSize int) (*Writer, error) {
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("parquet: at least one column is required")
+	}
+	if rowGroupSize <= 0 {
+		rowGroupSize = DefaultRowGroupSize
+	}
+
+	pw := &Writer{
+		w:            w,
+		columns:      columns,
+		rowGroupSize: rowGroupSize,
+		data:         make([]bytes.Buffer, len(columns)),
+	}
+	if err := pw.write(magic); err != nil {
+		return nil, err
+	}
+	return pw, nil
+}
+
+// Write appends a row. Values are matched to columns by position and have to be
+// int64, float32, float64, string or time.Time according to the column type.
+func (w *Writer) Write(row ...any) error {
+	if w.closed {
+		return fmt.Errorf("parquet: write on closed writer")
+	}
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("parquet: row has %d values, expected %d", len(row), len(w.columns))
+	}
+
+	for i, c := range w.columns {
+		if !c.accepts(row[i]) {
+			return fmt.Errorf("parquet: unexpected %T for column %q", row[i], c.Name)
+		}
+	}
+
+	var scratch [8]byte
+	for i := range w.columns {
+		buf := &w.data[i]
+		switch v := row[i].(type) {
+		case int64:
+			binary.LittleEndian.PutUint64(scratch[:], uint64(v))
+			buf.Write(scratch[:8])
+		case time.Time:
+			binary.LittleEndian.PutUint64(scratch[:], uint64(v.UnixMicro()))
+			buf.Write(scratch[:8])
+		case float32:
+			binary.LittleEndian.PutUint32(scratch[:], math.Float32bits(v))
+			buf.Write(scratch[:4])
+		case float64:
+			binary.LittleEndian.PutUint64(scratch[:], math.Float64bits(v))
+			buf.Write(scratch[:8])
+		case string:
+			binary.LittleEndian.PutUint32(scratch[:], uint32(len(v)))
+			buf.Write(scratch[:4])
+			buf.WriteString(v)
+		}
+	}
+
+	w.rows++
+	if w.rows >= w.rowGroupSize {
+		return w.flushRowGroup()
+	}
+	return nil
+}
+
+// Close flushes the last row group and writes the file footer. It does not close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return nil
+	}
+	w.closed = true
+
+	if err := w.flushRowGroup(); err != nil {
+		return err
+	}
+
+	footer := w.fileMetadata()
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(footer)))
+
+	if err := w.write(footer); err != nil {
+		return err
+	}
+	if err := w.write(size[:]); err != nil {
+		return err
+	}
+	return w.write(magic)
+}
+
+// flushRowGroup writes every column of the buffered rows as a single data page.
+func (w *Writer) flushRowGroup() error {
+	if w.rows == 0 {
+		return nil
+	}
+
+	rg := rowGroup{numRows: int64(w.rows), chunks: make([]columnChunk, len(w.columns))}
+	for i := range w.columns {
+		page := w.data[i].Bytes()
+		header := pageHeader(w.rows, len(page))
+
+		rg.chunks[i] = columnChunk{offset: w.offset, size: int64(len(header) + len(page))}
+		rg.size += rg.chunks[i].size
+
+		if err := w.write(header); err != nil {
+			return err
+		}
+		if err := w.write(page); err != nil {
+			return err
+		}
+		w.data[i].Reset()
+	}
+
+	w.rowGroups = append(w.rowGroups, rg)
+	w.numRows += rg.numRows
+	w.rows = 0
+	return nil
+}
+
+func (w *Writer) write(b []byte) error {
+	n, err := w.w.Write(b)
+	w.offset += int64(n)
+	if err != nil {
+		return fmt.Errorf("parquet: %w", err)
+	}
+	return nil
+}
+
+func pageHeader(numValues, size int) []byte {
+	var t compactWriter
+	t.i32(1, pageTypeData)
+	t.i32(2, int32(size))
+	t.i32(3, int32(size))
+	t.structField(5)
+	t.i32(1, int32(numValues))
+	t.i32(2, encodingPlain)
+	t.i32(3, encodingRLE)
+	t.i32(4, encodingRLE)
+	t.endStruct()
+	t.endStruct()
+	return t.buf.Bytes()
+}
+
+func (w *Writer) fileMetadata() []byte {
+	var t compactWriter
+	t.i32(1, 1)
+
+	t.listHeader(2, thriftStruct, len(w.columns)+1)
+	t.beginStruct()
+	t.binary(4, "schema")
+	t.i32(5, int32(len(w.columns)))
+	t.endStruct()
+	for _, c := range w.columns {
+		t.beginStruct()
+		t.i32(1, c.physicalType())
+		t.i32(3, repetitionRequired)
+		t.binary(4, c.Name)
+		switch c.Type {
+		case String:
+			t.i32(6, convertedUTF8)
+		case Timestamp:
+			t.i32(6, convertedTimestampMicros)
+		}
+		t.endStruct()
+	}
+
+	t.i64(3, w.numRows)
+
+	t.listHeader(4, thriftStruct, len(w.rowGroups))
+	for _, rg := range w.rowGroups {
+		t.beginStruct()
+		t.listHeader(1, thriftStruct, len(rg.chunks))
+		for i, chunk := range rg.chunks {
+			c := w.columns[i]
+			t.beginStruct()
+			t.i64(2, chunk.offset)
+			t.structField(3)
+			t.i32(1, c.physicalType())
+			t.listHeader(2, thriftI32, 2)
+			t.listI32(encodingPlain)
+			t.listI32(encodingRLE)
+			t.listHeader(3, thriftBinary, 1)
+			t.listBinary(c.Name)
+			t.i32(4, codecUncompressed)
+			t.i64(5, rg.numRows)
+			t.i64(6, chunk.size)
+			t.i64(7, chunk.size)
+			t.i64(9, chunk.offset)
+			t.endStruct()
+			t.endStruct()
+		}
+		t.i64(2, rg.size)
+		t.i64(3, rg.numRows)
+		t.endStruct()
+	}
+
+	t.binary(6, "iot-monitor-backend")
+	t.endStruct()
+	return t.buf.Bytes()
+}
+
+// accepts reports whether v is the Go type written to a column of this type.
+func (c Column) accepts(v any) bool {
+	switch v.(type) {
+	case int64:
+		return c.Type == Int64
+	case time.Time:
+		return c.Type == Timestamp
+	case float32:
+		return c.Type == Float
+	case float64:
+		return c.Type == Double
+	case string:
+		return c.Type == String
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compactReader decodes Thrift compact structs into maps keyed by field id, which is
+// enough to check the metadata written by Writer.
+type compactReader struct {
+	b   []byte
+	pos int
+}
+
+func (r *compactReader) byte() byte {
+	b := r.b[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *compactReader) varint() uint64 {
+	var v uint64
+	for shift := 0; ; shift += 7 {
+		b := r.byte()
+		v |= uint64(b&0x7f) << shift
+		if b < 0x80 {
+			return v
+		}
+	}
+}
+
+func (r *compactReader) zigzag() int64 {
+	v := r.varint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *compactReader) value(typ byte) any {
+	switch typ {
+	case thriftI32, thriftI64:
+		return r.zigzag()
+	case thriftBinary:
+		n := int(r.varint())
+		s := string(r.b[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case thriftList:
+		h := r.byte()
+		size, elem := int(h>>4), h&0x0f
+		if size == 15 {
+			size = int(r.varint())
+		}
+		list := make([]any, size)
+		for i := range list {
+			list[i] = r.value(elem)
+		}
+		return list
+	case thriftStruct:
+		return r.readStruct()
+	}
+	panic("unsupported thrift type")
+}
+
+func (r *compactReader) readStruct() map[int16]any {
+	fields := make(map[int16]any)
+	var last int16
+	for {
+		h := r.byte()
+		if h == 0 {
+			return fields
+		}
+		id := last + int16(h>>4)
+		if h>>4 == 0 {
+			id = int16(r.zigzag())
+		}
+		fields[id] = r.value(h & 0x0f)
+		last = id
+	}
+}
+
+func TestWriter(t *testing.T) {
+	columns := []Column{
+		{Name: "time", Type: Timestamp},
+		{Name: "sensor_id", Type: Int64},
+		{Name: "sensor_name", Type: String},
+		{Name: "value", Type: Float},
+	}
+
+	var out bytes.Buffer
+	w, err := NewWriter(&out, columns, 2)
+	require.NoError(t, err)
+
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		require.NoError(t, w.Write(start.Add(time.Duration(i)*time.Minute), int64(7), "boiler", float32(i)+0.5))
+	}
+	assert.Error(t, w.Write(int64(1), int64(7), "boiler", float32(1)))
+	require.NoError(t, w.Close())
+
+	file := out.Bytes()
+	require.True(t, bytes.HasPrefix(file, magic))
+	require.True(t, bytes.HasSuffix(file, magic))
+
+	footerLen := int(binary.LittleEndian.Uint32(file[len(file)-8:]))
+	footer := &compactReader{b: file[len(file)-8-footerLen : len(file)-8]}
+	meta := footer.readStruct()
+
+	assert.Equal(t, int64(3), meta[3])
+
+	schema := meta[2].([]any)
+	require.Len(t, schema, 5)
+	assert.Equal(t, int64(4), schema[0].(map[int16]any)[5])
+	for i, c := range columns {
+		assert.Equal(t, c.Name, schema[i+1].(map[int16]any)[4])
+	}
+
+	rowGroups := meta[4].([]any)
+	require.Len(t, rowGroups, 2)
+	assert.Equal(t, int64(2), rowGroups[0].(map[int16]any)[3])
+	assert.Equal(t, int64(1), rowGroups[1].(map[int16]any)[3])
+
+	// Read the value column of the second row group back from its data page.
+	chunk := rowGroups[1].(map[int16]any)[1].([]any)[3].(map[int16]any)
+	offset := chunk[2].(int64)
+	page := &compactReader{b: file, pos: int(offset)}
+	header := page.readStruct()
+	assert.Equal(t, int64(4), header[2])
+	assert.Equal(t, int64(1), header[5].(map[int16]any)[1])
+	assert.Equal(t, float32(2.5), math.Float32frombits(binary.LittleEndian.Uint32(file[page.pos:])))
+}
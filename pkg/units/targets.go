@@ -0,0 +1,87 @@
+package units
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Targets holds at most one target unit per dimension. Values are converted to the
+// target of their dimension and left alone when their dimension has none, so a single
+// set of targets, e.g. °F and psi, can apply to sensors measuring different things.
+type Targets map[Dimension]*Unit
+
+// ParseTargets parses a comma separated list of units such as "°F,psi". An empty
+// string yields no targets.
+func ParseTargets(s string) (Targets, error) {
+	targets := make(Targets)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		u, ok := Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown unit %q", name)
+		}
+		if prev, ok := targets[u.Dimension]; ok && prev != u {
+			return nil, fmt.Errorf("units %s and %s both measure %s", prev.Symbol, u.Symbol, u.Dimension)
+		}
+		targets[u.Dimension] = u
+	}
+	return targets, nil
+}
+
+// ParsePreferences parses preferences keyed by dimension name, as stored for a user.
+func ParsePreferences(prefs map[string]string) (Targets, error) {
+	targets := make(Targets, len(prefs))
+	for dim, name := range prefs {
+		u, ok := Lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown unit %q", name)
+		}
+		if string(u.Dimension) != dim {
+			return nil, fmt.Errorf("unit %s measures %s, not %s", u.Symbol, u.Dimension, dim)
+		}
+		targets[u.Dimension] = u
+	}
+	return targets, nil
+}
+
+// Preferences is the inverse of ParsePreferences.
+func (t Targets) Preferences() map[string]string {
+	prefs := make(map[string]string, len(t))
+	for dim, u := range t {
+		prefs[string(dim)] = u.Symbol
+	}
+	return prefs
+}
+
+// String renders the targets in the format read by ParseTargets, ordered by dimension.
+func (t Targets) String() string {
+	dims := make([]Dimension, 0, len(t))
+	for dim := range t {
+		dims = append(dims, dim)
+	}
+	sort.Slice(dims, func(i, j int) bool { return dims[i] < dims[j] })
+
+	symbols := make([]string, len(dims))
+	for i, dim := range dims {
+		symbols[i] = t[dim].Symbol
+	}
+	return strings.Join(symbols, ",")
+}
+
+// For returns the unit named from and its target. ok is false when from is not a
+// registered unit, its dimension has no target or it already is the target.
+func (t Targets) For(from string) (src, dst *Unit, ok bool) {
+	src, known := Lookup(from)
+	if !known {
+		return nil, nil, false
+	}
+	dst, ok = t[src.Dimension]
+	if !ok || dst == src {
+		return nil, nil, false
+	}
+	return src, dst, true
+}
@@ -0,0 +1,182 @@
+// Package units is a registry of measurement units with conversion between units of
+// the same dimension.
+//
+// Sensor types declare their unit as free-form text, so units are looked up by symbol
+// or by one of their aliases, e.g. "°C", "C", "degC" and "celsius" all name degrees
+// Celsius. Every unit is an affine function of the base unit of its dimension, which
+// covers scaled units (hPa, psi) as well as temperatures (°F).
+package units
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Dimension is a physical quantity. Values can only be converted within a dimension.
+type Dimension string
+
+const (
+	Temperature Dimension = "temperature"
+	Pressure    Dimension = "pressure"
+	Length      Dimension = "length"
+	Speed       Dimension = "speed"
+	Mass        Dimension = "mass"
+	Volume      Dimension = "volume"
+	Energy      Dimension = "energy"
+	Power       Dimension = "power"
+	Voltage     Dimension = "voltage"
+	Current     Dimension = "current"
+	Illuminance Dimension = "illuminance"
+	Ratio       Dimension = "ratio"
+)
+
+// Unit is a registered unit. A value v in the unit is v*scale + offset in the base unit
+// of its dimension.
+type Unit struct {
+	Symbol    string
+	Dimension Dimension
+	scale     float64
+	offset    float64
+	aliases   []string
+}
+
+func (u *Unit) String() string {
+	return u.Symbol
+}
+
+// Linear returns a and b such that converting v from u to the unit to gives a*v + b.
+// It is meant for aggregates: a sum of n values converts to a*sum + b*n.
+func (u *Unit) Linear(to *Unit) (a, b float64) {
+	a = u.scale / to.scale
+	b = (u.offset - to.offset) / to.scale
+	return a, b
+}
+
+// Convert converts v from u to the unit to, which must have the same dimension.
+func (u *Unit) Convert(v float64, to *Unit) float64 {
+	a, b := u.Linear(to)
+	return a*v + b
+}
+
+var registry = []*Unit{
+	{Symbol: "K", Dimension: Temperature, scale: 1, aliases: []string{"kelvin"}},
+	{Symbol: "°C", Dimension: Temperature, scale: 1, offset: 273.15, aliases: []string{"C", "degC", "celsius"}},
+	{Symbol: "°F", Dimension: Temperature, scale: 5.0 / 9, offset: 273.15 - 32*5.0/9, aliases: []string{"F", "degF", "fahrenheit"}},
+
+	{Symbol: "Pa", Dimension: Pressure, scale: 1, aliases: []string{"pascal"}},
+	{Symbol: "hPa", Dimension: Pressure, scale: 100},
+	{Symbol: "kPa", Dimension: Pressure, scale: 1e3},
+	{Symbol: "MPa", Dimension: Pressure, scale: 1e6},
+	{Symbol: "bar", Dimension: Pressure, scale: 1e5},
+	{Symbol: "mbar", Dimension: Pressure, scale: 100},
+	{Symbol: "psi", Dimension: Pressure, scale: 6894.757293168},
+	{Symbol: "atm", Dimension: Pressure, scale: 101325},
+	{Symbol: "mmHg", Dimension: Pressure, scale: 133.322387415},
+	{Symbol: "inHg", Dimension: Pressure, scale: 3386.389},
+
+	{Symbol: "m", Dimension: Length, scale: 1, aliases: []string{"meter", "metre"}},
+	{Symbol: "mm", Dimension: Length, scale: 1e-3},
+	{Symbol: "cm", Dimension: Length, scale: 1e-2},
+	{Symbol: "km", Dimension: Length, scale: 1e3},
+	{Symbol: "in", Dimension: Length, scale: 0.0254, aliases: []string{"inch"}},
+	{Symbol: "ft", Dimension: Length, scale: 0.3048, aliases: []string{"foot", "feet"}},
+	{Symbol: "yd", Dimension: Length, scale: 0.9144},
+	{Symbol: "mi", Dimension: Length, scale: 1609.344, aliases: []string{"mile"}},
+
+	{Symbol: "m/s", Dimension: Speed, scale: 1},
+	{Symbol: "km/h", Dimension: Speed, scale: 1 / 3.6, aliases: []string{"kph", "kmh"}},
+	{Symbol: "mph", Dimension: Speed, scale: 0.44704},
+	{Symbol: "kn", Dimension: Speed, scale: 1852.0 / 3600, aliases: []string{"kt", "knot"}},
+	{Symbol: "ft/s", Dimension: Speed, scale: 0.3048},
+
+	{Symbol: "kg", Dimension: Mass, scale: 1, aliases: []string{"kilogram"}},
+	{Symbol: "g", Dimension: Mass, scale: 1e-3, aliases: []string{"gram"}},
+	{Symbol: "mg", Dimension: Mass, scale: 1e-6},
+	{Symbol: "t", Dimension: Mass, scale: 1e3, aliases: []string{"tonne"}},
+	{Symbol: "lb", Dimension: Mass, scale: 0.45359237, aliases: []string{"lbs", "pound"}},
+	{Symbol: "oz", Dimension: Mass, scale: 0.028349523125, aliases: []string{"ounce"}},
+
+	{Symbol: "m³", Dimension: Volume, scale: 1, aliases: []string{"m3"}},
+	{Symbol: "L", Dimension: Volume, scale: 1e-3, aliases: []string{"l", "liter", "litre"}},
+	{Symbol: "mL", Dimension: Volume, scale: 1e-6, aliases: []string{"ml"}},
+	{Symbol: "gal", Dimension: Volume, scale: 0.003785411784, aliases: []string{"gallon"}},
+	{Symbol: "ft³", Dimension: Volume, scale: 0.028316846592, aliases: []string{"ft3"}},
+
+	{Symbol: "J", Dimension: Energy, scale: 1, aliases: []string{"joule"}},
+	{Symbol: "kJ", Dimension: Energy, scale: 1e3},
+	{Symbol: "Wh", Dimension: Energy, scale: 3600},
+	{Symbol: "kWh", Dimension: Energy, scale: 3.6e6},
+	{Symbol: "MWh", Dimension: Energy, scale: 3.6e9},
+
+	{Symbol: "W", Dimension: Power, scale: 1, aliases: []string{"watt"}},
+	{Symbol: "kW", Dimension: Power, scale: 1e3},
+	{Symbol: "MW", Dimension: Power, scale: 1e6},
+	{Symbol: "hp", Dimension: Power, scale: 745.6998715822702},
+
+	{Symbol: "V", Dimension: Voltage, scale: 1, aliases: []string{"volt"}},
+	{Symbol: "mV", Dimension: Voltage, scale: 1e-3},
+	{Symbol: "kV", Dimension: Voltage, scale: 1e3},
+
+	{Symbol: "A", Dimension: Current, scale: 1, aliases: []string{"ampere"}},
+	{Symbol: "mA", Dimension: Current, scale: 1e-3},
+
+	{Symbol: "lx", Dimension: Illuminance, scale: 1, aliases: []string{"lux"}},
+	{Symbol: "fc", Dimension: Illuminance, scale: 10.763910416709722},
+
+	{Symbol: "%", Dimension: Ratio, scale: 1e-2, aliases: []string{"percent"}},
+	{Symbol: "ppm", Dimension: Ratio, scale: 1e-6},
+	{Symbol: "ppb", Dimension: Ratio, scale: 1e-9},
+}
+
+var (
+	bySymbol = make(map[string]*Unit)
+	// byAlias holds aliases in lower case, so they match regardless of case.
+	byAlias = make(map[string]*Unit)
+)
+
+func init() {
+	for _, u := range registry {
+		bySymbol[u.Symbol] = u
+		for _, a := range u.aliases {
+			byAlias[strings.ToLower(a)] = u
+		}
+	}
+}
+
+// Lookup returns the unit named by a symbol or alias. Symbols are case sensitive
+// (mm and Mm differ), aliases are not.
+func Lookup(name string) (*Unit, bool) {
+	name = strings.TrimSpace(name)
+	if u, ok := bySymbol[name]; ok {
+		return u, true
+	}
+	u, ok := byAlias[strings.ToLower(name)]
+	return u, ok
+}
+
+// All returns every registered unit, grouped by dimension.
+func All() []*Unit {
+	units := make([]*Unit, len(registry))
+	copy(units, registry)
+	sort.SliceStable(units, func(i, j int) bool {
+		return units[i].Dimension < units[j].Dimension
+	})
+	return units
+}
+
+// Convert converts v between two named units.
+func Convert(v float64, from, to string) (float64, error) {
+	f, ok := Lookup(from)
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q", from)
+	}
+	t, ok := Lookup(to)
+	if !ok {
+		return 0, fmt.Errorf("unknown unit %q", to)
+	}
+	if f.Dimension != t.Dimension {
+		return 0, fmt.Errorf("cannot convert %s (%s) to %s (%s)", f.Symbol, f.Dimension, t.Symbol, t.Dimension)
+	}
+	return f.Convert(v, t), nil
+}
@@ -0,0 +1,90 @@
+package units
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		from, to string
+		in, want float64
+	}{
+		{"°C", "°F", 100, 212},
+		{"degF", "celsius", 32, 0},
+		{"°C", "K", -273.15, 0},
+		{"bar", "psi", 1, 14.5038},
+		{"hPa", "kPa", 1013.25, 101.325},
+		{"km/h", "m/s", 36, 10},
+		{"in", "mm", 1, 25.4},
+		{"kWh", "J", 1, 3.6e6},
+		{"%", "ppm", 1, 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+" to "+tt.to, func(t *testing.T) {
+			got, err := Convert(tt.in, tt.from, tt.to)
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, got, 1e-4)
+		})
+	}
+
+	_, err := Convert(1, "°C", "psi")
+	assert.Error(t, err)
+	_, err = Convert(1, "furlong", "m")
+	assert.Error(t, err)
+}
+
+func TestLookup(t *testing.T) {
+	u, ok := Lookup(" Celsius ")
+	require.True(t, ok)
+	assert.Equal(t, "°C", u.Symbol)
+
+	u, ok = Lookup("mm")
+	require.True(t, ok)
+	assert.Equal(t, Length, u.Dimension)
+
+	_, ok = Lookup("MM")
+	assert.False(t, ok, "symbols are case sensitive")
+}
+
+func TestLinear(t *testing.T) {
+	c, _ := Lookup("°C")
+	f, _ := Lookup("°F")
+
+	// The sum of 10 °C and 20 °C converts to the sum of 50 °F and 68 °F.
+	a, b := c.Linear(f)
+	assert.InDelta(t, 118, a*30+b*2, 1e-9)
+}
+
+func TestTargets(t *testing.T) {
+	targets, err := ParseTargets("degF, psi")
+	require.NoError(t, err)
+	assert.Equal(t, "psi,°F", targets.String())
+	assert.Equal(t, map[string]string{"temperature": "°F", "pressure": "psi"}, targets.Preferences())
+
+	src, dst, ok := targets.For("hPa")
+	require.True(t, ok)
+	assert.Equal(t, "hPa", src.Symbol)
+	assert.Equal(t, "psi", dst.Symbol)
+
+	_, _, ok = targets.For("%")
+	assert.False(t, ok, "no target for the dimension")
+	_, _, ok = targets.For("°F")
+	assert.False(t, ok, "already in the target unit")
+	_, _, ok = targets.For("widgets")
+	assert.False(t, ok)
+
+	_, err = ParseTargets("°F,K")
+	assert.Error(t, err)
+	_, err = ParseTargets("°X")
+	assert.Error(t, err)
+
+	_, err = ParsePreferences(map[string]string{"temperature": "psi"})
+	assert.Error(t, err)
+	prefs, err := ParsePreferences(map[string]string{"temperature": "F"})
+	require.NoError(t, err)
+	assert.Equal(t, "°F", prefs.String())
+}
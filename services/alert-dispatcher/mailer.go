@@ -22,14 +22,18 @@ func (m *Mailer) SendAlertEmail(to string, event AlertEvent) error {
 	msg := gomail.NewMessage()
 	msg.SetHeader("From", m.from)
 	msg.SetHeader("To", to)
-	msg.SetHeader("Subject", "IOT Alert: "+event.Message)
+	subject, heading := "IOT Alert: ", "IOT Alert Triggered"
+	if event.Status == "resolved" {
+		subject, heading = "IOT Alert Resolved: ", "IOT Alert Resolved"
+	}
+	msg.SetHeader("Subject", subject+event.Message)
 	msg.SetBody("text/html", fmt.Sprintf(`
-		<h2>IOT Alert Triggered</h2>
+		<h2>%s</h2>
 		<p><strong>Message:</strong> %s</p>
 		<p><strong>Sensor ID:</strong> %d</p>
 		<p><strong>Value:</strong> %f</p>
 		<p><strong>Time:</strong> %s</p>
-		`, event.Message, event.SensorID, event.Value, event.Timestamp.Format(time.RFC1123)))
+		`, heading, event.Message, event.SensorID, event.Value, event.Timestamp.Format(time.RFC1123)))
 
 		return m.dialer.DialAndSend(msg)
 		}
@@ -24,8 +24,12 @@ type AlertEvent struct {
         RuleID    int       `json:"rule_id"`
         UserID    int64     `json:"user_id"`
         SensorID  int64     `json:"sensor_id"`
+        // Status is firing, acknowledged or resolved; events of older alert services
+        // leave it empty and are treated as firing.
+        Status    string    `json:"status,omitempty"`
         Message   string    `json:"message"`
         Value     float64   `json:"value"`
+        Count     int       `json:"count,omitempty"`
         Timestamp time.Time `json:"timestamp"`
 }
 func main() {
@@ -173,9 +177,15 @@ func processAlert(body []byte, authClient pb_auth.AuthServiceClient, mailer *Mai
 	logger.Info("Received alert event",
 		zap.Int("alert_id", event.AlertID),
 		zap.Int64("user_id", event.UserID),
+		zap.String("status", event.Status),
 		zap.String("message", event.Message),
 	)
 
+	// The user acknowledged the alert themselves, so there is nothing to tell them.
+	if event.Status == "acknowledged" {
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
@@ -28,6 +28,20 @@ type Alert struct {
 	TriggeredAt time.Time `json:"triggered_at,omitempty"`
 	// IsRead holds the value of the "is_read" field.
 	IsRead bool `json:"is_read,omitempty"`
+	// Status holds the value of the "status" field.
+	Status string `json:"status,omitempty"`
+	// Count holds the value of the "count" field.
+	Count int `json:"count,omitempty"`
+	// LastValue holds the value of the "last_value" field.
+	LastValue float64 `json:"last_value,omitempty"`
+	// LastTriggeredAt holds the value of the "last_triggered_at" field.
+	LastTriggeredAt time.Time `json:"last_triggered_at,omitempty"`
+	// AcknowledgedAt holds the value of the "acknowledged_at" field.
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	// AcknowledgedBy holds the value of the "acknowledged_by" field.
+	AcknowledgedBy int64 `json:"acknowledged_by,omitempty"`
+	// ResolvedAt holds the value of the "resolved_at" field.
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the AlertQuery when eager-loading is set.
 	Edges             AlertEdges `json:"edges"`
@@ -62,13 +76,13 @@ func (*Alert) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case alert.FieldIsRead:
 			values[i] = new(sql.NullBool)
-		case alert.FieldValue:
+		case alert.FieldValue, alert.FieldLastValue:
 			values[i] = new(sql.NullFloat64)
-		case alert.FieldID, alert.FieldUserID:
+		case alert.FieldID, alert.FieldUserID, alert.FieldCount, alert.FieldAcknowledgedBy:
 			values[i] = new(sql.NullInt64)
-		case alert.FieldMessage:
+		case alert.FieldMessage, alert.FieldStatus:
 			values[i] = new(sql.NullString)
-		case alert.FieldTriggeredAt:
+		case alert.FieldTriggeredAt, alert.FieldLastTriggeredAt, alert.FieldAcknowledgedAt, alert.FieldResolvedAt:
 			values[i] = new(sql.NullTime)
 		case alert.ForeignKeys[0]: // alert_rule_alerts
 			values[i] = new(sql.NullInt64)
@@ -123,6 +137,50 @@ func (a *Alert) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.IsRead = value.Bool
 			}
+		case alert.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				a.Status = value.String
+			}
+		case alert.FieldCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field count", values[i])
+			} else if value.Valid {
+				a.Count = int(value.Int64)
+			}
+		case alert.FieldLastValue:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_value", values[i])
+			} else if value.Valid {
+				a.LastValue = value.Float64
+			}
+		case alert.FieldLastTriggeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_triggered_at", values[i])
+			} else if value.Valid {
+				a.LastTriggeredAt = value.Time
+			}
+		case alert.FieldAcknowledgedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_at", values[i])
+			} else if value.Valid {
+				a.AcknowledgedAt = new(time.Time)
+				*a.AcknowledgedAt = value.Time
+			}
+		case alert.FieldAcknowledgedBy:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_by", values[i])
+			} else if value.Valid {
+				a.AcknowledgedBy = value.Int64
+			}
+		case alert.FieldResolvedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field resolved_at", values[i])
+			} else if value.Valid {
+				a.ResolvedAt = new(time.Time)
+				*a.ResolvedAt = value.Time
+			}
 		case alert.ForeignKeys[0]:
 			if value, ok := values[i].(*sql.NullInt64); !ok {
 				return fmt.Errorf("unexpected type %T for edge-field alert_rule_alerts", value)
@@ -185,6 +243,31 @@ func (a *Alert) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("is_read=")
 	builder.WriteString(fmt.Sprintf("%v", a.IsRead))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(a.Status)
+	builder.WriteString(", ")
+	builder.WriteString("count=")
+	builder.WriteString(fmt.Sprintf("%v", a.Count))
+	builder.WriteString(", ")
+	builder.WriteString("last_value=")
+	builder.WriteString(fmt.Sprintf("%v", a.LastValue))
+	builder.WriteString(", ")
+	builder.WriteString("last_triggered_at=")
+	builder.WriteString(a.LastTriggeredAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := a.AcknowledgedAt; v != nil {
+		builder.WriteString("acknowledged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("acknowledged_by=")
+	builder.WriteString(fmt.Sprintf("%v", a.AcknowledgedBy))
+	builder.WriteString(", ")
+	if v := a.ResolvedAt; v != nil {
+		builder.WriteString("resolved_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -24,6 +24,20 @@ const (
 	FieldTriggeredAt = "triggered_at"
 	// FieldIsRead holds the string denoting the is_read field in the database.
 	FieldIsRead = "is_read"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldCount holds the string denoting the count field in the database.
+	FieldCount = "count"
+	// FieldLastValue holds the string denoting the last_value field in the database.
+	FieldLastValue = "last_value"
+	// FieldLastTriggeredAt holds the string denoting the last_triggered_at field in the database.
+	FieldLastTriggeredAt = "last_triggered_at"
+	// FieldAcknowledgedAt holds the string denoting the acknowledged_at field in the database.
+	FieldAcknowledgedAt = "acknowledged_at"
+	// FieldAcknowledgedBy holds the string denoting the acknowledged_by field in the database.
+	FieldAcknowledgedBy = "acknowledged_by"
+	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
+	FieldResolvedAt = "resolved_at"
 	// EdgeRule holds the string denoting the rule edge name in mutations.
 	EdgeRule = "rule"
 	// Table holds the table name of the alert in the database.
@@ -45,6 +59,13 @@ var Columns = []string{
 	FieldMessage,
 	FieldTriggeredAt,
 	FieldIsRead,
+	FieldStatus,
+	FieldCount,
+	FieldLastValue,
+	FieldLastTriggeredAt,
+	FieldAcknowledgedAt,
+	FieldAcknowledgedBy,
+	FieldResolvedAt,
 }
 
 // ForeignKeys holds the SQL foreign-keys that are owned by the "alerts"
@@ -73,6 +94,10 @@ var (
 	DefaultTriggeredAt func() time.Time
 	// DefaultIsRead holds the default value on creation for the "is_read" field.
 	DefaultIsRead bool
+	// DefaultStatus holds the default value on creation for the "status" field.
+	DefaultStatus string
+	// DefaultCount holds the default value on creation for the "count" field.
+	DefaultCount int
 )
 
 // OrderOption defines the ordering options for the Alert queries.
@@ -108,6 +133,41 @@ func ByIsRead(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsRead, opts...).ToFunc()
 }
 
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByCount orders the results by the count field.
+func ByCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCount, opts...).ToFunc()
+}
+
+// ByLastValue orders the results by the last_value field.
+func ByLastValue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastValue, opts...).ToFunc()
+}
+
+// ByLastTriggeredAt orders the results by the last_triggered_at field.
+func ByLastTriggeredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastTriggeredAt, opts...).ToFunc()
+}
+
+// ByAcknowledgedAt orders the results by the acknowledged_at field.
+func ByAcknowledgedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcknowledgedAt, opts...).ToFunc()
+}
+
+// ByAcknowledgedBy orders the results by the acknowledged_by field.
+func ByAcknowledgedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcknowledgedBy, opts...).ToFunc()
+}
+
+// ByResolvedAt orders the results by the resolved_at field.
+func ByResolvedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldResolvedAt, opts...).ToFunc()
+}
+
 // ByRuleField orders the results by rule field.
 func ByRuleField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
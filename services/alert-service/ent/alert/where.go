@@ -80,6 +80,41 @@ func IsRead(v bool) predicate.Alert {
 	return predicate.Alert(sql.FieldEQ(FieldIsRead, v))
 }
 
+// Status applies equality check predicate on the "status" field. It's identical to StatusEQ.
+func Status(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldStatus, v))
+}
+
+// Count applies equality check predicate on the "count" field. It's identical to CountEQ.
+func Count(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldCount, v))
+}
+
+// LastValue applies equality check predicate on the "last_value" field. It's identical to LastValueEQ.
+func LastValue(v float64) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldLastValue, v))
+}
+
+// LastTriggeredAt applies equality check predicate on the "last_triggered_at" field. It's identical to LastTriggeredAtEQ.
+func LastTriggeredAt(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldLastTriggeredAt, v))
+}
+
+// AcknowledgedAt applies equality check predicate on the "acknowledged_at" field. It's identical to AcknowledgedAtEQ.
+func AcknowledgedAt(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedBy applies equality check predicate on the "acknowledged_by" field. It's identical to AcknowledgedByEQ.
+func AcknowledgedBy(v int64) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// ResolvedAt applies equality check predicate on the "resolved_at" field. It's identical to ResolvedAtEQ.
+func ResolvedAt(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldResolvedAt, v))
+}
+
 // UserIDEQ applies the EQ predicate on the "user_id" field.
 func UserIDEQ(v int64) predicate.Alert {
 	return predicate.Alert(sql.FieldEQ(FieldUserID, v))
@@ -275,6 +310,361 @@ func IsReadNEQ(v bool) predicate.Alert {
 	return predicate.Alert(sql.FieldNEQ(FieldIsRead, v))
 }
 
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...string) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// StatusGT applies the GT predicate on the "status" field.
+func StatusGT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldStatus, v))
+}
+
+// StatusGTE applies the GTE predicate on the "status" field.
+func StatusGTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldStatus, v))
+}
+
+// StatusLT applies the LT predicate on the "status" field.
+func StatusLT(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldStatus, v))
+}
+
+// StatusLTE applies the LTE predicate on the "status" field.
+func StatusLTE(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldStatus, v))
+}
+
+// StatusContains applies the Contains predicate on the "status" field.
+func StatusContains(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContains(FieldStatus, v))
+}
+
+// StatusHasPrefix applies the HasPrefix predicate on the "status" field.
+func StatusHasPrefix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasPrefix(FieldStatus, v))
+}
+
+// StatusHasSuffix applies the HasSuffix predicate on the "status" field.
+func StatusHasSuffix(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldHasSuffix(FieldStatus, v))
+}
+
+// StatusEqualFold applies the EqualFold predicate on the "status" field.
+func StatusEqualFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldEqualFold(FieldStatus, v))
+}
+
+// StatusContainsFold applies the ContainsFold predicate on the "status" field.
+func StatusContainsFold(v string) predicate.Alert {
+	return predicate.Alert(sql.FieldContainsFold(FieldStatus, v))
+}
+
+// CountEQ applies the EQ predicate on the "count" field.
+func CountEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldCount, v))
+}
+
+// CountNEQ applies the NEQ predicate on the "count" field.
+func CountNEQ(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldCount, v))
+}
+
+// CountIn applies the In predicate on the "count" field.
+func CountIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldCount, vs...))
+}
+
+// CountNotIn applies the NotIn predicate on the "count" field.
+func CountNotIn(vs ...int) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldCount, vs...))
+}
+
+// CountGT applies the GT predicate on the "count" field.
+func CountGT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldCount, v))
+}
+
+// CountGTE applies the GTE predicate on the "count" field.
+func CountGTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldCount, v))
+}
+
+// CountLT applies the LT predicate on the "count" field.
+func CountLT(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldCount, v))
+}
+
+// CountLTE applies the LTE predicate on the "count" field.
+func CountLTE(v int) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldCount, v))
+}
+
+// LastValueEQ applies the EQ predicate on the "last_value" field.
+func LastValueEQ(v float64) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldLastValue, v))
+}
+
+// LastValueNEQ applies the NEQ predicate on the "last_value" field.
+func LastValueNEQ(v float64) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldLastValue, v))
+}
+
+// LastValueIn applies the In predicate on the "last_value" field.
+func LastValueIn(vs ...float64) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldLastValue, vs...))
+}
+
+// LastValueNotIn applies the NotIn predicate on the "last_value" field.
+func LastValueNotIn(vs ...float64) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldLastValue, vs...))
+}
+
+// LastValueGT applies the GT predicate on the "last_value" field.
+func LastValueGT(v float64) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldLastValue, v))
+}
+
+// LastValueGTE applies the GTE predicate on the "last_value" field.
+func LastValueGTE(v float64) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldLastValue, v))
+}
+
+// LastValueLT applies the LT predicate on the "last_value" field.
+func LastValueLT(v float64) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldLastValue, v))
+}
+
+// LastValueLTE applies the LTE predicate on the "last_value" field.
+func LastValueLTE(v float64) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldLastValue, v))
+}
+
+// LastValueIsNil applies the IsNil predicate on the "last_value" field.
+func LastValueIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldLastValue))
+}
+
+// LastValueNotNil applies the NotNil predicate on the "last_value" field.
+func LastValueNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldLastValue))
+}
+
+// LastTriggeredAtEQ applies the EQ predicate on the "last_triggered_at" field.
+func LastTriggeredAtEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtNEQ applies the NEQ predicate on the "last_triggered_at" field.
+func LastTriggeredAtNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtIn applies the In predicate on the "last_triggered_at" field.
+func LastTriggeredAtIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldLastTriggeredAt, vs...))
+}
+
+// LastTriggeredAtNotIn applies the NotIn predicate on the "last_triggered_at" field.
+func LastTriggeredAtNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldLastTriggeredAt, vs...))
+}
+
+// LastTriggeredAtGT applies the GT predicate on the "last_triggered_at" field.
+func LastTriggeredAtGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtGTE applies the GTE predicate on the "last_triggered_at" field.
+func LastTriggeredAtGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtLT applies the LT predicate on the "last_triggered_at" field.
+func LastTriggeredAtLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtLTE applies the LTE predicate on the "last_triggered_at" field.
+func LastTriggeredAtLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldLastTriggeredAt, v))
+}
+
+// LastTriggeredAtIsNil applies the IsNil predicate on the "last_triggered_at" field.
+func LastTriggeredAtIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldLastTriggeredAt))
+}
+
+// LastTriggeredAtNotNil applies the NotNil predicate on the "last_triggered_at" field.
+func LastTriggeredAtNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldLastTriggeredAt))
+}
+
+// AcknowledgedAtEQ applies the EQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtNEQ applies the NEQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIn applies the In predicate on the "acknowledged_at" field.
+func AcknowledgedAtIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtNotIn applies the NotIn predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtGT applies the GT predicate on the "acknowledged_at" field.
+func AcknowledgedAtGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtGTE applies the GTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLT applies the LT predicate on the "acknowledged_at" field.
+func AcknowledgedAtLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLTE applies the LTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIsNil applies the IsNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedAtNotNil applies the NotNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedByEQ applies the EQ predicate on the "acknowledged_by" field.
+func AcknowledgedByEQ(v int64) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByNEQ applies the NEQ predicate on the "acknowledged_by" field.
+func AcknowledgedByNEQ(v int64) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIn applies the In predicate on the "acknowledged_by" field.
+func AcknowledgedByIn(vs ...int64) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByNotIn applies the NotIn predicate on the "acknowledged_by" field.
+func AcknowledgedByNotIn(vs ...int64) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByGT applies the GT predicate on the "acknowledged_by" field.
+func AcknowledgedByGT(v int64) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByGTE applies the GTE predicate on the "acknowledged_by" field.
+func AcknowledgedByGTE(v int64) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLT applies the LT predicate on the "acknowledged_by" field.
+func AcknowledgedByLT(v int64) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLTE applies the LTE predicate on the "acknowledged_by" field.
+func AcknowledgedByLTE(v int64) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIsNil applies the IsNil predicate on the "acknowledged_by" field.
+func AcknowledgedByIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldAcknowledgedBy))
+}
+
+// AcknowledgedByNotNil applies the NotNil predicate on the "acknowledged_by" field.
+func AcknowledgedByNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldAcknowledgedBy))
+}
+
+// ResolvedAtEQ applies the EQ predicate on the "resolved_at" field.
+func ResolvedAtEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtNEQ applies the NEQ predicate on the "resolved_at" field.
+func ResolvedAtNEQ(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNEQ(FieldResolvedAt, v))
+}
+
+// ResolvedAtIn applies the In predicate on the "resolved_at" field.
+func ResolvedAtIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtNotIn applies the NotIn predicate on the "resolved_at" field.
+func ResolvedAtNotIn(vs ...time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldNotIn(FieldResolvedAt, vs...))
+}
+
+// ResolvedAtGT applies the GT predicate on the "resolved_at" field.
+func ResolvedAtGT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGT(FieldResolvedAt, v))
+}
+
+// ResolvedAtGTE applies the GTE predicate on the "resolved_at" field.
+func ResolvedAtGTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldGTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtLT applies the LT predicate on the "resolved_at" field.
+func ResolvedAtLT(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLT(FieldResolvedAt, v))
+}
+
+// ResolvedAtLTE applies the LTE predicate on the "resolved_at" field.
+func ResolvedAtLTE(v time.Time) predicate.Alert {
+	return predicate.Alert(sql.FieldLTE(FieldResolvedAt, v))
+}
+
+// ResolvedAtIsNil applies the IsNil predicate on the "resolved_at" field.
+func ResolvedAtIsNil() predicate.Alert {
+	return predicate.Alert(sql.FieldIsNull(FieldResolvedAt))
+}
+
+// ResolvedAtNotNil applies the NotNil predicate on the "resolved_at" field.
+func ResolvedAtNotNil() predicate.Alert {
+	return predicate.Alert(sql.FieldNotNull(FieldResolvedAt))
+}
+
 // HasRule applies the HasEdge predicate on the "rule" edge.
 func HasRule() predicate.Alert {
 	return predicate.Alert(func(s *sql.Selector) {
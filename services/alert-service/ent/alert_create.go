@@ -67,6 +67,104 @@ func (ac *AlertCreate) SetNillableIsRead(b *bool) *AlertCreate {
 	return ac
 }
 
+// SetStatus sets the "status" field.
+func (ac *AlertCreate) SetStatus(s string) *AlertCreate {
+	ac.mutation.SetStatus(s)
+	return ac
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableStatus(s *string) *AlertCreate {
+	if s != nil {
+		ac.SetStatus(*s)
+	}
+	return ac
+}
+
+// SetCount sets the "count" field.
+func (ac *AlertCreate) SetCount(i int) *AlertCreate {
+	ac.mutation.SetCount(i)
+	return ac
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableCount(i *int) *AlertCreate {
+	if i != nil {
+		ac.SetCount(*i)
+	}
+	return ac
+}
+
+// SetLastValue sets the "last_value" field.
+func (ac *AlertCreate) SetLastValue(f float64) *AlertCreate {
+	ac.mutation.SetLastValue(f)
+	return ac
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableLastValue(f *float64) *AlertCreate {
+	if f != nil {
+		ac.SetLastValue(*f)
+	}
+	return ac
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (ac *AlertCreate) SetLastTriggeredAt(t time.Time) *AlertCreate {
+	ac.mutation.SetLastTriggeredAt(t)
+	return ac
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableLastTriggeredAt(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetLastTriggeredAt(*t)
+	}
+	return ac
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (ac *AlertCreate) SetAcknowledgedAt(t time.Time) *AlertCreate {
+	ac.mutation.SetAcknowledgedAt(t)
+	return ac
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableAcknowledgedAt(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetAcknowledgedAt(*t)
+	}
+	return ac
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (ac *AlertCreate) SetAcknowledgedBy(i int64) *AlertCreate {
+	ac.mutation.SetAcknowledgedBy(i)
+	return ac
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableAcknowledgedBy(i *int64) *AlertCreate {
+	if i != nil {
+		ac.SetAcknowledgedBy(*i)
+	}
+	return ac
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (ac *AlertCreate) SetResolvedAt(t time.Time) *AlertCreate {
+	ac.mutation.SetResolvedAt(t)
+	return ac
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (ac *AlertCreate) SetNillableResolvedAt(t *time.Time) *AlertCreate {
+	if t != nil {
+		ac.SetResolvedAt(*t)
+	}
+	return ac
+}
+
 // SetRuleID sets the "rule" edge to the AlertRule entity by ID.
 func (ac *AlertCreate) SetRuleID(id int) *AlertCreate {
 	ac.mutation.SetRuleID(id)
@@ -121,6 +219,14 @@ func (ac *AlertCreate) defaults() {
 		v := alert.DefaultIsRead
 		ac.mutation.SetIsRead(v)
 	}
+	if _, ok := ac.mutation.Status(); !ok {
+		v := alert.DefaultStatus
+		ac.mutation.SetStatus(v)
+	}
+	if _, ok := ac.mutation.Count(); !ok {
+		v := alert.DefaultCount
+		ac.mutation.SetCount(v)
+	}
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -140,6 +246,12 @@ func (ac *AlertCreate) check() error {
 	if _, ok := ac.mutation.IsRead(); !ok {
 		return &ValidationError{Name: "is_read", err: errors.New(`ent: missing required field "Alert.is_read"`)}
 	}
+	if _, ok := ac.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Alert.status"`)}
+	}
+	if _, ok := ac.mutation.Count(); !ok {
+		return &ValidationError{Name: "count", err: errors.New(`ent: missing required field "Alert.count"`)}
+	}
 	if len(ac.mutation.RuleIDs()) == 0 {
 		return &ValidationError{Name: "rule", err: errors.New(`ent: missing required edge "Alert.rule"`)}
 	}
@@ -189,6 +301,34 @@ func (ac *AlertCreate) createSpec() (*Alert, *sqlgraph.CreateSpec) {
 		_spec.SetField(alert.FieldIsRead, field.TypeBool, value)
 		_node.IsRead = value
 	}
+	if value, ok := ac.mutation.Status(); ok {
+		_spec.SetField(alert.FieldStatus, field.TypeString, value)
+		_node.Status = value
+	}
+	if value, ok := ac.mutation.Count(); ok {
+		_spec.SetField(alert.FieldCount, field.TypeInt, value)
+		_node.Count = value
+	}
+	if value, ok := ac.mutation.LastValue(); ok {
+		_spec.SetField(alert.FieldLastValue, field.TypeFloat64, value)
+		_node.LastValue = value
+	}
+	if value, ok := ac.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(alert.FieldLastTriggeredAt, field.TypeTime, value)
+		_node.LastTriggeredAt = value
+	}
+	if value, ok := ac.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(alert.FieldAcknowledgedAt, field.TypeTime, value)
+		_node.AcknowledgedAt = &value
+	}
+	if value, ok := ac.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(alert.FieldAcknowledgedBy, field.TypeInt64, value)
+		_node.AcknowledgedBy = value
+	}
+	if value, ok := ac.mutation.ResolvedAt(); ok {
+		_spec.SetField(alert.FieldResolvedAt, field.TypeTime, value)
+		_node.ResolvedAt = &value
+	}
 	if nodes := ac.mutation.RuleIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -113,6 +113,155 @@ func (au *AlertUpdate) SetNillableIsRead(b *bool) *AlertUpdate {
 	return au
 }
 
+// SetStatus sets the "status" field.
+func (au *AlertUpdate) SetStatus(s string) *AlertUpdate {
+	au.mutation.SetStatus(s)
+	return au
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableStatus(s *string) *AlertUpdate {
+	if s != nil {
+		au.SetStatus(*s)
+	}
+	return au
+}
+
+// SetCount sets the "count" field.
+func (au *AlertUpdate) SetCount(i int) *AlertUpdate {
+	au.mutation.ResetCount()
+	au.mutation.SetCount(i)
+	return au
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableCount(i *int) *AlertUpdate {
+	if i != nil {
+		au.SetCount(*i)
+	}
+	return au
+}
+
+// AddCount adds i to the "count" field.
+func (au *AlertUpdate) AddCount(i int) *AlertUpdate {
+	au.mutation.AddCount(i)
+	return au
+}
+
+// SetLastValue sets the "last_value" field.
+func (au *AlertUpdate) SetLastValue(f float64) *AlertUpdate {
+	au.mutation.ResetLastValue()
+	au.mutation.SetLastValue(f)
+	return au
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableLastValue(f *float64) *AlertUpdate {
+	if f != nil {
+		au.SetLastValue(*f)
+	}
+	return au
+}
+
+// AddLastValue adds f to the "last_value" field.
+func (au *AlertUpdate) AddLastValue(f float64) *AlertUpdate {
+	au.mutation.AddLastValue(f)
+	return au
+}
+
+// ClearLastValue clears the value of the "last_value" field.
+func (au *AlertUpdate) ClearLastValue() *AlertUpdate {
+	au.mutation.ClearLastValue()
+	return au
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (au *AlertUpdate) SetLastTriggeredAt(t time.Time) *AlertUpdate {
+	au.mutation.SetLastTriggeredAt(t)
+	return au
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableLastTriggeredAt(t *time.Time) *AlertUpdate {
+	if t != nil {
+		au.SetLastTriggeredAt(*t)
+	}
+	return au
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (au *AlertUpdate) ClearLastTriggeredAt() *AlertUpdate {
+	au.mutation.ClearLastTriggeredAt()
+	return au
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (au *AlertUpdate) SetAcknowledgedAt(t time.Time) *AlertUpdate {
+	au.mutation.SetAcknowledgedAt(t)
+	return au
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableAcknowledgedAt(t *time.Time) *AlertUpdate {
+	if t != nil {
+		au.SetAcknowledgedAt(*t)
+	}
+	return au
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (au *AlertUpdate) ClearAcknowledgedAt() *AlertUpdate {
+	au.mutation.ClearAcknowledgedAt()
+	return au
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (au *AlertUpdate) SetAcknowledgedBy(i int64) *AlertUpdate {
+	au.mutation.ResetAcknowledgedBy()
+	au.mutation.SetAcknowledgedBy(i)
+	return au
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableAcknowledgedBy(i *int64) *AlertUpdate {
+	if i != nil {
+		au.SetAcknowledgedBy(*i)
+	}
+	return au
+}
+
+// AddAcknowledgedBy adds i to the "acknowledged_by" field.
+func (au *AlertUpdate) AddAcknowledgedBy(i int64) *AlertUpdate {
+	au.mutation.AddAcknowledgedBy(i)
+	return au
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (au *AlertUpdate) ClearAcknowledgedBy() *AlertUpdate {
+	au.mutation.ClearAcknowledgedBy()
+	return au
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (au *AlertUpdate) SetResolvedAt(t time.Time) *AlertUpdate {
+	au.mutation.SetResolvedAt(t)
+	return au
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (au *AlertUpdate) SetNillableResolvedAt(t *time.Time) *AlertUpdate {
+	if t != nil {
+		au.SetResolvedAt(*t)
+	}
+	return au
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (au *AlertUpdate) ClearResolvedAt() *AlertUpdate {
+	au.mutation.ClearResolvedAt()
+	return au
+}
+
 // SetRuleID sets the "rule" edge to the AlertRule entity by ID.
 func (au *AlertUpdate) SetRuleID(id int) *AlertUpdate {
 	au.mutation.SetRuleID(id)
@@ -203,6 +352,51 @@ func (au *AlertUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.IsRead(); ok {
 		_spec.SetField(alert.FieldIsRead, field.TypeBool, value)
 	}
+	if value, ok := au.mutation.Status(); ok {
+		_spec.SetField(alert.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := au.mutation.Count(); ok {
+		_spec.SetField(alert.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedCount(); ok {
+		_spec.AddField(alert.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.LastValue(); ok {
+		_spec.SetField(alert.FieldLastValue, field.TypeFloat64, value)
+	}
+	if value, ok := au.mutation.AddedLastValue(); ok {
+		_spec.AddField(alert.FieldLastValue, field.TypeFloat64, value)
+	}
+	if au.mutation.LastValueCleared() {
+		_spec.ClearField(alert.FieldLastValue, field.TypeFloat64)
+	}
+	if value, ok := au.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(alert.FieldLastTriggeredAt, field.TypeTime, value)
+	}
+	if au.mutation.LastTriggeredAtCleared() {
+		_spec.ClearField(alert.FieldLastTriggeredAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(alert.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if au.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(alert.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(alert.FieldAcknowledgedBy, field.TypeInt64, value)
+	}
+	if value, ok := au.mutation.AddedAcknowledgedBy(); ok {
+		_spec.AddField(alert.FieldAcknowledgedBy, field.TypeInt64, value)
+	}
+	if au.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(alert.FieldAcknowledgedBy, field.TypeInt64)
+	}
+	if value, ok := au.mutation.ResolvedAt(); ok {
+		_spec.SetField(alert.FieldResolvedAt, field.TypeTime, value)
+	}
+	if au.mutation.ResolvedAtCleared() {
+		_spec.ClearField(alert.FieldResolvedAt, field.TypeTime)
+	}
 	if au.mutation.RuleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -336,6 +530,155 @@ func (auo *AlertUpdateOne) SetNillableIsRead(b *bool) *AlertUpdateOne {
 	return auo
 }
 
+// SetStatus sets the "status" field.
+func (auo *AlertUpdateOne) SetStatus(s string) *AlertUpdateOne {
+	auo.mutation.SetStatus(s)
+	return auo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableStatus(s *string) *AlertUpdateOne {
+	if s != nil {
+		auo.SetStatus(*s)
+	}
+	return auo
+}
+
+// SetCount sets the "count" field.
+func (auo *AlertUpdateOne) SetCount(i int) *AlertUpdateOne {
+	auo.mutation.ResetCount()
+	auo.mutation.SetCount(i)
+	return auo
+}
+
+// SetNillableCount sets the "count" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableCount(i *int) *AlertUpdateOne {
+	if i != nil {
+		auo.SetCount(*i)
+	}
+	return auo
+}
+
+// AddCount adds i to the "count" field.
+func (auo *AlertUpdateOne) AddCount(i int) *AlertUpdateOne {
+	auo.mutation.AddCount(i)
+	return auo
+}
+
+// SetLastValue sets the "last_value" field.
+func (auo *AlertUpdateOne) SetLastValue(f float64) *AlertUpdateOne {
+	auo.mutation.ResetLastValue()
+	auo.mutation.SetLastValue(f)
+	return auo
+}
+
+// SetNillableLastValue sets the "last_value" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableLastValue(f *float64) *AlertUpdateOne {
+	if f != nil {
+		auo.SetLastValue(*f)
+	}
+	return auo
+}
+
+// AddLastValue adds f to the "last_value" field.
+func (auo *AlertUpdateOne) AddLastValue(f float64) *AlertUpdateOne {
+	auo.mutation.AddLastValue(f)
+	return auo
+}
+
+// ClearLastValue clears the value of the "last_value" field.
+func (auo *AlertUpdateOne) ClearLastValue() *AlertUpdateOne {
+	auo.mutation.ClearLastValue()
+	return auo
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (auo *AlertUpdateOne) SetLastTriggeredAt(t time.Time) *AlertUpdateOne {
+	auo.mutation.SetLastTriggeredAt(t)
+	return auo
+}
+
+// SetNillableLastTriggeredAt sets the "last_triggered_at" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableLastTriggeredAt(t *time.Time) *AlertUpdateOne {
+	if t != nil {
+		auo.SetLastTriggeredAt(*t)
+	}
+	return auo
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (auo *AlertUpdateOne) ClearLastTriggeredAt() *AlertUpdateOne {
+	auo.mutation.ClearLastTriggeredAt()
+	return auo
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (auo *AlertUpdateOne) SetAcknowledgedAt(t time.Time) *AlertUpdateOne {
+	auo.mutation.SetAcknowledgedAt(t)
+	return auo
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableAcknowledgedAt(t *time.Time) *AlertUpdateOne {
+	if t != nil {
+		auo.SetAcknowledgedAt(*t)
+	}
+	return auo
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (auo *AlertUpdateOne) ClearAcknowledgedAt() *AlertUpdateOne {
+	auo.mutation.ClearAcknowledgedAt()
+	return auo
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (auo *AlertUpdateOne) SetAcknowledgedBy(i int64) *AlertUpdateOne {
+	auo.mutation.ResetAcknowledgedBy()
+	auo.mutation.SetAcknowledgedBy(i)
+	return auo
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableAcknowledgedBy(i *int64) *AlertUpdateOne {
+	if i != nil {
+		auo.SetAcknowledgedBy(*i)
+	}
+	return auo
+}
+
+// AddAcknowledgedBy adds i to the "acknowledged_by" field.
+func (auo *AlertUpdateOne) AddAcknowledgedBy(i int64) *AlertUpdateOne {
+	auo.mutation.AddAcknowledgedBy(i)
+	return auo
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (auo *AlertUpdateOne) ClearAcknowledgedBy() *AlertUpdateOne {
+	auo.mutation.ClearAcknowledgedBy()
+	return auo
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (auo *AlertUpdateOne) SetResolvedAt(t time.Time) *AlertUpdateOne {
+	auo.mutation.SetResolvedAt(t)
+	return auo
+}
+
+// SetNillableResolvedAt sets the "resolved_at" field if the given value is not nil.
+func (auo *AlertUpdateOne) SetNillableResolvedAt(t *time.Time) *AlertUpdateOne {
+	if t != nil {
+		auo.SetResolvedAt(*t)
+	}
+	return auo
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (auo *AlertUpdateOne) ClearResolvedAt() *AlertUpdateOne {
+	auo.mutation.ClearResolvedAt()
+	return auo
+}
+
 // SetRuleID sets the "rule" edge to the AlertRule entity by ID.
 func (auo *AlertUpdateOne) SetRuleID(id int) *AlertUpdateOne {
 	auo.mutation.SetRuleID(id)
@@ -456,6 +799,51 @@ func (auo *AlertUpdateOne) sqlSave(ctx context.Context) (_node *Alert, err error
 	if value, ok := auo.mutation.IsRead(); ok {
 		_spec.SetField(alert.FieldIsRead, field.TypeBool, value)
 	}
+	if value, ok := auo.mutation.Status(); ok {
+		_spec.SetField(alert.FieldStatus, field.TypeString, value)
+	}
+	if value, ok := auo.mutation.Count(); ok {
+		_spec.SetField(alert.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedCount(); ok {
+		_spec.AddField(alert.FieldCount, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.LastValue(); ok {
+		_spec.SetField(alert.FieldLastValue, field.TypeFloat64, value)
+	}
+	if value, ok := auo.mutation.AddedLastValue(); ok {
+		_spec.AddField(alert.FieldLastValue, field.TypeFloat64, value)
+	}
+	if auo.mutation.LastValueCleared() {
+		_spec.ClearField(alert.FieldLastValue, field.TypeFloat64)
+	}
+	if value, ok := auo.mutation.LastTriggeredAt(); ok {
+		_spec.SetField(alert.FieldLastTriggeredAt, field.TypeTime, value)
+	}
+	if auo.mutation.LastTriggeredAtCleared() {
+		_spec.ClearField(alert.FieldLastTriggeredAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(alert.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if auo.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(alert.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(alert.FieldAcknowledgedBy, field.TypeInt64, value)
+	}
+	if value, ok := auo.mutation.AddedAcknowledgedBy(); ok {
+		_spec.AddField(alert.FieldAcknowledgedBy, field.TypeInt64, value)
+	}
+	if auo.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(alert.FieldAcknowledgedBy, field.TypeInt64)
+	}
+	if value, ok := auo.mutation.ResolvedAt(); ok {
+		_spec.SetField(alert.FieldResolvedAt, field.TypeTime, value)
+	}
+	if auo.mutation.ResolvedAtCleared() {
+		_spec.ClearField(alert.FieldResolvedAt, field.TypeTime)
+	}
 	if auo.mutation.RuleCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
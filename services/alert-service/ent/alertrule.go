@@ -23,10 +23,26 @@ type AlertRule struct {
 	UserID int64 `json:"user_id,omitempty"`
 	// SensorID holds the value of the "sensor_id" field.
 	SensorID int64 `json:"sensor_id,omitempty"`
+	// Channel holds the value of the "channel" field.
+	Channel string `json:"channel,omitempty"`
 	// ConditionType holds the value of the "condition_type" field.
 	ConditionType string `json:"condition_type,omitempty"`
 	// Threshold holds the value of the "threshold" field.
 	Threshold float64 `json:"threshold,omitempty"`
+	// ThresholdHigh holds the value of the "threshold_high" field.
+	ThresholdHigh float64 `json:"threshold_high,omitempty"`
+	// ForSeconds holds the value of the "for_seconds" field.
+	ForSeconds int `json:"for_seconds,omitempty"`
+	// ForReadings holds the value of the "for_readings" field.
+	ForReadings int `json:"for_readings,omitempty"`
+	// ClearThreshold holds the value of the "clear_threshold" field.
+	ClearThreshold *float64 `json:"clear_threshold,omitempty"`
+	// Metric holds the value of the "metric" field.
+	Metric string `json:"metric,omitempty"`
+	// WindowSeconds holds the value of the "window_seconds" field.
+	WindowSeconds int `json:"window_seconds,omitempty"`
+	// SkipBadQuality holds the value of the "skip_bad_quality" field.
+	SkipBadQuality bool `json:"skip_bad_quality,omitempty"`
 	// Description holds the value of the "description" field.
 	Description string `json:"description,omitempty"`
 	// IsEnabled holds the value of the "is_enabled" field.
@@ -62,13 +78,13 @@ func (*AlertRule) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case alertrule.FieldIsEnabled:
+		case alertrule.FieldSkipBadQuality, alertrule.FieldIsEnabled:
 			values[i] = new(sql.NullBool)
-		case alertrule.FieldThreshold:
+		case alertrule.FieldThreshold, alertrule.FieldThresholdHigh, alertrule.FieldClearThreshold:
 			values[i] = new(sql.NullFloat64)
-		case alertrule.FieldID, alertrule.FieldUserID, alertrule.FieldSensorID:
+		case alertrule.FieldID, alertrule.FieldUserID, alertrule.FieldSensorID, alertrule.FieldForSeconds, alertrule.FieldForReadings, alertrule.FieldWindowSeconds:
 			values[i] = new(sql.NullInt64)
-		case alertrule.FieldName, alertrule.FieldConditionType, alertrule.FieldDescription:
+		case alertrule.FieldName, alertrule.FieldChannel, alertrule.FieldConditionType, alertrule.FieldMetric, alertrule.FieldDescription:
 			values[i] = new(sql.NullString)
 		case alertrule.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -111,6 +127,12 @@ func (ar *AlertRule) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ar.SensorID = value.Int64
 			}
+		case alertrule.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				ar.Channel = value.String
+			}
 		case alertrule.FieldConditionType:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field condition_type", values[i])
@@ -123,6 +145,49 @@ func (ar *AlertRule) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				ar.Threshold = value.Float64
 			}
+		case alertrule.FieldThresholdHigh:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field threshold_high", values[i])
+			} else if value.Valid {
+				ar.ThresholdHigh = value.Float64
+			}
+		case alertrule.FieldForSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field for_seconds", values[i])
+			} else if value.Valid {
+				ar.ForSeconds = int(value.Int64)
+			}
+		case alertrule.FieldForReadings:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field for_readings", values[i])
+			} else if value.Valid {
+				ar.ForReadings = int(value.Int64)
+			}
+		case alertrule.FieldClearThreshold:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field clear_threshold", values[i])
+			} else if value.Valid {
+				ar.ClearThreshold = new(float64)
+				*ar.ClearThreshold = value.Float64
+			}
+		case alertrule.FieldMetric:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field metric", values[i])
+			} else if value.Valid {
+				ar.Metric = value.String
+			}
+		case alertrule.FieldWindowSeconds:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field window_seconds", values[i])
+			} else if value.Valid {
+				ar.WindowSeconds = int(value.Int64)
+			}
+		case alertrule.FieldSkipBadQuality:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field skip_bad_quality", values[i])
+			} else if value.Valid {
+				ar.SkipBadQuality = value.Bool
+			}
 		case alertrule.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
@@ -191,12 +256,38 @@ func (ar *AlertRule) String() string {
 	builder.WriteString("sensor_id=")
 	builder.WriteString(fmt.Sprintf("%v", ar.SensorID))
 	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(ar.Channel)
+	builder.WriteString(", ")
 	builder.WriteString("condition_type=")
 	builder.WriteString(ar.ConditionType)
 	builder.WriteString(", ")
 	builder.WriteString("threshold=")
 	builder.WriteString(fmt.Sprintf("%v", ar.Threshold))
 	builder.WriteString(", ")
+	builder.WriteString("threshold_high=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ThresholdHigh))
+	builder.WriteString(", ")
+	builder.WriteString("for_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ForSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("for_readings=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ForReadings))
+	builder.WriteString(", ")
+	if v := ar.ClearThreshold; v != nil {
+		builder.WriteString("clear_threshold=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("metric=")
+	builder.WriteString(ar.Metric)
+	builder.WriteString(", ")
+	builder.WriteString("window_seconds=")
+	builder.WriteString(fmt.Sprintf("%v", ar.WindowSeconds))
+	builder.WriteString(", ")
+	builder.WriteString("skip_bad_quality=")
+	builder.WriteString(fmt.Sprintf("%v", ar.SkipBadQuality))
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(ar.Description)
 	builder.WriteString(", ")
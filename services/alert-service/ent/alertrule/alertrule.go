@@ -20,10 +20,26 @@ const (
 	FieldUserID = "user_id"
 	// FieldSensorID holds the string denoting the sensor_id field in the database.
 	FieldSensorID = "sensor_id"
+	// FieldChannel holds the string denoting the channel field in the database.
+	FieldChannel = "channel"
 	// FieldConditionType holds the string denoting the condition_type field in the database.
 	FieldConditionType = "condition_type"
 	// FieldThreshold holds the string denoting the threshold field in the database.
 	FieldThreshold = "threshold"
+	// FieldThresholdHigh holds the string denoting the threshold_high field in the database.
+	FieldThresholdHigh = "threshold_high"
+	// FieldForSeconds holds the string denoting the for_seconds field in the database.
+	FieldForSeconds = "for_seconds"
+	// FieldForReadings holds the string denoting the for_readings field in the database.
+	FieldForReadings = "for_readings"
+	// FieldClearThreshold holds the string denoting the clear_threshold field in the database.
+	FieldClearThreshold = "clear_threshold"
+	// FieldMetric holds the string denoting the metric field in the database.
+	FieldMetric = "metric"
+	// FieldWindowSeconds holds the string denoting the window_seconds field in the database.
+	FieldWindowSeconds = "window_seconds"
+	// FieldSkipBadQuality holds the string denoting the skip_bad_quality field in the database.
+	FieldSkipBadQuality = "skip_bad_quality"
 	// FieldDescription holds the string denoting the description field in the database.
 	FieldDescription = "description"
 	// FieldIsEnabled holds the string denoting the is_enabled field in the database.
@@ -49,8 +65,16 @@ var Columns = []string{
 	FieldName,
 	FieldUserID,
 	FieldSensorID,
+	FieldChannel,
 	FieldConditionType,
 	FieldThreshold,
+	FieldThresholdHigh,
+	FieldForSeconds,
+	FieldForReadings,
+	FieldClearThreshold,
+	FieldMetric,
+	FieldWindowSeconds,
+	FieldSkipBadQuality,
 	FieldDescription,
 	FieldIsEnabled,
 	FieldCreatedAt,
@@ -69,8 +93,22 @@ func ValidColumn(column string) bool {
 var (
 	// NameValidator is a validator for the "name" field. It is called by the builders before save.
 	NameValidator func(string) error
+	// DefaultChannel holds the default value on creation for the "channel" field.
+	DefaultChannel string
 	// DefaultConditionType holds the default value on creation for the "condition_type" field.
 	DefaultConditionType string
+	// DefaultThresholdHigh holds the default value on creation for the "threshold_high" field.
+	DefaultThresholdHigh float64
+	// DefaultForSeconds holds the default value on creation for the "for_seconds" field.
+	DefaultForSeconds int
+	// DefaultForReadings holds the default value on creation for the "for_readings" field.
+	DefaultForReadings int
+	// DefaultMetric holds the default value on creation for the "metric" field.
+	DefaultMetric string
+	// DefaultWindowSeconds holds the default value on creation for the "window_seconds" field.
+	DefaultWindowSeconds int
+	// DefaultSkipBadQuality holds the default value on creation for the "skip_bad_quality" field.
+	DefaultSkipBadQuality bool
 	// DefaultIsEnabled holds the default value on creation for the "is_enabled" field.
 	DefaultIsEnabled bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -100,6 +138,11 @@ func BySensorID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSensorID, opts...).ToFunc()
 }
 
+// ByChannel orders the results by the channel field.
+func ByChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannel, opts...).ToFunc()
+}
+
 // ByConditionType orders the results by the condition_type field.
 func ByConditionType(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldConditionType, opts...).ToFunc()
@@ -110,6 +153,41 @@ func ByThreshold(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldThreshold, opts...).ToFunc()
 }
 
+// ByThresholdHigh orders the results by the threshold_high field.
+func ByThresholdHigh(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThresholdHigh, opts...).ToFunc()
+}
+
+// ByForSeconds orders the results by the for_seconds field.
+func ByForSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForSeconds, opts...).ToFunc()
+}
+
+// ByForReadings orders the results by the for_readings field.
+func ByForReadings(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldForReadings, opts...).ToFunc()
+}
+
+// ByClearThreshold orders the results by the clear_threshold field.
+func ByClearThreshold(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClearThreshold, opts...).ToFunc()
+}
+
+// ByMetric orders the results by the metric field.
+func ByMetric(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMetric, opts...).ToFunc()
+}
+
+// ByWindowSeconds orders the results by the window_seconds field.
+func ByWindowSeconds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWindowSeconds, opts...).ToFunc()
+}
+
+// BySkipBadQuality orders the results by the skip_bad_quality field.
+func BySkipBadQuality(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSkipBadQuality, opts...).ToFunc()
+}
+
 // ByDescription orders the results by the description field.
 func ByDescription(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldDescription, opts...).ToFunc()
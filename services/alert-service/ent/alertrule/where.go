@@ -70,6 +70,11 @@ func SensorID(v int64) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldEQ(FieldSensorID, v))
 }
 
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldChannel, v))
+}
+
 // ConditionType applies equality check predicate on the "condition_type" field. It's identical to ConditionTypeEQ.
 func ConditionType(v string) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldEQ(FieldConditionType, v))
@@ -80,6 +85,41 @@ func Threshold(v float64) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldEQ(FieldThreshold, v))
 }
 
+// ThresholdHigh applies equality check predicate on the "threshold_high" field. It's identical to ThresholdHighEQ.
+func ThresholdHigh(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldThresholdHigh, v))
+}
+
+// ForSeconds applies equality check predicate on the "for_seconds" field. It's identical to ForSecondsEQ.
+func ForSeconds(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldForSeconds, v))
+}
+
+// ForReadings applies equality check predicate on the "for_readings" field. It's identical to ForReadingsEQ.
+func ForReadings(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldForReadings, v))
+}
+
+// ClearThreshold applies equality check predicate on the "clear_threshold" field. It's identical to ClearThresholdEQ.
+func ClearThreshold(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldClearThreshold, v))
+}
+
+// Metric applies equality check predicate on the "metric" field. It's identical to MetricEQ.
+func Metric(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldMetric, v))
+}
+
+// WindowSeconds applies equality check predicate on the "window_seconds" field. It's identical to WindowSecondsEQ.
+func WindowSeconds(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldWindowSeconds, v))
+}
+
+// SkipBadQuality applies equality check predicate on the "skip_bad_quality" field. It's identical to SkipBadQualityEQ.
+func SkipBadQuality(v bool) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldSkipBadQuality, v))
+}
+
 // Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
 func Description(v string) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldEQ(FieldDescription, v))
@@ -240,6 +280,71 @@ func SensorIDLTE(v int64) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldLTE(FieldSensorID, v))
 }
 
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldContainsFold(FieldChannel, v))
+}
+
 // ConditionTypeEQ applies the EQ predicate on the "condition_type" field.
 func ConditionTypeEQ(v string) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldEQ(FieldConditionType, v))
@@ -345,6 +450,291 @@ func ThresholdLTE(v float64) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldLTE(FieldThreshold, v))
 }
 
+// ThresholdHighEQ applies the EQ predicate on the "threshold_high" field.
+func ThresholdHighEQ(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldThresholdHigh, v))
+}
+
+// ThresholdHighNEQ applies the NEQ predicate on the "threshold_high" field.
+func ThresholdHighNEQ(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldThresholdHigh, v))
+}
+
+// ThresholdHighIn applies the In predicate on the "threshold_high" field.
+func ThresholdHighIn(vs ...float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIn(FieldThresholdHigh, vs...))
+}
+
+// ThresholdHighNotIn applies the NotIn predicate on the "threshold_high" field.
+func ThresholdHighNotIn(vs ...float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotIn(FieldThresholdHigh, vs...))
+}
+
+// ThresholdHighGT applies the GT predicate on the "threshold_high" field.
+func ThresholdHighGT(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGT(FieldThresholdHigh, v))
+}
+
+// ThresholdHighGTE applies the GTE predicate on the "threshold_high" field.
+func ThresholdHighGTE(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGTE(FieldThresholdHigh, v))
+}
+
+// ThresholdHighLT applies the LT predicate on the "threshold_high" field.
+func ThresholdHighLT(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLT(FieldThresholdHigh, v))
+}
+
+// ThresholdHighLTE applies the LTE predicate on the "threshold_high" field.
+func ThresholdHighLTE(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLTE(FieldThresholdHigh, v))
+}
+
+// ForSecondsEQ applies the EQ predicate on the "for_seconds" field.
+func ForSecondsEQ(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldForSeconds, v))
+}
+
+// ForSecondsNEQ applies the NEQ predicate on the "for_seconds" field.
+func ForSecondsNEQ(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldForSeconds, v))
+}
+
+// ForSecondsIn applies the In predicate on the "for_seconds" field.
+func ForSecondsIn(vs ...int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIn(FieldForSeconds, vs...))
+}
+
+// ForSecondsNotIn applies the NotIn predicate on the "for_seconds" field.
+func ForSecondsNotIn(vs ...int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotIn(FieldForSeconds, vs...))
+}
+
+// ForSecondsGT applies the GT predicate on the "for_seconds" field.
+func ForSecondsGT(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGT(FieldForSeconds, v))
+}
+
+// ForSecondsGTE applies the GTE predicate on the "for_seconds" field.
+func ForSecondsGTE(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGTE(FieldForSeconds, v))
+}
+
+// ForSecondsLT applies the LT predicate on the "for_seconds" field.
+func ForSecondsLT(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLT(FieldForSeconds, v))
+}
+
+// ForSecondsLTE applies the LTE predicate on the "for_seconds" field.
+func ForSecondsLTE(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLTE(FieldForSeconds, v))
+}
+
+// ForReadingsEQ applies the EQ predicate on the "for_readings" field.
+func ForReadingsEQ(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldForReadings, v))
+}
+
+// ForReadingsNEQ applies the NEQ predicate on the "for_readings" field.
+func ForReadingsNEQ(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldForReadings, v))
+}
+
+// ForReadingsIn applies the In predicate on the "for_readings" field.
+func ForReadingsIn(vs ...int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIn(FieldForReadings, vs...))
+}
+
+// ForReadingsNotIn applies the NotIn predicate on the "for_readings" field.
+func ForReadingsNotIn(vs ...int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotIn(FieldForReadings, vs...))
+}
+
+// ForReadingsGT applies the GT predicate on the "for_readings" field.
+func ForReadingsGT(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGT(FieldForReadings, v))
+}
+
+// ForReadingsGTE applies the GTE predicate on the "for_readings" field.
+func ForReadingsGTE(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGTE(FieldForReadings, v))
+}
+
+// ForReadingsLT applies the LT predicate on the "for_readings" field.
+func ForReadingsLT(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLT(FieldForReadings, v))
+}
+
+// ForReadingsLTE applies the LTE predicate on the "for_readings" field.
+func ForReadingsLTE(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLTE(FieldForReadings, v))
+}
+
+// ClearThresholdEQ applies the EQ predicate on the "clear_threshold" field.
+func ClearThresholdEQ(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldClearThreshold, v))
+}
+
+// ClearThresholdNEQ applies the NEQ predicate on the "clear_threshold" field.
+func ClearThresholdNEQ(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldClearThreshold, v))
+}
+
+// ClearThresholdIn applies the In predicate on the "clear_threshold" field.
+func ClearThresholdIn(vs ...float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIn(FieldClearThreshold, vs...))
+}
+
+// ClearThresholdNotIn applies the NotIn predicate on the "clear_threshold" field.
+func ClearThresholdNotIn(vs ...float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotIn(FieldClearThreshold, vs...))
+}
+
+// ClearThresholdGT applies the GT predicate on the "clear_threshold" field.
+func ClearThresholdGT(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGT(FieldClearThreshold, v))
+}
+
+// ClearThresholdGTE applies the GTE predicate on the "clear_threshold" field.
+func ClearThresholdGTE(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGTE(FieldClearThreshold, v))
+}
+
+// ClearThresholdLT applies the LT predicate on the "clear_threshold" field.
+func ClearThresholdLT(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLT(FieldClearThreshold, v))
+}
+
+// ClearThresholdLTE applies the LTE predicate on the "clear_threshold" field.
+func ClearThresholdLTE(v float64) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLTE(FieldClearThreshold, v))
+}
+
+// ClearThresholdIsNil applies the IsNil predicate on the "clear_threshold" field.
+func ClearThresholdIsNil() predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIsNull(FieldClearThreshold))
+}
+
+// ClearThresholdNotNil applies the NotNil predicate on the "clear_threshold" field.
+func ClearThresholdNotNil() predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotNull(FieldClearThreshold))
+}
+
+// MetricEQ applies the EQ predicate on the "metric" field.
+func MetricEQ(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldMetric, v))
+}
+
+// MetricNEQ applies the NEQ predicate on the "metric" field.
+func MetricNEQ(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldMetric, v))
+}
+
+// MetricIn applies the In predicate on the "metric" field.
+func MetricIn(vs ...string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIn(FieldMetric, vs...))
+}
+
+// MetricNotIn applies the NotIn predicate on the "metric" field.
+func MetricNotIn(vs ...string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotIn(FieldMetric, vs...))
+}
+
+// MetricGT applies the GT predicate on the "metric" field.
+func MetricGT(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGT(FieldMetric, v))
+}
+
+// MetricGTE applies the GTE predicate on the "metric" field.
+func MetricGTE(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGTE(FieldMetric, v))
+}
+
+// MetricLT applies the LT predicate on the "metric" field.
+func MetricLT(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLT(FieldMetric, v))
+}
+
+// MetricLTE applies the LTE predicate on the "metric" field.
+func MetricLTE(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLTE(FieldMetric, v))
+}
+
+// MetricContains applies the Contains predicate on the "metric" field.
+func MetricContains(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldContains(FieldMetric, v))
+}
+
+// MetricHasPrefix applies the HasPrefix predicate on the "metric" field.
+func MetricHasPrefix(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldHasPrefix(FieldMetric, v))
+}
+
+// MetricHasSuffix applies the HasSuffix predicate on the "metric" field.
+func MetricHasSuffix(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldHasSuffix(FieldMetric, v))
+}
+
+// MetricEqualFold applies the EqualFold predicate on the "metric" field.
+func MetricEqualFold(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEqualFold(FieldMetric, v))
+}
+
+// MetricContainsFold applies the ContainsFold predicate on the "metric" field.
+func MetricContainsFold(v string) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldContainsFold(FieldMetric, v))
+}
+
+// WindowSecondsEQ applies the EQ predicate on the "window_seconds" field.
+func WindowSecondsEQ(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldWindowSeconds, v))
+}
+
+// WindowSecondsNEQ applies the NEQ predicate on the "window_seconds" field.
+func WindowSecondsNEQ(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldWindowSeconds, v))
+}
+
+// WindowSecondsIn applies the In predicate on the "window_seconds" field.
+func WindowSecondsIn(vs ...int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldIn(FieldWindowSeconds, vs...))
+}
+
+// WindowSecondsNotIn applies the NotIn predicate on the "window_seconds" field.
+func WindowSecondsNotIn(vs ...int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNotIn(FieldWindowSeconds, vs...))
+}
+
+// WindowSecondsGT applies the GT predicate on the "window_seconds" field.
+func WindowSecondsGT(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGT(FieldWindowSeconds, v))
+}
+
+// WindowSecondsGTE applies the GTE predicate on the "window_seconds" field.
+func WindowSecondsGTE(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldGTE(FieldWindowSeconds, v))
+}
+
+// WindowSecondsLT applies the LT predicate on the "window_seconds" field.
+func WindowSecondsLT(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLT(FieldWindowSeconds, v))
+}
+
+// WindowSecondsLTE applies the LTE predicate on the "window_seconds" field.
+func WindowSecondsLTE(v int) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldLTE(FieldWindowSeconds, v))
+}
+
+// SkipBadQualityEQ applies the EQ predicate on the "skip_bad_quality" field.
+func SkipBadQualityEQ(v bool) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldEQ(FieldSkipBadQuality, v))
+}
+
+// SkipBadQualityNEQ applies the NEQ predicate on the "skip_bad_quality" field.
+func SkipBadQualityNEQ(v bool) predicate.AlertRule {
+	return predicate.AlertRule(sql.FieldNEQ(FieldSkipBadQuality, v))
+}
+
 // DescriptionEQ applies the EQ predicate on the "description" field.
 func DescriptionEQ(v string) predicate.AlertRule {
 	return predicate.AlertRule(sql.FieldEQ(FieldDescription, v))
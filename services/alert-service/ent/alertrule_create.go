@@ -39,6 +39,20 @@ func (arc *AlertRuleCreate) SetSensorID(i int64) *AlertRuleCreate {
 	return arc
 }
 
+// SetChannel sets the "channel" field.
+func (arc *AlertRuleCreate) SetChannel(s string) *AlertRuleCreate {
+	arc.mutation.SetChannel(s)
+	return arc
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableChannel(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetChannel(*s)
+	}
+	return arc
+}
+
 // SetConditionType sets the "condition_type" field.
 func (arc *AlertRuleCreate) SetConditionType(s string) *AlertRuleCreate {
 	arc.mutation.SetConditionType(s)
@@ -59,6 +73,104 @@ func (arc *AlertRuleCreate) SetThreshold(f float64) *AlertRuleCreate {
 	return arc
 }
 
+// SetThresholdHigh sets the "threshold_high" field.
+func (arc *AlertRuleCreate) SetThresholdHigh(f float64) *AlertRuleCreate {
+	arc.mutation.SetThresholdHigh(f)
+	return arc
+}
+
+// SetNillableThresholdHigh sets the "threshold_high" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableThresholdHigh(f *float64) *AlertRuleCreate {
+	if f != nil {
+		arc.SetThresholdHigh(*f)
+	}
+	return arc
+}
+
+// SetForSeconds sets the "for_seconds" field.
+func (arc *AlertRuleCreate) SetForSeconds(i int) *AlertRuleCreate {
+	arc.mutation.SetForSeconds(i)
+	return arc
+}
+
+// SetNillableForSeconds sets the "for_seconds" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableForSeconds(i *int) *AlertRuleCreate {
+	if i != nil {
+		arc.SetForSeconds(*i)
+	}
+	return arc
+}
+
+// SetForReadings sets the "for_readings" field.
+func (arc *AlertRuleCreate) SetForReadings(i int) *AlertRuleCreate {
+	arc.mutation.SetForReadings(i)
+	return arc
+}
+
+// SetNillableForReadings sets the "for_readings" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableForReadings(i *int) *AlertRuleCreate {
+	if i != nil {
+		arc.SetForReadings(*i)
+	}
+	return arc
+}
+
+// SetClearThreshold sets the "clear_threshold" field.
+func (arc *AlertRuleCreate) SetClearThreshold(f float64) *AlertRuleCreate {
+	arc.mutation.SetClearThreshold(f)
+	return arc
+}
+
+// SetNillableClearThreshold sets the "clear_threshold" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableClearThreshold(f *float64) *AlertRuleCreate {
+	if f != nil {
+		arc.SetClearThreshold(*f)
+	}
+	return arc
+}
+
+// SetMetric sets the "metric" field.
+func (arc *AlertRuleCreate) SetMetric(s string) *AlertRuleCreate {
+	arc.mutation.SetMetric(s)
+	return arc
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableMetric(s *string) *AlertRuleCreate {
+	if s != nil {
+		arc.SetMetric(*s)
+	}
+	return arc
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (arc *AlertRuleCreate) SetWindowSeconds(i int) *AlertRuleCreate {
+	arc.mutation.SetWindowSeconds(i)
+	return arc
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableWindowSeconds(i *int) *AlertRuleCreate {
+	if i != nil {
+		arc.SetWindowSeconds(*i)
+	}
+	return arc
+}
+
+// SetSkipBadQuality sets the "skip_bad_quality" field.
+func (arc *AlertRuleCreate) SetSkipBadQuality(b bool) *AlertRuleCreate {
+	arc.mutation.SetSkipBadQuality(b)
+	return arc
+}
+
+// SetNillableSkipBadQuality sets the "skip_bad_quality" field if the given value is not nil.
+func (arc *AlertRuleCreate) SetNillableSkipBadQuality(b *bool) *AlertRuleCreate {
+	if b != nil {
+		arc.SetSkipBadQuality(*b)
+	}
+	return arc
+}
+
 // SetDescription sets the "description" field.
 func (arc *AlertRuleCreate) SetDescription(s string) *AlertRuleCreate {
 	arc.mutation.SetDescription(s)
@@ -151,10 +263,38 @@ func (arc *AlertRuleCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (arc *AlertRuleCreate) defaults() {
+	if _, ok := arc.mutation.Channel(); !ok {
+		v := alertrule.DefaultChannel
+		arc.mutation.SetChannel(v)
+	}
 	if _, ok := arc.mutation.ConditionType(); !ok {
 		v := alertrule.DefaultConditionType
 		arc.mutation.SetConditionType(v)
 	}
+	if _, ok := arc.mutation.ThresholdHigh(); !ok {
+		v := alertrule.DefaultThresholdHigh
+		arc.mutation.SetThresholdHigh(v)
+	}
+	if _, ok := arc.mutation.ForSeconds(); !ok {
+		v := alertrule.DefaultForSeconds
+		arc.mutation.SetForSeconds(v)
+	}
+	if _, ok := arc.mutation.ForReadings(); !ok {
+		v := alertrule.DefaultForReadings
+		arc.mutation.SetForReadings(v)
+	}
+	if _, ok := arc.mutation.Metric(); !ok {
+		v := alertrule.DefaultMetric
+		arc.mutation.SetMetric(v)
+	}
+	if _, ok := arc.mutation.WindowSeconds(); !ok {
+		v := alertrule.DefaultWindowSeconds
+		arc.mutation.SetWindowSeconds(v)
+	}
+	if _, ok := arc.mutation.SkipBadQuality(); !ok {
+		v := alertrule.DefaultSkipBadQuality
+		arc.mutation.SetSkipBadQuality(v)
+	}
 	if _, ok := arc.mutation.IsEnabled(); !ok {
 		v := alertrule.DefaultIsEnabled
 		arc.mutation.SetIsEnabled(v)
@@ -181,12 +321,33 @@ func (arc *AlertRuleCreate) check() error {
 	if _, ok := arc.mutation.SensorID(); !ok {
 		return &ValidationError{Name: "sensor_id", err: errors.New(`ent: missing required field "AlertRule.sensor_id"`)}
 	}
+	if _, ok := arc.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "AlertRule.channel"`)}
+	}
 	if _, ok := arc.mutation.ConditionType(); !ok {
 		return &ValidationError{Name: "condition_type", err: errors.New(`ent: missing required field "AlertRule.condition_type"`)}
 	}
 	if _, ok := arc.mutation.Threshold(); !ok {
 		return &ValidationError{Name: "threshold", err: errors.New(`ent: missing required field "AlertRule.threshold"`)}
 	}
+	if _, ok := arc.mutation.ThresholdHigh(); !ok {
+		return &ValidationError{Name: "threshold_high", err: errors.New(`ent: missing required field "AlertRule.threshold_high"`)}
+	}
+	if _, ok := arc.mutation.ForSeconds(); !ok {
+		return &ValidationError{Name: "for_seconds", err: errors.New(`ent: missing required field "AlertRule.for_seconds"`)}
+	}
+	if _, ok := arc.mutation.ForReadings(); !ok {
+		return &ValidationError{Name: "for_readings", err: errors.New(`ent: missing required field "AlertRule.for_readings"`)}
+	}
+	if _, ok := arc.mutation.Metric(); !ok {
+		return &ValidationError{Name: "metric", err: errors.New(`ent: missing required field "AlertRule.metric"`)}
+	}
+	if _, ok := arc.mutation.WindowSeconds(); !ok {
+		return &ValidationError{Name: "window_seconds", err: errors.New(`ent: missing required field "AlertRule.window_seconds"`)}
+	}
+	if _, ok := arc.mutation.SkipBadQuality(); !ok {
+		return &ValidationError{Name: "skip_bad_quality", err: errors.New(`ent: missing required field "AlertRule.skip_bad_quality"`)}
+	}
 	if _, ok := arc.mutation.IsEnabled(); !ok {
 		return &ValidationError{Name: "is_enabled", err: errors.New(`ent: missing required field "AlertRule.is_enabled"`)}
 	}
@@ -231,6 +392,10 @@ func (arc *AlertRuleCreate) createSpec() (*AlertRule, *sqlgraph.CreateSpec) {
 		_spec.SetField(alertrule.FieldSensorID, field.TypeInt64, value)
 		_node.SensorID = value
 	}
+	if value, ok := arc.mutation.Channel(); ok {
+		_spec.SetField(alertrule.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
 	if value, ok := arc.mutation.ConditionType(); ok {
 		_spec.SetField(alertrule.FieldConditionType, field.TypeString, value)
 		_node.ConditionType = value
@@ -239,6 +404,34 @@ func (arc *AlertRuleCreate) createSpec() (*AlertRule, *sqlgraph.CreateSpec) {
 		_spec.SetField(alertrule.FieldThreshold, field.TypeFloat64, value)
 		_node.Threshold = value
 	}
+	if value, ok := arc.mutation.ThresholdHigh(); ok {
+		_spec.SetField(alertrule.FieldThresholdHigh, field.TypeFloat64, value)
+		_node.ThresholdHigh = value
+	}
+	if value, ok := arc.mutation.ForSeconds(); ok {
+		_spec.SetField(alertrule.FieldForSeconds, field.TypeInt, value)
+		_node.ForSeconds = value
+	}
+	if value, ok := arc.mutation.ForReadings(); ok {
+		_spec.SetField(alertrule.FieldForReadings, field.TypeInt, value)
+		_node.ForReadings = value
+	}
+	if value, ok := arc.mutation.ClearThreshold(); ok {
+		_spec.SetField(alertrule.FieldClearThreshold, field.TypeFloat64, value)
+		_node.ClearThreshold = &value
+	}
+	if value, ok := arc.mutation.Metric(); ok {
+		_spec.SetField(alertrule.FieldMetric, field.TypeString, value)
+		_node.Metric = value
+	}
+	if value, ok := arc.mutation.WindowSeconds(); ok {
+		_spec.SetField(alertrule.FieldWindowSeconds, field.TypeInt, value)
+		_node.WindowSeconds = value
+	}
+	if value, ok := arc.mutation.SkipBadQuality(); ok {
+		_spec.SetField(alertrule.FieldSkipBadQuality, field.TypeBool, value)
+		_node.SkipBadQuality = value
+	}
 	if value, ok := arc.mutation.Description(); ok {
 		_spec.SetField(alertrule.FieldDescription, field.TypeString, value)
 		_node.Description = value
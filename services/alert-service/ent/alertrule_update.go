@@ -85,6 +85,20 @@ func (aru *AlertRuleUpdate) AddSensorID(i int64) *AlertRuleUpdate {
 	return aru
 }
 
+// SetChannel sets the "channel" field.
+func (aru *AlertRuleUpdate) SetChannel(s string) *AlertRuleUpdate {
+	aru.mutation.SetChannel(s)
+	return aru
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableChannel(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetChannel(*s)
+	}
+	return aru
+}
+
 // SetConditionType sets the "condition_type" field.
 func (aru *AlertRuleUpdate) SetConditionType(s string) *AlertRuleUpdate {
 	aru.mutation.SetConditionType(s)
@@ -120,6 +134,145 @@ func (aru *AlertRuleUpdate) AddThreshold(f float64) *AlertRuleUpdate {
 	return aru
 }
 
+// SetThresholdHigh sets the "threshold_high" field.
+func (aru *AlertRuleUpdate) SetThresholdHigh(f float64) *AlertRuleUpdate {
+	aru.mutation.ResetThresholdHigh()
+	aru.mutation.SetThresholdHigh(f)
+	return aru
+}
+
+// SetNillableThresholdHigh sets the "threshold_high" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableThresholdHigh(f *float64) *AlertRuleUpdate {
+	if f != nil {
+		aru.SetThresholdHigh(*f)
+	}
+	return aru
+}
+
+// AddThresholdHigh adds f to the "threshold_high" field.
+func (aru *AlertRuleUpdate) AddThresholdHigh(f float64) *AlertRuleUpdate {
+	aru.mutation.AddThresholdHigh(f)
+	return aru
+}
+
+// SetForSeconds sets the "for_seconds" field.
+func (aru *AlertRuleUpdate) SetForSeconds(i int) *AlertRuleUpdate {
+	aru.mutation.ResetForSeconds()
+	aru.mutation.SetForSeconds(i)
+	return aru
+}
+
+// SetNillableForSeconds sets the "for_seconds" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableForSeconds(i *int) *AlertRuleUpdate {
+	if i != nil {
+		aru.SetForSeconds(*i)
+	}
+	return aru
+}
+
+// AddForSeconds adds i to the "for_seconds" field.
+func (aru *AlertRuleUpdate) AddForSeconds(i int) *AlertRuleUpdate {
+	aru.mutation.AddForSeconds(i)
+	return aru
+}
+
+// SetForReadings sets the "for_readings" field.
+func (aru *AlertRuleUpdate) SetForReadings(i int) *AlertRuleUpdate {
+	aru.mutation.ResetForReadings()
+	aru.mutation.SetForReadings(i)
+	return aru
+}
+
+// SetNillableForReadings sets the "for_readings" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableForReadings(i *int) *AlertRuleUpdate {
+	if i != nil {
+		aru.SetForReadings(*i)
+	}
+	return aru
+}
+
+// AddForReadings adds i to the "for_readings" field.
+func (aru *AlertRuleUpdate) AddForReadings(i int) *AlertRuleUpdate {
+	aru.mutation.AddForReadings(i)
+	return aru
+}
+
+// SetClearThreshold sets the "clear_threshold" field.
+func (aru *AlertRuleUpdate) SetClearThreshold(f float64) *AlertRuleUpdate {
+	aru.mutation.ResetClearThreshold()
+	aru.mutation.SetClearThreshold(f)
+	return aru
+}
+
+// SetNillableClearThreshold sets the "clear_threshold" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableClearThreshold(f *float64) *AlertRuleUpdate {
+	if f != nil {
+		aru.SetClearThreshold(*f)
+	}
+	return aru
+}
+
+// AddClearThreshold adds f to the "clear_threshold" field.
+func (aru *AlertRuleUpdate) AddClearThreshold(f float64) *AlertRuleUpdate {
+	aru.mutation.AddClearThreshold(f)
+	return aru
+}
+
+// ClearClearThreshold clears the value of the "clear_threshold" field.
+func (aru *AlertRuleUpdate) ClearClearThreshold() *AlertRuleUpdate {
+	aru.mutation.ClearClearThreshold()
+	return aru
+}
+
+// SetMetric sets the "metric" field.
+func (aru *AlertRuleUpdate) SetMetric(s string) *AlertRuleUpdate {
+	aru.mutation.SetMetric(s)
+	return aru
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableMetric(s *string) *AlertRuleUpdate {
+	if s != nil {
+		aru.SetMetric(*s)
+	}
+	return aru
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (aru *AlertRuleUpdate) SetWindowSeconds(i int) *AlertRuleUpdate {
+	aru.mutation.ResetWindowSeconds()
+	aru.mutation.SetWindowSeconds(i)
+	return aru
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableWindowSeconds(i *int) *AlertRuleUpdate {
+	if i != nil {
+		aru.SetWindowSeconds(*i)
+	}
+	return aru
+}
+
+// AddWindowSeconds adds i to the "window_seconds" field.
+func (aru *AlertRuleUpdate) AddWindowSeconds(i int) *AlertRuleUpdate {
+	aru.mutation.AddWindowSeconds(i)
+	return aru
+}
+
+// SetSkipBadQuality sets the "skip_bad_quality" field.
+func (aru *AlertRuleUpdate) SetSkipBadQuality(b bool) *AlertRuleUpdate {
+	aru.mutation.SetSkipBadQuality(b)
+	return aru
+}
+
+// SetNillableSkipBadQuality sets the "skip_bad_quality" field if the given value is not nil.
+func (aru *AlertRuleUpdate) SetNillableSkipBadQuality(b *bool) *AlertRuleUpdate {
+	if b != nil {
+		aru.SetSkipBadQuality(*b)
+	}
+	return aru
+}
+
 // SetDescription sets the "description" field.
 func (aru *AlertRuleUpdate) SetDescription(s string) *AlertRuleUpdate {
 	aru.mutation.SetDescription(s)
@@ -273,6 +426,9 @@ func (aru *AlertRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := aru.mutation.AddedSensorID(); ok {
 		_spec.AddField(alertrule.FieldSensorID, field.TypeInt64, value)
 	}
+	if value, ok := aru.mutation.Channel(); ok {
+		_spec.SetField(alertrule.FieldChannel, field.TypeString, value)
+	}
 	if value, ok := aru.mutation.ConditionType(); ok {
 		_spec.SetField(alertrule.FieldConditionType, field.TypeString, value)
 	}
@@ -282,6 +438,45 @@ func (aru *AlertRuleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := aru.mutation.AddedThreshold(); ok {
 		_spec.AddField(alertrule.FieldThreshold, field.TypeFloat64, value)
 	}
+	if value, ok := aru.mutation.ThresholdHigh(); ok {
+		_spec.SetField(alertrule.FieldThresholdHigh, field.TypeFloat64, value)
+	}
+	if value, ok := aru.mutation.AddedThresholdHigh(); ok {
+		_spec.AddField(alertrule.FieldThresholdHigh, field.TypeFloat64, value)
+	}
+	if value, ok := aru.mutation.ForSeconds(); ok {
+		_spec.SetField(alertrule.FieldForSeconds, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.AddedForSeconds(); ok {
+		_spec.AddField(alertrule.FieldForSeconds, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.ForReadings(); ok {
+		_spec.SetField(alertrule.FieldForReadings, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.AddedForReadings(); ok {
+		_spec.AddField(alertrule.FieldForReadings, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.ClearThreshold(); ok {
+		_spec.SetField(alertrule.FieldClearThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := aru.mutation.AddedClearThreshold(); ok {
+		_spec.AddField(alertrule.FieldClearThreshold, field.TypeFloat64, value)
+	}
+	if aru.mutation.ClearThresholdCleared() {
+		_spec.ClearField(alertrule.FieldClearThreshold, field.TypeFloat64)
+	}
+	if value, ok := aru.mutation.Metric(); ok {
+		_spec.SetField(alertrule.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := aru.mutation.WindowSeconds(); ok {
+		_spec.SetField(alertrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.AddedWindowSeconds(); ok {
+		_spec.AddField(alertrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := aru.mutation.SkipBadQuality(); ok {
+		_spec.SetField(alertrule.FieldSkipBadQuality, field.TypeBool, value)
+	}
 	if value, ok := aru.mutation.Description(); ok {
 		_spec.SetField(alertrule.FieldDescription, field.TypeString, value)
 	}
@@ -415,6 +610,20 @@ func (aruo *AlertRuleUpdateOne) AddSensorID(i int64) *AlertRuleUpdateOne {
 	return aruo
 }
 
+// SetChannel sets the "channel" field.
+func (aruo *AlertRuleUpdateOne) SetChannel(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetChannel(s)
+	return aruo
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableChannel(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetChannel(*s)
+	}
+	return aruo
+}
+
 // SetConditionType sets the "condition_type" field.
 func (aruo *AlertRuleUpdateOne) SetConditionType(s string) *AlertRuleUpdateOne {
 	aruo.mutation.SetConditionType(s)
@@ -450,6 +659,145 @@ func (aruo *AlertRuleUpdateOne) AddThreshold(f float64) *AlertRuleUpdateOne {
 	return aruo
 }
 
+// SetThresholdHigh sets the "threshold_high" field.
+func (aruo *AlertRuleUpdateOne) SetThresholdHigh(f float64) *AlertRuleUpdateOne {
+	aruo.mutation.ResetThresholdHigh()
+	aruo.mutation.SetThresholdHigh(f)
+	return aruo
+}
+
+// SetNillableThresholdHigh sets the "threshold_high" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableThresholdHigh(f *float64) *AlertRuleUpdateOne {
+	if f != nil {
+		aruo.SetThresholdHigh(*f)
+	}
+	return aruo
+}
+
+// AddThresholdHigh adds f to the "threshold_high" field.
+func (aruo *AlertRuleUpdateOne) AddThresholdHigh(f float64) *AlertRuleUpdateOne {
+	aruo.mutation.AddThresholdHigh(f)
+	return aruo
+}
+
+// SetForSeconds sets the "for_seconds" field.
+func (aruo *AlertRuleUpdateOne) SetForSeconds(i int) *AlertRuleUpdateOne {
+	aruo.mutation.ResetForSeconds()
+	aruo.mutation.SetForSeconds(i)
+	return aruo
+}
+
+// SetNillableForSeconds sets the "for_seconds" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableForSeconds(i *int) *AlertRuleUpdateOne {
+	if i != nil {
+		aruo.SetForSeconds(*i)
+	}
+	return aruo
+}
+
+// AddForSeconds adds i to the "for_seconds" field.
+func (aruo *AlertRuleUpdateOne) AddForSeconds(i int) *AlertRuleUpdateOne {
+	aruo.mutation.AddForSeconds(i)
+	return aruo
+}
+
+// SetForReadings sets the "for_readings" field.
+func (aruo *AlertRuleUpdateOne) SetForReadings(i int) *AlertRuleUpdateOne {
+	aruo.mutation.ResetForReadings()
+	aruo.mutation.SetForReadings(i)
+	return aruo
+}
+
+// SetNillableForReadings sets the "for_readings" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableForReadings(i *int) *AlertRuleUpdateOne {
+	if i != nil {
+		aruo.SetForReadings(*i)
+	}
+	return aruo
+}
+
+// AddForReadings adds i to the "for_readings" field.
+func (aruo *AlertRuleUpdateOne) AddForReadings(i int) *AlertRuleUpdateOne {
+	aruo.mutation.AddForReadings(i)
+	return aruo
+}
+
+// SetClearThreshold sets the "clear_threshold" field.
+func (aruo *AlertRuleUpdateOne) SetClearThreshold(f float64) *AlertRuleUpdateOne {
+	aruo.mutation.ResetClearThreshold()
+	aruo.mutation.SetClearThreshold(f)
+	return aruo
+}
+
+// SetNillableClearThreshold sets the "clear_threshold" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableClearThreshold(f *float64) *AlertRuleUpdateOne {
+	if f != nil {
+		aruo.SetClearThreshold(*f)
+	}
+	return aruo
+}
+
+// AddClearThreshold adds f to the "clear_threshold" field.
+func (aruo *AlertRuleUpdateOne) AddClearThreshold(f float64) *AlertRuleUpdateOne {
+	aruo.mutation.AddClearThreshold(f)
+	return aruo
+}
+
+// ClearClearThreshold clears the value of the "clear_threshold" field.
+func (aruo *AlertRuleUpdateOne) ClearClearThreshold() *AlertRuleUpdateOne {
+	aruo.mutation.ClearClearThreshold()
+	return aruo
+}
+
+// SetMetric sets the "metric" field.
+func (aruo *AlertRuleUpdateOne) SetMetric(s string) *AlertRuleUpdateOne {
+	aruo.mutation.SetMetric(s)
+	return aruo
+}
+
+// SetNillableMetric sets the "metric" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableMetric(s *string) *AlertRuleUpdateOne {
+	if s != nil {
+		aruo.SetMetric(*s)
+	}
+	return aruo
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (aruo *AlertRuleUpdateOne) SetWindowSeconds(i int) *AlertRuleUpdateOne {
+	aruo.mutation.ResetWindowSeconds()
+	aruo.mutation.SetWindowSeconds(i)
+	return aruo
+}
+
+// SetNillableWindowSeconds sets the "window_seconds" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableWindowSeconds(i *int) *AlertRuleUpdateOne {
+	if i != nil {
+		aruo.SetWindowSeconds(*i)
+	}
+	return aruo
+}
+
+// AddWindowSeconds adds i to the "window_seconds" field.
+func (aruo *AlertRuleUpdateOne) AddWindowSeconds(i int) *AlertRuleUpdateOne {
+	aruo.mutation.AddWindowSeconds(i)
+	return aruo
+}
+
+// SetSkipBadQuality sets the "skip_bad_quality" field.
+func (aruo *AlertRuleUpdateOne) SetSkipBadQuality(b bool) *AlertRuleUpdateOne {
+	aruo.mutation.SetSkipBadQuality(b)
+	return aruo
+}
+
+// SetNillableSkipBadQuality sets the "skip_bad_quality" field if the given value is not nil.
+func (aruo *AlertRuleUpdateOne) SetNillableSkipBadQuality(b *bool) *AlertRuleUpdateOne {
+	if b != nil {
+		aruo.SetSkipBadQuality(*b)
+	}
+	return aruo
+}
+
 // SetDescription sets the "description" field.
 func (aruo *AlertRuleUpdateOne) SetDescription(s string) *AlertRuleUpdateOne {
 	aruo.mutation.SetDescription(s)
@@ -633,6 +981,9 @@ func (aruo *AlertRuleUpdateOne) sqlSave(ctx context.Context) (_node *AlertRule,
 	if value, ok := aruo.mutation.AddedSensorID(); ok {
 		_spec.AddField(alertrule.FieldSensorID, field.TypeInt64, value)
 	}
+	if value, ok := aruo.mutation.Channel(); ok {
+		_spec.SetField(alertrule.FieldChannel, field.TypeString, value)
+	}
 	if value, ok := aruo.mutation.ConditionType(); ok {
 		_spec.SetField(alertrule.FieldConditionType, field.TypeString, value)
 	}
@@ -642,6 +993,45 @@ func (aruo *AlertRuleUpdateOne) sqlSave(ctx context.Context) (_node *AlertRule,
 	if value, ok := aruo.mutation.AddedThreshold(); ok {
 		_spec.AddField(alertrule.FieldThreshold, field.TypeFloat64, value)
 	}
+	if value, ok := aruo.mutation.ThresholdHigh(); ok {
+		_spec.SetField(alertrule.FieldThresholdHigh, field.TypeFloat64, value)
+	}
+	if value, ok := aruo.mutation.AddedThresholdHigh(); ok {
+		_spec.AddField(alertrule.FieldThresholdHigh, field.TypeFloat64, value)
+	}
+	if value, ok := aruo.mutation.ForSeconds(); ok {
+		_spec.SetField(alertrule.FieldForSeconds, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.AddedForSeconds(); ok {
+		_spec.AddField(alertrule.FieldForSeconds, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.ForReadings(); ok {
+		_spec.SetField(alertrule.FieldForReadings, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.AddedForReadings(); ok {
+		_spec.AddField(alertrule.FieldForReadings, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.ClearThreshold(); ok {
+		_spec.SetField(alertrule.FieldClearThreshold, field.TypeFloat64, value)
+	}
+	if value, ok := aruo.mutation.AddedClearThreshold(); ok {
+		_spec.AddField(alertrule.FieldClearThreshold, field.TypeFloat64, value)
+	}
+	if aruo.mutation.ClearThresholdCleared() {
+		_spec.ClearField(alertrule.FieldClearThreshold, field.TypeFloat64)
+	}
+	if value, ok := aruo.mutation.Metric(); ok {
+		_spec.SetField(alertrule.FieldMetric, field.TypeString, value)
+	}
+	if value, ok := aruo.mutation.WindowSeconds(); ok {
+		_spec.SetField(alertrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.AddedWindowSeconds(); ok {
+		_spec.AddField(alertrule.FieldWindowSeconds, field.TypeInt, value)
+	}
+	if value, ok := aruo.mutation.SkipBadQuality(); ok {
+		_spec.SetField(alertrule.FieldSkipBadQuality, field.TypeBool, value)
+	}
 	if value, ok := aruo.mutation.Description(); ok {
 		_spec.SetField(alertrule.FieldDescription, field.TypeString, value)
 	}
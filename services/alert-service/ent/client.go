@@ -17,6 +17,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alert"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alertrule"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/outboxevent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
 )
 
 // Client is the client that holds all ent builders.
@@ -28,6 +30,10 @@ type Client struct {
 	Alert *AlertClient
 	// AlertRule is the client for interacting with the AlertRule builders.
 	AlertRule *AlertRuleClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// RuleState is the client for interacting with the RuleState builders.
+	RuleState *RuleStateClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -41,6 +47,8 @@ func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.Alert = NewAlertClient(c.config)
 	c.AlertRule = NewAlertRuleClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
+	c.RuleState = NewRuleStateClient(c.config)
 }
 
 type (
@@ -131,10 +139,12 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Alert:     NewAlertClient(cfg),
-		AlertRule: NewAlertRuleClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Alert:       NewAlertClient(cfg),
+		AlertRule:   NewAlertRuleClient(cfg),
+		OutboxEvent: NewOutboxEventClient(cfg),
+		RuleState:   NewRuleStateClient(cfg),
 	}, nil
 }
 
@@ -152,10 +162,12 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Alert:     NewAlertClient(cfg),
-		AlertRule: NewAlertRuleClient(cfg),
+		ctx:         ctx,
+		config:      cfg,
+		Alert:       NewAlertClient(cfg),
+		AlertRule:   NewAlertRuleClient(cfg),
+		OutboxEvent: NewOutboxEventClient(cfg),
+		RuleState:   NewRuleStateClient(cfg),
 	}, nil
 }
 
@@ -186,6 +198,8 @@ func (c *Client) Close() error {
 func (c *Client) Use(hooks ...Hook) {
 	c.Alert.Use(hooks...)
 	c.AlertRule.Use(hooks...)
+	c.OutboxEvent.Use(hooks...)
+	c.RuleState.Use(hooks...)
 }
 
 // Intercept adds the query interceptors to all the entity clients.
@@ -193,6 +207,8 @@ func (c *Client) Use(hooks ...Hook) {
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	c.Alert.Intercept(interceptors...)
 	c.AlertRule.Intercept(interceptors...)
+	c.OutboxEvent.Intercept(interceptors...)
+	c.RuleState.Intercept(interceptors...)
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -202,6 +218,10 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Alert.mutate(ctx, m)
 	case *AlertRuleMutation:
 		return c.AlertRule.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
+	case *RuleStateMutation:
+		return c.RuleState.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -505,12 +525,278 @@ func (c *AlertRuleClient) mutate(ctx context.Context, m *AlertRuleMutation) (Val
 	}
 }
 
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(oe *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(oe))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id int) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(oe *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(oe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id int) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id int) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id int) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
+// RuleStateClient is a client for the RuleState schema.
+type RuleStateClient struct {
+	config
+}
+
+// NewRuleStateClient returns a client for the RuleState from the given config.
+func NewRuleStateClient(c config) *RuleStateClient {
+	return &RuleStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `rulestate.Hooks(f(g(h())))`.
+func (c *RuleStateClient) Use(hooks ...Hook) {
+	c.hooks.RuleState = append(c.hooks.RuleState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `rulestate.Intercept(f(g(h())))`.
+func (c *RuleStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RuleState = append(c.inters.RuleState, interceptors...)
+}
+
+// Create returns a builder for creating a RuleState entity.
+func (c *RuleStateClient) Create() *RuleStateCreate {
+	mutation := newRuleStateMutation(c.config, OpCreate)
+	return &RuleStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RuleState entities.
+func (c *RuleStateClient) CreateBulk(builders ...*RuleStateCreate) *RuleStateCreateBulk {
+	return &RuleStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RuleStateClient) MapCreateBulk(slice any, setFunc func(*RuleStateCreate, int)) *RuleStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RuleStateCreateBulk{err: fmt.Errorf("calling to RuleStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RuleStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RuleStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RuleState.
+func (c *RuleStateClient) Update() *RuleStateUpdate {
+	mutation := newRuleStateMutation(c.config, OpUpdate)
+	return &RuleStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RuleStateClient) UpdateOne(rs *RuleState) *RuleStateUpdateOne {
+	mutation := newRuleStateMutation(c.config, OpUpdateOne, withRuleState(rs))
+	return &RuleStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RuleStateClient) UpdateOneID(id int) *RuleStateUpdateOne {
+	mutation := newRuleStateMutation(c.config, OpUpdateOne, withRuleStateID(id))
+	return &RuleStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RuleState.
+func (c *RuleStateClient) Delete() *RuleStateDelete {
+	mutation := newRuleStateMutation(c.config, OpDelete)
+	return &RuleStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RuleStateClient) DeleteOne(rs *RuleState) *RuleStateDeleteOne {
+	return c.DeleteOneID(rs.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RuleStateClient) DeleteOneID(id int) *RuleStateDeleteOne {
+	builder := c.Delete().Where(rulestate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RuleStateDeleteOne{builder}
+}
+
+// Query returns a query builder for RuleState.
+func (c *RuleStateClient) Query() *RuleStateQuery {
+	return &RuleStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRuleState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RuleState entity by its id.
+func (c *RuleStateClient) Get(ctx context.Context, id int) (*RuleState, error) {
+	return c.Query().Where(rulestate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RuleStateClient) GetX(ctx context.Context, id int) *RuleState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RuleStateClient) Hooks() []Hook {
+	return c.hooks.RuleState
+}
+
+// Interceptors returns the client interceptors.
+func (c *RuleStateClient) Interceptors() []Interceptor {
+	return c.inters.RuleState
+}
+
+func (c *RuleStateClient) mutate(ctx context.Context, m *RuleStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RuleStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RuleStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RuleStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RuleStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RuleState mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Alert, AlertRule []ent.Hook
+		Alert, AlertRule, OutboxEvent, RuleState []ent.Hook
 	}
 	inters struct {
-		Alert, AlertRule []ent.Interceptor
+		Alert, AlertRule, OutboxEvent, RuleState []ent.Interceptor
 	}
 )
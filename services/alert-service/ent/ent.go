@@ -14,6 +14,8 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alert"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alertrule"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/outboxevent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -74,8 +76,10 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			alert.Table:     alert.ValidColumn,
-			alertrule.Table: alertrule.ValidColumn,
+			alert.Table:       alert.ValidColumn,
+			alertrule.Table:   alertrule.ValidColumn,
+			outboxevent.Table: outboxevent.ValidColumn,
+			rulestate.Table:   rulestate.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
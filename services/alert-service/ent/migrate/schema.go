@@ -16,6 +16,13 @@ var (
 		{Name: "message", Type: field.TypeString},
 		{Name: "triggered_at", Type: field.TypeTime},
 		{Name: "is_read", Type: field.TypeBool, Default: false},
+		{Name: "status", Type: field.TypeString, Default: "firing"},
+		{Name: "count", Type: field.TypeInt, Default: 1},
+		{Name: "last_value", Type: field.TypeFloat64, Nullable: true},
+		{Name: "last_triggered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acknowledged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acknowledged_by", Type: field.TypeInt64, Nullable: true},
+		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
 		{Name: "alert_rule_alerts", Type: field.TypeInt},
 	}
 	// AlertsTable holds the schema information for the "alerts" table.
@@ -26,11 +33,18 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "alerts_alert_rules_alerts",
-				Columns:    []*schema.Column{AlertsColumns[6]},
+				Columns:    []*schema.Column{AlertsColumns[13]},
 				RefColumns: []*schema.Column{AlertRulesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "alert_status_alert_rule_alerts",
+				Unique:  false,
+				Columns: []*schema.Column{AlertsColumns[6], AlertsColumns[13]},
+			},
+		},
 	}
 	// AlertRulesColumns holds the columns for the "alert_rules" table.
 	AlertRulesColumns = []*schema.Column{
@@ -38,8 +52,16 @@ var (
 		{Name: "name", Type: field.TypeString},
 		{Name: "user_id", Type: field.TypeInt64},
 		{Name: "sensor_id", Type: field.TypeInt64},
+		{Name: "channel", Type: field.TypeString, Default: ""},
 		{Name: "condition_type", Type: field.TypeString, Default: "GT"},
 		{Name: "threshold", Type: field.TypeFloat64},
+		{Name: "threshold_high", Type: field.TypeFloat64, Default: 0},
+		{Name: "for_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "for_readings", Type: field.TypeInt, Default: 0},
+		{Name: "clear_threshold", Type: field.TypeFloat64, Nullable: true},
+		{Name: "metric", Type: field.TypeString, Default: "VALUE"},
+		{Name: "window_seconds", Type: field.TypeInt, Default: 0},
+		{Name: "skip_bad_quality", Type: field.TypeBool, Default: false},
 		{Name: "description", Type: field.TypeString, Nullable: true},
 		{Name: "is_enabled", Type: field.TypeBool, Default: true},
 		{Name: "created_at", Type: field.TypeTime},
@@ -50,10 +72,53 @@ var (
 		Columns:    AlertRulesColumns,
 		PrimaryKey: []*schema.Column{AlertRulesColumns[0]},
 	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "exchange", Type: field.TypeString},
+		{Name: "routing_key", Type: field.TypeString, Default: ""},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "claim_token", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[6]},
+			},
+		},
+	}
+	// RuleStatesColumns holds the columns for the "rule_states" table.
+	RuleStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "rule_id", Type: field.TypeInt, Unique: true},
+		{Name: "pending_since", Type: field.TypeTime, Nullable: true},
+		{Name: "pending_readings", Type: field.TypeInt, Default: 0},
+		{Name: "firing", Type: field.TypeBool, Default: false},
+		{Name: "version", Type: field.TypeInt, Default: 0},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RuleStatesTable holds the schema information for the "rule_states" table.
+	RuleStatesTable = &schema.Table{
+		Name:       "rule_states",
+		Columns:    RuleStatesColumns,
+		PrimaryKey: []*schema.Column{RuleStatesColumns[0]},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		AlertsTable,
 		AlertRulesTable,
+		OutboxEventsTable,
+		RuleStatesTable,
 	}
 )
 
@@ -13,7 +13,9 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alert"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alertrule"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/outboxevent"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/predicate"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
 )
 
 const (
@@ -25,29 +27,41 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeAlert     = "Alert"
-	TypeAlertRule = "AlertRule"
+	TypeAlert       = "Alert"
+	TypeAlertRule   = "AlertRule"
+	TypeOutboxEvent = "OutboxEvent"
+	TypeRuleState   = "RuleState"
 )
 
 // AlertMutation represents an operation that mutates the Alert nodes in the graph.
 type AlertMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	user_id       *int64
-	adduser_id    *int64
-	value         *float64
-	addvalue      *float64
-	message       *string
-	triggered_at  *time.Time
-	is_read       *bool
-	clearedFields map[string]struct{}
-	rule          *int
-	clearedrule   bool
-	done          bool
-	oldValue      func(context.Context) (*Alert, error)
-	predicates    []predicate.Alert
+	op                 Op
+	typ                string
+	id                 *int
+	user_id            *int64
+	adduser_id         *int64
+	value              *float64
+	addvalue           *float64
+	message            *string
+	triggered_at       *time.Time
+	is_read            *bool
+	status             *string
+	count              *int
+	addcount           *int
+	last_value         *float64
+	addlast_value      *float64
+	last_triggered_at  *time.Time
+	acknowledged_at    *time.Time
+	acknowledged_by    *int64
+	addacknowledged_by *int64
+	resolved_at        *time.Time
+	clearedFields      map[string]struct{}
+	rule               *int
+	clearedrule        bool
+	done               bool
+	oldValue           func(context.Context) (*Alert, error)
+	predicates         []predicate.Alert
 }
 
 var _ ent.Mutation = (*AlertMutation)(nil)
@@ -368,6 +382,385 @@ func (m *AlertMutation) ResetIsRead() {
 	m.is_read = nil
 }
 
+// SetStatus sets the "status" field.
+func (m *AlertMutation) SetStatus(s string) {
+	m.status = &s
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *AlertMutation) Status() (r string, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldStatus(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *AlertMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCount sets the "count" field.
+func (m *AlertMutation) SetCount(i int) {
+	m.count = &i
+	m.addcount = nil
+}
+
+// Count returns the value of the "count" field in the mutation.
+func (m *AlertMutation) Count() (r int, exists bool) {
+	v := m.count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCount returns the old "count" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCount: %w", err)
+	}
+	return oldValue.Count, nil
+}
+
+// AddCount adds i to the "count" field.
+func (m *AlertMutation) AddCount(i int) {
+	if m.addcount != nil {
+		*m.addcount += i
+	} else {
+		m.addcount = &i
+	}
+}
+
+// AddedCount returns the value that was added to the "count" field in this mutation.
+func (m *AlertMutation) AddedCount() (r int, exists bool) {
+	v := m.addcount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCount resets all changes to the "count" field.
+func (m *AlertMutation) ResetCount() {
+	m.count = nil
+	m.addcount = nil
+}
+
+// SetLastValue sets the "last_value" field.
+func (m *AlertMutation) SetLastValue(f float64) {
+	m.last_value = &f
+	m.addlast_value = nil
+}
+
+// LastValue returns the value of the "last_value" field in the mutation.
+func (m *AlertMutation) LastValue() (r float64, exists bool) {
+	v := m.last_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastValue returns the old "last_value" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldLastValue(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastValue: %w", err)
+	}
+	return oldValue.LastValue, nil
+}
+
+// AddLastValue adds f to the "last_value" field.
+func (m *AlertMutation) AddLastValue(f float64) {
+	if m.addlast_value != nil {
+		*m.addlast_value += f
+	} else {
+		m.addlast_value = &f
+	}
+}
+
+// AddedLastValue returns the value that was added to the "last_value" field in this mutation.
+func (m *AlertMutation) AddedLastValue() (r float64, exists bool) {
+	v := m.addlast_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastValue clears the value of the "last_value" field.
+func (m *AlertMutation) ClearLastValue() {
+	m.last_value = nil
+	m.addlast_value = nil
+	m.clearedFields[alert.FieldLastValue] = struct{}{}
+}
+
+// LastValueCleared returns if the "last_value" field was cleared in this mutation.
+func (m *AlertMutation) LastValueCleared() bool {
+	_, ok := m.clearedFields[alert.FieldLastValue]
+	return ok
+}
+
+// ResetLastValue resets all changes to the "last_value" field.
+func (m *AlertMutation) ResetLastValue() {
+	m.last_value = nil
+	m.addlast_value = nil
+	delete(m.clearedFields, alert.FieldLastValue)
+}
+
+// SetLastTriggeredAt sets the "last_triggered_at" field.
+func (m *AlertMutation) SetLastTriggeredAt(t time.Time) {
+	m.last_triggered_at = &t
+}
+
+// LastTriggeredAt returns the value of the "last_triggered_at" field in the mutation.
+func (m *AlertMutation) LastTriggeredAt() (r time.Time, exists bool) {
+	v := m.last_triggered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastTriggeredAt returns the old "last_triggered_at" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldLastTriggeredAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTriggeredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTriggeredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTriggeredAt: %w", err)
+	}
+	return oldValue.LastTriggeredAt, nil
+}
+
+// ClearLastTriggeredAt clears the value of the "last_triggered_at" field.
+func (m *AlertMutation) ClearLastTriggeredAt() {
+	m.last_triggered_at = nil
+	m.clearedFields[alert.FieldLastTriggeredAt] = struct{}{}
+}
+
+// LastTriggeredAtCleared returns if the "last_triggered_at" field was cleared in this mutation.
+func (m *AlertMutation) LastTriggeredAtCleared() bool {
+	_, ok := m.clearedFields[alert.FieldLastTriggeredAt]
+	return ok
+}
+
+// ResetLastTriggeredAt resets all changes to the "last_triggered_at" field.
+func (m *AlertMutation) ResetLastTriggeredAt() {
+	m.last_triggered_at = nil
+	delete(m.clearedFields, alert.FieldLastTriggeredAt)
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (m *AlertMutation) SetAcknowledgedAt(t time.Time) {
+	m.acknowledged_at = &t
+}
+
+// AcknowledgedAt returns the value of the "acknowledged_at" field in the mutation.
+func (m *AlertMutation) AcknowledgedAt() (r time.Time, exists bool) {
+	v := m.acknowledged_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcknowledgedAt returns the old "acknowledged_at" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldAcknowledgedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedAt: %w", err)
+	}
+	return oldValue.AcknowledgedAt, nil
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (m *AlertMutation) ClearAcknowledgedAt() {
+	m.acknowledged_at = nil
+	m.clearedFields[alert.FieldAcknowledgedAt] = struct{}{}
+}
+
+// AcknowledgedAtCleared returns if the "acknowledged_at" field was cleared in this mutation.
+func (m *AlertMutation) AcknowledgedAtCleared() bool {
+	_, ok := m.clearedFields[alert.FieldAcknowledgedAt]
+	return ok
+}
+
+// ResetAcknowledgedAt resets all changes to the "acknowledged_at" field.
+func (m *AlertMutation) ResetAcknowledgedAt() {
+	m.acknowledged_at = nil
+	delete(m.clearedFields, alert.FieldAcknowledgedAt)
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (m *AlertMutation) SetAcknowledgedBy(i int64) {
+	m.acknowledged_by = &i
+	m.addacknowledged_by = nil
+}
+
+// AcknowledgedBy returns the value of the "acknowledged_by" field in the mutation.
+func (m *AlertMutation) AcknowledgedBy() (r int64, exists bool) {
+	v := m.acknowledged_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcknowledgedBy returns the old "acknowledged_by" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldAcknowledgedBy(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedBy: %w", err)
+	}
+	return oldValue.AcknowledgedBy, nil
+}
+
+// AddAcknowledgedBy adds i to the "acknowledged_by" field.
+func (m *AlertMutation) AddAcknowledgedBy(i int64) {
+	if m.addacknowledged_by != nil {
+		*m.addacknowledged_by += i
+	} else {
+		m.addacknowledged_by = &i
+	}
+}
+
+// AddedAcknowledgedBy returns the value that was added to the "acknowledged_by" field in this mutation.
+func (m *AlertMutation) AddedAcknowledgedBy() (r int64, exists bool) {
+	v := m.addacknowledged_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (m *AlertMutation) ClearAcknowledgedBy() {
+	m.acknowledged_by = nil
+	m.addacknowledged_by = nil
+	m.clearedFields[alert.FieldAcknowledgedBy] = struct{}{}
+}
+
+// AcknowledgedByCleared returns if the "acknowledged_by" field was cleared in this mutation.
+func (m *AlertMutation) AcknowledgedByCleared() bool {
+	_, ok := m.clearedFields[alert.FieldAcknowledgedBy]
+	return ok
+}
+
+// ResetAcknowledgedBy resets all changes to the "acknowledged_by" field.
+func (m *AlertMutation) ResetAcknowledgedBy() {
+	m.acknowledged_by = nil
+	m.addacknowledged_by = nil
+	delete(m.clearedFields, alert.FieldAcknowledgedBy)
+}
+
+// SetResolvedAt sets the "resolved_at" field.
+func (m *AlertMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
+}
+
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *AlertMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResolvedAt returns the old "resolved_at" field's value of the Alert entity.
+// If the Alert object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertMutation) OldResolvedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+	}
+	return oldValue.ResolvedAt, nil
+}
+
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *AlertMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[alert.FieldResolvedAt] = struct{}{}
+}
+
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *AlertMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[alert.FieldResolvedAt]
+	return ok
+}
+
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *AlertMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, alert.FieldResolvedAt)
+}
+
 // SetRuleID sets the "rule" edge to the AlertRule entity by id.
 func (m *AlertMutation) SetRuleID(id int) {
 	m.rule = &id
@@ -441,7 +834,7 @@ func (m *AlertMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *AlertMutation) Fields() []string {
-	fields := make([]string, 0, 5)
+	fields := make([]string, 0, 12)
 	if m.user_id != nil {
 		fields = append(fields, alert.FieldUserID)
 	}
@@ -457,6 +850,27 @@ func (m *AlertMutation) Fields() []string {
 	if m.is_read != nil {
 		fields = append(fields, alert.FieldIsRead)
 	}
+	if m.status != nil {
+		fields = append(fields, alert.FieldStatus)
+	}
+	if m.count != nil {
+		fields = append(fields, alert.FieldCount)
+	}
+	if m.last_value != nil {
+		fields = append(fields, alert.FieldLastValue)
+	}
+	if m.last_triggered_at != nil {
+		fields = append(fields, alert.FieldLastTriggeredAt)
+	}
+	if m.acknowledged_at != nil {
+		fields = append(fields, alert.FieldAcknowledgedAt)
+	}
+	if m.acknowledged_by != nil {
+		fields = append(fields, alert.FieldAcknowledgedBy)
+	}
+	if m.resolved_at != nil {
+		fields = append(fields, alert.FieldResolvedAt)
+	}
 	return fields
 }
 
@@ -475,6 +889,20 @@ func (m *AlertMutation) Field(name string) (ent.Value, bool) {
 		return m.TriggeredAt()
 	case alert.FieldIsRead:
 		return m.IsRead()
+	case alert.FieldStatus:
+		return m.Status()
+	case alert.FieldCount:
+		return m.Count()
+	case alert.FieldLastValue:
+		return m.LastValue()
+	case alert.FieldLastTriggeredAt:
+		return m.LastTriggeredAt()
+	case alert.FieldAcknowledgedAt:
+		return m.AcknowledgedAt()
+	case alert.FieldAcknowledgedBy:
+		return m.AcknowledgedBy()
+	case alert.FieldResolvedAt:
+		return m.ResolvedAt()
 	}
 	return nil, false
 }
@@ -494,6 +922,20 @@ func (m *AlertMutation) OldField(ctx context.Context, name string) (ent.Value, e
 		return m.OldTriggeredAt(ctx)
 	case alert.FieldIsRead:
 		return m.OldIsRead(ctx)
+	case alert.FieldStatus:
+		return m.OldStatus(ctx)
+	case alert.FieldCount:
+		return m.OldCount(ctx)
+	case alert.FieldLastValue:
+		return m.OldLastValue(ctx)
+	case alert.FieldLastTriggeredAt:
+		return m.OldLastTriggeredAt(ctx)
+	case alert.FieldAcknowledgedAt:
+		return m.OldAcknowledgedAt(ctx)
+	case alert.FieldAcknowledgedBy:
+		return m.OldAcknowledgedBy(ctx)
+	case alert.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
 	}
 	return nil, fmt.Errorf("unknown Alert field %s", name)
 }
@@ -538,6 +980,55 @@ func (m *AlertMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsRead(v)
 		return nil
+	case alert.FieldStatus:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case alert.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCount(v)
+		return nil
+	case alert.FieldLastValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastValue(v)
+		return nil
+	case alert.FieldLastTriggeredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTriggeredAt(v)
+		return nil
+	case alert.FieldAcknowledgedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedAt(v)
+		return nil
+	case alert.FieldAcknowledgedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedBy(v)
+		return nil
+	case alert.FieldResolvedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResolvedAt(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Alert field %s", name)
 }
@@ -552,6 +1043,15 @@ func (m *AlertMutation) AddedFields() []string {
 	if m.addvalue != nil {
 		fields = append(fields, alert.FieldValue)
 	}
+	if m.addcount != nil {
+		fields = append(fields, alert.FieldCount)
+	}
+	if m.addlast_value != nil {
+		fields = append(fields, alert.FieldLastValue)
+	}
+	if m.addacknowledged_by != nil {
+		fields = append(fields, alert.FieldAcknowledgedBy)
+	}
 	return fields
 }
 
@@ -564,6 +1064,12 @@ func (m *AlertMutation) AddedField(name string) (ent.Value, bool) {
 		return m.AddedUserID()
 	case alert.FieldValue:
 		return m.AddedValue()
+	case alert.FieldCount:
+		return m.AddedCount()
+	case alert.FieldLastValue:
+		return m.AddedLastValue()
+	case alert.FieldAcknowledgedBy:
+		return m.AddedAcknowledgedBy()
 	}
 	return nil, false
 }
@@ -587,16 +1093,53 @@ func (m *AlertMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddValue(v)
 		return nil
-	}
-	return fmt.Errorf("unknown Alert numeric field %s", name)
-}
-
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *AlertMutation) ClearedFields() []string {
-	return nil
-}
-
+	case alert.FieldCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCount(v)
+		return nil
+	case alert.FieldLastValue:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastValue(v)
+		return nil
+	case alert.FieldAcknowledgedBy:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAcknowledgedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Alert numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AlertMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(alert.FieldLastValue) {
+		fields = append(fields, alert.FieldLastValue)
+	}
+	if m.FieldCleared(alert.FieldLastTriggeredAt) {
+		fields = append(fields, alert.FieldLastTriggeredAt)
+	}
+	if m.FieldCleared(alert.FieldAcknowledgedAt) {
+		fields = append(fields, alert.FieldAcknowledgedAt)
+	}
+	if m.FieldCleared(alert.FieldAcknowledgedBy) {
+		fields = append(fields, alert.FieldAcknowledgedBy)
+	}
+	if m.FieldCleared(alert.FieldResolvedAt) {
+		fields = append(fields, alert.FieldResolvedAt)
+	}
+	return fields
+}
+
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
 func (m *AlertMutation) FieldCleared(name string) bool {
@@ -607,6 +1150,23 @@ func (m *AlertMutation) FieldCleared(name string) bool {
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
 func (m *AlertMutation) ClearField(name string) error {
+	switch name {
+	case alert.FieldLastValue:
+		m.ClearLastValue()
+		return nil
+	case alert.FieldLastTriggeredAt:
+		m.ClearLastTriggeredAt()
+		return nil
+	case alert.FieldAcknowledgedAt:
+		m.ClearAcknowledgedAt()
+		return nil
+	case alert.FieldAcknowledgedBy:
+		m.ClearAcknowledgedBy()
+		return nil
+	case alert.FieldResolvedAt:
+		m.ClearResolvedAt()
+		return nil
+	}
 	return fmt.Errorf("unknown Alert nullable field %s", name)
 }
 
@@ -629,6 +1189,27 @@ func (m *AlertMutation) ResetField(name string) error {
 	case alert.FieldIsRead:
 		m.ResetIsRead()
 		return nil
+	case alert.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case alert.FieldCount:
+		m.ResetCount()
+		return nil
+	case alert.FieldLastValue:
+		m.ResetLastValue()
+		return nil
+	case alert.FieldLastTriggeredAt:
+		m.ResetLastTriggeredAt()
+		return nil
+	case alert.FieldAcknowledgedAt:
+		m.ResetAcknowledgedAt()
+		return nil
+	case alert.FieldAcknowledgedBy:
+		m.ResetAcknowledgedBy()
+		return nil
+	case alert.FieldResolvedAt:
+		m.ResetResolvedAt()
+		return nil
 	}
 	return fmt.Errorf("unknown Alert field %s", name)
 }
@@ -710,27 +1291,40 @@ func (m *AlertMutation) ResetEdge(name string) error {
 // AlertRuleMutation represents an operation that mutates the AlertRule nodes in the graph.
 type AlertRuleMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	name           *string
-	user_id        *int64
-	adduser_id     *int64
-	sensor_id      *int64
-	addsensor_id   *int64
-	condition_type *string
-	threshold      *float64
-	addthreshold   *float64
-	description    *string
-	is_enabled     *bool
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	alerts         map[int]struct{}
-	removedalerts  map[int]struct{}
-	clearedalerts  bool
-	done           bool
-	oldValue       func(context.Context) (*AlertRule, error)
-	predicates     []predicate.AlertRule
+	op                 Op
+	typ                string
+	id                 *int
+	name               *string
+	user_id            *int64
+	adduser_id         *int64
+	sensor_id          *int64
+	addsensor_id       *int64
+	channel            *string
+	condition_type     *string
+	threshold          *float64
+	addthreshold       *float64
+	threshold_high     *float64
+	addthreshold_high  *float64
+	for_seconds        *int
+	addfor_seconds     *int
+	for_readings       *int
+	addfor_readings    *int
+	clear_threshold    *float64
+	addclear_threshold *float64
+	metric             *string
+	window_seconds     *int
+	addwindow_seconds  *int
+	skip_bad_quality   *bool
+	description        *string
+	is_enabled         *bool
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	alerts             map[int]struct{}
+	removedalerts      map[int]struct{}
+	clearedalerts      bool
+	done               bool
+	oldValue           func(context.Context) (*AlertRule, error)
+	predicates         []predicate.AlertRule
 }
 
 var _ ent.Mutation = (*AlertRuleMutation)(nil)
@@ -979,6 +1573,42 @@ func (m *AlertRuleMutation) ResetSensorID() {
 	m.addsensor_id = nil
 }
 
+// SetChannel sets the "channel" field.
+func (m *AlertRuleMutation) SetChannel(s string) {
+	m.channel = &s
+}
+
+// Channel returns the value of the "channel" field in the mutation.
+func (m *AlertRuleMutation) Channel() (r string, exists bool) {
+	v := m.channel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannel returns the old "channel" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldChannel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
+	}
+	return oldValue.Channel, nil
+}
+
+// ResetChannel resets all changes to the "channel" field.
+func (m *AlertRuleMutation) ResetChannel() {
+	m.channel = nil
+}
+
 // SetConditionType sets the "condition_type" field.
 func (m *AlertRuleMutation) SetConditionType(s string) {
 	m.condition_type = &s
@@ -1071,239 +1701,2372 @@ func (m *AlertRuleMutation) ResetThreshold() {
 	m.addthreshold = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *AlertRuleMutation) SetDescription(s string) {
-	m.description = &s
+// SetThresholdHigh sets the "threshold_high" field.
+func (m *AlertRuleMutation) SetThresholdHigh(f float64) {
+	m.threshold_high = &f
+	m.addthreshold_high = nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *AlertRuleMutation) Description() (r string, exists bool) {
-	v := m.description
+// ThresholdHigh returns the value of the "threshold_high" field in the mutation.
+func (m *AlertRuleMutation) ThresholdHigh() (r float64, exists bool) {
+	v := m.threshold_high
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the AlertRule entity.
+// OldThresholdHigh returns the old "threshold_high" field's value of the AlertRule entity.
 // If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AlertRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *AlertRuleMutation) OldThresholdHigh(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldThresholdHigh is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldThresholdHigh requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldThresholdHigh: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.ThresholdHigh, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *AlertRuleMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[alertrule.FieldDescription] = struct{}{}
+// AddThresholdHigh adds f to the "threshold_high" field.
+func (m *AlertRuleMutation) AddThresholdHigh(f float64) {
+	if m.addthreshold_high != nil {
+		*m.addthreshold_high += f
+	} else {
+		m.addthreshold_high = &f
+	}
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *AlertRuleMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[alertrule.FieldDescription]
-	return ok
+// AddedThresholdHigh returns the value that was added to the "threshold_high" field in this mutation.
+func (m *AlertRuleMutation) AddedThresholdHigh() (r float64, exists bool) {
+	v := m.addthreshold_high
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *AlertRuleMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, alertrule.FieldDescription)
+// ResetThresholdHigh resets all changes to the "threshold_high" field.
+func (m *AlertRuleMutation) ResetThresholdHigh() {
+	m.threshold_high = nil
+	m.addthreshold_high = nil
 }
 
-// SetIsEnabled sets the "is_enabled" field.
-func (m *AlertRuleMutation) SetIsEnabled(b bool) {
-	m.is_enabled = &b
+// SetForSeconds sets the "for_seconds" field.
+func (m *AlertRuleMutation) SetForSeconds(i int) {
+	m.for_seconds = &i
+	m.addfor_seconds = nil
 }
 
-// IsEnabled returns the value of the "is_enabled" field in the mutation.
-func (m *AlertRuleMutation) IsEnabled() (r bool, exists bool) {
-	v := m.is_enabled
+// ForSeconds returns the value of the "for_seconds" field in the mutation.
+func (m *AlertRuleMutation) ForSeconds() (r int, exists bool) {
+	v := m.for_seconds
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsEnabled returns the old "is_enabled" field's value of the AlertRule entity.
+// OldForSeconds returns the old "for_seconds" field's value of the AlertRule entity.
 // If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AlertRuleMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+func (m *AlertRuleMutation) OldForSeconds(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+		return v, errors.New("OldForSeconds is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+		return v, errors.New("OldForSeconds requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+		return v, fmt.Errorf("querying old value for OldForSeconds: %w", err)
 	}
-	return oldValue.IsEnabled, nil
+	return oldValue.ForSeconds, nil
 }
 
-// ResetIsEnabled resets all changes to the "is_enabled" field.
-func (m *AlertRuleMutation) ResetIsEnabled() {
-	m.is_enabled = nil
+// AddForSeconds adds i to the "for_seconds" field.
+func (m *AlertRuleMutation) AddForSeconds(i int) {
+	if m.addfor_seconds != nil {
+		*m.addfor_seconds += i
+	} else {
+		m.addfor_seconds = &i
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *AlertRuleMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedForSeconds returns the value that was added to the "for_seconds" field in this mutation.
+func (m *AlertRuleMutation) AddedForSeconds() (r int, exists bool) {
+	v := m.addfor_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *AlertRuleMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetForSeconds resets all changes to the "for_seconds" field.
+func (m *AlertRuleMutation) ResetForSeconds() {
+	m.for_seconds = nil
+	m.addfor_seconds = nil
+}
+
+// SetForReadings sets the "for_readings" field.
+func (m *AlertRuleMutation) SetForReadings(i int) {
+	m.for_readings = &i
+	m.addfor_readings = nil
+}
+
+// ForReadings returns the value of the "for_readings" field in the mutation.
+func (m *AlertRuleMutation) ForReadings() (r int, exists bool) {
+	v := m.for_readings
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the AlertRule entity.
+// OldForReadings returns the old "for_readings" field's value of the AlertRule entity.
 // If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *AlertRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AlertRuleMutation) OldForReadings(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldForReadings is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldForReadings requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldForReadings: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.ForReadings, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *AlertRuleMutation) ResetCreatedAt() {
-	m.created_at = nil
+// AddForReadings adds i to the "for_readings" field.
+func (m *AlertRuleMutation) AddForReadings(i int) {
+	if m.addfor_readings != nil {
+		*m.addfor_readings += i
+	} else {
+		m.addfor_readings = &i
+	}
 }
 
-// AddAlertIDs adds the "alerts" edge to the Alert entity by ids.
-func (m *AlertRuleMutation) AddAlertIDs(ids ...int) {
-	if m.alerts == nil {
-		m.alerts = make(map[int]struct{})
-	}
-	for i := range ids {
-		m.alerts[ids[i]] = struct{}{}
+// AddedForReadings returns the value that was added to the "for_readings" field in this mutation.
+func (m *AlertRuleMutation) AddedForReadings() (r int, exists bool) {
+	v := m.addfor_readings
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearAlerts clears the "alerts" edge to the Alert entity.
-func (m *AlertRuleMutation) ClearAlerts() {
-	m.clearedalerts = true
+// ResetForReadings resets all changes to the "for_readings" field.
+func (m *AlertRuleMutation) ResetForReadings() {
+	m.for_readings = nil
+	m.addfor_readings = nil
 }
 
-// AlertsCleared reports if the "alerts" edge to the Alert entity was cleared.
-func (m *AlertRuleMutation) AlertsCleared() bool {
-	return m.clearedalerts
+// SetClearThreshold sets the "clear_threshold" field.
+func (m *AlertRuleMutation) SetClearThreshold(f float64) {
+	m.clear_threshold = &f
+	m.addclear_threshold = nil
 }
 
-// RemoveAlertIDs removes the "alerts" edge to the Alert entity by IDs.
-func (m *AlertRuleMutation) RemoveAlertIDs(ids ...int) {
-	if m.removedalerts == nil {
-		m.removedalerts = make(map[int]struct{})
-	}
-	for i := range ids {
-		delete(m.alerts, ids[i])
-		m.removedalerts[ids[i]] = struct{}{}
+// ClearThreshold returns the value of the "clear_threshold" field in the mutation.
+func (m *AlertRuleMutation) ClearThreshold() (r float64, exists bool) {
+	v := m.clear_threshold
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedAlerts returns the removed IDs of the "alerts" edge to the Alert entity.
-func (m *AlertRuleMutation) RemovedAlertsIDs() (ids []int) {
-	for id := range m.removedalerts {
-		ids = append(ids, id)
+// OldClearThreshold returns the old "clear_threshold" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldClearThreshold(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClearThreshold is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClearThreshold requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClearThreshold: %w", err)
+	}
+	return oldValue.ClearThreshold, nil
 }
 
-// AlertsIDs returns the "alerts" edge IDs in the mutation.
-func (m *AlertRuleMutation) AlertsIDs() (ids []int) {
-	for id := range m.alerts {
-		ids = append(ids, id)
+// AddClearThreshold adds f to the "clear_threshold" field.
+func (m *AlertRuleMutation) AddClearThreshold(f float64) {
+	if m.addclear_threshold != nil {
+		*m.addclear_threshold += f
+	} else {
+		m.addclear_threshold = &f
 	}
-	return
 }
 
-// ResetAlerts resets all changes to the "alerts" edge.
-func (m *AlertRuleMutation) ResetAlerts() {
-	m.alerts = nil
-	m.clearedalerts = false
-	m.removedalerts = nil
+// AddedClearThreshold returns the value that was added to the "clear_threshold" field in this mutation.
+func (m *AlertRuleMutation) AddedClearThreshold() (r float64, exists bool) {
+	v := m.addclear_threshold
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Where appends a list predicates to the AlertRuleMutation builder.
-func (m *AlertRuleMutation) Where(ps ...predicate.AlertRule) {
-	m.predicates = append(m.predicates, ps...)
+// ClearClearThreshold clears the value of the "clear_threshold" field.
+func (m *AlertRuleMutation) ClearClearThreshold() {
+	m.clear_threshold = nil
+	m.addclear_threshold = nil
+	m.clearedFields[alertrule.FieldClearThreshold] = struct{}{}
 }
 
-// WhereP appends storage-level predicates to the AlertRuleMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *AlertRuleMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.AlertRule, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// ClearThresholdCleared returns if the "clear_threshold" field was cleared in this mutation.
+func (m *AlertRuleMutation) ClearThresholdCleared() bool {
+	_, ok := m.clearedFields[alertrule.FieldClearThreshold]
+	return ok
 }
 
-// Op returns the operation name.
-func (m *AlertRuleMutation) Op() Op {
-	return m.op
+// ResetClearThreshold resets all changes to the "clear_threshold" field.
+func (m *AlertRuleMutation) ResetClearThreshold() {
+	m.clear_threshold = nil
+	m.addclear_threshold = nil
+	delete(m.clearedFields, alertrule.FieldClearThreshold)
 }
 
-// SetOp allows setting the mutation operation.
-func (m *AlertRuleMutation) SetOp(op Op) {
-	m.op = op
+// SetMetric sets the "metric" field.
+func (m *AlertRuleMutation) SetMetric(s string) {
+	m.metric = &s
 }
 
-// Type returns the node type of this mutation (AlertRule).
-func (m *AlertRuleMutation) Type() string {
-	return m.typ
+// Metric returns the value of the "metric" field in the mutation.
+func (m *AlertRuleMutation) Metric() (r string, exists bool) {
+	v := m.metric
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *AlertRuleMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, alertrule.FieldName)
+// OldMetric returns the old "metric" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldMetric(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetric is only allowed on UpdateOne operations")
 	}
-	if m.user_id != nil {
-		fields = append(fields, alertrule.FieldUserID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetric requires an ID field in the mutation")
 	}
-	if m.sensor_id != nil {
-		fields = append(fields, alertrule.FieldSensorID)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetric: %w", err)
 	}
-	if m.condition_type != nil {
-		fields = append(fields, alertrule.FieldConditionType)
+	return oldValue.Metric, nil
+}
+
+// ResetMetric resets all changes to the "metric" field.
+func (m *AlertRuleMutation) ResetMetric() {
+	m.metric = nil
+}
+
+// SetWindowSeconds sets the "window_seconds" field.
+func (m *AlertRuleMutation) SetWindowSeconds(i int) {
+	m.window_seconds = &i
+	m.addwindow_seconds = nil
+}
+
+// WindowSeconds returns the value of the "window_seconds" field in the mutation.
+func (m *AlertRuleMutation) WindowSeconds() (r int, exists bool) {
+	v := m.window_seconds
+	if v == nil {
+		return
 	}
-	if m.threshold != nil {
-		fields = append(fields, alertrule.FieldThreshold)
+	return *v, true
+}
+
+// OldWindowSeconds returns the old "window_seconds" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldWindowSeconds(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWindowSeconds is only allowed on UpdateOne operations")
 	}
-	if m.description != nil {
-		fields = append(fields, alertrule.FieldDescription)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWindowSeconds requires an ID field in the mutation")
 	}
-	if m.is_enabled != nil {
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWindowSeconds: %w", err)
+	}
+	return oldValue.WindowSeconds, nil
+}
+
+// AddWindowSeconds adds i to the "window_seconds" field.
+func (m *AlertRuleMutation) AddWindowSeconds(i int) {
+	if m.addwindow_seconds != nil {
+		*m.addwindow_seconds += i
+	} else {
+		m.addwindow_seconds = &i
+	}
+}
+
+// AddedWindowSeconds returns the value that was added to the "window_seconds" field in this mutation.
+func (m *AlertRuleMutation) AddedWindowSeconds() (r int, exists bool) {
+	v := m.addwindow_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWindowSeconds resets all changes to the "window_seconds" field.
+func (m *AlertRuleMutation) ResetWindowSeconds() {
+	m.window_seconds = nil
+	m.addwindow_seconds = nil
+}
+
+// SetSkipBadQuality sets the "skip_bad_quality" field.
+func (m *AlertRuleMutation) SetSkipBadQuality(b bool) {
+	m.skip_bad_quality = &b
+}
+
+// SkipBadQuality returns the value of the "skip_bad_quality" field in the mutation.
+func (m *AlertRuleMutation) SkipBadQuality() (r bool, exists bool) {
+	v := m.skip_bad_quality
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSkipBadQuality returns the old "skip_bad_quality" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldSkipBadQuality(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSkipBadQuality is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSkipBadQuality requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSkipBadQuality: %w", err)
+	}
+	return oldValue.SkipBadQuality, nil
+}
+
+// ResetSkipBadQuality resets all changes to the "skip_bad_quality" field.
+func (m *AlertRuleMutation) ResetSkipBadQuality() {
+	m.skip_bad_quality = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *AlertRuleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *AlertRuleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *AlertRuleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[alertrule.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *AlertRuleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[alertrule.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *AlertRuleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, alertrule.FieldDescription)
+}
+
+// SetIsEnabled sets the "is_enabled" field.
+func (m *AlertRuleMutation) SetIsEnabled(b bool) {
+	m.is_enabled = &b
+}
+
+// IsEnabled returns the value of the "is_enabled" field in the mutation.
+func (m *AlertRuleMutation) IsEnabled() (r bool, exists bool) {
+	v := m.is_enabled
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsEnabled returns the old "is_enabled" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldIsEnabled(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsEnabled is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsEnabled requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsEnabled: %w", err)
+	}
+	return oldValue.IsEnabled, nil
+}
+
+// ResetIsEnabled resets all changes to the "is_enabled" field.
+func (m *AlertRuleMutation) ResetIsEnabled() {
+	m.is_enabled = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AlertRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AlertRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AlertRule entity.
+// If the AlertRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AlertRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AlertRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// AddAlertIDs adds the "alerts" edge to the Alert entity by ids.
+func (m *AlertRuleMutation) AddAlertIDs(ids ...int) {
+	if m.alerts == nil {
+		m.alerts = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.alerts[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAlerts clears the "alerts" edge to the Alert entity.
+func (m *AlertRuleMutation) ClearAlerts() {
+	m.clearedalerts = true
+}
+
+// AlertsCleared reports if the "alerts" edge to the Alert entity was cleared.
+func (m *AlertRuleMutation) AlertsCleared() bool {
+	return m.clearedalerts
+}
+
+// RemoveAlertIDs removes the "alerts" edge to the Alert entity by IDs.
+func (m *AlertRuleMutation) RemoveAlertIDs(ids ...int) {
+	if m.removedalerts == nil {
+		m.removedalerts = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.alerts, ids[i])
+		m.removedalerts[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAlerts returns the removed IDs of the "alerts" edge to the Alert entity.
+func (m *AlertRuleMutation) RemovedAlertsIDs() (ids []int) {
+	for id := range m.removedalerts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// AlertsIDs returns the "alerts" edge IDs in the mutation.
+func (m *AlertRuleMutation) AlertsIDs() (ids []int) {
+	for id := range m.alerts {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAlerts resets all changes to the "alerts" edge.
+func (m *AlertRuleMutation) ResetAlerts() {
+	m.alerts = nil
+	m.clearedalerts = false
+	m.removedalerts = nil
+}
+
+// Where appends a list predicates to the AlertRuleMutation builder.
+func (m *AlertRuleMutation) Where(ps ...predicate.AlertRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AlertRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AlertRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AlertRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AlertRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AlertRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AlertRule).
+func (m *AlertRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AlertRuleMutation) Fields() []string {
+	fields := make([]string, 0, 16)
+	if m.name != nil {
+		fields = append(fields, alertrule.FieldName)
+	}
+	if m.user_id != nil {
+		fields = append(fields, alertrule.FieldUserID)
+	}
+	if m.sensor_id != nil {
+		fields = append(fields, alertrule.FieldSensorID)
+	}
+	if m.channel != nil {
+		fields = append(fields, alertrule.FieldChannel)
+	}
+	if m.condition_type != nil {
+		fields = append(fields, alertrule.FieldConditionType)
+	}
+	if m.threshold != nil {
+		fields = append(fields, alertrule.FieldThreshold)
+	}
+	if m.threshold_high != nil {
+		fields = append(fields, alertrule.FieldThresholdHigh)
+	}
+	if m.for_seconds != nil {
+		fields = append(fields, alertrule.FieldForSeconds)
+	}
+	if m.for_readings != nil {
+		fields = append(fields, alertrule.FieldForReadings)
+	}
+	if m.clear_threshold != nil {
+		fields = append(fields, alertrule.FieldClearThreshold)
+	}
+	if m.metric != nil {
+		fields = append(fields, alertrule.FieldMetric)
+	}
+	if m.window_seconds != nil {
+		fields = append(fields, alertrule.FieldWindowSeconds)
+	}
+	if m.skip_bad_quality != nil {
+		fields = append(fields, alertrule.FieldSkipBadQuality)
+	}
+	if m.description != nil {
+		fields = append(fields, alertrule.FieldDescription)
+	}
+	if m.is_enabled != nil {
 		fields = append(fields, alertrule.FieldIsEnabled)
 	}
-	if m.created_at != nil {
-		fields = append(fields, alertrule.FieldCreatedAt)
+	if m.created_at != nil {
+		fields = append(fields, alertrule.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *AlertRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case alertrule.FieldName:
+		return m.Name()
+	case alertrule.FieldUserID:
+		return m.UserID()
+	case alertrule.FieldSensorID:
+		return m.SensorID()
+	case alertrule.FieldChannel:
+		return m.Channel()
+	case alertrule.FieldConditionType:
+		return m.ConditionType()
+	case alertrule.FieldThreshold:
+		return m.Threshold()
+	case alertrule.FieldThresholdHigh:
+		return m.ThresholdHigh()
+	case alertrule.FieldForSeconds:
+		return m.ForSeconds()
+	case alertrule.FieldForReadings:
+		return m.ForReadings()
+	case alertrule.FieldClearThreshold:
+		return m.ClearThreshold()
+	case alertrule.FieldMetric:
+		return m.Metric()
+	case alertrule.FieldWindowSeconds:
+		return m.WindowSeconds()
+	case alertrule.FieldSkipBadQuality:
+		return m.SkipBadQuality()
+	case alertrule.FieldDescription:
+		return m.Description()
+	case alertrule.FieldIsEnabled:
+		return m.IsEnabled()
+	case alertrule.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *AlertRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case alertrule.FieldName:
+		return m.OldName(ctx)
+	case alertrule.FieldUserID:
+		return m.OldUserID(ctx)
+	case alertrule.FieldSensorID:
+		return m.OldSensorID(ctx)
+	case alertrule.FieldChannel:
+		return m.OldChannel(ctx)
+	case alertrule.FieldConditionType:
+		return m.OldConditionType(ctx)
+	case alertrule.FieldThreshold:
+		return m.OldThreshold(ctx)
+	case alertrule.FieldThresholdHigh:
+		return m.OldThresholdHigh(ctx)
+	case alertrule.FieldForSeconds:
+		return m.OldForSeconds(ctx)
+	case alertrule.FieldForReadings:
+		return m.OldForReadings(ctx)
+	case alertrule.FieldClearThreshold:
+		return m.OldClearThreshold(ctx)
+	case alertrule.FieldMetric:
+		return m.OldMetric(ctx)
+	case alertrule.FieldWindowSeconds:
+		return m.OldWindowSeconds(ctx)
+	case alertrule.FieldSkipBadQuality:
+		return m.OldSkipBadQuality(ctx)
+	case alertrule.FieldDescription:
+		return m.OldDescription(ctx)
+	case alertrule.FieldIsEnabled:
+		return m.OldIsEnabled(ctx)
+	case alertrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown AlertRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AlertRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case alertrule.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case alertrule.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case alertrule.FieldSensorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSensorID(v)
+		return nil
+	case alertrule.FieldChannel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannel(v)
+		return nil
+	case alertrule.FieldConditionType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetConditionType(v)
+		return nil
+	case alertrule.FieldThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThreshold(v)
+		return nil
+	case alertrule.FieldThresholdHigh:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThresholdHigh(v)
+		return nil
+	case alertrule.FieldForSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForSeconds(v)
+		return nil
+	case alertrule.FieldForReadings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetForReadings(v)
+		return nil
+	case alertrule.FieldClearThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClearThreshold(v)
+		return nil
+	case alertrule.FieldMetric:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMetric(v)
+		return nil
+	case alertrule.FieldWindowSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWindowSeconds(v)
+		return nil
+	case alertrule.FieldSkipBadQuality:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSkipBadQuality(v)
+		return nil
+	case alertrule.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case alertrule.FieldIsEnabled:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsEnabled(v)
+		return nil
+	case alertrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AlertRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *AlertRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.adduser_id != nil {
+		fields = append(fields, alertrule.FieldUserID)
+	}
+	if m.addsensor_id != nil {
+		fields = append(fields, alertrule.FieldSensorID)
+	}
+	if m.addthreshold != nil {
+		fields = append(fields, alertrule.FieldThreshold)
+	}
+	if m.addthreshold_high != nil {
+		fields = append(fields, alertrule.FieldThresholdHigh)
+	}
+	if m.addfor_seconds != nil {
+		fields = append(fields, alertrule.FieldForSeconds)
+	}
+	if m.addfor_readings != nil {
+		fields = append(fields, alertrule.FieldForReadings)
+	}
+	if m.addclear_threshold != nil {
+		fields = append(fields, alertrule.FieldClearThreshold)
+	}
+	if m.addwindow_seconds != nil {
+		fields = append(fields, alertrule.FieldWindowSeconds)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AlertRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case alertrule.FieldUserID:
+		return m.AddedUserID()
+	case alertrule.FieldSensorID:
+		return m.AddedSensorID()
+	case alertrule.FieldThreshold:
+		return m.AddedThreshold()
+	case alertrule.FieldThresholdHigh:
+		return m.AddedThresholdHigh()
+	case alertrule.FieldForSeconds:
+		return m.AddedForSeconds()
+	case alertrule.FieldForReadings:
+		return m.AddedForReadings()
+	case alertrule.FieldClearThreshold:
+		return m.AddedClearThreshold()
+	case alertrule.FieldWindowSeconds:
+		return m.AddedWindowSeconds()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AlertRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case alertrule.FieldUserID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUserID(v)
+		return nil
+	case alertrule.FieldSensorID:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSensorID(v)
+		return nil
+	case alertrule.FieldThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThreshold(v)
+		return nil
+	case alertrule.FieldThresholdHigh:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddThresholdHigh(v)
+		return nil
+	case alertrule.FieldForSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddForSeconds(v)
+		return nil
+	case alertrule.FieldForReadings:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddForReadings(v)
+		return nil
+	case alertrule.FieldClearThreshold:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClearThreshold(v)
+		return nil
+	case alertrule.FieldWindowSeconds:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWindowSeconds(v)
+		return nil
+	}
+	return fmt.Errorf("unknown AlertRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AlertRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(alertrule.FieldClearThreshold) {
+		fields = append(fields, alertrule.FieldClearThreshold)
+	}
+	if m.FieldCleared(alertrule.FieldDescription) {
+		fields = append(fields, alertrule.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AlertRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AlertRuleMutation) ClearField(name string) error {
+	switch name {
+	case alertrule.FieldClearThreshold:
+		m.ClearClearThreshold()
+		return nil
+	case alertrule.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown AlertRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AlertRuleMutation) ResetField(name string) error {
+	switch name {
+	case alertrule.FieldName:
+		m.ResetName()
+		return nil
+	case alertrule.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case alertrule.FieldSensorID:
+		m.ResetSensorID()
+		return nil
+	case alertrule.FieldChannel:
+		m.ResetChannel()
+		return nil
+	case alertrule.FieldConditionType:
+		m.ResetConditionType()
+		return nil
+	case alertrule.FieldThreshold:
+		m.ResetThreshold()
+		return nil
+	case alertrule.FieldThresholdHigh:
+		m.ResetThresholdHigh()
+		return nil
+	case alertrule.FieldForSeconds:
+		m.ResetForSeconds()
+		return nil
+	case alertrule.FieldForReadings:
+		m.ResetForReadings()
+		return nil
+	case alertrule.FieldClearThreshold:
+		m.ResetClearThreshold()
+		return nil
+	case alertrule.FieldMetric:
+		m.ResetMetric()
+		return nil
+	case alertrule.FieldWindowSeconds:
+		m.ResetWindowSeconds()
+		return nil
+	case alertrule.FieldSkipBadQuality:
+		m.ResetSkipBadQuality()
+		return nil
+	case alertrule.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case alertrule.FieldIsEnabled:
+		m.ResetIsEnabled()
+		return nil
+	case alertrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AlertRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AlertRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.alerts != nil {
+		edges = append(edges, alertrule.EdgeAlerts)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AlertRuleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case alertrule.EdgeAlerts:
+		ids := make([]ent.Value, 0, len(m.alerts))
+		for id := range m.alerts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AlertRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedalerts != nil {
+		edges = append(edges, alertrule.EdgeAlerts)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AlertRuleMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case alertrule.EdgeAlerts:
+		ids := make([]ent.Value, 0, len(m.removedalerts))
+		for id := range m.removedalerts {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AlertRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedalerts {
+		edges = append(edges, alertrule.EdgeAlerts)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AlertRuleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case alertrule.EdgeAlerts:
+		return m.clearedalerts
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AlertRuleMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AlertRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AlertRuleMutation) ResetEdge(name string) error {
+	switch name {
+	case alertrule.EdgeAlerts:
+		m.ResetAlerts()
+		return nil
+	}
+	return fmt.Errorf("unknown AlertRule edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	exchange        *string
+	routing_key     *string
+	payload         *[]byte
+	attempts        *int
+	addattempts     *int
+	last_error      *string
+	next_attempt_at *time.Time
+	claim_token     *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*OutboxEvent, error)
+	predicates      []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id int) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExchange sets the "exchange" field.
+func (m *OutboxEventMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *OutboxEventMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *OutboxEventMutation) ResetExchange() {
+	m.exchange = nil
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (m *OutboxEventMutation) SetRoutingKey(s string) {
+	m.routing_key = &s
+}
+
+// RoutingKey returns the value of the "routing_key" field in the mutation.
+func (m *OutboxEventMutation) RoutingKey() (r string, exists bool) {
+	v := m.routing_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoutingKey returns the old "routing_key" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldRoutingKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoutingKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoutingKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoutingKey: %w", err)
+	}
+	return oldValue.RoutingKey, nil
+}
+
+// ResetRoutingKey resets all changes to the "routing_key" field.
+func (m *OutboxEventMutation) ResetRoutingKey() {
+	m.routing_key = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEventMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxevent.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEventMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxevent.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxEventMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxEventMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxEventMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (m *OutboxEventMutation) SetClaimToken(s string) {
+	m.claim_token = &s
+}
+
+// ClaimToken returns the value of the "claim_token" field in the mutation.
+func (m *OutboxEventMutation) ClaimToken() (r string, exists bool) {
+	v := m.claim_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimToken returns the old "claim_token" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldClaimToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimToken: %w", err)
+	}
+	return oldValue.ClaimToken, nil
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (m *OutboxEventMutation) ClearClaimToken() {
+	m.claim_token = nil
+	m.clearedFields[outboxevent.FieldClaimToken] = struct{}{}
+}
+
+// ClaimTokenCleared returns if the "claim_token" field was cleared in this mutation.
+func (m *OutboxEventMutation) ClaimTokenCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldClaimToken]
+	return ok
+}
+
+// ResetClaimToken resets all changes to the "claim_token" field.
+func (m *OutboxEventMutation) ResetClaimToken() {
+	m.claim_token = nil
+	delete(m.clearedFields, outboxevent.FieldClaimToken)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.exchange != nil {
+		fields = append(fields, outboxevent.FieldExchange)
+	}
+	if m.routing_key != nil {
+		fields = append(fields, outboxevent.FieldRoutingKey)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, outboxevent.FieldNextAttemptAt)
+	}
+	if m.claim_token != nil {
+		fields = append(fields, outboxevent.FieldClaimToken)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldExchange:
+		return m.Exchange()
+	case outboxevent.FieldRoutingKey:
+		return m.RoutingKey()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	case outboxevent.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outboxevent.FieldClaimToken:
+		return m.ClaimToken()
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldExchange:
+		return m.OldExchange(ctx)
+	case outboxevent.FieldRoutingKey:
+		return m.OldRoutingKey(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxevent.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outboxevent.FieldClaimToken:
+		return m.OldClaimToken(ctx)
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case outboxevent.FieldRoutingKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingKey(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outboxevent.FieldClaimToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimToken(v)
+		return nil
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldLastError) {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.FieldCleared(outboxevent.FieldClaimToken) {
+		fields = append(fields, outboxevent.FieldClaimToken)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case outboxevent.FieldClaimToken:
+		m.ClearClaimToken()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case outboxevent.FieldRoutingKey:
+		m.ResetRoutingKey()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outboxevent.FieldClaimToken:
+		m.ResetClaimToken()
+		return nil
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
+// RuleStateMutation represents an operation that mutates the RuleState nodes in the graph.
+type RuleStateMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *int
+	rule_id             *int
+	addrule_id          *int
+	pending_since       *time.Time
+	pending_readings    *int
+	addpending_readings *int
+	firing              *bool
+	version             *int
+	addversion          *int
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*RuleState, error)
+	predicates          []predicate.RuleState
+}
+
+var _ ent.Mutation = (*RuleStateMutation)(nil)
+
+// rulestateOption allows management of the mutation configuration using functional options.
+type rulestateOption func(*RuleStateMutation)
+
+// newRuleStateMutation creates new mutation for the RuleState entity.
+func newRuleStateMutation(c config, op Op, opts ...rulestateOption) *RuleStateMutation {
+	m := &RuleStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRuleState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRuleStateID sets the ID field of the mutation.
+func withRuleStateID(id int) rulestateOption {
+	return func(m *RuleStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RuleState
+		)
+		m.oldValue = func(ctx context.Context) (*RuleState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RuleState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRuleState sets the old RuleState of the mutation.
+func withRuleState(node *RuleState) rulestateOption {
+	return func(m *RuleStateMutation) {
+		m.oldValue = func(context.Context) (*RuleState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RuleStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RuleStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RuleStateMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RuleStateMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RuleState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetRuleID sets the "rule_id" field.
+func (m *RuleStateMutation) SetRuleID(i int) {
+	m.rule_id = &i
+	m.addrule_id = nil
+}
+
+// RuleID returns the value of the "rule_id" field in the mutation.
+func (m *RuleStateMutation) RuleID() (r int, exists bool) {
+	v := m.rule_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRuleID returns the old "rule_id" field's value of the RuleState entity.
+// If the RuleState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleStateMutation) OldRuleID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRuleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRuleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRuleID: %w", err)
+	}
+	return oldValue.RuleID, nil
+}
+
+// AddRuleID adds i to the "rule_id" field.
+func (m *RuleStateMutation) AddRuleID(i int) {
+	if m.addrule_id != nil {
+		*m.addrule_id += i
+	} else {
+		m.addrule_id = &i
+	}
+}
+
+// AddedRuleID returns the value that was added to the "rule_id" field in this mutation.
+func (m *RuleStateMutation) AddedRuleID() (r int, exists bool) {
+	v := m.addrule_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetRuleID resets all changes to the "rule_id" field.
+func (m *RuleStateMutation) ResetRuleID() {
+	m.rule_id = nil
+	m.addrule_id = nil
+}
+
+// SetPendingSince sets the "pending_since" field.
+func (m *RuleStateMutation) SetPendingSince(t time.Time) {
+	m.pending_since = &t
+}
+
+// PendingSince returns the value of the "pending_since" field in the mutation.
+func (m *RuleStateMutation) PendingSince() (r time.Time, exists bool) {
+	v := m.pending_since
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingSince returns the old "pending_since" field's value of the RuleState entity.
+// If the RuleState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleStateMutation) OldPendingSince(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingSince is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingSince requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingSince: %w", err)
+	}
+	return oldValue.PendingSince, nil
+}
+
+// ClearPendingSince clears the value of the "pending_since" field.
+func (m *RuleStateMutation) ClearPendingSince() {
+	m.pending_since = nil
+	m.clearedFields[rulestate.FieldPendingSince] = struct{}{}
+}
+
+// PendingSinceCleared returns if the "pending_since" field was cleared in this mutation.
+func (m *RuleStateMutation) PendingSinceCleared() bool {
+	_, ok := m.clearedFields[rulestate.FieldPendingSince]
+	return ok
+}
+
+// ResetPendingSince resets all changes to the "pending_since" field.
+func (m *RuleStateMutation) ResetPendingSince() {
+	m.pending_since = nil
+	delete(m.clearedFields, rulestate.FieldPendingSince)
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (m *RuleStateMutation) SetPendingReadings(i int) {
+	m.pending_readings = &i
+	m.addpending_readings = nil
+}
+
+// PendingReadings returns the value of the "pending_readings" field in the mutation.
+func (m *RuleStateMutation) PendingReadings() (r int, exists bool) {
+	v := m.pending_readings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPendingReadings returns the old "pending_readings" field's value of the RuleState entity.
+// If the RuleState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleStateMutation) OldPendingReadings(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPendingReadings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPendingReadings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPendingReadings: %w", err)
+	}
+	return oldValue.PendingReadings, nil
+}
+
+// AddPendingReadings adds i to the "pending_readings" field.
+func (m *RuleStateMutation) AddPendingReadings(i int) {
+	if m.addpending_readings != nil {
+		*m.addpending_readings += i
+	} else {
+		m.addpending_readings = &i
+	}
+}
+
+// AddedPendingReadings returns the value that was added to the "pending_readings" field in this mutation.
+func (m *RuleStateMutation) AddedPendingReadings() (r int, exists bool) {
+	v := m.addpending_readings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPendingReadings resets all changes to the "pending_readings" field.
+func (m *RuleStateMutation) ResetPendingReadings() {
+	m.pending_readings = nil
+	m.addpending_readings = nil
+}
+
+// SetFiring sets the "firing" field.
+func (m *RuleStateMutation) SetFiring(b bool) {
+	m.firing = &b
+}
+
+// Firing returns the value of the "firing" field in the mutation.
+func (m *RuleStateMutation) Firing() (r bool, exists bool) {
+	v := m.firing
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFiring returns the old "firing" field's value of the RuleState entity.
+// If the RuleState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleStateMutation) OldFiring(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFiring is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFiring requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFiring: %w", err)
+	}
+	return oldValue.Firing, nil
+}
+
+// ResetFiring resets all changes to the "firing" field.
+func (m *RuleStateMutation) ResetFiring() {
+	m.firing = nil
+}
+
+// SetVersion sets the "version" field.
+func (m *RuleStateMutation) SetVersion(i int) {
+	m.version = &i
+	m.addversion = nil
+}
+
+// Version returns the value of the "version" field in the mutation.
+func (m *RuleStateMutation) Version() (r int, exists bool) {
+	v := m.version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVersion returns the old "version" field's value of the RuleState entity.
+// If the RuleState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleStateMutation) OldVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+	}
+	return oldValue.Version, nil
+}
+
+// AddVersion adds i to the "version" field.
+func (m *RuleStateMutation) AddVersion(i int) {
+	if m.addversion != nil {
+		*m.addversion += i
+	} else {
+		m.addversion = &i
+	}
+}
+
+// AddedVersion returns the value that was added to the "version" field in this mutation.
+func (m *RuleStateMutation) AddedVersion() (r int, exists bool) {
+	v := m.addversion
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVersion resets all changes to the "version" field.
+func (m *RuleStateMutation) ResetVersion() {
+	m.version = nil
+	m.addversion = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RuleStateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RuleStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RuleState entity.
+// If the RuleState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RuleStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RuleStateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RuleStateMutation builder.
+func (m *RuleStateMutation) Where(ps ...predicate.RuleState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RuleStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RuleStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RuleState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RuleStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RuleStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RuleState).
+func (m *RuleStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RuleStateMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.rule_id != nil {
+		fields = append(fields, rulestate.FieldRuleID)
+	}
+	if m.pending_since != nil {
+		fields = append(fields, rulestate.FieldPendingSince)
+	}
+	if m.pending_readings != nil {
+		fields = append(fields, rulestate.FieldPendingReadings)
+	}
+	if m.firing != nil {
+		fields = append(fields, rulestate.FieldFiring)
+	}
+	if m.version != nil {
+		fields = append(fields, rulestate.FieldVersion)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, rulestate.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1311,24 +4074,20 @@ func (m *AlertRuleMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *AlertRuleMutation) Field(name string) (ent.Value, bool) {
+func (m *RuleStateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case alertrule.FieldName:
-		return m.Name()
-	case alertrule.FieldUserID:
-		return m.UserID()
-	case alertrule.FieldSensorID:
-		return m.SensorID()
-	case alertrule.FieldConditionType:
-		return m.ConditionType()
-	case alertrule.FieldThreshold:
-		return m.Threshold()
-	case alertrule.FieldDescription:
-		return m.Description()
-	case alertrule.FieldIsEnabled:
-		return m.IsEnabled()
-	case alertrule.FieldCreatedAt:
-		return m.CreatedAt()
+	case rulestate.FieldRuleID:
+		return m.RuleID()
+	case rulestate.FieldPendingSince:
+		return m.PendingSince()
+	case rulestate.FieldPendingReadings:
+		return m.PendingReadings()
+	case rulestate.FieldFiring:
+		return m.Firing()
+	case rulestate.FieldVersion:
+		return m.Version()
+	case rulestate.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1336,105 +4095,87 @@ func (m *AlertRuleMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *AlertRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *RuleStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case alertrule.FieldName:
-		return m.OldName(ctx)
-	case alertrule.FieldUserID:
-		return m.OldUserID(ctx)
-	case alertrule.FieldSensorID:
-		return m.OldSensorID(ctx)
-	case alertrule.FieldConditionType:
-		return m.OldConditionType(ctx)
-	case alertrule.FieldThreshold:
-		return m.OldThreshold(ctx)
-	case alertrule.FieldDescription:
-		return m.OldDescription(ctx)
-	case alertrule.FieldIsEnabled:
-		return m.OldIsEnabled(ctx)
-	case alertrule.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	}
-	return nil, fmt.Errorf("unknown AlertRule field %s", name)
+	case rulestate.FieldRuleID:
+		return m.OldRuleID(ctx)
+	case rulestate.FieldPendingSince:
+		return m.OldPendingSince(ctx)
+	case rulestate.FieldPendingReadings:
+		return m.OldPendingReadings(ctx)
+	case rulestate.FieldFiring:
+		return m.OldFiring(ctx)
+	case rulestate.FieldVersion:
+		return m.OldVersion(ctx)
+	case rulestate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RuleState field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AlertRuleMutation) SetField(name string, value ent.Value) error {
+func (m *RuleStateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case alertrule.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case alertrule.FieldUserID:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case alertrule.FieldSensorID:
-		v, ok := value.(int64)
+	case rulestate.FieldRuleID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSensorID(v)
+		m.SetRuleID(v)
 		return nil
-	case alertrule.FieldConditionType:
-		v, ok := value.(string)
+	case rulestate.FieldPendingSince:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetConditionType(v)
+		m.SetPendingSince(v)
 		return nil
-	case alertrule.FieldThreshold:
-		v, ok := value.(float64)
+	case rulestate.FieldPendingReadings:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThreshold(v)
+		m.SetPendingReadings(v)
 		return nil
-	case alertrule.FieldDescription:
-		v, ok := value.(string)
+	case rulestate.FieldFiring:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetFiring(v)
 		return nil
-	case alertrule.FieldIsEnabled:
-		v, ok := value.(bool)
+	case rulestate.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsEnabled(v)
+		m.SetVersion(v)
 		return nil
-	case alertrule.FieldCreatedAt:
+	case rulestate.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AlertRule field %s", name)
+	return fmt.Errorf("unknown RuleState field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *AlertRuleMutation) AddedFields() []string {
+func (m *RuleStateMutation) AddedFields() []string {
 	var fields []string
-	if m.adduser_id != nil {
-		fields = append(fields, alertrule.FieldUserID)
+	if m.addrule_id != nil {
+		fields = append(fields, rulestate.FieldRuleID)
 	}
-	if m.addsensor_id != nil {
-		fields = append(fields, alertrule.FieldSensorID)
+	if m.addpending_readings != nil {
+		fields = append(fields, rulestate.FieldPendingReadings)
 	}
-	if m.addthreshold != nil {
-		fields = append(fields, alertrule.FieldThreshold)
+	if m.addversion != nil {
+		fields = append(fields, rulestate.FieldVersion)
 	}
 	return fields
 }
@@ -1442,14 +4183,14 @@ func (m *AlertRuleMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *AlertRuleMutation) AddedField(name string) (ent.Value, bool) {
+func (m *RuleStateMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case alertrule.FieldUserID:
-		return m.AddedUserID()
-	case alertrule.FieldSensorID:
-		return m.AddedSensorID()
-	case alertrule.FieldThreshold:
-		return m.AddedThreshold()
+	case rulestate.FieldRuleID:
+		return m.AddedRuleID()
+	case rulestate.FieldPendingReadings:
+		return m.AddedPendingReadings()
+	case rulestate.FieldVersion:
+		return m.AddedVersion()
 	}
 	return nil, false
 }
@@ -1457,173 +4198,131 @@ func (m *AlertRuleMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *AlertRuleMutation) AddField(name string, value ent.Value) error {
+func (m *RuleStateMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case alertrule.FieldUserID:
-		v, ok := value.(int64)
+	case rulestate.FieldRuleID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddUserID(v)
+		m.AddRuleID(v)
 		return nil
-	case alertrule.FieldSensorID:
-		v, ok := value.(int64)
+	case rulestate.FieldPendingReadings:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddSensorID(v)
+		m.AddPendingReadings(v)
 		return nil
-	case alertrule.FieldThreshold:
-		v, ok := value.(float64)
+	case rulestate.FieldVersion:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddThreshold(v)
+		m.AddVersion(v)
 		return nil
 	}
-	return fmt.Errorf("unknown AlertRule numeric field %s", name)
+	return fmt.Errorf("unknown RuleState numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *AlertRuleMutation) ClearedFields() []string {
+func (m *RuleStateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(alertrule.FieldDescription) {
-		fields = append(fields, alertrule.FieldDescription)
+	if m.FieldCleared(rulestate.FieldPendingSince) {
+		fields = append(fields, rulestate.FieldPendingSince)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *AlertRuleMutation) FieldCleared(name string) bool {
+func (m *RuleStateMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *AlertRuleMutation) ClearField(name string) error {
+func (m *RuleStateMutation) ClearField(name string) error {
 	switch name {
-	case alertrule.FieldDescription:
-		m.ClearDescription()
+	case rulestate.FieldPendingSince:
+		m.ClearPendingSince()
 		return nil
 	}
-	return fmt.Errorf("unknown AlertRule nullable field %s", name)
+	return fmt.Errorf("unknown RuleState nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *AlertRuleMutation) ResetField(name string) error {
+func (m *RuleStateMutation) ResetField(name string) error {
 	switch name {
-	case alertrule.FieldName:
-		m.ResetName()
-		return nil
-	case alertrule.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case alertrule.FieldSensorID:
-		m.ResetSensorID()
+	case rulestate.FieldRuleID:
+		m.ResetRuleID()
 		return nil
-	case alertrule.FieldConditionType:
-		m.ResetConditionType()
+	case rulestate.FieldPendingSince:
+		m.ResetPendingSince()
 		return nil
-	case alertrule.FieldThreshold:
-		m.ResetThreshold()
+	case rulestate.FieldPendingReadings:
+		m.ResetPendingReadings()
 		return nil
-	case alertrule.FieldDescription:
-		m.ResetDescription()
+	case rulestate.FieldFiring:
+		m.ResetFiring()
 		return nil
-	case alertrule.FieldIsEnabled:
-		m.ResetIsEnabled()
+	case rulestate.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case alertrule.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case rulestate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown AlertRule field %s", name)
+	return fmt.Errorf("unknown RuleState field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *AlertRuleMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.alerts != nil {
-		edges = append(edges, alertrule.EdgeAlerts)
-	}
+func (m *RuleStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *AlertRuleMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case alertrule.EdgeAlerts:
-		ids := make([]ent.Value, 0, len(m.alerts))
-		for id := range m.alerts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RuleStateMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *AlertRuleMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedalerts != nil {
-		edges = append(edges, alertrule.EdgeAlerts)
-	}
+func (m *RuleStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *AlertRuleMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case alertrule.EdgeAlerts:
-		ids := make([]ent.Value, 0, len(m.removedalerts))
-		for id := range m.removedalerts {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *RuleStateMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *AlertRuleMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedalerts {
-		edges = append(edges, alertrule.EdgeAlerts)
-	}
+func (m *RuleStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *AlertRuleMutation) EdgeCleared(name string) bool {
-	switch name {
-	case alertrule.EdgeAlerts:
-		return m.clearedalerts
-	}
+func (m *RuleStateMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *AlertRuleMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown AlertRule unique edge %s", name)
+func (m *RuleStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RuleState unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *AlertRuleMutation) ResetEdge(name string) error {
-	switch name {
-	case alertrule.EdgeAlerts:
-		m.ResetAlerts()
-		return nil
-	}
-	return fmt.Errorf("unknown AlertRule edge %s", name)
+func (m *RuleStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RuleState edge %s", name)
 }
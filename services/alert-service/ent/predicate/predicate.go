@@ -11,3 +11,9 @@ type Alert func(*sql.Selector)
 
 // AlertRule is the predicate function for alertrule builders.
 type AlertRule func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
+// RuleState is the predicate function for rulestate builders.
+type RuleState func(*sql.Selector)
@@ -0,0 +1,164 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
+)
+
+// RuleState is the model entity for the RuleState schema.
+type RuleState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// RuleID holds the value of the "rule_id" field.
+	RuleID int `json:"rule_id,omitempty"`
+	// PendingSince holds the value of the "pending_since" field.
+	PendingSince *time.Time `json:"pending_since,omitempty"`
+	// PendingReadings holds the value of the "pending_readings" field.
+	PendingReadings int `json:"pending_readings,omitempty"`
+	// Firing holds the value of the "firing" field.
+	Firing bool `json:"firing,omitempty"`
+	// Version holds the value of the "version" field.
+	Version int `json:"version,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RuleState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case rulestate.FieldFiring:
+			values[i] = new(sql.NullBool)
+		case rulestate.FieldID, rulestate.FieldRuleID, rulestate.FieldPendingReadings, rulestate.FieldVersion:
+			values[i] = new(sql.NullInt64)
+		case rulestate.FieldPendingSince, rulestate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RuleState fields.
+func (rs *RuleState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case rulestate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			rs.ID = int(value.Int64)
+		case rulestate.FieldRuleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rule_id", values[i])
+			} else if value.Valid {
+				rs.RuleID = int(value.Int64)
+			}
+		case rulestate.FieldPendingSince:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_since", values[i])
+			} else if value.Valid {
+				rs.PendingSince = new(time.Time)
+				*rs.PendingSince = value.Time
+			}
+		case rulestate.FieldPendingReadings:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field pending_readings", values[i])
+			} else if value.Valid {
+				rs.PendingReadings = int(value.Int64)
+			}
+		case rulestate.FieldFiring:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field firing", values[i])
+			} else if value.Valid {
+				rs.Firing = value.Bool
+			}
+		case rulestate.FieldVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field version", values[i])
+			} else if value.Valid {
+				rs.Version = int(value.Int64)
+			}
+		case rulestate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				rs.UpdatedAt = value.Time
+			}
+		default:
+			rs.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RuleState.
+// This includes values selected through modifiers, order, etc.
+func (rs *RuleState) Value(name string) (ent.Value, error) {
+	return rs.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RuleState.
+// Note that you need to call RuleState.Unwrap() before calling this method if this RuleState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (rs *RuleState) Update() *RuleStateUpdateOne {
+	return NewRuleStateClient(rs.config).UpdateOne(rs)
+}
+
+// Unwrap unwraps the RuleState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (rs *RuleState) Unwrap() *RuleState {
+	_tx, ok := rs.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RuleState is not a transactional entity")
+	}
+	rs.config.driver = _tx.drv
+	return rs
+}
+
+// String implements the fmt.Stringer.
+func (rs *RuleState) String() string {
+	var builder strings.Builder
+	builder.WriteString("RuleState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", rs.ID))
+	builder.WriteString("rule_id=")
+	builder.WriteString(fmt.Sprintf("%v", rs.RuleID))
+	builder.WriteString(", ")
+	if v := rs.PendingSince; v != nil {
+		builder.WriteString("pending_since=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("pending_readings=")
+	builder.WriteString(fmt.Sprintf("%v", rs.PendingReadings))
+	builder.WriteString(", ")
+	builder.WriteString("firing=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Firing))
+	builder.WriteString(", ")
+	builder.WriteString("version=")
+	builder.WriteString(fmt.Sprintf("%v", rs.Version))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(rs.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RuleStates is a parsable slice of RuleState.
+type RuleStates []*RuleState
@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rulestate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+)
+
+const (
+	// Label holds the string label denoting the rulestate type in the database.
+	Label = "rule_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldRuleID holds the string denoting the rule_id field in the database.
+	FieldRuleID = "rule_id"
+	// FieldPendingSince holds the string denoting the pending_since field in the database.
+	FieldPendingSince = "pending_since"
+	// FieldPendingReadings holds the string denoting the pending_readings field in the database.
+	FieldPendingReadings = "pending_readings"
+	// FieldFiring holds the string denoting the firing field in the database.
+	FieldFiring = "firing"
+	// FieldVersion holds the string denoting the version field in the database.
+	FieldVersion = "version"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the rulestate in the database.
+	Table = "rule_states"
+)
+
+// Columns holds all SQL columns for rulestate fields.
+var Columns = []string{
+	FieldID,
+	FieldRuleID,
+	FieldPendingSince,
+	FieldPendingReadings,
+	FieldFiring,
+	FieldVersion,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultPendingReadings holds the default value on creation for the "pending_readings" field.
+	DefaultPendingReadings int
+	// DefaultFiring holds the default value on creation for the "firing" field.
+	DefaultFiring bool
+	// DefaultVersion holds the default value on creation for the "version" field.
+	DefaultVersion int
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the RuleState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByRuleID orders the results by the rule_id field.
+func ByRuleID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRuleID, opts...).ToFunc()
+}
+
+// ByPendingSince orders the results by the pending_since field.
+func ByPendingSince(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingSince, opts...).ToFunc()
+}
+
+// ByPendingReadings orders the results by the pending_readings field.
+func ByPendingReadings(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPendingReadings, opts...).ToFunc()
+}
+
+// ByFiring orders the results by the firing field.
+func ByFiring(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFiring, opts...).ToFunc()
+}
+
+// ByVersion orders the results by the version field.
+func ByVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVersion, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rulestate
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLTE(FieldID, id))
+}
+
+// RuleID applies equality check predicate on the "rule_id" field. It's identical to RuleIDEQ.
+func RuleID(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldRuleID, v))
+}
+
+// PendingSince applies equality check predicate on the "pending_since" field. It's identical to PendingSinceEQ.
+func PendingSince(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldPendingSince, v))
+}
+
+// PendingReadings applies equality check predicate on the "pending_readings" field. It's identical to PendingReadingsEQ.
+func PendingReadings(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldPendingReadings, v))
+}
+
+// Firing applies equality check predicate on the "firing" field. It's identical to FiringEQ.
+func Firing(v bool) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldFiring, v))
+}
+
+// Version applies equality check predicate on the "version" field. It's identical to VersionEQ.
+func Version(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldVersion, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// RuleIDEQ applies the EQ predicate on the "rule_id" field.
+func RuleIDEQ(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldRuleID, v))
+}
+
+// RuleIDNEQ applies the NEQ predicate on the "rule_id" field.
+func RuleIDNEQ(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNEQ(FieldRuleID, v))
+}
+
+// RuleIDIn applies the In predicate on the "rule_id" field.
+func RuleIDIn(vs ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldIn(FieldRuleID, vs...))
+}
+
+// RuleIDNotIn applies the NotIn predicate on the "rule_id" field.
+func RuleIDNotIn(vs ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNotIn(FieldRuleID, vs...))
+}
+
+// RuleIDGT applies the GT predicate on the "rule_id" field.
+func RuleIDGT(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGT(FieldRuleID, v))
+}
+
+// RuleIDGTE applies the GTE predicate on the "rule_id" field.
+func RuleIDGTE(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGTE(FieldRuleID, v))
+}
+
+// RuleIDLT applies the LT predicate on the "rule_id" field.
+func RuleIDLT(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLT(FieldRuleID, v))
+}
+
+// RuleIDLTE applies the LTE predicate on the "rule_id" field.
+func RuleIDLTE(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLTE(FieldRuleID, v))
+}
+
+// PendingSinceEQ applies the EQ predicate on the "pending_since" field.
+func PendingSinceEQ(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldPendingSince, v))
+}
+
+// PendingSinceNEQ applies the NEQ predicate on the "pending_since" field.
+func PendingSinceNEQ(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNEQ(FieldPendingSince, v))
+}
+
+// PendingSinceIn applies the In predicate on the "pending_since" field.
+func PendingSinceIn(vs ...time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldIn(FieldPendingSince, vs...))
+}
+
+// PendingSinceNotIn applies the NotIn predicate on the "pending_since" field.
+func PendingSinceNotIn(vs ...time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNotIn(FieldPendingSince, vs...))
+}
+
+// PendingSinceGT applies the GT predicate on the "pending_since" field.
+func PendingSinceGT(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGT(FieldPendingSince, v))
+}
+
+// PendingSinceGTE applies the GTE predicate on the "pending_since" field.
+func PendingSinceGTE(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGTE(FieldPendingSince, v))
+}
+
+// PendingSinceLT applies the LT predicate on the "pending_since" field.
+func PendingSinceLT(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLT(FieldPendingSince, v))
+}
+
+// PendingSinceLTE applies the LTE predicate on the "pending_since" field.
+func PendingSinceLTE(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLTE(FieldPendingSince, v))
+}
+
+// PendingSinceIsNil applies the IsNil predicate on the "pending_since" field.
+func PendingSinceIsNil() predicate.RuleState {
+	return predicate.RuleState(sql.FieldIsNull(FieldPendingSince))
+}
+
+// PendingSinceNotNil applies the NotNil predicate on the "pending_since" field.
+func PendingSinceNotNil() predicate.RuleState {
+	return predicate.RuleState(sql.FieldNotNull(FieldPendingSince))
+}
+
+// PendingReadingsEQ applies the EQ predicate on the "pending_readings" field.
+func PendingReadingsEQ(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldPendingReadings, v))
+}
+
+// PendingReadingsNEQ applies the NEQ predicate on the "pending_readings" field.
+func PendingReadingsNEQ(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNEQ(FieldPendingReadings, v))
+}
+
+// PendingReadingsIn applies the In predicate on the "pending_readings" field.
+func PendingReadingsIn(vs ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldIn(FieldPendingReadings, vs...))
+}
+
+// PendingReadingsNotIn applies the NotIn predicate on the "pending_readings" field.
+func PendingReadingsNotIn(vs ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNotIn(FieldPendingReadings, vs...))
+}
+
+// PendingReadingsGT applies the GT predicate on the "pending_readings" field.
+func PendingReadingsGT(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGT(FieldPendingReadings, v))
+}
+
+// PendingReadingsGTE applies the GTE predicate on the "pending_readings" field.
+func PendingReadingsGTE(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGTE(FieldPendingReadings, v))
+}
+
+// PendingReadingsLT applies the LT predicate on the "pending_readings" field.
+func PendingReadingsLT(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLT(FieldPendingReadings, v))
+}
+
+// PendingReadingsLTE applies the LTE predicate on the "pending_readings" field.
+func PendingReadingsLTE(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLTE(FieldPendingReadings, v))
+}
+
+// FiringEQ applies the EQ predicate on the "firing" field.
+func FiringEQ(v bool) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldFiring, v))
+}
+
+// FiringNEQ applies the NEQ predicate on the "firing" field.
+func FiringNEQ(v bool) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNEQ(FieldFiring, v))
+}
+
+// VersionEQ applies the EQ predicate on the "version" field.
+func VersionEQ(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldVersion, v))
+}
+
+// VersionNEQ applies the NEQ predicate on the "version" field.
+func VersionNEQ(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNEQ(FieldVersion, v))
+}
+
+// VersionIn applies the In predicate on the "version" field.
+func VersionIn(vs ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldIn(FieldVersion, vs...))
+}
+
+// VersionNotIn applies the NotIn predicate on the "version" field.
+func VersionNotIn(vs ...int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNotIn(FieldVersion, vs...))
+}
+
+// VersionGT applies the GT predicate on the "version" field.
+func VersionGT(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGT(FieldVersion, v))
+}
+
+// VersionGTE applies the GTE predicate on the "version" field.
+func VersionGTE(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGTE(FieldVersion, v))
+}
+
+// VersionLT applies the LT predicate on the "version" field.
+func VersionLT(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLT(FieldVersion, v))
+}
+
+// VersionLTE applies the LTE predicate on the "version" field.
+func VersionLTE(v int) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLTE(FieldVersion, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RuleState {
+	return predicate.RuleState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RuleState) predicate.RuleState {
+	return predicate.RuleState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RuleState) predicate.RuleState {
+	return predicate.RuleState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RuleState) predicate.RuleState {
+	return predicate.RuleState(sql.NotPredicates(p))
+}
@@ -0,0 +1,308 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
+)
+
+// RuleStateCreate is the builder for creating a RuleState entity.
+type RuleStateCreate struct {
+	config
+	mutation *RuleStateMutation
+	hooks    []Hook
+}
+
+// SetRuleID sets the "rule_id" field.
+func (rsc *RuleStateCreate) SetRuleID(i int) *RuleStateCreate {
+	rsc.mutation.SetRuleID(i)
+	return rsc
+}
+
+// SetPendingSince sets the "pending_since" field.
+func (rsc *RuleStateCreate) SetPendingSince(t time.Time) *RuleStateCreate {
+	rsc.mutation.SetPendingSince(t)
+	return rsc
+}
+
+// SetNillablePendingSince sets the "pending_since" field if the given value is not nil.
+func (rsc *RuleStateCreate) SetNillablePendingSince(t *time.Time) *RuleStateCreate {
+	if t != nil {
+		rsc.SetPendingSince(*t)
+	}
+	return rsc
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (rsc *RuleStateCreate) SetPendingReadings(i int) *RuleStateCreate {
+	rsc.mutation.SetPendingReadings(i)
+	return rsc
+}
+
+// SetNillablePendingReadings sets the "pending_readings" field if the given value is not nil.
+func (rsc *RuleStateCreate) SetNillablePendingReadings(i *int) *RuleStateCreate {
+	if i != nil {
+		rsc.SetPendingReadings(*i)
+	}
+	return rsc
+}
+
+// SetFiring sets the "firing" field.
+func (rsc *RuleStateCreate) SetFiring(b bool) *RuleStateCreate {
+	rsc.mutation.SetFiring(b)
+	return rsc
+}
+
+// SetNillableFiring sets the "firing" field if the given value is not nil.
+func (rsc *RuleStateCreate) SetNillableFiring(b *bool) *RuleStateCreate {
+	if b != nil {
+		rsc.SetFiring(*b)
+	}
+	return rsc
+}
+
+// SetVersion sets the "version" field.
+func (rsc *RuleStateCreate) SetVersion(i int) *RuleStateCreate {
+	rsc.mutation.SetVersion(i)
+	return rsc
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (rsc *RuleStateCreate) SetNillableVersion(i *int) *RuleStateCreate {
+	if i != nil {
+		rsc.SetVersion(*i)
+	}
+	return rsc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsc *RuleStateCreate) SetUpdatedAt(t time.Time) *RuleStateCreate {
+	rsc.mutation.SetUpdatedAt(t)
+	return rsc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (rsc *RuleStateCreate) SetNillableUpdatedAt(t *time.Time) *RuleStateCreate {
+	if t != nil {
+		rsc.SetUpdatedAt(*t)
+	}
+	return rsc
+}
+
+// Mutation returns the RuleStateMutation object of the builder.
+func (rsc *RuleStateCreate) Mutation() *RuleStateMutation {
+	return rsc.mutation
+}
+
+// Save creates the RuleState in the database.
+func (rsc *RuleStateCreate) Save(ctx context.Context) (*RuleState, error) {
+	rsc.defaults()
+	return withHooks(ctx, rsc.sqlSave, rsc.mutation, rsc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (rsc *RuleStateCreate) SaveX(ctx context.Context) *RuleState {
+	v, err := rsc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rsc *RuleStateCreate) Exec(ctx context.Context) error {
+	_, err := rsc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsc *RuleStateCreate) ExecX(ctx context.Context) {
+	if err := rsc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsc *RuleStateCreate) defaults() {
+	if _, ok := rsc.mutation.PendingReadings(); !ok {
+		v := rulestate.DefaultPendingReadings
+		rsc.mutation.SetPendingReadings(v)
+	}
+	if _, ok := rsc.mutation.Firing(); !ok {
+		v := rulestate.DefaultFiring
+		rsc.mutation.SetFiring(v)
+	}
+	if _, ok := rsc.mutation.Version(); !ok {
+		v := rulestate.DefaultVersion
+		rsc.mutation.SetVersion(v)
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		v := rulestate.DefaultUpdatedAt()
+		rsc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (rsc *RuleStateCreate) check() error {
+	if _, ok := rsc.mutation.RuleID(); !ok {
+		return &ValidationError{Name: "rule_id", err: errors.New(`ent: missing required field "RuleState.rule_id"`)}
+	}
+	if _, ok := rsc.mutation.PendingReadings(); !ok {
+		return &ValidationError{Name: "pending_readings", err: errors.New(`ent: missing required field "RuleState.pending_readings"`)}
+	}
+	if _, ok := rsc.mutation.Firing(); !ok {
+		return &ValidationError{Name: "firing", err: errors.New(`ent: missing required field "RuleState.firing"`)}
+	}
+	if _, ok := rsc.mutation.Version(); !ok {
+		return &ValidationError{Name: "version", err: errors.New(`ent: missing required field "RuleState.version"`)}
+	}
+	if _, ok := rsc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RuleState.updated_at"`)}
+	}
+	return nil
+}
+
+func (rsc *RuleStateCreate) sqlSave(ctx context.Context) (*RuleState, error) {
+	if err := rsc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := rsc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, rsc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	rsc.mutation.id = &_node.ID
+	rsc.mutation.done = true
+	return _node, nil
+}
+
+func (rsc *RuleStateCreate) createSpec() (*RuleState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RuleState{config: rsc.config}
+		_spec = sqlgraph.NewCreateSpec(rulestate.Table, sqlgraph.NewFieldSpec(rulestate.FieldID, field.TypeInt))
+	)
+	if value, ok := rsc.mutation.RuleID(); ok {
+		_spec.SetField(rulestate.FieldRuleID, field.TypeInt, value)
+		_node.RuleID = value
+	}
+	if value, ok := rsc.mutation.PendingSince(); ok {
+		_spec.SetField(rulestate.FieldPendingSince, field.TypeTime, value)
+		_node.PendingSince = &value
+	}
+	if value, ok := rsc.mutation.PendingReadings(); ok {
+		_spec.SetField(rulestate.FieldPendingReadings, field.TypeInt, value)
+		_node.PendingReadings = value
+	}
+	if value, ok := rsc.mutation.Firing(); ok {
+		_spec.SetField(rulestate.FieldFiring, field.TypeBool, value)
+		_node.Firing = value
+	}
+	if value, ok := rsc.mutation.Version(); ok {
+		_spec.SetField(rulestate.FieldVersion, field.TypeInt, value)
+		_node.Version = value
+	}
+	if value, ok := rsc.mutation.UpdatedAt(); ok {
+		_spec.SetField(rulestate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RuleStateCreateBulk is the builder for creating many RuleState entities in bulk.
+type RuleStateCreateBulk struct {
+	config
+	err      error
+	builders []*RuleStateCreate
+}
+
+// Save creates the RuleState entities in the database.
+func (rscb *RuleStateCreateBulk) Save(ctx context.Context) ([]*RuleState, error) {
+	if rscb.err != nil {
+		return nil, rscb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(rscb.builders))
+	nodes := make([]*RuleState, len(rscb.builders))
+	mutators := make([]Mutator, len(rscb.builders))
+	for i := range rscb.builders {
+		func(i int, root context.Context) {
+			builder := rscb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RuleStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, rscb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, rscb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, rscb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rscb *RuleStateCreateBulk) SaveX(ctx context.Context) []*RuleState {
+	v, err := rscb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (rscb *RuleStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := rscb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rscb *RuleStateCreateBulk) ExecX(ctx context.Context) {
+	if err := rscb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/predicate"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
+)
+
+// RuleStateDelete is the builder for deleting a RuleState entity.
+type RuleStateDelete struct {
+	config
+	hooks    []Hook
+	mutation *RuleStateMutation
+}
+
+// Where appends a list predicates to the RuleStateDelete builder.
+func (rsd *RuleStateDelete) Where(ps ...predicate.RuleState) *RuleStateDelete {
+	rsd.mutation.Where(ps...)
+	return rsd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (rsd *RuleStateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, rsd.sqlExec, rsd.mutation, rsd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsd *RuleStateDelete) ExecX(ctx context.Context) int {
+	n, err := rsd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (rsd *RuleStateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(rulestate.Table, sqlgraph.NewFieldSpec(rulestate.FieldID, field.TypeInt))
+	if ps := rsd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, rsd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	rsd.mutation.done = true
+	return affected, err
+}
+
+// RuleStateDeleteOne is the builder for deleting a single RuleState entity.
+type RuleStateDeleteOne struct {
+	rsd *RuleStateDelete
+}
+
+// Where appends a list predicates to the RuleStateDelete builder.
+func (rsdo *RuleStateDeleteOne) Where(ps ...predicate.RuleState) *RuleStateDeleteOne {
+	rsdo.rsd.mutation.Where(ps...)
+	return rsdo
+}
+
+// Exec executes the deletion query.
+func (rsdo *RuleStateDeleteOne) Exec(ctx context.Context) error {
+	n, err := rsdo.rsd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{rulestate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsdo *RuleStateDeleteOne) ExecX(ctx context.Context) {
+	if err := rsdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
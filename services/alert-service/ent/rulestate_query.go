@@ -0,0 +1,527 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/predicate"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
+)
+
+// RuleStateQuery is the builder for querying RuleState entities.
+type RuleStateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []rulestate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.RuleState
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the RuleStateQuery builder.
+func (rsq *RuleStateQuery) Where(ps ...predicate.RuleState) *RuleStateQuery {
+	rsq.predicates = append(rsq.predicates, ps...)
+	return rsq
+}
+
+// Limit the number of records to be returned by this query.
+func (rsq *RuleStateQuery) Limit(limit int) *RuleStateQuery {
+	rsq.ctx.Limit = &limit
+	return rsq
+}
+
+// Offset to start from.
+func (rsq *RuleStateQuery) Offset(offset int) *RuleStateQuery {
+	rsq.ctx.Offset = &offset
+	return rsq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (rsq *RuleStateQuery) Unique(unique bool) *RuleStateQuery {
+	rsq.ctx.Unique = &unique
+	return rsq
+}
+
+// Order specifies how the records should be ordered.
+func (rsq *RuleStateQuery) Order(o ...rulestate.OrderOption) *RuleStateQuery {
+	rsq.order = append(rsq.order, o...)
+	return rsq
+}
+
+// First returns the first RuleState entity from the query.
+// Returns a *NotFoundError when no RuleState was found.
+func (rsq *RuleStateQuery) First(ctx context.Context) (*RuleState, error) {
+	nodes, err := rsq.Limit(1).All(setContextOp(ctx, rsq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{rulestate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (rsq *RuleStateQuery) FirstX(ctx context.Context) *RuleState {
+	node, err := rsq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first RuleState ID from the query.
+// Returns a *NotFoundError when no RuleState ID was found.
+func (rsq *RuleStateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rsq.Limit(1).IDs(setContextOp(ctx, rsq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{rulestate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (rsq *RuleStateQuery) FirstIDX(ctx context.Context) int {
+	id, err := rsq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single RuleState entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one RuleState entity is found.
+// Returns a *NotFoundError when no RuleState entities are found.
+func (rsq *RuleStateQuery) Only(ctx context.Context) (*RuleState, error) {
+	nodes, err := rsq.Limit(2).All(setContextOp(ctx, rsq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{rulestate.Label}
+	default:
+		return nil, &NotSingularError{rulestate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (rsq *RuleStateQuery) OnlyX(ctx context.Context) *RuleState {
+	node, err := rsq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only RuleState ID in the query.
+// Returns a *NotSingularError when more than one RuleState ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (rsq *RuleStateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = rsq.Limit(2).IDs(setContextOp(ctx, rsq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{rulestate.Label}
+	default:
+		err = &NotSingularError{rulestate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (rsq *RuleStateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := rsq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of RuleStates.
+func (rsq *RuleStateQuery) All(ctx context.Context) ([]*RuleState, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryAll)
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*RuleState, *RuleStateQuery]()
+	return withInterceptors[[]*RuleState](ctx, rsq, qr, rsq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (rsq *RuleStateQuery) AllX(ctx context.Context) []*RuleState {
+	nodes, err := rsq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of RuleState IDs.
+func (rsq *RuleStateQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if rsq.ctx.Unique == nil && rsq.path != nil {
+		rsq.Unique(true)
+	}
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryIDs)
+	if err = rsq.Select(rulestate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (rsq *RuleStateQuery) IDsX(ctx context.Context) []int {
+	ids, err := rsq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (rsq *RuleStateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryCount)
+	if err := rsq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, rsq, querierCount[*RuleStateQuery](), rsq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (rsq *RuleStateQuery) CountX(ctx context.Context) int {
+	count, err := rsq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (rsq *RuleStateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, rsq.ctx, ent.OpQueryExist)
+	switch _, err := rsq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (rsq *RuleStateQuery) ExistX(ctx context.Context) bool {
+	exist, err := rsq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the RuleStateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (rsq *RuleStateQuery) Clone() *RuleStateQuery {
+	if rsq == nil {
+		return nil
+	}
+	return &RuleStateQuery{
+		config:     rsq.config,
+		ctx:        rsq.ctx.Clone(),
+		order:      append([]rulestate.OrderOption{}, rsq.order...),
+		inters:     append([]Interceptor{}, rsq.inters...),
+		predicates: append([]predicate.RuleState{}, rsq.predicates...),
+		// clone intermediate query.
+		sql:  rsq.sql.Clone(),
+		path: rsq.path,
+	}
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		RuleID int `json:"rule_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.RuleState.Query().
+//		GroupBy(rulestate.FieldRuleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (rsq *RuleStateQuery) GroupBy(field string, fields ...string) *RuleStateGroupBy {
+	rsq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &RuleStateGroupBy{build: rsq}
+	grbuild.flds = &rsq.ctx.Fields
+	grbuild.label = rulestate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		RuleID int `json:"rule_id,omitempty"`
+//	}
+//
+//	client.RuleState.Query().
+//		Select(rulestate.FieldRuleID).
+//		Scan(ctx, &v)
+func (rsq *RuleStateQuery) Select(fields ...string) *RuleStateSelect {
+	rsq.ctx.Fields = append(rsq.ctx.Fields, fields...)
+	sbuild := &RuleStateSelect{RuleStateQuery: rsq}
+	sbuild.label = rulestate.Label
+	sbuild.flds, sbuild.scan = &rsq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a RuleStateSelect configured with the given aggregations.
+func (rsq *RuleStateQuery) Aggregate(fns ...AggregateFunc) *RuleStateSelect {
+	return rsq.Select().Aggregate(fns...)
+}
+
+func (rsq *RuleStateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range rsq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, rsq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range rsq.ctx.Fields {
+		if !rulestate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if rsq.path != nil {
+		prev, err := rsq.path(ctx)
+		if err != nil {
+			return err
+		}
+		rsq.sql = prev
+	}
+	return nil
+}
+
+func (rsq *RuleStateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*RuleState, error) {
+	var (
+		nodes = []*RuleState{}
+		_spec = rsq.querySpec()
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*RuleState).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &RuleState{config: rsq.config}
+		nodes = append(nodes, node)
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, rsq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	return nodes, nil
+}
+
+func (rsq *RuleStateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := rsq.querySpec()
+	_spec.Node.Columns = rsq.ctx.Fields
+	if len(rsq.ctx.Fields) > 0 {
+		_spec.Unique = rsq.ctx.Unique != nil && *rsq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, rsq.driver, _spec)
+}
+
+func (rsq *RuleStateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(rulestate.Table, rulestate.Columns, sqlgraph.NewFieldSpec(rulestate.FieldID, field.TypeInt))
+	_spec.From = rsq.sql
+	if unique := rsq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if rsq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := rsq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rulestate.FieldID)
+		for i := range fields {
+			if fields[i] != rulestate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+	}
+	if ps := rsq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := rsq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := rsq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := rsq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (rsq *RuleStateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(rsq.driver.Dialect())
+	t1 := builder.Table(rulestate.Table)
+	columns := rsq.ctx.Fields
+	if len(columns) == 0 {
+		columns = rulestate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if rsq.sql != nil {
+		selector = rsq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if rsq.ctx.Unique != nil && *rsq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range rsq.predicates {
+		p(selector)
+	}
+	for _, p := range rsq.order {
+		p(selector)
+	}
+	if offset := rsq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := rsq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// RuleStateGroupBy is the group-by builder for RuleState entities.
+type RuleStateGroupBy struct {
+	selector
+	build *RuleStateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (rsgb *RuleStateGroupBy) Aggregate(fns ...AggregateFunc) *RuleStateGroupBy {
+	rsgb.fns = append(rsgb.fns, fns...)
+	return rsgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rsgb *RuleStateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rsgb.build.ctx, ent.OpQueryGroupBy)
+	if err := rsgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RuleStateQuery, *RuleStateGroupBy](ctx, rsgb.build, rsgb, rsgb.build.inters, v)
+}
+
+func (rsgb *RuleStateGroupBy) sqlScan(ctx context.Context, root *RuleStateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(rsgb.fns))
+	for _, fn := range rsgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*rsgb.flds)+len(rsgb.fns))
+		for _, f := range *rsgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*rsgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rsgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// RuleStateSelect is the builder for selecting fields of RuleState entities.
+type RuleStateSelect struct {
+	*RuleStateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (rss *RuleStateSelect) Aggregate(fns ...AggregateFunc) *RuleStateSelect {
+	rss.fns = append(rss.fns, fns...)
+	return rss
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (rss *RuleStateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, rss.ctx, ent.OpQuerySelect)
+	if err := rss.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*RuleStateQuery, *RuleStateSelect](ctx, rss.RuleStateQuery, rss, rss.inters, v)
+}
+
+func (rss *RuleStateSelect) sqlScan(ctx context.Context, root *RuleStateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(rss.fns))
+	for _, fn := range rss.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*rss.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := rss.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
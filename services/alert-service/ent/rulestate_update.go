@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/predicate"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
+)
+
+// RuleStateUpdate is the builder for updating RuleState entities.
+type RuleStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RuleStateMutation
+}
+
+// Where appends a list predicates to the RuleStateUpdate builder.
+func (rsu *RuleStateUpdate) Where(ps ...predicate.RuleState) *RuleStateUpdate {
+	rsu.mutation.Where(ps...)
+	return rsu
+}
+
+// SetRuleID sets the "rule_id" field.
+func (rsu *RuleStateUpdate) SetRuleID(i int) *RuleStateUpdate {
+	rsu.mutation.ResetRuleID()
+	rsu.mutation.SetRuleID(i)
+	return rsu
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (rsu *RuleStateUpdate) SetNillableRuleID(i *int) *RuleStateUpdate {
+	if i != nil {
+		rsu.SetRuleID(*i)
+	}
+	return rsu
+}
+
+// AddRuleID adds i to the "rule_id" field.
+func (rsu *RuleStateUpdate) AddRuleID(i int) *RuleStateUpdate {
+	rsu.mutation.AddRuleID(i)
+	return rsu
+}
+
+// SetPendingSince sets the "pending_since" field.
+func (rsu *RuleStateUpdate) SetPendingSince(t time.Time) *RuleStateUpdate {
+	rsu.mutation.SetPendingSince(t)
+	return rsu
+}
+
+// SetNillablePendingSince sets the "pending_since" field if the given value is not nil.
+func (rsu *RuleStateUpdate) SetNillablePendingSince(t *time.Time) *RuleStateUpdate {
+	if t != nil {
+		rsu.SetPendingSince(*t)
+	}
+	return rsu
+}
+
+// ClearPendingSince clears the value of the "pending_since" field.
+func (rsu *RuleStateUpdate) ClearPendingSince() *RuleStateUpdate {
+	rsu.mutation.ClearPendingSince()
+	return rsu
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (rsu *RuleStateUpdate) SetPendingReadings(i int) *RuleStateUpdate {
+	rsu.mutation.ResetPendingReadings()
+	rsu.mutation.SetPendingReadings(i)
+	return rsu
+}
+
+// SetNillablePendingReadings sets the "pending_readings" field if the given value is not nil.
+func (rsu *RuleStateUpdate) SetNillablePendingReadings(i *int) *RuleStateUpdate {
+	if i != nil {
+		rsu.SetPendingReadings(*i)
+	}
+	return rsu
+}
+
+// AddPendingReadings adds i to the "pending_readings" field.
+func (rsu *RuleStateUpdate) AddPendingReadings(i int) *RuleStateUpdate {
+	rsu.mutation.AddPendingReadings(i)
+	return rsu
+}
+
+// SetFiring sets the "firing" field.
+func (rsu *RuleStateUpdate) SetFiring(b bool) *RuleStateUpdate {
+	rsu.mutation.SetFiring(b)
+	return rsu
+}
+
+// SetNillableFiring sets the "firing" field if the given value is not nil.
+func (rsu *RuleStateUpdate) SetNillableFiring(b *bool) *RuleStateUpdate {
+	if b != nil {
+		rsu.SetFiring(*b)
+	}
+	return rsu
+}
+
+// SetVersion sets the "version" field.
+func (rsu *RuleStateUpdate) SetVersion(i int) *RuleStateUpdate {
+	rsu.mutation.ResetVersion()
+	rsu.mutation.SetVersion(i)
+	return rsu
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (rsu *RuleStateUpdate) SetNillableVersion(i *int) *RuleStateUpdate {
+	if i != nil {
+		rsu.SetVersion(*i)
+	}
+	return rsu
+}
+
+// AddVersion adds i to the "version" field.
+func (rsu *RuleStateUpdate) AddVersion(i int) *RuleStateUpdate {
+	rsu.mutation.AddVersion(i)
+	return rsu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsu *RuleStateUpdate) SetUpdatedAt(t time.Time) *RuleStateUpdate {
+	rsu.mutation.SetUpdatedAt(t)
+	return rsu
+}
+
+// Mutation returns the RuleStateMutation object of the builder.
+func (rsu *RuleStateUpdate) Mutation() *RuleStateMutation {
+	return rsu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (rsu *RuleStateUpdate) Save(ctx context.Context) (int, error) {
+	rsu.defaults()
+	return withHooks(ctx, rsu.sqlSave, rsu.mutation, rsu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsu *RuleStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := rsu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (rsu *RuleStateUpdate) Exec(ctx context.Context) error {
+	_, err := rsu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsu *RuleStateUpdate) ExecX(ctx context.Context) {
+	if err := rsu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsu *RuleStateUpdate) defaults() {
+	if _, ok := rsu.mutation.UpdatedAt(); !ok {
+		v := rulestate.UpdateDefaultUpdatedAt()
+		rsu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (rsu *RuleStateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rulestate.Table, rulestate.Columns, sqlgraph.NewFieldSpec(rulestate.FieldID, field.TypeInt))
+	if ps := rsu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsu.mutation.RuleID(); ok {
+		_spec.SetField(rulestate.FieldRuleID, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedRuleID(); ok {
+		_spec.AddField(rulestate.FieldRuleID, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.PendingSince(); ok {
+		_spec.SetField(rulestate.FieldPendingSince, field.TypeTime, value)
+	}
+	if rsu.mutation.PendingSinceCleared() {
+		_spec.ClearField(rulestate.FieldPendingSince, field.TypeTime)
+	}
+	if value, ok := rsu.mutation.PendingReadings(); ok {
+		_spec.SetField(rulestate.FieldPendingReadings, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedPendingReadings(); ok {
+		_spec.AddField(rulestate.FieldPendingReadings, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.Firing(); ok {
+		_spec.SetField(rulestate.FieldFiring, field.TypeBool, value)
+	}
+	if value, ok := rsu.mutation.Version(); ok {
+		_spec.SetField(rulestate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.AddedVersion(); ok {
+		_spec.AddField(rulestate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := rsu.mutation.UpdatedAt(); ok {
+		_spec.SetField(rulestate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, rsu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rulestate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	rsu.mutation.done = true
+	return n, nil
+}
+
+// RuleStateUpdateOne is the builder for updating a single RuleState entity.
+type RuleStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RuleStateMutation
+}
+
+// SetRuleID sets the "rule_id" field.
+func (rsuo *RuleStateUpdateOne) SetRuleID(i int) *RuleStateUpdateOne {
+	rsuo.mutation.ResetRuleID()
+	rsuo.mutation.SetRuleID(i)
+	return rsuo
+}
+
+// SetNillableRuleID sets the "rule_id" field if the given value is not nil.
+func (rsuo *RuleStateUpdateOne) SetNillableRuleID(i *int) *RuleStateUpdateOne {
+	if i != nil {
+		rsuo.SetRuleID(*i)
+	}
+	return rsuo
+}
+
+// AddRuleID adds i to the "rule_id" field.
+func (rsuo *RuleStateUpdateOne) AddRuleID(i int) *RuleStateUpdateOne {
+	rsuo.mutation.AddRuleID(i)
+	return rsuo
+}
+
+// SetPendingSince sets the "pending_since" field.
+func (rsuo *RuleStateUpdateOne) SetPendingSince(t time.Time) *RuleStateUpdateOne {
+	rsuo.mutation.SetPendingSince(t)
+	return rsuo
+}
+
+// SetNillablePendingSince sets the "pending_since" field if the given value is not nil.
+func (rsuo *RuleStateUpdateOne) SetNillablePendingSince(t *time.Time) *RuleStateUpdateOne {
+	if t != nil {
+		rsuo.SetPendingSince(*t)
+	}
+	return rsuo
+}
+
+// ClearPendingSince clears the value of the "pending_since" field.
+func (rsuo *RuleStateUpdateOne) ClearPendingSince() *RuleStateUpdateOne {
+	rsuo.mutation.ClearPendingSince()
+	return rsuo
+}
+
+// SetPendingReadings sets the "pending_readings" field.
+func (rsuo *RuleStateUpdateOne) SetPendingReadings(i int) *RuleStateUpdateOne {
+	rsuo.mutation.ResetPendingReadings()
+	rsuo.mutation.SetPendingReadings(i)
+	return rsuo
+}
+
+// SetNillablePendingReadings sets the "pending_readings" field if the given value is not nil.
+func (rsuo *RuleStateUpdateOne) SetNillablePendingReadings(i *int) *RuleStateUpdateOne {
+	if i != nil {
+		rsuo.SetPendingReadings(*i)
+	}
+	return rsuo
+}
+
+// AddPendingReadings adds i to the "pending_readings" field.
+func (rsuo *RuleStateUpdateOne) AddPendingReadings(i int) *RuleStateUpdateOne {
+	rsuo.mutation.AddPendingReadings(i)
+	return rsuo
+}
+
+// SetFiring sets the "firing" field.
+func (rsuo *RuleStateUpdateOne) SetFiring(b bool) *RuleStateUpdateOne {
+	rsuo.mutation.SetFiring(b)
+	return rsuo
+}
+
+// SetNillableFiring sets the "firing" field if the given value is not nil.
+func (rsuo *RuleStateUpdateOne) SetNillableFiring(b *bool) *RuleStateUpdateOne {
+	if b != nil {
+		rsuo.SetFiring(*b)
+	}
+	return rsuo
+}
+
+// SetVersion sets the "version" field.
+func (rsuo *RuleStateUpdateOne) SetVersion(i int) *RuleStateUpdateOne {
+	rsuo.mutation.ResetVersion()
+	rsuo.mutation.SetVersion(i)
+	return rsuo
+}
+
+// SetNillableVersion sets the "version" field if the given value is not nil.
+func (rsuo *RuleStateUpdateOne) SetNillableVersion(i *int) *RuleStateUpdateOne {
+	if i != nil {
+		rsuo.SetVersion(*i)
+	}
+	return rsuo
+}
+
+// AddVersion adds i to the "version" field.
+func (rsuo *RuleStateUpdateOne) AddVersion(i int) *RuleStateUpdateOne {
+	rsuo.mutation.AddVersion(i)
+	return rsuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (rsuo *RuleStateUpdateOne) SetUpdatedAt(t time.Time) *RuleStateUpdateOne {
+	rsuo.mutation.SetUpdatedAt(t)
+	return rsuo
+}
+
+// Mutation returns the RuleStateMutation object of the builder.
+func (rsuo *RuleStateUpdateOne) Mutation() *RuleStateMutation {
+	return rsuo.mutation
+}
+
+// Where appends a list predicates to the RuleStateUpdate builder.
+func (rsuo *RuleStateUpdateOne) Where(ps ...predicate.RuleState) *RuleStateUpdateOne {
+	rsuo.mutation.Where(ps...)
+	return rsuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (rsuo *RuleStateUpdateOne) Select(field string, fields ...string) *RuleStateUpdateOne {
+	rsuo.fields = append([]string{field}, fields...)
+	return rsuo
+}
+
+// Save executes the query and returns the updated RuleState entity.
+func (rsuo *RuleStateUpdateOne) Save(ctx context.Context) (*RuleState, error) {
+	rsuo.defaults()
+	return withHooks(ctx, rsuo.sqlSave, rsuo.mutation, rsuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (rsuo *RuleStateUpdateOne) SaveX(ctx context.Context) *RuleState {
+	node, err := rsuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (rsuo *RuleStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := rsuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (rsuo *RuleStateUpdateOne) ExecX(ctx context.Context) {
+	if err := rsuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (rsuo *RuleStateUpdateOne) defaults() {
+	if _, ok := rsuo.mutation.UpdatedAt(); !ok {
+		v := rulestate.UpdateDefaultUpdatedAt()
+		rsuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (rsuo *RuleStateUpdateOne) sqlSave(ctx context.Context) (_node *RuleState, err error) {
+	_spec := sqlgraph.NewUpdateSpec(rulestate.Table, rulestate.Columns, sqlgraph.NewFieldSpec(rulestate.FieldID, field.TypeInt))
+	id, ok := rsuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RuleState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := rsuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, rulestate.FieldID)
+		for _, f := range fields {
+			if !rulestate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != rulestate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := rsuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := rsuo.mutation.RuleID(); ok {
+		_spec.SetField(rulestate.FieldRuleID, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedRuleID(); ok {
+		_spec.AddField(rulestate.FieldRuleID, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.PendingSince(); ok {
+		_spec.SetField(rulestate.FieldPendingSince, field.TypeTime, value)
+	}
+	if rsuo.mutation.PendingSinceCleared() {
+		_spec.ClearField(rulestate.FieldPendingSince, field.TypeTime)
+	}
+	if value, ok := rsuo.mutation.PendingReadings(); ok {
+		_spec.SetField(rulestate.FieldPendingReadings, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedPendingReadings(); ok {
+		_spec.AddField(rulestate.FieldPendingReadings, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.Firing(); ok {
+		_spec.SetField(rulestate.FieldFiring, field.TypeBool, value)
+	}
+	if value, ok := rsuo.mutation.Version(); ok {
+		_spec.SetField(rulestate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.AddedVersion(); ok {
+		_spec.AddField(rulestate.FieldVersion, field.TypeInt, value)
+	}
+	if value, ok := rsuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(rulestate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RuleState{config: rsuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, rsuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{rulestate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	rsuo.mutation.done = true
+	return _node, nil
+}
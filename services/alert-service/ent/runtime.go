@@ -7,6 +7,8 @@ import (
 
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alert"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alertrule"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/outboxevent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/schema"
 )
 
@@ -24,22 +26,96 @@ func init() {
 	alertDescIsRead := alertFields[4].Descriptor()
 	// alert.DefaultIsRead holds the default value on creation for the is_read field.
 	alert.DefaultIsRead = alertDescIsRead.Default.(bool)
+	// alertDescStatus is the schema descriptor for status field.
+	alertDescStatus := alertFields[5].Descriptor()
+	// alert.DefaultStatus holds the default value on creation for the status field.
+	alert.DefaultStatus = alertDescStatus.Default.(string)
+	// alertDescCount is the schema descriptor for count field.
+	alertDescCount := alertFields[6].Descriptor()
+	// alert.DefaultCount holds the default value on creation for the count field.
+	alert.DefaultCount = alertDescCount.Default.(int)
 	alertruleFields := schema.AlertRule{}.Fields()
 	_ = alertruleFields
 	// alertruleDescName is the schema descriptor for name field.
 	alertruleDescName := alertruleFields[0].Descriptor()
 	// alertrule.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	alertrule.NameValidator = alertruleDescName.Validators[0].(func(string) error)
+	// alertruleDescChannel is the schema descriptor for channel field.
+	alertruleDescChannel := alertruleFields[3].Descriptor()
+	// alertrule.DefaultChannel holds the default value on creation for the channel field.
+	alertrule.DefaultChannel = alertruleDescChannel.Default.(string)
 	// alertruleDescConditionType is the schema descriptor for condition_type field.
-	alertruleDescConditionType := alertruleFields[3].Descriptor()
+	alertruleDescConditionType := alertruleFields[4].Descriptor()
 	// alertrule.DefaultConditionType holds the default value on creation for the condition_type field.
 	alertrule.DefaultConditionType = alertruleDescConditionType.Default.(string)
+	// alertruleDescThresholdHigh is the schema descriptor for threshold_high field.
+	alertruleDescThresholdHigh := alertruleFields[6].Descriptor()
+	// alertrule.DefaultThresholdHigh holds the default value on creation for the threshold_high field.
+	alertrule.DefaultThresholdHigh = alertruleDescThresholdHigh.Default.(float64)
+	// alertruleDescForSeconds is the schema descriptor for for_seconds field.
+	alertruleDescForSeconds := alertruleFields[7].Descriptor()
+	// alertrule.DefaultForSeconds holds the default value on creation for the for_seconds field.
+	alertrule.DefaultForSeconds = alertruleDescForSeconds.Default.(int)
+	// alertruleDescForReadings is the schema descriptor for for_readings field.
+	alertruleDescForReadings := alertruleFields[8].Descriptor()
+	// alertrule.DefaultForReadings holds the default value on creation for the for_readings field.
+	alertrule.DefaultForReadings = alertruleDescForReadings.Default.(int)
+	// alertruleDescMetric is the schema descriptor for metric field.
+	alertruleDescMetric := alertruleFields[10].Descriptor()
+	// alertrule.DefaultMetric holds the default value on creation for the metric field.
+	alertrule.DefaultMetric = alertruleDescMetric.Default.(string)
+	// alertruleDescWindowSeconds is the schema descriptor for window_seconds field.
+	alertruleDescWindowSeconds := alertruleFields[11].Descriptor()
+	// alertrule.DefaultWindowSeconds holds the default value on creation for the window_seconds field.
+	alertrule.DefaultWindowSeconds = alertruleDescWindowSeconds.Default.(int)
+	// alertruleDescSkipBadQuality is the schema descriptor for skip_bad_quality field.
+	alertruleDescSkipBadQuality := alertruleFields[12].Descriptor()
+	// alertrule.DefaultSkipBadQuality holds the default value on creation for the skip_bad_quality field.
+	alertrule.DefaultSkipBadQuality = alertruleDescSkipBadQuality.Default.(bool)
 	// alertruleDescIsEnabled is the schema descriptor for is_enabled field.
-	alertruleDescIsEnabled := alertruleFields[6].Descriptor()
+	alertruleDescIsEnabled := alertruleFields[14].Descriptor()
 	// alertrule.DefaultIsEnabled holds the default value on creation for the is_enabled field.
 	alertrule.DefaultIsEnabled = alertruleDescIsEnabled.Default.(bool)
 	// alertruleDescCreatedAt is the schema descriptor for created_at field.
-	alertruleDescCreatedAt := alertruleFields[7].Descriptor()
+	alertruleDescCreatedAt := alertruleFields[15].Descriptor()
 	// alertrule.DefaultCreatedAt holds the default value on creation for the created_at field.
 	alertrule.DefaultCreatedAt = alertruleDescCreatedAt.Default.(func() time.Time)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescRoutingKey is the schema descriptor for routing_key field.
+	outboxeventDescRoutingKey := outboxeventFields[1].Descriptor()
+	// outboxevent.DefaultRoutingKey holds the default value on creation for the routing_key field.
+	outboxevent.DefaultRoutingKey = outboxeventDescRoutingKey.Default.(string)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[3].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxeventDescNextAttemptAt := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxevent.DefaultNextAttemptAt = outboxeventDescNextAttemptAt.Default.(func() time.Time)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
+	rulestateFields := schema.RuleState{}.Fields()
+	_ = rulestateFields
+	// rulestateDescPendingReadings is the schema descriptor for pending_readings field.
+	rulestateDescPendingReadings := rulestateFields[2].Descriptor()
+	// rulestate.DefaultPendingReadings holds the default value on creation for the pending_readings field.
+	rulestate.DefaultPendingReadings = rulestateDescPendingReadings.Default.(int)
+	// rulestateDescFiring is the schema descriptor for firing field.
+	rulestateDescFiring := rulestateFields[3].Descriptor()
+	// rulestate.DefaultFiring holds the default value on creation for the firing field.
+	rulestate.DefaultFiring = rulestateDescFiring.Default.(bool)
+	// rulestateDescVersion is the schema descriptor for version field.
+	rulestateDescVersion := rulestateFields[4].Descriptor()
+	// rulestate.DefaultVersion holds the default value on creation for the version field.
+	rulestate.DefaultVersion = rulestateDescVersion.Default.(int)
+	// rulestateDescUpdatedAt is the schema descriptor for updated_at field.
+	rulestateDescUpdatedAt := rulestateFields[5].Descriptor()
+	// rulestate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	rulestate.DefaultUpdatedAt = rulestateDescUpdatedAt.Default.(func() time.Time)
+	// rulestate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	rulestate.UpdateDefaultUpdatedAt = rulestateDescUpdatedAt.UpdateDefault.(func() time.Time)
 }
@@ -6,6 +6,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 )
 
 type Alert struct {
@@ -15,10 +16,27 @@ type Alert struct {
 func (Alert) Fields() []ent.Field {
 	return []ent.Field{
 		field.Int64("user_id"),
+		// value is the reading that raised the alert, last_value the latest one that
+		// still violated the rule. count is the number of violating readings.
 		field.Float("value"),
 		field.String("message"),
 		field.Time("triggered_at").Default(time.Now),
 		field.Bool("is_read").Default(false),
+		// status is firing, acknowledged or resolved. A rule has at most one alert
+		// that is not resolved.
+		field.String("status").Default("firing"),
+		field.Int("count").Default(1),
+		field.Float("last_value").Optional(),
+		field.Time("last_triggered_at").Optional(),
+		field.Time("acknowledged_at").Optional().Nillable(),
+		field.Int64("acknowledged_by").Optional(),
+		field.Time("resolved_at").Optional().Nillable(),
+	}
+}
+
+func (Alert) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status").Edges("rule"),
 	}
 }
 
@@ -17,8 +17,23 @@ func (AlertRule) Fields() []ent.Field {
 		field.String("name").NotEmpty(),
 		field.Int64("user_id"),
 		field.Int64("sensor_id"),
+		// channel selects a channel of a multi-channel sensor; empty evaluates the primary value.
+		field.String("channel").Default(""),
 		field.String("condition_type").Default("GT"),
 		field.Float("threshold"),
+		// threshold_high is the upper bound of BETWEEN and OUTSIDE conditions.
+		field.Float("threshold_high").Default(0),
+		// for_seconds and for_readings delay firing until the condition held that long.
+		field.Int("for_seconds").Default(0),
+		field.Int("for_readings").Default(0),
+		// clear_threshold is the value a fired rule has to get back to before it re-arms.
+		field.Float("clear_threshold").Optional().Nillable(),
+		// metric is compared instead of the reading itself; rate metrics look back
+		// window_seconds.
+		field.String("metric").Default("VALUE"),
+		field.Int("window_seconds").Default(0),
+		// skip_bad_quality ignores readings whose quality is not good.
+		field.Bool("skip_bad_quality").Default(false),
 		field.String("description").Optional(),
 		field.Bool("is_enabled").Default(true),
 		field.Time("created_at").Default(time.Now),
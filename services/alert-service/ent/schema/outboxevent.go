@@ -0,0 +1,35 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// OutboxEvent is an event written together with the change it describes and
+// published to RabbitMQ afterwards by the outbox relay.
+type OutboxEvent struct {
+	ent.Schema
+}
+
+func (OutboxEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("exchange"),
+		field.String("routing_key").Default(""),
+		field.Bytes("payload"),
+		field.Int("attempts").Default(0),
+		field.String("last_error").Optional(),
+		field.Time("next_attempt_at").Default(time.Now),
+		// claim_token tags the rows of the latest claim by a relay.
+		field.String("claim_token").Optional(),
+		field.Time("created_at").Default(time.Now).Immutable(),
+	}
+}
+
+func (OutboxEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("next_attempt_at"),
+	}
+}
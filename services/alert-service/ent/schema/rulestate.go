@@ -0,0 +1,30 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+)
+
+// RuleState is the evaluation state of an alert rule, kept between readings so that
+// "for" conditions and re-arming survive restarts.
+type RuleState struct {
+	ent.Schema
+}
+
+func (RuleState) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("rule_id").Unique(),
+		// pending_since is the time of the first reading of the current run of readings
+		// matching the condition, pending_readings the length of that run.
+		field.Time("pending_since").Optional().Nillable(),
+		field.Int("pending_readings").Default(0),
+		// firing is set once the rule fired, until it re-arms.
+		field.Bool("firing").Default(false),
+		// version is raised by every change, so a state saved from a stale copy is
+		// detected instead of overwriting a reset.
+		field.Int("version").Default(0),
+		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
+	}
+}
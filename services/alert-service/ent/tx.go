@@ -16,6 +16,10 @@ type Tx struct {
 	Alert *AlertClient
 	// AlertRule is the client for interacting with the AlertRule builders.
 	AlertRule *AlertRuleClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
+	// RuleState is the client for interacting with the RuleState builders.
+	RuleState *RuleStateClient
 
 	// lazily loaded.
 	client     *Client
@@ -149,6 +153,8 @@ func (tx *Tx) Client() *Client {
 func (tx *Tx) init() {
 	tx.Alert = NewAlertClient(tx.config)
 	tx.AlertRule = NewAlertRuleClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
+	tx.RuleState = NewRuleStateClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
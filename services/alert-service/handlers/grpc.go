@@ -2,26 +2,39 @@ package handlers
 
 import (
 	"context"
+	"errors"
 
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/alert_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/service"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/storage"
 )
 
 type AlertGrpcHandler struct {
 	pb.UnimplementedAlertServiceServer
 	alertService     *service.AlertService
 	alertRuleService *service.AlertRuleService
+	// relay publishes the alert events queued in the outbox by status changes.
+	relay *outbox.Relay
+	// sensorClient looks up the channels a rule's sensor declares.
+	sensorClient pb_sensor.SensorServiceClient
 }
 
-func NewAlertGrpcHandler(alertService *service.AlertService, alertRuleService *service.AlertRuleService) *AlertGrpcHandler {
+func NewAlertGrpcHandler(alertService *service.AlertService, alertRuleService *service.AlertRuleService, relay *outbox.Relay, sensorClient pb_sensor.SensorServiceClient) *AlertGrpcHandler {
 	return &AlertGrpcHandler{
 		alertService:     alertService,
 		alertRuleService: alertRuleService,
+		relay:            relay,
+		sensorClient:     sensorClient,
 	}
 }
 
@@ -62,6 +75,41 @@ func (h *AlertGrpcHandler) MarkAlertAsRead(ctx context.Context, req *pb.MarkAler
 	return &pb.MarkAlertAsReadResponse{Success: success}, nil
 }
 
+func (h *AlertGrpcHandler) AcknowledgeAlert(ctx context.Context, req *pb.AcknowledgeAlertRequest) (*pb.AcknowledgeAlertResponse, error) {
+	logger.Info("gRPC AcknowledgeAlert", zap.Int64("id", req.Id), zap.Int64("userId", req.UserId))
+	a, changed, err := h.alertService.Acknowledge(ctx, int(req.Id), req.UserId)
+	if err != nil {
+		return nil, h.transitionError("acknowledge", req.Id, err)
+	}
+	if changed {
+		h.relay.Notify()
+	}
+	return &pb.AcknowledgeAlertResponse{Alert: h.mapAlert(a)}, nil
+}
+
+func (h *AlertGrpcHandler) ResolveAlert(ctx context.Context, req *pb.ResolveAlertRequest) (*pb.ResolveAlertResponse, error) {
+	logger.Info("gRPC ResolveAlert", zap.Int64("id", req.Id), zap.Int64("userId", req.UserId))
+	a, changed, err := h.alertService.Resolve(ctx, int(req.Id), req.UserId)
+	if err != nil {
+		return nil, h.transitionError("resolve", req.Id, err)
+	}
+	if changed {
+		h.relay.Notify()
+	}
+	return &pb.ResolveAlertResponse{Alert: h.mapAlert(a)}, nil
+}
+
+func (h *AlertGrpcHandler) transitionError(action string, id int64, err error) error {
+	switch {
+	case ent.IsNotFound(err):
+		return status.Error(codes.NotFound, "alert not found")
+	case errors.Is(err, storage.ErrAlertResolved):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	logger.Error("Failed to "+action+" alert", zap.Error(err), zap.Int64("id", id))
+	return status.Errorf(codes.Internal, "failed to %s alert", action)
+}
+
 func (h *AlertGrpcHandler) ListAlertRules(ctx context.Context, req *pb.ListAlertRulesRequest) (*pb.ListAlertRulesResponse, error) {
 	logger.Info("gRPC ListAlertRules", zap.Int64("userId", req.UserId), zap.Int32("limit", req.Limit), zap.Int32("offset", req.Offset))
 	rules, totalCount, err := h.alertRuleService.ListAlertRules(ctx, req.UserId, int(req.Limit), int(req.Offset))
@@ -81,14 +129,29 @@ func (h *AlertGrpcHandler) ListAlertRules(ctx context.Context, req *pb.ListAlert
 
 func (h *AlertGrpcHandler) CreateAlertRule(ctx context.Context, req *pb.CreateAlertRuleRequest) (*pb.CreateAlertRuleResponse, error) {
 	logger.Info("gRPC CreateAlertRule", zap.Int64("userId", req.UserId), zap.Int64("sensorId", req.SensorId))
-	rule, err := h.alertRuleService.CreateAlertRule(ctx, &ent.AlertRule{
-		Name:          req.Name,
-		SensorID:      req.SensorId,
-		ConditionType: req.ConditionType,
-		Threshold:     req.Threshold,
-		Description:   req.Description,
-		UserID:        req.UserId,
-	})
+	rule := &ent.AlertRule{
+		Name:           req.Name,
+		SensorID:       req.SensorId,
+		Channel:        req.Channel,
+		ConditionType:  req.ConditionType,
+		Threshold:      req.Threshold,
+		ThresholdHigh:  req.ThresholdHigh,
+		ForSeconds:     int(req.ForSeconds),
+		ForReadings:    int(req.ForReadings),
+		ClearThreshold: clearThreshold(req.ClearThreshold),
+		Metric:         req.Metric,
+		WindowSeconds:  int(req.WindowSeconds),
+		SkipBadQuality: req.SkipBadQuality,
+		Description:    req.Description,
+		UserID:         req.UserId,
+	}
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+	if err := h.validateChannel(ctx, rule); err != nil {
+		return nil, err
+	}
+	rule, err := h.alertRuleService.CreateAlertRule(ctx, rule)
 	if err != nil {
 		logger.Error("Failed to create alert rule", zap.Error(err), zap.Int64("userId", req.UserId), zap.Int64("sensorId", req.SensorId))
 		return nil, err
@@ -105,6 +168,7 @@ func (h *AlertGrpcHandler) DeleteAlertRule(ctx context.Context, req *pb.DeleteAl
 		logger.Error("Failed to delete alert rule", zap.Error(err), zap.Int64("id", req.Id))
 		return nil, err
 	}
+	h.relay.Notify()
 	return &pb.DeleteAlertRuleResponse{}, nil
 }
 
@@ -122,27 +186,97 @@ func (h *AlertGrpcHandler) GetAlertRule(ctx context.Context, req *pb.GetAlertRul
 
 func (h *AlertGrpcHandler) UpdateAlertRule(ctx context.Context, req *pb.UpdateAlertRuleRequest) (*pb.UpdateAlertRuleResponse, error) {
 	logger.Info("gRPC UpdateAlertRule", zap.Int64("id", req.Id))
-	rule, err := h.alertRuleService.UpdateAlertRule(ctx, &ent.AlertRule{
-		ID:            int(req.Id),
-		Name:          req.Name,
-		SensorID:      req.SensorId,
-		ConditionType: req.ConditionType,
-		Threshold:     req.Threshold,
-		Description:   req.Description,
-		IsEnabled:     req.IsEnabled,
-	})
-
+	rule := &ent.AlertRule{
+		ID:             int(req.Id),
+		Name:           req.Name,
+		SensorID:       req.SensorId,
+		Channel:        req.Channel,
+		ConditionType:  req.ConditionType,
+		Threshold:      req.Threshold,
+		ThresholdHigh:  req.ThresholdHigh,
+		ForSeconds:     int(req.ForSeconds),
+		ForReadings:    int(req.ForReadings),
+		ClearThreshold: clearThreshold(req.ClearThreshold),
+		Metric:         req.Metric,
+		WindowSeconds:  int(req.WindowSeconds),
+		SkipBadQuality: req.SkipBadQuality,
+		Description:    req.Description,
+		IsEnabled:      req.IsEnabled,
+	}
+	if err := validateRule(rule); err != nil {
+		return nil, err
+	}
+	if err := h.validateChannel(ctx, rule); err != nil {
+		return nil, err
+	}
+	rule, err := h.alertRuleService.UpdateAlertRule(ctx, rule)
 	if err != nil {
 		logger.Error("Failed to update alert rule", zap.Error(err), zap.Int64("id", req.Id))
 		return nil, err
 	}
+	// The open alert of the rule was resolved along with the update.
+	h.relay.Notify()
 	return &pb.UpdateAlertRuleResponse{
 		AlertRule: h.mapAlertRule(rule),
 	}, nil
 }
 
+// validateRule checks the condition, metric and timing of rule and normalizes its
+// condition type and metric. Thresholds a condition does not use and the window of
+// VALUE rules are cleared.
+func validateRule(rule *ent.AlertRule) error {
+	conditionType, err := service.NormalizeCondition(rule.ConditionType, rule.Threshold, rule.ThresholdHigh)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	rule.ConditionType = conditionType
+	if !service.IsRangeCondition(conditionType) {
+		rule.ThresholdHigh = 0
+	}
+	if conditionType == service.ConditionNoData {
+		rule.Threshold = 0
+	}
+	rule.Metric, rule.WindowSeconds, err = service.NormalizeMetric(rule.Metric, rule.WindowSeconds)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := service.ValidateTiming(rule); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// validateChannel checks that the channel of rule is declared by the type of its sensor.
+func (h *AlertGrpcHandler) validateChannel(ctx context.Context, rule *ent.AlertRule) error {
+	if rule.Channel == "" {
+		return nil
+	}
+	res, err := h.sensorClient.GetSensor(ctx, &pb_sensor.GetSensorRequest{Id: rule.SensorID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return status.Errorf(codes.InvalidArgument, "sensor %d not found", rule.SensorID)
+		}
+		logger.Error("Failed to get sensor of alert rule", zap.Error(err), zap.Int64("sensorId", rule.SensorID))
+		return status.Error(codes.Unavailable, "failed to check the channel of the alert rule")
+	}
+	for _, c := range res.Sensor.GetSensorType().GetChannels() {
+		if c.Name == rule.Channel {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "sensor %d has no channel %q", rule.SensorID, rule.Channel)
+}
+
+func clearThreshold(v *wrapperspb.DoubleValue) *float64 {
+	if v == nil {
+		return nil
+	}
+	threshold := v.Value
+	return &threshold
+}
+
 func (h *AlertGrpcHandler) mapAlert(a *ent.Alert) *pb.Alert {
-	return &pb.Alert{
+	res := &pb.Alert{
 		Id:          int64(a.ID),
 		RuleId:      int64(a.Edges.Rule.ID),
 		SensorId:    a.Edges.Rule.SensorID,
@@ -150,19 +284,43 @@ func (h *AlertGrpcHandler) mapAlert(a *ent.Alert) *pb.Alert {
 		Value:       a.Value,
 		IsRead:      a.IsRead,
 		TriggeredAt: timestamppb.New(a.TriggeredAt),
+		Status:      a.Status,
+		Count:       int32(a.Count),
+		LastValue:   a.LastValue,
+	}
+	if !a.LastTriggeredAt.IsZero() {
+		res.LastTriggeredAt = timestamppb.New(a.LastTriggeredAt)
+	}
+	if a.AcknowledgedAt != nil {
+		res.AcknowledgedAt = timestamppb.New(*a.AcknowledgedAt)
 	}
+	if a.ResolvedAt != nil {
+		res.ResolvedAt = timestamppb.New(*a.ResolvedAt)
+	}
+	return res
 }
 
 func (h *AlertGrpcHandler) mapAlertRule(r *ent.AlertRule) *pb.AlertRule {
-	return &pb.AlertRule{
-		Id:            int64(r.ID),
-		Name:          r.Name,
-		SensorId:      r.SensorID,
-		ConditionType: r.ConditionType,
-		Threshold:     r.Threshold,
-		Description:   r.Description,
-		IsEnabled:     r.IsEnabled,
-		CreatedAt:     timestamppb.New(r.CreatedAt),
-		UserId:        r.UserID,
+	rule := &pb.AlertRule{
+		Id:             int64(r.ID),
+		Name:           r.Name,
+		SensorId:       r.SensorID,
+		Channel:        r.Channel,
+		ConditionType:  r.ConditionType,
+		Threshold:      r.Threshold,
+		ThresholdHigh:  r.ThresholdHigh,
+		ForSeconds:     int32(r.ForSeconds),
+		ForReadings:    int32(r.ForReadings),
+		Metric:         r.Metric,
+		WindowSeconds:  int32(r.WindowSeconds),
+		SkipBadQuality: r.SkipBadQuality,
+		Description:    r.Description,
+		IsEnabled:      r.IsEnabled,
+		CreatedAt:      timestamppb.New(r.CreatedAt),
+		UserId:         r.UserID,
+	}
+	if r.ClearThreshold != nil {
+		rule.ClearThreshold = wrapperspb.Double(*r.ClearThreshold)
 	}
+	return rule
 }
@@ -12,19 +12,54 @@ import (
 	amqp "github.com/rabbitmq/amqp091-go"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alert"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alertrule"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/enttest"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/outboxevent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/service"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/storage"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	_ "modernc.org/sqlite"
 )
 
-type MockPublisher struct {
+type MockNotifier struct {
 	mock.Mock
 }
 
-func (m *MockPublisher) PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error {
-	args := m.Called(ctx, exchange, key, mandatory, immediate, msg)
-	return args.Error(0)
+func (m *MockNotifier) Notify() {
+	m.Called()
+}
+
+// recordingChannel stands in for the RabbitMQ channel of the consumer, recording what
+// it publishes and acknowledges.
+type recordingChannel struct {
+	published []amqp.Publishing
+	keys      []string
+	acks      int
+	nacks     int
+}
+
+func (c *recordingChannel) PublishWithContext(_ context.Context, exchange, key string, _, _ bool, msg amqp.Publishing) error {
+	c.published = append(c.published, msg)
+	c.keys = append(c.keys, exchange+"/"+key)
+	return nil
+}
+
+func (c *recordingChannel) Ack(uint64, bool) error {
+	c.acks++
+	return nil
+}
+
+func (c *recordingChannel) Nack(uint64, bool, bool) error {
+	c.nacks++
+	return nil
+}
+
+func (c *recordingChannel) Reject(uint64, bool) error {
+	c.nacks++
+	return nil
 }
 
 func TestMain(m *testing.M) {
@@ -37,32 +72,6 @@ func TestMain(m *testing.M) {
 	m.Run()
 }
 
-func TestIsTriggered(t *testing.T) {
-	tests := []struct {
-		name          string
-		conditionType string
-		threshold     float64
-		value         float64
-		expected      bool
-	}{
-		{"GT Triggered", "GT", 25.0, 26.0, true},
-		{"GT Not Triggered", "GT", 25.0, 24.0, false},
-		{"LT Triggered", "LT", 10.0, 5.0, true},
-		{"LT Not Triggered", "LT", 10.0, 15.0, false},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			rule := &ent.AlertRule{
-				ConditionType: tt.conditionType,
-				Threshold:     tt.threshold,
-			}
-			result := isTriggered(rule, tt.value)
-			assert.Equal(t, tt.expected, result)
-		})
-	}
-}
-
 func TestProcessMessage(t *testing.T) {
 	db, err := sql.Open("sqlite", "file:ent?mode=memory&cache=shared&_pragma=foreign_keys(1)")
 	if err != nil {
@@ -73,6 +82,8 @@ func TestProcessMessage(t *testing.T) {
 	defer client.Close()
 
 	ctx := context.Background()
+	history := service.NewReadingHistory(nil)
+	tracker := service.NewReadingTracker(nil)
 
 	_, err = client.AlertRule.Create().
 		SetName("Temp Alert").
@@ -85,8 +96,8 @@ func TestProcessMessage(t *testing.T) {
 	assert.NoError(t, err)
 
 	t.Run("Triggers and Saves Alert", func(t *testing.T) {
-		mockPub := new(MockPublisher)
-		
+		notifier := new(MockNotifier)
+
 		data := SensorData{
 			SensorID:  1,
 			Value:     35.0,
@@ -94,26 +105,58 @@ func TestProcessMessage(t *testing.T) {
 		}
 		body, _ := json.Marshal(data)
 
-		mockPub.On("PublishWithContext", mock.Anything, "alerts_exchange", "", false, false, mock.MatchedBy(func(p amqp.Publishing) bool {
-			var event AlertEvent
-			json.Unmarshal(p.Body, &event)
-			return event.Value == 35.0 && event.SensorID == 1
-		})).Return(nil)
+		notifier.On("Notify").Return()
 
-		processMessage(client, mockPub, body)
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
 
 		alerts, err := client.Alert.Query().All(ctx)
 		assert.NoError(t, err)
 		assert.Len(t, alerts, 1)
 		assert.Equal(t, 35.0, alerts[0].Value)
+		assert.Equal(t, storage.AlertFiring, alerts[0].Status)
 		assert.Contains(t, alerts[0].Message, "Temp Alert")
 
-		mockPub.AssertExpectations(t)
+		events, err := client.OutboxEvent.Query().Where(outboxevent.Exchange("alerts_exchange")).All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, events, 1)
+
+		var event storage.AlertEvent
+		assert.NoError(t, json.Unmarshal(events[0].Payload, &event))
+		assert.Equal(t, alerts[0].ID, event.AlertID)
+		assert.Equal(t, 35.0, event.Value)
+		assert.Equal(t, int64(1), event.SensorID)
+		assert.Equal(t, storage.AlertFiring, event.Status)
+
+		notifier.AssertExpectations(t)
 	})
 
-	t.Run("Does Not Trigger Below Threshold", func(t *testing.T) {
-		mockPub := new(MockPublisher)
-		
+	t.Run("Deduplicates Repeated Violations", func(t *testing.T) {
+		notifier := new(MockNotifier)
+
+		body, _ := json.Marshal(SensorData{
+			SensorID:  1,
+			Value:     37.0,
+			Timestamp: time.Now(),
+		})
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+
+		alerts, err := client.Alert.Query().All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, 2, alerts[0].Count)
+		assert.Equal(t, 35.0, alerts[0].Value)
+		assert.Equal(t, 37.0, alerts[0].LastValue)
+
+		outboxCount, _ := client.OutboxEvent.Query().Count(ctx)
+		assert.Equal(t, 1, outboxCount)
+
+		notifier.AssertNotCalled(t, "Notify")
+	})
+
+	t.Run("Resolves Alert Below Threshold", func(t *testing.T) {
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+
 		data := SensorData{
 			SensorID:  1,
 			Value:     25.0,
@@ -121,11 +164,434 @@ func TestProcessMessage(t *testing.T) {
 		}
 		body, _ := json.Marshal(data)
 
-		processMessage(client, mockPub, body)
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+
+		alerts, err := client.Alert.Query().All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, storage.AlertResolved, alerts[0].Status)
+		assert.NotNil(t, alerts[0].ResolvedAt)
+
+		events, err := client.OutboxEvent.Query().Order(ent.Asc(outboxevent.FieldID)).All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, events, 2)
+
+		var event storage.AlertEvent
+		assert.NoError(t, json.Unmarshal(events[1].Payload, &event))
+		assert.Equal(t, storage.AlertResolved, event.Status)
+		assert.Equal(t, 37.0, event.Value)
+		assert.Equal(t, 2, event.Count)
+
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+		outboxCount, _ := client.OutboxEvent.Query().Count(ctx)
+		assert.Equal(t, 2, outboxCount)
+
+		notifier.AssertNumberOfCalls(t, "Notify", 1)
+	})
+
+	t.Run("Judges Quality Per Rule And Channel", func(t *testing.T) {
+		rule := func(name, channel string, skip bool) *ent.AlertRule {
+			r, err := client.AlertRule.Create().
+				SetName(name).
+				SetSensorID(7).
+				SetChannel(channel).
+				SetConditionType("GT").
+				SetThreshold(30.0).
+				SetSkipBadQuality(skip).
+				SetUserID(100).
+				SetIsEnabled(true).
+				Save(ctx)
+			assert.NoError(t, err)
+			return r
+		}
+		anyHumidity := rule("Any Humidity", "humidity", false)
+		goodHumidity := rule("Good Humidity", "humidity", true)
+		goodTemperature := rule("Good Temperature", "temperature", true)
+		goodPrimary := rule("Good Primary", "", true)
+
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+
+		body, _ := json.Marshal(SensorData{
+			SensorID:       7,
+			Value:          35.0,
+			Timestamp:      time.Now(),
+			Quality:        "out_of_range",
+			Values:         map[string]float64{"temperature": 35.0, "humidity": 130.0},
+			ChannelQuality: map[string]string{"temperature": "good", "humidity": "out_of_range"},
+		})
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+
+		for _, tc := range []struct {
+			rule  *ent.AlertRule
+			fired bool
+		}{
+			{anyHumidity, true},
+			{goodHumidity, false},
+			{goodTemperature, true},
+			{goodPrimary, false},
+		} {
+			count, err := tc.rule.QueryAlerts().Count(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.fired, count == 1, tc.rule.Name)
+		}
+		notifier.AssertNumberOfCalls(t, "Notify", 2)
+	})
+
+	t.Run("Evaluates Rule Channel", func(t *testing.T) {
+		rule, err := client.AlertRule.Create().
+			SetName("Humidity Alert").
+			SetSensorID(2).
+			SetChannel("humidity").
+			SetConditionType("GT").
+			SetThreshold(80.0).
+			SetUserID(100).
+			SetIsEnabled(true).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+
+		withoutChannel, _ := json.Marshal(SensorData{
+			SensorID:  2,
+			Value:     21.0,
+			Timestamp: time.Now(),
+			Values:    map[string]float64{"temperature": 21.0},
+		})
+		assert.NoError(t, processMessage(client, notifier, history, withoutChannel, nil))
+
+		withChannel, _ := json.Marshal(SensorData{
+			SensorID:  2,
+			Value:     21.0,
+			Timestamp: time.Now(),
+			Values:    map[string]float64{"temperature": 21.0, "humidity": 85.0},
+		})
+		assert.NoError(t, processMessage(client, notifier, history, withChannel, nil))
+
+		alerts, err := rule.QueryAlerts().All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, 85.0, alerts[0].Value)
+		assert.Contains(t, alerts[0].Message, "humidity")
+
+		notifier.AssertNumberOfCalls(t, "Notify", 1)
+	})
+	t.Run("Persists For Condition State", func(t *testing.T) {
+		rule, err := client.AlertRule.Create().
+			SetName("Sustained Alert").
+			SetSensorID(3).
+			SetConditionType("GT").
+			SetThreshold(50.0).
+			SetForReadings(2).
+			SetUserID(100).
+			SetIsEnabled(true).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+
+		reading := func(value float64) []byte {
+			body, _ := json.Marshal(SensorData{SensorID: 3, Value: value, Timestamp: time.Now()})
+			return body
+		}
+
+		assert.NoError(t, processMessage(client, notifier, history, reading(55.0), nil))
+		state, err := client.RuleState.Query().Where(rulestate.RuleID(rule.ID)).Only(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, state.PendingReadings)
+		assert.False(t, state.Firing)
+		notifier.AssertNotCalled(t, "Notify")
+
+		assert.NoError(t, processMessage(client, notifier, history, reading(56.0), nil))
+		assert.NoError(t, processMessage(client, notifier, history, reading(57.0), nil))
+
+		alerts, err := rule.QueryAlerts().All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, 56.0, alerts[0].Value)
+
+		state, err = client.RuleState.Query().Where(rulestate.RuleID(rule.ID)).Only(ctx)
+		assert.NoError(t, err)
+		assert.True(t, state.Firing)
+		assert.Zero(t, state.PendingReadings)
+		notifier.AssertNumberOfCalls(t, "Notify", 1)
+
+		rule.Threshold = 60.0
+		_, err = storage.NewAlertRuleStorage(client).Update(ctx, rule)
+		assert.NoError(t, err)
+		state, err = client.RuleState.Query().Where(rulestate.RuleID(rule.ID)).Only(ctx)
+		assert.NoError(t, err)
+		assert.False(t, state.Firing, "changing a rule re-arms it")
+	})
+	t.Run("Acknowledges And Resolves Alert", func(t *testing.T) {
+		rule, err := client.AlertRule.Create().
+			SetName("Pressure Alert").
+			SetSensorID(4).
+			SetConditionType("LT").
+			SetThreshold(900.0).
+			SetUserID(200).
+			SetIsEnabled(true).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+		body, _ := json.Marshal(SensorData{SensorID: 4, Value: 850.0, Timestamp: time.Now()})
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+
+		a, err := rule.QueryAlerts().Only(ctx)
+		assert.NoError(t, err)
+		alerts := storage.NewAlertStorage(client)
+		eventCount := func() int {
+			count, _ := client.OutboxEvent.Query().Count(ctx)
+			return count
+		}
+		before := eventCount()
+
+		_, _, err = alerts.Acknowledge(ctx, a.ID, 100)
+		assert.True(t, ent.IsNotFound(err), "alerts of other users are not found")
+
+		acknowledged, changed, err := alerts.Acknowledge(ctx, a.ID, 200)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, storage.AlertAcknowledged, acknowledged.Status)
+		assert.Equal(t, int64(200), acknowledged.AcknowledgedBy)
+		assert.Equal(t, before+1, eventCount())
+
+		_, changed, err = alerts.Acknowledge(ctx, a.ID, 200)
+		assert.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, before+1, eventCount())
+
+		// An evaluation may hold the state of the rule while it is resolved.
+		held, err := client.RuleState.Query().Where(rulestate.RuleID(rule.ID)).Only(ctx)
+		assert.NoError(t, err)
+		assert.True(t, held.Firing)
+
+		resolved, changed, err := alerts.Resolve(ctx, a.ID, 200)
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, storage.AlertResolved, resolved.Status)
+		assert.Equal(t, before+2, eventCount())
+
+		state, err := client.RuleState.Query().Where(rulestate.RuleID(rule.ID)).Only(ctx)
+		assert.NoError(t, err)
+		assert.False(t, state.Firing, "resolving re-arms the rule")
+		assert.Equal(t, held.ID, state.ID, "the state is reset in place")
+
+		// The stale copy of the evaluation does not fire the rule again.
+		assert.ErrorIs(t, saveRuleState(ctx, client, held), errStaleRuleState)
+		state, err = client.RuleState.Query().Where(rulestate.RuleID(rule.ID)).Only(ctx)
+		assert.NoError(t, err)
+		assert.False(t, state.Firing)
+		assert.NoError(t, saveRuleState(ctx, client, state))
+
+		// A state removed since it was loaded is stored again.
+		_, err = client.RuleState.Delete().Where(rulestate.RuleID(rule.ID)).Exec(ctx)
+		assert.NoError(t, err)
+		assert.NoError(t, saveRuleState(ctx, client, state))
+		assert.NotEqual(t, held.ID, state.ID)
+
+		_, _, err = alerts.Acknowledge(ctx, a.ID, 200)
+		assert.ErrorIs(t, err, storage.ErrAlertResolved)
+
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+		count, err := rule.QueryAlerts().Count(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, count, "an ongoing violation raises a new alert")
+	})
+	t.Run("Resolves Open Alert When Rule Changes", func(t *testing.T) {
+		rule, err := client.AlertRule.Create().
+			SetName("Boiler Alert").
+			SetSensorID(8).
+			SetConditionType("GT").
+			SetThreshold(60.0).
+			SetUserID(300).
+			SetIsEnabled(true).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+		body, _ := json.Marshal(SensorData{SensorID: 8, Value: 70.0, Timestamp: time.Now()})
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+
+		eventCount := func() int {
+			count, _ := client.OutboxEvent.Query().Count(ctx)
+			return count
+		}
+		openAlerts := func() int {
+			count, _ := client.Alert.Query().
+				Where(alert.HasRuleWith(alertrule.ID(rule.ID)), alert.StatusNEQ(storage.AlertResolved)).
+				Count(ctx)
+			return count
+		}
+		assert.Equal(t, 1, openAlerts())
+		before := eventCount()
+
+		rules := storage.NewAlertRuleStorage(client)
+		rule.Threshold = 65.0
+		rule, err = rules.Update(ctx, rule)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, openAlerts(), "the alert of the old condition is resolved")
+		assert.Equal(t, before+1, eventCount())
+
+		assert.NoError(t, processMessage(client, notifier, history, body, nil))
+		assert.Equal(t, 1, openAlerts(), "a violation of the new condition raises one alert")
 
-		count, _ := client.Alert.Query().Count(ctx)
-		assert.Equal(t, 1, count)
+		assert.NoError(t, rules.Delete(ctx, int64(rule.ID)))
+		assert.Equal(t, before+3, eventCount(), "the open alert is resolved before the rule is deleted")
+		exists, err := client.AlertRule.Query().Where(alertrule.ID(rule.ID)).Exist(ctx)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+		states, err := client.RuleState.Query().Where(rulestate.RuleID(rule.ID)).Count(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, states)
+		assert.Error(t, rules.Delete(ctx, int64(rule.ID)))
+	})
+	t.Run("Evaluates Delta Over Window", func(t *testing.T) {
+		rule, err := client.AlertRule.Create().
+			SetName("Rising Temperature").
+			SetSensorID(5).
+			SetConditionType("GT").
+			SetThreshold(5.0).
+			SetMetric(service.MetricDelta).
+			SetWindowSeconds(60).
+			SetUserID(100).
+			SetIsEnabled(true).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+
+		start := time.Now()
+		reading := func(value float64, offset time.Duration) []byte {
+			body, _ := json.Marshal(SensorData{SensorID: 5, Value: value, Timestamp: start.Add(offset)})
+			return body
+		}
+
+		assert.NoError(t, processMessage(client, notifier, history, reading(100.0, 0), nil))
+		assert.NoError(t, processMessage(client, notifier, history, reading(103.0, 30*time.Second), nil))
+		notifier.AssertNotCalled(t, "Notify")
+
+		// The first reading left the window, the delta is measured from 103.
+		assert.NoError(t, processMessage(client, notifier, history, reading(107.0, 80*time.Second), nil))
+		notifier.AssertNotCalled(t, "Notify")
+
+		assert.NoError(t, processMessage(client, notifier, history, reading(110.0, 85*time.Second), nil))
+		alerts, err := rule.QueryAlerts().All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, 7.0, alerts[0].Value)
+		assert.Contains(t, alerts[0].Message, "DELTA over 60s")
+		notifier.AssertNumberOfCalls(t, "Notify", 1)
+	})
+	t.Run("Fires And Resolves No Data Rule", func(t *testing.T) {
+		rule, err := client.AlertRule.Create().
+			SetName("Sensor Offline").
+			SetSensorID(6).
+			SetConditionType(service.ConditionNoData).
+			SetThreshold(0).
+			SetForSeconds(60).
+			SetUserID(100).
+			SetIsEnabled(true).
+			Save(ctx)
+		assert.NoError(t, err)
+
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+
+		tracker.Observe(6, time.Now().Add(-2*time.Minute))
+		checkSilentSensors(client, notifier, tracker)
+		checkSilentSensors(client, notifier, tracker)
+
+		alerts, err := rule.QueryAlerts().All(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, alerts, 1)
+		assert.Equal(t, storage.AlertFiring, alerts[0].Status)
+		assert.Contains(t, alerts[0].Message, "no data for 2m0s")
+		notifier.AssertNumberOfCalls(t, "Notify", 1)
 
-		mockPub.AssertNotCalled(t, "PublishWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		body, _ := json.Marshal(SensorData{SensorID: 6, Value: 21.0, Timestamp: time.Now()})
+		deliveries := make(chan amqp.Delivery, 1)
+		deliveries <- amqp.Delivery{Body: body}
+		close(deliveries)
+		trackReadings(deliveries, tracker)
+		select {
+		case <-tracker.Wake():
+		default:
+			t.Fatal("a reading of a silent sensor wakes the checker")
+		}
+		notifier.AssertNumberOfCalls(t, "Notify", 1)
+
+		checkSilentSensors(client, notifier, tracker)
+
+		resolved, err := client.Alert.Get(ctx, alerts[0].ID)
+		assert.NoError(t, err)
+		assert.Equal(t, storage.AlertResolved, resolved.Status)
+		notifier.AssertNumberOfCalls(t, "Notify", 2)
+	})
+
+	t.Run("Retries Only Pending Rules", func(t *testing.T) {
+		notifier := new(MockNotifier)
+		notifier.On("Notify").Return()
+
+		var rules []*ent.AlertRule
+		for _, name := range []string{"Evaluated", "Pending"} {
+			rule, err := client.AlertRule.Create().
+				SetName(name).
+				SetSensorID(9).
+				SetConditionType("GT").
+				SetThreshold(30.0).
+				SetUserID(100).
+				SetIsEnabled(true).
+				Save(ctx)
+			assert.NoError(t, err)
+			rules = append(rules, rule)
+		}
+
+		attempts, ruleIDs := retryState(retryHeaders(2, []int{rules[1].ID}))
+		assert.Equal(t, 2, attempts)
+		assert.Equal(t, []int{rules[1].ID}, ruleIDs)
+
+		body, _ := json.Marshal(SensorData{SensorID: 9, Value: 35.0, Timestamp: time.Now()})
+		assert.NoError(t, processMessage(client, notifier, history, body, ruleIDs))
+
+		for i, want := range []int{0, 1} {
+			n, err := client.Alert.Query().Where(alert.HasRuleWith(alertrule.ID(rules[i].ID))).Count(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, want, n, "only the pending rule evaluates the retried reading")
+		}
+	})
+
+	t.Run("Reports Readings To Retry", func(t *testing.T) {
+		notifier := new(MockNotifier)
+
+		assert.NoError(t, processMessage(client, notifier, history, []byte("not json"), nil), "undecodable readings are dropped")
+
+		body, _ := json.Marshal(SensorData{SensorID: 1, Value: 35.0, Timestamp: time.Now()})
+		assert.NoError(t, client.Close())
+		assert.Error(t, processMessage(client, notifier, history, body, nil), "readings are retried while the database is down")
+		notifier.AssertNotCalled(t, "Notify")
+
+		ch := &recordingChannel{}
+		handleDelivery(ch, client, notifier, history, amqp.Delivery{Acknowledger: ch, Body: body})
+		assert.Equal(t, []string{"/" + alertRetryQueue}, ch.keys, "a failed reading waits in the retry queue")
+		assert.Equal(t, 1, ch.acks)
+		attempts, ruleIDs := retryState(ch.published[0].Headers)
+		assert.Equal(t, 1, attempts)
+		assert.Nil(t, ruleIDs, "every rule retries when none could be loaded")
+
+		handleDelivery(ch, client, notifier, history, amqp.Delivery{
+			Acknowledger: ch,
+			Body:         body,
+			Headers:      retryHeaders(maxEvaluationAttempts-1, nil),
+		})
+		assert.Equal(t, alertDeadLetterExchange+"/", ch.keys[1], "the last attempt goes to the dead letter exchange")
+		assert.Equal(t, 2, ch.acks)
+		assert.Zero(t, ch.nacks)
 	})
 }
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/service"
+)
+
+const (
+	// alertRetryQueue holds readings that failed to evaluate for retryDelay, after which
+	// they are dead-lettered back to alert_engine_queue.
+	alertRetryQueue = "alert_engine_retry"
+	// alertDeadLetterExchange receives readings given up after maxEvaluationAttempts.
+	alertDeadLetterExchange = "alert_engine_dlx"
+	alertDeadLetterQueue    = "alert_engine_dead_letters"
+
+	retryDelay            = 10 * time.Second
+	maxEvaluationAttempts = 5
+
+	// Headers of a retried reading: how often it was evaluated and which rules still
+	// have to see it. Rules that already evaluated it are not run again.
+	attemptsHeader = "x-evaluation-attempts"
+	ruleIDsHeader  = "x-pending-rule-ids"
+)
+
+// failedRulesError reports the rules of a reading that could not be evaluated. The
+// other rules of the sensor evaluated it.
+type failedRulesError struct {
+	SensorID int64
+	RuleIDs  []int
+}
+
+func (e *failedRulesError) Error() string {
+	return fmt.Sprintf("failed to evaluate rules %v of sensor %d", e.RuleIDs, e.SensorID)
+}
+
+// IPublisher publishes messages to RabbitMQ, as *amqp.Channel does.
+type IPublisher interface {
+	PublishWithContext(ctx context.Context, exchange, key string, mandatory, immediate bool, msg amqp.Publishing) error
+}
+
+// handleDelivery evaluates a reading of alert_engine_queue and acknowledges it. A
+// reading that fails is republished to alertRetryQueue with the rules that still have
+// to evaluate it, or to alertDeadLetterExchange once it failed maxEvaluationAttempts
+// times. It is only requeued when that publish fails.
+func handleDelivery(pub IPublisher, client *ent.Client, notifier IOutboxNotifier, history *service.ReadingHistory, d amqp.Delivery) {
+	attempts, ruleIDs := retryState(d.Headers)
+
+	err := processMessage(client, notifier, history, d.Body, ruleIDs)
+	if err == nil {
+		if err := d.Ack(false); err != nil {
+			logger.Error("Failed to acknowledge reading", zap.Error(err))
+		}
+		return
+	}
+
+	// Unless single rules failed, the rules were not even loaded and all of them retry.
+	var failed *failedRulesError
+	if errors.As(err, &failed) {
+		ruleIDs = failed.RuleIDs
+	}
+	attempts++
+
+	exchange, key := "", alertRetryQueue
+	if attempts >= maxEvaluationAttempts {
+		exchange, key = alertDeadLetterExchange, ""
+		logger.Error("Giving up on reading, sending it to the dead letter exchange",
+			zap.Int("attempts", attempts),
+			zap.Ints("rule_ids", ruleIDs),
+			zap.Error(err),
+		)
+	} else {
+		logger.Error("Failed to evaluate reading, retrying it",
+			zap.Int("attempts", attempts),
+			zap.Ints("rule_ids", ruleIDs),
+			zap.Duration("delay", retryDelay),
+			zap.Error(err),
+		)
+	}
+
+	msg := amqp.Publishing{
+		ContentType:  d.ContentType,
+		DeliveryMode: amqp.Persistent,
+		Body:         d.Body,
+		Headers:      retryHeaders(attempts, ruleIDs),
+	}
+	if err := pub.PublishWithContext(context.Background(), exchange, key, false, false, msg); err != nil {
+		logger.Error("Failed to republish reading, requeueing it", zap.Error(err))
+		if err := d.Nack(false, true); err != nil {
+			logger.Error("Failed to requeue reading", zap.Error(err))
+		}
+		return
+	}
+	if err := d.Ack(false); err != nil {
+		logger.Error("Failed to acknowledge reading", zap.Error(err))
+	}
+}
+
+func retryHeaders(attempts int, ruleIDs []int) amqp.Table {
+	headers := amqp.Table{attemptsHeader: int32(attempts)}
+	if ruleIDs != nil {
+		ids := make([]any, len(ruleIDs))
+		for i, id := range ruleIDs {
+			ids[i] = int64(id)
+		}
+		headers[ruleIDsHeader] = ids
+	}
+	return headers
+}
+
+// retryState reads the headers set by retryHeaders. A reading delivered for the first
+// time has no attempts and nil rule IDs, so every rule evaluates it.
+func retryState(headers amqp.Table) (int, []int) {
+	attempts := 0
+	switch v := headers[attemptsHeader].(type) {
+	case int32:
+		attempts = int(v)
+	case int64:
+		attempts = int(v)
+	}
+
+	raw, ok := headers[ruleIDsHeader].([]any)
+	if !ok {
+		return attempts, nil
+	}
+	ruleIDs := make([]int, 0, len(raw))
+	for _, v := range raw {
+		switch id := v.(type) {
+		case int32:
+			ruleIDs = append(ruleIDs, int(id))
+		case int64:
+			ruleIDs = append(ruleIDs, int(id))
+		}
+	}
+	return attempts, ruleIDs
+}
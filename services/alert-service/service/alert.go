@@ -26,3 +26,11 @@ func (s *AlertService) ListAlerts(ctx context.Context, userID int64, limit, offs
 func (s *AlertService) MarkAsRead(ctx context.Context, id int) (bool, error) {
 	return s.storage.MarkAsRead(ctx, id)
 }
+
+func (s *AlertService) Acknowledge(ctx context.Context, id int, userID int64) (*ent.Alert, bool, error) {
+	return s.storage.Acknowledge(ctx, id, userID)
+}
+
+func (s *AlertService) Resolve(ctx context.Context, id int, userID int64) (*ent.Alert, bool, error) {
+	return s.storage.Resolve(ctx, id, userID)
+}
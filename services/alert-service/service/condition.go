@@ -0,0 +1,82 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Condition types of alert rules. BETWEEN and OUTSIDE compare against the range from
+// threshold to threshold_high, bounds included in BETWEEN. NO_DATA rules do not look at
+// readings but fire when a sensor has not sent any for the rule's for_seconds.
+const (
+	ConditionGT      = "GT"
+	ConditionGTE     = "GTE"
+	ConditionLT      = "LT"
+	ConditionLTE     = "LTE"
+	ConditionEQ      = "EQ"
+	ConditionNEQ     = "NEQ"
+	ConditionBetween = "BETWEEN"
+	ConditionOutside = "OUTSIDE"
+	ConditionNoData  = "NO_DATA"
+)
+
+var conditionTypes = []string{
+	ConditionGT, ConditionGTE, ConditionLT, ConditionLTE,
+	ConditionEQ, ConditionNEQ, ConditionBetween, ConditionOutside,
+	ConditionNoData,
+}
+
+// IsRangeCondition reports whether conditionType uses both thresholds.
+func IsRangeCondition(conditionType string) bool {
+	return conditionType == ConditionBetween || conditionType == ConditionOutside
+}
+
+// NormalizeCondition validates a rule condition and returns its condition type in
+// upper case. Single-threshold conditions ignore thresholdHigh.
+func NormalizeCondition(conditionType string, threshold, thresholdHigh float64) (string, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(conditionType))
+	if !slices.Contains(conditionTypes, normalized) {
+		return "", fmt.Errorf("unknown condition type %q, expected one of %s", conditionType, strings.Join(conditionTypes, ", "))
+	}
+
+	if math.IsNaN(threshold) || math.IsInf(threshold, 0) {
+		return "", errors.New("threshold must be a finite number")
+	}
+	if IsRangeCondition(normalized) {
+		if math.IsNaN(thresholdHigh) || math.IsInf(thresholdHigh, 0) {
+			return "", errors.New("threshold_high must be a finite number")
+		}
+		if thresholdHigh <= threshold {
+			return "", fmt.Errorf("%s requires threshold_high to be greater than threshold", normalized)
+		}
+	}
+	return normalized, nil
+}
+
+// ConditionMet reports whether value satisfies the condition. Unknown condition types,
+// and NO_DATA, are never met by a reading. Readings are stored as float32, so EQ and
+// NEQ compare at that precision: a reading of 21.3 arrives as 21.299999237060547.
+func ConditionMet(conditionType string, threshold, thresholdHigh, value float64) bool {
+	switch conditionType {
+	case ConditionGT:
+		return value > threshold
+	case ConditionGTE:
+		return value >= threshold
+	case ConditionLT:
+		return value < threshold
+	case ConditionLTE:
+		return value <= threshold
+	case ConditionEQ:
+		return float32(value) == float32(threshold)
+	case ConditionNEQ:
+		return float32(value) != float32(threshold)
+	case ConditionBetween:
+		return value >= threshold && value <= thresholdHigh
+	case ConditionOutside:
+		return value < threshold || value > thresholdHigh
+	}
+	return false
+}
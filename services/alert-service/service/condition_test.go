@@ -0,0 +1,81 @@
+package service
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionMet(t *testing.T) {
+	tests := []struct {
+		name          string
+		conditionType string
+		threshold     float64
+		thresholdHigh float64
+		value         float64
+		expected      bool
+	}{
+		{"GT Triggered", "GT", 25.0, 0, 26.0, true},
+		{"GT Not Triggered", "GT", 25.0, 0, 24.0, false},
+		{"GT Not Triggered At Threshold", "GT", 25.0, 0, 25.0, false},
+		{"GTE Triggered At Threshold", "GTE", 25.0, 0, 25.0, true},
+		{"GTE Not Triggered", "GTE", 25.0, 0, 24.9, false},
+		{"LT Triggered", "LT", 10.0, 0, 5.0, true},
+		{"LT Not Triggered", "LT", 10.0, 0, 15.0, false},
+		{"LTE Triggered At Threshold", "LTE", 10.0, 0, 10.0, true},
+		{"LTE Not Triggered", "LTE", 10.0, 0, 10.1, false},
+		{"EQ Triggered", "EQ", 1.0, 0, 1.0, true},
+		{"EQ Not Triggered", "EQ", 1.0, 0, 0.0, false},
+		{"NEQ Triggered", "NEQ", 1.0, 0, 0.0, true},
+		{"NEQ Not Triggered", "NEQ", 1.0, 0, 1.0, false},
+		{"EQ Float32 Reading", "EQ", 21.3, 0, float64(float32(21.3)), true},
+		{"NEQ Float32 Reading", "NEQ", 21.3, 0, float64(float32(21.3)), false},
+		{"EQ Next Float32", "EQ", 21.3, 0, float64(math.Nextafter32(21.3, 22)), false},
+		{"BETWEEN Triggered", "BETWEEN", 10.0, 20.0, 15.0, true},
+		{"BETWEEN Triggered At Bound", "BETWEEN", 10.0, 20.0, 20.0, true},
+		{"BETWEEN Not Triggered", "BETWEEN", 10.0, 20.0, 21.0, false},
+		{"OUTSIDE Triggered Below", "OUTSIDE", 10.0, 20.0, 9.0, true},
+		{"OUTSIDE Triggered Above", "OUTSIDE", 10.0, 20.0, 21.0, true},
+		{"OUTSIDE Not Triggered At Bound", "OUTSIDE", 10.0, 20.0, 10.0, false},
+		{"Unknown Never Triggered", "ABOVE", 10.0, 0, 100.0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := ConditionMet(tt.conditionType, tt.threshold, tt.thresholdHigh, tt.value)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestNormalizeCondition(t *testing.T) {
+	conditionType, err := NormalizeCondition(" gte ", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, ConditionGTE, conditionType)
+
+	conditionType, err = NormalizeCondition("between", 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, ConditionBetween, conditionType)
+
+	tests := []struct {
+		name          string
+		conditionType string
+		threshold     float64
+		thresholdHigh float64
+	}{
+		{"Unknown", "ABOVE", 10, 0},
+		{"Empty", "", 10, 0},
+		{"NaN Threshold", "GT", math.NaN(), 0},
+		{"Infinite Upper Bound", "OUTSIDE", 10, math.Inf(1)},
+		{"Missing Upper Bound", "BETWEEN", 10, 0},
+		{"Empty Range", "OUTSIDE", 10, 10},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NormalizeCondition(tt.conditionType, tt.threshold, tt.thresholdHigh)
+			assert.Error(t, err)
+		})
+	}
+}
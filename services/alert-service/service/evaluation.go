@@ -0,0 +1,170 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
+)
+
+// maxForSeconds bounds the "for" duration of a rule to a week.
+const maxForSeconds = 7 * 24 * 60 * 60
+
+// minNoDataSeconds is the shortest silence a NO_DATA rule can wait for.
+const minNoDataSeconds = 60
+
+// ValidateTiming checks the "for" duration and the clear threshold of a rule whose
+// condition was already normalized. A rule waits either for a duration or for a number
+// of readings, not both. The clear threshold has to lie on the side of the threshold
+// where the condition does not hold, so only GT, GTE, LT and LTE support it.
+//
+// A NO_DATA rule requires for_seconds, the silence it waits for, and takes none of the
+// other settings.
+func ValidateTiming(rule *ent.AlertRule) error {
+	if rule.ConditionType == ConditionNoData {
+		return validateNoData(rule)
+	}
+	if rule.ForSeconds < 0 || rule.ForSeconds > maxForSeconds {
+		return fmt.Errorf("for_seconds must be between 0 and %d", maxForSeconds)
+	}
+	if rule.ForReadings < 0 {
+		return errors.New("for_readings must not be negative")
+	}
+	if rule.ForSeconds > 0 && rule.ForReadings > 0 {
+		return errors.New("for_seconds and for_readings cannot be combined")
+	}
+
+	if rule.ClearThreshold == nil {
+		return nil
+	}
+	clearAt := *rule.ClearThreshold
+	if math.IsNaN(clearAt) || math.IsInf(clearAt, 0) {
+		return errors.New("clear_threshold must be a finite number")
+	}
+	switch rule.ConditionType {
+	case ConditionGT, ConditionGTE:
+		if clearAt >= rule.Threshold {
+			return fmt.Errorf("clear_threshold of a %s rule must be below threshold", rule.ConditionType)
+		}
+	case ConditionLT, ConditionLTE:
+		if clearAt <= rule.Threshold {
+			return fmt.Errorf("clear_threshold of a %s rule must be above threshold", rule.ConditionType)
+		}
+	default:
+		return fmt.Errorf("clear_threshold is not supported by %s rules", rule.ConditionType)
+	}
+	return nil
+}
+
+func validateNoData(rule *ent.AlertRule) error {
+	if rule.ForSeconds < minNoDataSeconds || rule.ForSeconds > maxForSeconds {
+		return fmt.Errorf("%s requires for_seconds between %d and %d", ConditionNoData, minNoDataSeconds, maxForSeconds)
+	}
+	switch {
+	case rule.ForReadings != 0:
+		return fmt.Errorf("for_readings is not supported by %s rules", ConditionNoData)
+	case rule.ClearThreshold != nil:
+		return fmt.Errorf("clear_threshold is not supported by %s rules", ConditionNoData)
+	case rule.Channel != "":
+		return fmt.Errorf("channel is not supported by %s rules", ConditionNoData)
+	case IsRateMetric(rule.Metric):
+		return fmt.Errorf("%s rules do not support metric %s", ConditionNoData, rule.Metric)
+	}
+	return nil
+}
+
+// Outcome is what a reading means for a rule.
+type Outcome int
+
+const (
+	// OutcomeNone leaves the alerts of the rule alone.
+	OutcomeNone Outcome = iota
+	// OutcomeFire raises an alert.
+	OutcomeFire
+	// OutcomeRepeat violates the rule again while it is firing.
+	OutcomeRepeat
+	// OutcomeClear re-arms the rule and resolves its alert.
+	OutcomeClear
+)
+
+// EvaluateRule advances the evaluation state of rule with a reading of value taken at
+// at. It returns the outcome of the reading and whether state changed.
+//
+// A rule fires once its condition held for the rule's "for" duration or number of
+// readings, then repeats until it re-arms. It re-arms once the value reaches the
+// clear threshold or, without one, as soon as the condition stops holding.
+func EvaluateRule(rule *ent.AlertRule, state *ent.RuleState, value float64, at time.Time) (outcome Outcome, changed bool) {
+	met := ConditionMet(rule.ConditionType, rule.Threshold, rule.ThresholdHigh, value)
+
+	if state.Firing {
+		if cleared(rule, value, met) {
+			state.Firing = false
+			resetPending(state)
+			return OutcomeClear, true
+		}
+		if met {
+			return OutcomeRepeat, false
+		}
+		return OutcomeNone, false
+	}
+
+	if !met {
+		if state.PendingSince == nil && state.PendingReadings == 0 {
+			return OutcomeNone, false
+		}
+		resetPending(state)
+		return OutcomeNone, true
+	}
+
+	if state.PendingSince == nil {
+		state.PendingSince = &at
+	}
+	state.PendingReadings++
+
+	held := at.Sub(*state.PendingSince) >= time.Duration(rule.ForSeconds)*time.Second &&
+		state.PendingReadings >= rule.ForReadings
+	if held {
+		state.Firing = true
+		resetPending(state)
+		return OutcomeFire, true
+	}
+	return OutcomeNone, true
+}
+
+// EvaluateSilence checks a NO_DATA rule whose sensor last sent a reading at
+// lastReading. The rule fires once the sensor was silent for the rule's for_seconds
+// and clears as soon as it reports again.
+func EvaluateSilence(rule *ent.AlertRule, state *ent.RuleState, lastReading, now time.Time) Outcome {
+	silent := now.Sub(lastReading) >= time.Duration(rule.ForSeconds)*time.Second
+	switch {
+	case silent && !state.Firing:
+		state.Firing = true
+		resetPending(state)
+		return OutcomeFire
+	case !silent && state.Firing:
+		state.Firing = false
+		resetPending(state)
+		return OutcomeClear
+	}
+	return OutcomeNone
+}
+
+func cleared(rule *ent.AlertRule, value float64, met bool) bool {
+	if rule.ClearThreshold == nil {
+		return !met
+	}
+	switch rule.ConditionType {
+	case ConditionGT, ConditionGTE:
+		return value <= *rule.ClearThreshold
+	case ConditionLT, ConditionLTE:
+		return value >= *rule.ClearThreshold
+	}
+	return !met
+}
+
+func resetPending(state *ent.RuleState) {
+	state.PendingSince = nil
+	state.PendingReadings = 0
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
+)
+
+func TestEvaluateRuleFiresOnce(t *testing.T) {
+	rule := &ent.AlertRule{ConditionType: ConditionGT, Threshold: 30}
+	state := &ent.RuleState{}
+	now := time.Now()
+
+	outcome, changed := EvaluateRule(rule, state, 35, now)
+	assert.Equal(t, OutcomeFire, outcome)
+	assert.True(t, changed)
+	assert.True(t, state.Firing)
+
+	outcome, changed = EvaluateRule(rule, state, 36, now)
+	assert.Equal(t, OutcomeRepeat, outcome, "a fired rule repeats until it re-arms")
+	assert.False(t, changed)
+
+	outcome, changed = EvaluateRule(rule, state, 29, now)
+	assert.Equal(t, OutcomeClear, outcome)
+	assert.True(t, changed)
+	assert.False(t, state.Firing)
+
+	outcome, _ = EvaluateRule(rule, state, 28, now)
+	assert.Equal(t, OutcomeNone, outcome)
+
+	outcome, _ = EvaluateRule(rule, state, 31, now)
+	assert.Equal(t, OutcomeFire, outcome)
+}
+
+func TestEvaluateRuleForReadings(t *testing.T) {
+	rule := &ent.AlertRule{ConditionType: ConditionGT, Threshold: 30, ForReadings: 3}
+	state := &ent.RuleState{}
+	now := time.Now()
+
+	for _, v := range []float64{31, 32} {
+		outcome, changed := EvaluateRule(rule, state, v, now)
+		assert.Equal(t, OutcomeNone, outcome)
+		assert.True(t, changed)
+	}
+	assert.Equal(t, 2, state.PendingReadings)
+
+	outcome, _ := EvaluateRule(rule, state, 20, now)
+	assert.Equal(t, OutcomeNone, outcome)
+	assert.Zero(t, state.PendingReadings, "a reading not matching breaks the run")
+	assert.Nil(t, state.PendingSince)
+
+	for i, v := range []float64{31, 32, 33} {
+		outcome, _ := EvaluateRule(rule, state, v, now)
+		assert.Equal(t, i == 2, outcome == OutcomeFire)
+	}
+}
+
+func TestEvaluateRuleForSeconds(t *testing.T) {
+	rule := &ent.AlertRule{ConditionType: ConditionLT, Threshold: 10, ForSeconds: 60}
+	state := &ent.RuleState{}
+	start := time.Now()
+
+	outcome, _ := EvaluateRule(rule, state, 5, start)
+	assert.Equal(t, OutcomeNone, outcome)
+	assert.Equal(t, start, *state.PendingSince)
+
+	outcome, _ = EvaluateRule(rule, state, 5, start.Add(59*time.Second))
+	assert.Equal(t, OutcomeNone, outcome)
+
+	outcome, _ = EvaluateRule(rule, state, 5, start.Add(60*time.Second))
+	assert.Equal(t, OutcomeFire, outcome)
+	assert.Nil(t, state.PendingSince)
+}
+
+func TestEvaluateRuleClearThreshold(t *testing.T) {
+	clearAt := 25.0
+	rule := &ent.AlertRule{ConditionType: ConditionGT, Threshold: 30, ClearThreshold: &clearAt}
+	state := &ent.RuleState{}
+	now := time.Now()
+
+	outcome, _ := EvaluateRule(rule, state, 31, now)
+	assert.Equal(t, OutcomeFire, outcome)
+
+	for _, v := range []float64{29, 31, 26, 31} {
+		outcome, _ := EvaluateRule(rule, state, v, now)
+		assert.NotEqual(t, OutcomeFire, outcome, "oscillating above the clear threshold does not re-arm")
+		assert.True(t, state.Firing)
+	}
+
+	outcome, changed := EvaluateRule(rule, state, 25, now)
+	assert.Equal(t, OutcomeClear, outcome)
+	assert.True(t, changed)
+	assert.False(t, state.Firing)
+
+	outcome, _ = EvaluateRule(rule, state, 31, now)
+	assert.Equal(t, OutcomeFire, outcome)
+}
+
+func TestValidateTiming(t *testing.T) {
+	below := 25.0
+	above := 35.0
+
+	assert.NoError(t, ValidateTiming(&ent.AlertRule{ConditionType: ConditionGT, Threshold: 30, ForSeconds: 60}))
+	assert.NoError(t, ValidateTiming(&ent.AlertRule{ConditionType: ConditionGTE, Threshold: 30, ClearThreshold: &below}))
+	assert.NoError(t, ValidateTiming(&ent.AlertRule{ConditionType: ConditionLT, Threshold: 30, ForReadings: 3, ClearThreshold: &above}))
+	assert.NoError(t, ValidateTiming(&ent.AlertRule{ConditionType: ConditionNoData, ForSeconds: 300, Metric: MetricValue}))
+
+	for name, rule := range map[string]*ent.AlertRule{
+		"Negative Duration":     {ConditionType: ConditionGT, ForSeconds: -1},
+		"Duration Too Long":     {ConditionType: ConditionGT, ForSeconds: maxForSeconds + 1},
+		"Negative Readings":     {ConditionType: ConditionGT, ForReadings: -1},
+		"Duration And Readings": {ConditionType: ConditionGT, ForSeconds: 60, ForReadings: 3},
+		"Clear Above GT":        {ConditionType: ConditionGT, Threshold: 30, ClearThreshold: &above},
+		"Clear Below LT":        {ConditionType: ConditionLT, Threshold: 30, ClearThreshold: &below},
+		"Clear On Range":        {ConditionType: ConditionOutside, Threshold: 20, ThresholdHigh: 40, ClearThreshold: &below},
+		"No Data Without Time":  {ConditionType: ConditionNoData},
+		"No Data Too Short":     {ConditionType: ConditionNoData, ForSeconds: minNoDataSeconds - 1},
+		"No Data With Readings": {ConditionType: ConditionNoData, ForSeconds: 300, ForReadings: 3},
+		"No Data On Channel":    {ConditionType: ConditionNoData, ForSeconds: 300, Channel: "humidity"},
+		"No Data With Metric":   {ConditionType: ConditionNoData, ForSeconds: 300, Metric: MetricDelta},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, ValidateTiming(rule))
+		})
+	}
+}
+
+func TestEvaluateSilence(t *testing.T) {
+	rule := &ent.AlertRule{ConditionType: ConditionNoData, ForSeconds: 300}
+	state := &ent.RuleState{}
+	lastReading := time.Now()
+
+	outcome := EvaluateSilence(rule, state, lastReading, lastReading.Add(299*time.Second))
+	assert.Equal(t, OutcomeNone, outcome)
+
+	outcome = EvaluateSilence(rule, state, lastReading, lastReading.Add(300*time.Second))
+	assert.Equal(t, OutcomeFire, outcome)
+	assert.True(t, state.Firing)
+
+	outcome = EvaluateSilence(rule, state, lastReading, lastReading.Add(time.Hour))
+	assert.Equal(t, OutcomeNone, outcome, "a silent sensor fires once")
+
+	outcome = EvaluateSilence(rule, state, lastReading.Add(time.Hour), lastReading.Add(time.Hour))
+	assert.Equal(t, OutcomeClear, outcome)
+	assert.False(t, state.Firing)
+}
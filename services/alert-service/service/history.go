@@ -0,0 +1,157 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+const (
+	// maxHistorySamples bounds the samples kept per sensor channel.
+	maxHistorySamples = 10000
+	// backfillLimit is the most readings fetched from the data service per backfill.
+	backfillLimit   = 1000
+	backfillTimeout = 5 * time.Second
+)
+
+type historyKey struct {
+	sensorID int64
+	channel  string
+}
+
+type series struct {
+	// samples are sorted by time.
+	samples []Sample
+	// span is the longest window the series was backfilled for.
+	span time.Duration
+}
+
+// ReadingHistory keeps the recent good readings of the sensor channels watched by rate
+// rules. A channel seen for the first time, or asked for a longer window than before,
+// is backfilled from the data service so rules can be evaluated right after a
+// restart. A nil data client disables backfilling and the history fills up as readings
+// arrive.
+type ReadingHistory struct {
+	dataClient pb_data.DataServiceClient
+
+	mu     sync.Mutex
+	series map[historyKey]*series
+}
+
+func NewReadingHistory(dataClient pb_data.DataServiceClient) *ReadingHistory {
+	return &ReadingHistory{
+		dataClient: dataClient,
+		series:     make(map[historyKey]*series),
+	}
+}
+
+// Record adds a reading of a sensor channel, an empty channel being the primary value,
+// and returns the samples of the window ending at the reading. Samples older than
+// window are dropped, so window has to be the longest one of the channel's rules.
+func (h *ReadingHistory) Record(ctx context.Context, sensorID int64, channel string, value float64, at time.Time, window time.Duration) []Sample {
+	key := historyKey{sensorID: sensorID, channel: channel}
+
+	h.mu.Lock()
+	s, ok := h.series[key]
+	if !ok {
+		s = &series{}
+		h.series[key] = s
+	}
+	needsBackfill := window > s.span
+	h.mu.Unlock()
+
+	var backfill []Sample
+	if needsBackfill {
+		backfill = h.fetch(ctx, key, at.Add(-window), at)
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if needsBackfill {
+		s.span = max(s.span, window)
+		for _, sample := range backfill {
+			s.insert(sample)
+		}
+	}
+	s.insert(Sample{At: at, Value: value})
+
+	from := at.Add(-window)
+	start, _ := slices.BinarySearchFunc(s.samples, from, func(sample Sample, t time.Time) int {
+		return sample.At.Compare(t)
+	})
+	s.samples = s.samples[start:]
+	if len(s.samples) > maxHistorySamples {
+		s.samples = s.samples[len(s.samples)-maxHistorySamples:]
+	}
+
+	end, _ := slices.BinarySearchFunc(s.samples, at, func(sample Sample, t time.Time) int {
+		if sample.At.After(t) {
+			return 1
+		}
+		return -1
+	})
+	return slices.Clone(s.samples[:end])
+}
+
+// insert adds sample in time order, replacing a sample taken at the same time.
+func (s *series) insert(sample Sample) {
+	i, found := slices.BinarySearchFunc(s.samples, sample.At, func(e Sample, t time.Time) int {
+		return e.At.Compare(t)
+	})
+	if found {
+		s.samples[i] = sample
+		return
+	}
+	s.samples = slices.Insert(s.samples, i, sample)
+}
+
+// fetch returns the good readings of key taken from from up to, but excluding, to.
+func (h *ReadingHistory) fetch(ctx context.Context, key historyKey, from, to time.Time) []Sample {
+	if h.dataClient == nil {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, backfillTimeout)
+	defer cancel()
+
+	res, err := h.dataClient.GetLatestReadingsBySensor(ctx, &pb_data.LatestReadingsBySensorRequest{
+		SensorId: key.sensorID,
+		Limit:    backfillLimit,
+	})
+	if err != nil {
+		logger.Warn("Failed to backfill reading history",
+			zap.Int64("sensor_id", key.sensorID),
+			zap.String("channel", key.channel),
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	var samples []Sample
+	for _, reading := range res.Readings {
+		if reading.Quality != "" && reading.Quality != "good" {
+			continue
+		}
+		at := reading.Timestamp.AsTime()
+		if at.Before(from) || !at.Before(to) {
+			continue
+		}
+		value := reading.Value
+		if key.channel != "" {
+			v, ok := reading.Values[key.channel]
+			if !ok {
+				continue
+			}
+			value = v
+		}
+		samples = append(samples, Sample{At: at, Value: float64(value)})
+	}
+	return samples
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Metrics an alert rule compares against its thresholds. VALUE is the reading itself.
+// The rate metrics look at the readings of the rule's window, the current one
+// included: DELTA is the change from the oldest of them, DELTA_PERCENT is that change
+// in percent of the oldest reading and SLOPE is the least-squares trend per minute.
+const (
+	MetricValue        = "VALUE"
+	MetricDelta        = "DELTA"
+	MetricDeltaPercent = "DELTA_PERCENT"
+	MetricSlope        = "SLOPE"
+)
+
+var metrics = []string{MetricValue, MetricDelta, MetricDeltaPercent, MetricSlope}
+
+// Bounds of the window of a rate metric, in seconds.
+const (
+	minWindowSeconds = 10
+	maxWindowSeconds = 24 * 60 * 60
+)
+
+// IsRateMetric reports whether metric is computed over a window of readings.
+func IsRateMetric(metric string) bool {
+	return metric != "" && metric != MetricValue
+}
+
+// NormalizeMetric validates the metric of a rule and returns it in upper case, VALUE
+// when empty. Rate metrics require a window, VALUE ignores it.
+func NormalizeMetric(metric string, windowSeconds int) (string, int, error) {
+	normalized := strings.ToUpper(strings.TrimSpace(metric))
+	if normalized == "" {
+		normalized = MetricValue
+	}
+	if !slices.Contains(metrics, normalized) {
+		return "", 0, fmt.Errorf("unknown metric %q, expected one of %s", metric, strings.Join(metrics, ", "))
+	}
+
+	if !IsRateMetric(normalized) {
+		return normalized, 0, nil
+	}
+	if windowSeconds < minWindowSeconds || windowSeconds > maxWindowSeconds {
+		return "", 0, fmt.Errorf("%s requires window_seconds between %d and %d", normalized, minWindowSeconds, maxWindowSeconds)
+	}
+	return normalized, windowSeconds, nil
+}
+
+// Sample is a reading of one sensor channel.
+type Sample struct {
+	At    time.Time
+	Value float64
+}
+
+// ComputeMetric computes a rate metric over samples, which are sorted by time and end
+// with the current reading. It returns false when the samples do not determine the
+// metric: there are fewer than two of them, they were all taken at once or, for
+// DELTA_PERCENT, the oldest one is zero.
+func ComputeMetric(metric string, samples []Sample) (float64, bool) {
+	if len(samples) < 2 {
+		return 0, false
+	}
+	first, last := samples[0], samples[len(samples)-1]
+
+	switch metric {
+	case MetricDelta:
+		return last.Value - first.Value, true
+	case MetricDeltaPercent:
+		if first.Value == 0 {
+			return 0, false
+		}
+		return (last.Value - first.Value) / math.Abs(first.Value) * 100, true
+	case MetricSlope:
+		return slopePerMinute(samples)
+	}
+	return 0, false
+}
+
+func slopePerMinute(samples []Sample) (float64, bool) {
+	start := samples[0].At
+	n := float64(len(samples))
+
+	var sumX, sumY float64
+	for _, s := range samples {
+		sumX += s.At.Sub(start).Minutes()
+		sumY += s.Value
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX float64
+	for _, s := range samples {
+		dx := s.At.Sub(start).Minutes() - meanX
+		cov += dx * (s.Value - meanY)
+		varX += dx * dx
+	}
+	if varX == 0 {
+		return 0, false
+	}
+	return cov / varX, true
+}
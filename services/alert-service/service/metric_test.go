@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+func TestNormalizeMetric(t *testing.T) {
+	metric, window, err := NormalizeMetric("", 300)
+	require.NoError(t, err)
+	assert.Equal(t, MetricValue, metric)
+	assert.Zero(t, window)
+
+	metric, window, err = NormalizeMetric(" slope ", 300)
+	require.NoError(t, err)
+	assert.Equal(t, MetricSlope, metric)
+	assert.Equal(t, 300, window)
+
+	_, _, err = NormalizeMetric("RATE", 300)
+	assert.Error(t, err)
+	_, _, err = NormalizeMetric("DELTA", 0)
+	assert.Error(t, err)
+	_, _, err = NormalizeMetric("DELTA", maxWindowSeconds+1)
+	assert.Error(t, err)
+}
+
+func TestComputeMetric(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	samples := func(values ...float64) []Sample {
+		s := make([]Sample, len(values))
+		for i, v := range values {
+			s[i] = Sample{At: start.Add(time.Duration(i) * 30 * time.Second), Value: v}
+		}
+		return s
+	}
+
+	tests := []struct {
+		name     string
+		metric   string
+		samples  []Sample
+		expected float64
+		ok       bool
+	}{
+		{"Delta", MetricDelta, samples(10, 15, 12), 2, true},
+		{"Delta Percent", MetricDeltaPercent, samples(-50, -40), 20, true},
+		{"Delta Percent From Zero", MetricDeltaPercent, samples(0, 5), 0, false},
+		{"Slope", MetricSlope, samples(10, 11, 12, 13), 2, true},
+		{"Single Sample", MetricDelta, samples(10), 0, false},
+		{"Slope Of Simultaneous Samples", MetricSlope, []Sample{{At: start, Value: 1}, {At: start, Value: 2}}, 0, false},
+		{"Value Is Not A Rate Metric", MetricValue, samples(10, 15), 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := ComputeMetric(tt.metric, tt.samples)
+			assert.Equal(t, tt.ok, ok)
+			assert.InDelta(t, tt.expected, value, 1e-9)
+		})
+	}
+}
+
+// fakeDataClient serves stored readings and counts the lookups.
+type fakeDataClient struct {
+	pb_data.DataServiceClient
+	readings []*pb_data.ReadingUpdate
+	calls    int
+}
+
+func (c *fakeDataClient) GetLatestReadingsBatch(_ context.Context, _ *pb_data.LatestReadingsBatchRequest, _ ...grpc.CallOption) (*pb_data.LatestReadingsBatchResponse, error) {
+	c.calls++
+	return &pb_data.LatestReadingsBatchResponse{Readings: c.readings}, nil
+}
+
+func (c *fakeDataClient) GetLatestReadingsBySensor(_ context.Context, _ *pb_data.LatestReadingsBySensorRequest, _ ...grpc.CallOption) (*pb_data.LatestReadingsBySensorResponse, error) {
+	c.calls++
+	return &pb_data.LatestReadingsBySensorResponse{Readings: c.readings}, nil
+}
+
+func TestReadingHistory(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	stored := func(ago time.Duration, value float32, quality string) *pb_data.ReadingUpdate {
+		return &pb_data.ReadingUpdate{
+			SensorId:  1,
+			Value:     value,
+			Timestamp: timestamppb.New(now.Add(-ago)),
+			Quality:   quality,
+			Values:    map[string]float32{"humidity": value * 2},
+		}
+	}
+	client := &fakeDataClient{readings: []*pb_data.ReadingUpdate{
+		stored(10*time.Second, 4, "good"),
+		stored(20*time.Second, 3, "suspect"),
+		stored(40*time.Second, 2, ""),
+		stored(5*time.Minute, 1, "good"),
+	}}
+	history := NewReadingHistory(client)
+
+	samples := history.Record(ctx, 1, "", 5, now, time.Minute)
+	assert.Equal(t, []Sample{
+		{At: now.Add(-40 * time.Second), Value: 2},
+		{At: now.Add(-10 * time.Second), Value: 4},
+		{At: now, Value: 5},
+	}, samples)
+
+	samples = history.Record(ctx, 1, "", 6, now.Add(30*time.Second), time.Minute)
+	assert.Equal(t, []Sample{
+		{At: now.Add(-10 * time.Second), Value: 4},
+		{At: now, Value: 5},
+		{At: now.Add(30 * time.Second), Value: 6},
+	}, samples)
+	assert.Equal(t, 1, client.calls, "a channel is backfilled once per window")
+
+	samples = history.Record(ctx, 1, "humidity", 10, now, time.Minute)
+	assert.Equal(t, []Sample{
+		{At: now.Add(-40 * time.Second), Value: 4},
+		{At: now.Add(-10 * time.Second), Value: 8},
+		{At: now, Value: 10},
+	}, samples)
+	assert.Equal(t, 2, client.calls)
+}
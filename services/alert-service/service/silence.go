@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+const lastReadingTimeout = 5 * time.Second
+
+// ReadingTracker remembers when each sensor last sent a reading, of any quality. It has
+// to observe every reading, not just those of a queue shared with other consumers. The
+// sensors not heard from since the tracker started are looked up in the data service in
+// one batch, so a sensor that went silent before a restart is still noticed. Without
+// the data service, or when the sensor never reported, it counts as last heard from
+// when the tracker started.
+type ReadingTracker struct {
+	dataClient pb_data.DataServiceClient
+	started    time.Time
+	wake       chan struct{}
+
+	mu   sync.Mutex
+	seen map[int64]time.Time
+	// silent holds the sensors with a fired NO_DATA rule. A reading of one of them
+	// wakes the checker, so the rule is resolved right away.
+	silent map[int64]bool
+}
+
+func NewReadingTracker(dataClient pb_data.DataServiceClient) *ReadingTracker {
+	return &ReadingTracker{
+		dataClient: dataClient,
+		started:    time.Now(),
+		wake:       make(chan struct{}, 1),
+		seen:       make(map[int64]time.Time),
+		silent:     make(map[int64]bool),
+	}
+}
+
+// Observe records a reading of sensorID received at at. It never blocks.
+func (t *ReadingTracker) Observe(sensorID int64, at time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.observe(sensorID, at)
+	if t.silent[sensorID] {
+		delete(t.silent, sensorID)
+		select {
+		case t.wake <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (t *ReadingTracker) observe(sensorID int64, at time.Time) {
+	if last, ok := t.seen[sensorID]; !ok || at.After(last) {
+		t.seen[sensorID] = at
+	}
+}
+
+// Wake is signalled when a sensor marked silent reports again.
+func (t *ReadingTracker) Wake() <-chan struct{} {
+	return t.wake
+}
+
+// SetSilent marks whether sensorID has a fired NO_DATA rule.
+func (t *ReadingTracker) SetSilent(sensorID int64, silent bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if silent {
+		t.silent[sensorID] = true
+	} else {
+		delete(t.silent, sensorID)
+	}
+}
+
+// LastReadings returns when each of sensorIDs last sent a reading.
+func (t *ReadingTracker) LastReadings(ctx context.Context, sensorIDs []int64) map[int64]time.Time {
+	last := make(map[int64]time.Time, len(sensorIDs))
+	var missing []int64
+
+	t.mu.Lock()
+	for _, id := range sensorIDs {
+		if at, ok := t.seen[id]; ok {
+			last[id] = at
+		} else if _, dup := last[id]; !dup {
+			last[id] = t.started
+			missing = append(missing, id)
+		}
+	}
+	t.mu.Unlock()
+
+	if len(missing) == 0 {
+		return last
+	}
+	stored, ok := t.fetch(ctx, missing)
+	if !ok {
+		return last
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, id := range missing {
+		// Sensors that never reported are remembered as of the start, so they are
+		// not looked up on every check.
+		at, found := stored[id]
+		if !found {
+			at = t.started
+		}
+		// A reading may have been observed meanwhile.
+		t.observe(id, at)
+		last[id] = t.seen[id]
+	}
+	return last
+}
+
+// fetch looks up the time of the latest stored reading of sensorIDs. It returns false
+// when that is not known, in which case the lookup is retried next time.
+func (t *ReadingTracker) fetch(ctx context.Context, sensorIDs []int64) (map[int64]time.Time, bool) {
+	if t.dataClient == nil {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, lastReadingTimeout)
+	defer cancel()
+
+	res, err := t.dataClient.GetLatestReadingsBatch(ctx, &pb_data.LatestReadingsBatchRequest{SensorIds: sensorIDs})
+	if err != nil {
+		logger.Warn("Failed to look up latest readings", zap.Int("sensors", len(sensorIDs)), zap.Error(err))
+		return nil, false
+	}
+
+	stored := make(map[int64]time.Time, len(res.Readings))
+	for _, reading := range res.Readings {
+		stored[reading.SensorId] = reading.Timestamp.AsTime()
+	}
+	return stored, true
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+func TestReadingTracker(t *testing.T) {
+	ctx := context.Background()
+	stored := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	client := &fakeDataClient{readings: []*pb_data.ReadingUpdate{
+		{SensorId: 1, Value: 20, Timestamp: timestamppb.New(stored)},
+	}}
+	tracker := NewReadingTracker(client)
+
+	last := tracker.LastReadings(ctx, []int64{1, 2, 2})
+	assert.Equal(t, stored, last[1])
+	assert.Equal(t, tracker.started, last[2], "a sensor that never reported counts from the start")
+	assert.Equal(t, 1, client.calls, "missing sensors are looked up in one call")
+
+	tracker.LastReadings(ctx, []int64{1, 2})
+	assert.Equal(t, 1, client.calls, "looked up sensors are remembered")
+
+	received := time.Now()
+	tracker.Observe(1, received)
+	tracker.Observe(1, stored)
+	assert.Equal(t, received, tracker.LastReadings(ctx, []int64{1})[1])
+
+	offline := NewReadingTracker(nil)
+	assert.Equal(t, offline.started, offline.LastReadings(ctx, []int64{1})[1])
+}
+
+func TestReadingTrackerWakesOnSilentSensor(t *testing.T) {
+	tracker := NewReadingTracker(nil)
+
+	tracker.Observe(1, time.Now())
+	assert.Empty(t, tracker.Wake())
+
+	tracker.SetSilent(1, true)
+	tracker.Observe(1, time.Now())
+	assert.Len(t, tracker.Wake(), 1)
+	<-tracker.Wake()
+
+	tracker.Observe(1, time.Now())
+	assert.Empty(t, tracker.Wake(), "a sensor is reported back once")
+}
@@ -2,15 +2,85 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alert"
 )
 
+// AlertsExchange receives an event whenever an alert changes status.
+const AlertsExchange = "alerts_exchange"
+
+// Alert statuses. An alert is open until it is resolved.
+const (
+	AlertFiring       = "firing"
+	AlertAcknowledged = "acknowledged"
+	AlertResolved     = "resolved"
+)
+
+// ErrAlertResolved is returned when acknowledging an alert that was already resolved.
+var ErrAlertResolved = errors.New("alert is already resolved")
+
+// AlertEvent is the alerts_exchange payload of an alert status change. Value is the
+// reading that raised a firing alert and the last violating reading otherwise.
+type AlertEvent struct {
+	AlertID   int       `json:"alert_id"`
+	RuleID    int       `json:"rule_id"`
+	UserID    int64     `json:"user_id"`
+	SensorID  int64     `json:"sensor_id"`
+	Status    string    `json:"status"`
+	Message   string    `json:"message"`
+	Value     float64   `json:"value"`
+	Count     int       `json:"count"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// WriteAlertEvent queues the alerts_exchange event announcing the current status of a,
+// an alert of rule. client is expected to be the client of the transaction that
+// changed the alert.
+func WriteAlertEvent(ctx context.Context, client *ent.Client, a *ent.Alert, rule *ent.AlertRule) error {
+	event := AlertEvent{
+		AlertID:   a.ID,
+		RuleID:    rule.ID,
+		UserID:    a.UserID,
+		SensorID:  rule.SensorID,
+		Status:    a.Status,
+		Message:   a.Message,
+		Value:     a.LastValue,
+		Count:     a.Count,
+		Timestamp: a.TriggeredAt,
+	}
+	switch a.Status {
+	case AlertFiring:
+		event.Value = a.Value
+	case AlertAcknowledged:
+		event.Timestamp = *a.AcknowledgedAt
+	case AlertResolved:
+		event.Timestamp = *a.ResolvedAt
+	}
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return client.OutboxEvent.Create().
+		SetExchange(AlertsExchange).
+		SetPayload(body).
+		Exec(ctx)
+}
+
 type IAlertStorage interface {
 	Get(ctx context.Context, id int) (*ent.Alert, error)
 	List(ctx context.Context, userID int64, limit, offset int) ([]*ent.Alert, int, error)
 	MarkAsRead(ctx context.Context, id int) (bool, error)
+	// Acknowledge acknowledges a firing alert of userID, or of any user if userID is
+	// zero. It reports whether the status changed.
+	Acknowledge(ctx context.Context, id int, userID int64) (*ent.Alert, bool, error)
+	// Resolve resolves an open alert like Acknowledge and re-arms its rule.
+	Resolve(ctx context.Context, id int, userID int64) (*ent.Alert, bool, error)
 }
 
 type AlertStorage struct {
@@ -53,3 +123,70 @@ func (s *AlertStorage) MarkAsRead(ctx context.Context, id int) (bool, error) {
 	}
 	return true, nil
 }
+
+func (s *AlertStorage) Acknowledge(ctx context.Context, id int, userID int64) (*ent.Alert, bool, error) {
+	return s.transition(ctx, id, userID, func(tx *ent.Tx, a *ent.Alert) (*ent.Alert, error) {
+		if a.Status == AlertResolved {
+			return nil, ErrAlertResolved
+		}
+		if a.Status == AlertAcknowledged {
+			return nil, nil
+		}
+		return tx.Alert.UpdateOne(a).
+			SetStatus(AlertAcknowledged).
+			SetAcknowledgedAt(time.Now()).
+			SetAcknowledgedBy(userID).
+			Save(ctx)
+	})
+}
+
+func (s *AlertStorage) Resolve(ctx context.Context, id int, userID int64) (*ent.Alert, bool, error) {
+	return s.transition(ctx, id, userID, func(tx *ent.Tx, a *ent.Alert) (*ent.Alert, error) {
+		if a.Status == AlertResolved {
+			return nil, nil
+		}
+		// The rule starts over, so an ongoing violation raises a new alert.
+		if err := resetRuleState(ctx, tx.Client(), a.Edges.Rule.ID); err != nil {
+			return nil, err
+		}
+		return tx.Alert.UpdateOne(a).
+			SetStatus(AlertResolved).
+			SetResolvedAt(time.Now()).
+			Save(ctx)
+	})
+}
+
+// transition changes the status of an alert with change, which returns the updated
+// alert or nil to leave it as it is, and queues the event of the new status.
+func (s *AlertStorage) transition(ctx context.Context, id int, userID int64, change func(*ent.Tx, *ent.Alert) (*ent.Alert, error)) (*ent.Alert, bool, error) {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	query := tx.Alert.Query().Where(alert.ID(id)).WithRule()
+	if userID != 0 {
+		query.Where(alert.UserID(userID))
+	}
+	a, err := query.Only(ctx)
+	if err != nil {
+		return nil, false, rollback(tx, err)
+	}
+
+	updated, err := change(tx, a)
+	if err != nil {
+		return nil, false, rollback(tx, err)
+	}
+	if updated == nil {
+		return a, false, tx.Rollback()
+	}
+	updated.Edges.Rule = a.Edges.Rule
+
+	if err := WriteAlertEvent(ctx, tx.Client(), updated, a.Edges.Rule); err != nil {
+		return nil, false, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+	return updated, true, nil
+}
@@ -3,9 +3,12 @@ package storage
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alert"
 	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/alertrule"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/rulestate"
 )
 
 type IAlertRuleStorage interface {
@@ -29,8 +32,16 @@ func (s *AlertRuleStorage) Create(ctx context.Context, rule *ent.AlertRule) (*en
 		SetName(rule.Name).
 		SetUserID(rule.UserID).
 		SetSensorID(rule.SensorID).
+		SetChannel(rule.Channel).
 		SetConditionType(rule.ConditionType).
 		SetThreshold(rule.Threshold).
+		SetThresholdHigh(rule.ThresholdHigh).
+		SetForSeconds(rule.ForSeconds).
+		SetForReadings(rule.ForReadings).
+		SetMetric(rule.Metric).
+		SetWindowSeconds(rule.WindowSeconds).
+		SetSkipBadQuality(rule.SkipBadQuality).
+		SetNillableClearThreshold(rule.ClearThreshold).
 		SetDescription(rule.Description).
 		Save(ctx)
 }
@@ -59,28 +70,124 @@ func (s *AlertRuleStorage) List(ctx context.Context, userID int64, limit, offset
 	return rules, totalCount, nil
 }
 
+// Update replaces the rule, resolves its open alert and resets its evaluation state, so
+// a changed rule starts out armed and without a pending condition.
 func (s *AlertRuleStorage) Update(ctx context.Context, rule *ent.AlertRule) (*ent.AlertRule, error) {
-	return s.client.AlertRule.UpdateOneID(rule.ID).
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	update := tx.AlertRule.UpdateOneID(rule.ID).
 		SetName(rule.Name).
 		SetSensorID(rule.SensorID).
+		SetChannel(rule.Channel).
 		SetConditionType(rule.ConditionType).
 		SetThreshold(rule.Threshold).
+		SetThresholdHigh(rule.ThresholdHigh).
+		SetForSeconds(rule.ForSeconds).
+		SetForReadings(rule.ForReadings).
+		SetMetric(rule.Metric).
+		SetWindowSeconds(rule.WindowSeconds).
+		SetSkipBadQuality(rule.SkipBadQuality).
 		SetDescription(rule.Description).
-		SetIsEnabled(rule.IsEnabled).
-		Save(ctx)
+		SetIsEnabled(rule.IsEnabled)
+	if rule.ClearThreshold != nil {
+		update.SetClearThreshold(*rule.ClearThreshold)
+	} else {
+		update.ClearClearThreshold()
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := resolveOpenAlert(ctx, tx.Client(), updated); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := resetRuleState(ctx, tx.Client(), rule.ID); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated, nil
 }
 
+// Delete removes the rule together with its state and alerts. An open alert is resolved
+// first, so its subscribers are told it ended.
 func (s *AlertRuleStorage) Delete(ctx context.Context, id int64) error {
-	exists, err := s.client.AlertRule.Query().Where(alertrule.ID(int(id))).Exist(ctx)
+	tx, err := s.client.Tx(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to check if rule exists: %w", err)
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	rule, err := tx.AlertRule.Get(ctx, int(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return rollback(tx, fmt.Errorf("rule with ID %d does not exist", id))
+		}
+		return rollback(tx, fmt.Errorf("failed to check if rule exists: %w", err))
+	}
+	if err := resolveOpenAlert(ctx, tx.Client(), rule); err != nil {
+		return rollback(tx, err)
 	}
-	if !exists {
-		return fmt.Errorf("rule with ID %d does not exist", id)
+	if _, err := tx.Alert.Delete().Where(alert.HasRuleWith(alertrule.ID(rule.ID))).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete alerts of rule: %w", err))
 	}
-	err = s.client.AlertRule.DeleteOneID(int(id)).Exec(ctx)
+	if _, err := tx.RuleState.Delete().Where(rulestate.RuleID(rule.ID)).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete rule state: %w", err))
+	}
+	if err := tx.AlertRule.DeleteOneID(rule.ID).Exec(ctx); err != nil {
+		return rollback(tx, fmt.Errorf("failed to delete rule: %w", err))
+	}
+	return tx.Commit()
+}
+
+// resolveOpenAlert resolves the open alert of rule, if it has one, and queues its
+// event. client is expected to be the client of the transaction changing the rule.
+func resolveOpenAlert(ctx context.Context, client *ent.Client, rule *ent.AlertRule) error {
+	open, err := client.Alert.Query().
+		Where(alert.HasRuleWith(alertrule.ID(rule.ID)), alert.StatusNEQ(AlertResolved)).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to find open alert of rule: %w", err)
+	}
+	for _, a := range open {
+		resolved, err := client.Alert.UpdateOne(a).
+			SetStatus(AlertResolved).
+			SetResolvedAt(time.Now()).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to resolve alert of rule: %w", err)
+		}
+		if err := WriteAlertEvent(ctx, client, resolved, rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resetRuleState re-arms a rule and drops its pending condition. The state is reset in
+// place and its version raised, so an evaluation that loaded it meanwhile fails to save
+// its stale copy and evaluates the reading again.
+func resetRuleState(ctx context.Context, client *ent.Client, ruleID int) error {
+	err := client.RuleState.Update().
+		Where(rulestate.RuleID(ruleID)).
+		SetFiring(false).
+		SetPendingReadings(0).
+		ClearPendingSince().
+		AddVersion(1).
+		Exec(ctx)
 	if err != nil {
-		return fmt.Errorf("failed to delete rule: %w", err)
+		return fmt.Errorf("failed to reset rule state: %w", err)
 	}
 	return nil
 }
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
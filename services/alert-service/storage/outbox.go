@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"context"
+	"time"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/outboxevent"
+)
+
+type OutboxStorage struct {
+	client *ent.Client
+}
+
+func NewOutboxStorage(client *ent.Client) outbox.Store {
+	return &OutboxStorage{client: client}
+}
+
+func (s *OutboxStorage) Claim(ctx context.Context, limit int, lease time.Duration) ([]outbox.Message, error) {
+	now := time.Now()
+	ids, err := s.client.OutboxEvent.Query().
+		Where(outboxevent.NextAttemptAtLTE(now)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	// The update only takes rows that are still due, so rows another relay claimed
+	// since the query are left to it.
+	token := outbox.NewClaimToken()
+	err = s.client.OutboxEvent.Update().
+		Where(outboxevent.IDIn(ids...), outboxevent.NextAttemptAtLTE(now)).
+		SetClaimToken(token).
+		SetNextAttemptAt(now.Add(lease)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.client.OutboxEvent.Query().
+		Where(outboxevent.ClaimToken(token)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]outbox.Message, 0, len(events))
+	for _, e := range events {
+		msgs = append(msgs, outbox.Message{
+			ID:         int64(e.ID),
+			Exchange:   e.Exchange,
+			RoutingKey: e.RoutingKey,
+			Payload:    e.Payload,
+			Attempts:   e.Attempts,
+		})
+	}
+	return msgs, nil
+}
+
+func (s *OutboxStorage) MarkPublished(ctx context.Context, id int64) error {
+	_, err := s.client.OutboxEvent.Delete().Where(outboxevent.ID(int(id))).Exec(ctx)
+	return err
+}
+
+func (s *OutboxStorage) MarkFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error {
+	return s.client.OutboxEvent.UpdateOneID(int(id)).
+		AddAttempts(1).
+		SetLastError(reason).
+		SetNextAttemptAt(retryAt).
+		Exec(ctx)
+}
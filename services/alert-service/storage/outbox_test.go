@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	_ "modernc.org/sqlite"
+
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/alert-service/ent/enttest"
+)
+
+func TestOutboxClaim(t *testing.T) {
+	db, err := sql.Open("sqlite", "file:outbox?mode=memory&cache=shared&_pragma=foreign_keys(1)")
+	require.NoError(t, err)
+	client := enttest.NewClient(t, enttest.WithOptions(ent.Driver(entsql.OpenDB(dialect.SQLite, db))))
+	defer client.Close()
+
+	ctx := context.Background()
+	for range 3 {
+		require.NoError(t, client.OutboxEvent.Create().SetExchange(AlertsExchange).SetPayload([]byte("{}")).Exec(ctx))
+	}
+	store := NewOutboxStorage(client)
+
+	first, err := store.Claim(ctx, 2, time.Minute)
+	require.NoError(t, err)
+	assert.Len(t, first, 2)
+	assert.Less(t, first[0].ID, first[1].ID)
+
+	second, err := store.Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, second, 1, "leased messages are not claimed again")
+	assert.NotContains(t, []int64{first[0].ID, first[1].ID}, second[0].ID)
+
+	// A message whose lease ran out, because its relay stopped, is claimed again.
+	require.NoError(t, store.MarkFailed(ctx, first[0].ID, time.Now().Add(-time.Second), "relay stopped"))
+	third, err := store.Claim(ctx, 10, time.Minute)
+	require.NoError(t, err)
+	require.Len(t, third, 1)
+	assert.Equal(t, first[0].ID, third[0].ID)
+	assert.Equal(t, 1, third[0].Attempts)
+}
@@ -315,6 +315,70 @@ const docTemplate = `{
                 }
             }
         },
+        "/api/alerts/{id}/acknowledge": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Acknowledge a firing alert of the authenticated user. Acknowledging an acknowledged alert changes nothing.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Alerts"
+                ],
+                "summary": "Acknowledge Alert",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.AlertResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Alert not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Alert is already resolved",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
         "/api/alerts/{id}/read": {
             "post": {
                 "security": [
@@ -367,62 +431,163 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/data/readings": {
+        "/api/alerts/{id}/resolve": {
             "post": {
-                "description": "Sends a sensor reading to the data processing service",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Resolve an open alert of the authenticated user and re-arm its rule. A violation that is still ongoing raises a new alert. Resolving a resolved alert changes nothing.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Data"
+                    "Alerts"
                 ],
-                "summary": "Store a new sensor reading",
+                "summary": "Resolve Alert",
                 "parameters": [
                     {
-                        "description": "Sensor Reading",
-                        "name": "reading",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/types.StoreReadingRequest"
-                        }
+                        "type": "integer",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/types.AlertResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Alert not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/data/readings/latest": {
+        "/api/data/compare": {
             "get": {
-                "description": "Fetches the most recent reading for each specified sensor",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Aggregates several sensors, or the sensors of a group, into a time-aligned table with one row per bucket and one column per sensor. Every sensor has to belong to the authenticated user.",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Data"
                 ],
-                "summary": "Get latest readings for multiple sensors",
+                "summary": "Compare sensor readings",
                 "parameters": [
                     {
                         "type": "string",
                         "description": "Comma-separated sensor IDs",
                         "name": "sensor_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Sensor group ID, adds every sensor of the group",
+                        "name": "sensor_group_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time (RFC3339, default 24h ago)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time (RFC3339, default now)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket interval, e.g. 5m, 1h, 1d",
+                        "name": "interval",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket aggregate: avg, min, max, sum, count, first, last (default avg)",
+                        "name": "agg",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel of multi-channel sensors (default primary value)",
+                        "name": "channel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Fill buckets without readings with null, previous or linear",
+                        "name": "fill",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.MultiSensorReadingsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor or group not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "string"
                         }
@@ -430,31 +595,88 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/data/sensors/{sensor_id}/latest": {
+        "/api/data/export": {
             "get": {
-                "description": "Fetches the most recent N readings for a specific sensor",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Streams the raw readings of one or more sensors, or of a sensor group, as a CSV, NDJSON or Parquet download. Rows carry the sensor name, location and unit. Every sensor and the group have to belong to the signed in user.",
+                "produces": [
+                    "text/csv",
+                    "application/x-ndjson",
+                    "application/vnd.apache.parquet"
+                ],
                 "tags": [
                     "Data"
                 ],
-                "summary": "Get latest N readings for a single sensor",
+                "summary": "Export sensor readings",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Sensor ID",
-                        "name": "sensor_id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Comma-separated sensor IDs",
+                        "name": "sensor_ids",
+                        "in": "query"
                     },
                     {
                         "type": "integer",
-                        "description": "Number of readings to fetch (default 10)",
-                        "name": "limit",
+                        "description": "Sensor group ID, adds every sensor of the group",
+                        "name": "sensor_group_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time (RFC3339, default 24h ago)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time (RFC3339, default now)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "csv, ndjson or parquet (default csv)",
+                        "name": "format",
                         "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor or group not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
                         "schema": {
                             "type": "string"
                         }
@@ -462,88 +684,59 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/data/sensors/{sensor_id}/readings": {
+        "/api/data/groups/{group_id}/stats": {
             "get": {
-                "description": "Fetches historical data for a specific sensor",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the reading statistics of every sensor in the group and of all their readings together",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
                     "Data"
                 ],
-                "summary": "Get historical sensor readings",
+                "summary": "Get reading statistics for a sensor group",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor ID",
-                        "name": "sensor_id",
+                        "description": "Sensor group ID",
+                        "name": "group_id",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Start time (RFC3339)",
+                        "description": "Start time (RFC3339, default 24h ago)",
                         "name": "start_time",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "End time (RFC3339)",
+                        "description": "End time (RFC3339, default now)",
                         "name": "end_time",
                         "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel of multi-channel sensors (default primary value)",
+                        "name": "channel",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/types.GroupStatisticsResponse"
                         }
-                    }
-                }
-            }
-        },
-        "/api/data/ws/readings": {
-            "get": {
-                "description": "Establishes a WebSocket connection for real-time sensor data streaming",
-                "tags": [
-                    "Data"
-                ],
-                "summary": "Stream sensor readings via WebSocket",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Comma-separated sensor IDs",
-                        "name": "sensor_ids",
-                        "in": "query"
-                    }
-                ],
-                "responses": {}
-            }
-        },
-        "/api/sensor-groups": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get all sensor groups for the authenticated user",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "SensorGroups"
-                ],
-                "summary": "List sensor groups",
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/types.SensorGroupResponse"
-                            }
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -552,59 +745,119 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor group not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/data/imports": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Create a new sensor group",
+                "description": "Uploads a CSV file and starts an import job that validates and bulk-loads its rows. Columns are mapped by header name. Every sensor of the file has to belong to the authenticated user. Imported readings are not published for alert evaluation. Poll the returned job for progress and row errors.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "SensorGroups"
+                    "Data"
                 ],
-                "summary": "Create sensor group",
+                "summary": "Import historical readings from a CSV file",
                 "parameters": [
                     {
-                        "description": "Group data",
-                        "name": "group",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/types.CreateGroupRequest"
-                        }
+                        "type": "file",
+                        "description": "CSV file with a header row",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Import every row for this sensor",
+                        "name": "sensor_id",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column holding the sensor ID of each row",
+                        "name": "sensor_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Timestamp column (default time)",
+                        "name": "time_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Value column (default value)",
+                        "name": "value_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel to column mapping, e.g. temperature=temp_c,humidity=rh",
+                        "name": "channel_columns",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quality column (default good)",
+                        "name": "quality_column",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "rfc3339 (default), unix, unix_ms or a Go time layout such as 2006-01-02 15:04:05",
+                        "name": "time_format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "IANA timezone of timestamps without an offset (default UTC)",
+                        "name": "timezone",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field delimiter (default ,)",
+                        "name": "delimiter",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/types.ImportJobResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -613,37 +866,52 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Sensor not found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "413": {
+                        "description": "File too large",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/sensor-groups/{id}": {
+        "/api/data/imports/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a specific sensor group with its sensors",
+                "description": "Returns the status, progress counters and the first row errors of an import job started by the authenticated user",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "SensorGroups"
+                    "Data"
                 ],
-                "summary": "Get sensor group",
+                "summary": "Get an import job",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Group ID",
+                        "description": "Import job ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -653,16 +921,13 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/types.SensorGroupResponse"
+                            "$ref": "#/definitions/types.ImportJobResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -672,32 +937,23 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Import job not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update an existing sensor group",
+            }
+        },
+        "/api/data/readings": {
+            "post": {
+                "description": "Sends a sensor reading to the data processing service. Retries carrying the same idempotency key, or a reading for an already stored sensor/timestamp pair, are acknowledged with status \"duplicate\" and not processed again.",
                 "consumes": [
                     "application/json"
                 ],
@@ -705,36 +961,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "SensorGroups"
+                    "Data"
                 ],
-                "summary": "Update sensor group",
+                "summary": "Store a new sensor reading",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "type": "string",
+                        "description": "Client supplied key identifying the reading across retries",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     },
                     {
-                        "description": "Group data",
-                        "name": "group",
+                        "description": "Sensor Reading",
+                        "name": "reading",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.UpdateGroupRequest"
+                            "$ref": "#/definitions/types.StoreReadingRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/types.SensorGroupResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -742,219 +991,155 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Sensor not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
+            }
+        },
+        "/api/data/readings/batch": {
+            "post": {
+                "description": "Sends many readings, possibly for different sensors, in a single request. Invalid readings are reported per index and do not fail the batch.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
                 ],
-                "description": "Delete a sensor group",
                 "tags": [
-                    "SensorGroups"
+                    "Data"
                 ],
-                "summary": "Delete sensor group",
+                "summary": "Store a batch of sensor readings",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Sensor Readings",
+                        "name": "readings",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.StoreReadingsBatchRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
-                    },
-                    "400": {
-                        "description": "Bad Request",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/types.StoreReadingsBatchResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/sensor-groups/{id}/sensors": {
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Add sensors to an existing group",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+        "/api/data/readings/latest": {
+            "get": {
+                "description": "Fetches the most recent reading for each specified sensor",
                 "tags": [
-                    "SensorGroups"
+                    "Data"
                 ],
-                "summary": "Add sensors to group",
+                "summary": "Get latest readings for multiple sensors",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Group ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Comma-separated sensor IDs",
+                        "name": "sensor_ids",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Sensor IDs to add",
-                        "name": "sensors",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/types.AddSensorsRequest"
-                        }
+                        "type": "string",
+                        "description": "Comma-separated units to convert values to, one per dimension, e.g. °F,psi (default the unit preferences of the signed in user)",
+                        "name": "target_unit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/types.SensorGroupResponse"
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
-                    },
-                    "404": {
-                        "description": "Not Found",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/data/sensors/{sensor_id}/deletions": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Remove sensors from an existing group",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Lists the audit records of readings deleted from a sensor, newest first.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "SensorGroups"
+                    "Data"
                 ],
-                "summary": "Remove sensors from group",
+                "summary": "List reading deletions",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Group ID",
-                        "name": "id",
+                        "description": "Sensor ID",
+                        "name": "sensor_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Sensor IDs",
-                        "name": "sensors",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/types.AddSensorsRequest"
-                        }
+                        "type": "integer",
+                        "description": "Maximum number of records (default 100, max 1000)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/types.ReadingDeletionResponse"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -963,115 +1148,108 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Sensor not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/sensor-types": {
+        "/api/data/sensors/{sensor_id}/gaps": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get a list of all sensor types",
+                "description": "Returns the periods in which a sensor did not report for longer than threshold times its expected reporting interval. Without expected_interval the median spacing of the readings is used.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "SensorTypes"
+                    "Data"
+                ],
+                "summary": "List data gaps of a sensor",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "sensor_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time (RFC3339, default 24h ago)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time (RFC3339, default now)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expected reporting interval, e.g. 1m",
+                        "name": "expected_interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Multiple of the expected interval that counts as a gap (default 3)",
+                        "name": "threshold",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List Sensor Types",
                 "responses": {
                     "200": {
-                        "description": "List of sensor types",
+                        "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/types.SensorTypeResponse"
-                            }
+                            "$ref": "#/definitions/types.DataGapsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Create a new sensor type",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "SensorTypes"
-                ],
-                "summary": "Create Sensor Type",
-                "parameters": [
-                    {
-                        "description": "Sensor Type Data",
-                        "name": "sensorType",
-                        "in": "body",
-                        "required": true,
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
-                    }
-                ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
+                    },
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
                             "type": "string"
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Sensor not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "422": {
+                        "description": "Too few readings to estimate the interval",
                         "schema": {
                             "type": "string"
                         }
@@ -1079,130 +1257,220 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/sensor-types/{id}": {
+        "/api/data/sensors/{sensor_id}/latest": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Get details of a specific sensor type by ID",
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Fetches the most recent N readings for a specific sensor",
                 "tags": [
-                    "SensorTypes"
+                    "Data"
                 ],
-                "summary": "Get Sensor Type",
+                "summary": "Get latest N readings for a single sensor",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor Type ID",
-                        "name": "id",
+                        "description": "Sensor ID",
+                        "name": "sensor_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of readings to fetch (default 10)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Sensor type details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/types.SensorTypeResponse"
+                            "type": "string"
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                    }
+                }
+            }
+        },
+        "/api/data/sensors/{sensor_id}/readings": {
+            "get": {
+                "description": "Fetches historical data for a specific sensor, optionally rolled up into time buckets. Without interval, page_size and page_token the data points are returned as a bare array, as before buckets and pages were added, and X-Next-Page-Token is set when more readings follow.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Data"
+                ],
+                "summary": "Get historical sensor readings",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "sensor_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time (RFC3339)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time (RFC3339)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket interval, e.g. 5m, 1h, 1d",
+                        "name": "interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Bucket aggregate: avg, min, max, sum, count, first, last (default avg)",
+                        "name": "agg",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel of a multi-channel sensor (default primary value)",
+                        "name": "channel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Return every bucket, filling buckets without readings with null, previous or linear (requires interval)",
+                        "name": "fill",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated units to convert values to, one per dimension, e.g. °F,psi (default the unit preferences of the signed in user)",
+                        "name": "target_unit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Raw readings per page (default 1000, max 10000, not for interval queries)",
+                        "name": "page_size",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_page_token of the previous page",
+                        "name": "page_token",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.HistoricalReadingsResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "string"
                         }
                     },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Sensor not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
             },
-            "put": {
+            "delete": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Update an existing sensor type by ID",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Deletes readings of a sensor between start_time and end_time, optionally only those whose value (or channel value) compares true against value, e.g. op=gt\u0026value=1000 removes spikes. Either start_time or op is required; deleting a sensor with delete_readings removes all of them. With dry_run=true the matching readings are only counted. Every deletion is recorded with the user and reason.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "SensorTypes"
+                    "Data"
                 ],
-                "summary": "Update Sensor Type",
+                "summary": "Delete sensor readings",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor Type ID",
-                        "name": "id",
+                        "description": "Sensor ID",
+                        "name": "sensor_id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Sensor Type Data",
-                        "name": "sensorType",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "string"
-                        }
+                        "type": "string",
+                        "description": "Start time (RFC3339, inclusive)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time (RFC3339, inclusive, default now)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Value predicate: lt, lte, gt, gte, eq or ne",
+                        "name": "op",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Value compared by op",
+                        "name": "value",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel of a multi-channel sensor compared by op",
+                        "name": "channel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only count the matching readings",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Reason kept in the audit record",
+                        "name": "reason",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/types.DeleteReadingsResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -1211,57 +1479,80 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Sensor not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/data/sensors/{sensor_id}/stats": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Delete a sensor type by ID",
+                "description": "Returns count, min, max, mean, sample stddev, p50/p90/p95/p99 and the first and last reading of a sensor over a time range",
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "SensorTypes"
+                    "Data"
                 ],
-                "summary": "Delete Sensor Type",
+                "summary": "Get reading statistics for a sensor",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor Type ID",
-                        "name": "id",
+                        "description": "Sensor ID",
+                        "name": "sensor_id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start time (RFC3339, default 24h ago)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End time (RFC3339, default now)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel of a multi-channel sensor (default primary value)",
+                        "name": "channel",
+                        "in": "query"
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/types.SensorStatisticsResponse"
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "401": {
@@ -1270,97 +1561,171 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
                     "404": {
-                        "description": "Not Found",
+                        "description": "Sensor not found",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/sensors": {
+        "/api/data/units": {
             "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Fetches all sensors from the Sensor Service.",
+                "description": "Lists the units readings can be converted to with target_unit or the unit preferences of a user, grouped by dimension. Values only convert between units of the same dimension.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sensors"
+                    "Data"
                 ],
-                "summary": "ListSensors retrieves a list of all sensors.",
+                "summary": "List units",
                 "responses": {
                     "200": {
-                        "description": "List of sensors",
+                        "description": "OK",
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/types.SensorResponse"
+                                "$ref": "#/definitions/types.UnitResponse"
                             }
                         }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal Server Error",
-                        "schema": {
-                            "type": "string"
-                        }
                     }
                 }
-            },
-            "post": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Creates a new sensor in the Sensor Service.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+            }
+        },
+        "/api/data/ws/readings": {
+            "get": {
+                "description": "Establishes a WebSocket connection for real-time sensor data streaming",
                 "tags": [
-                    "Sensors"
+                    "Data"
                 ],
-                "summary": "CreateSensor creates a new sensor.",
+                "summary": "Stream sensor readings via WebSocket",
                 "parameters": [
                     {
-                        "description": "Sensor to create",
-                        "name": "sensor",
-                        "in": "body",
+                        "type": "string",
+                        "description": "Comma-separated sensor IDs",
+                        "name": "sensor_ids",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Channel of multi-channel sensors to stream as the value",
+                        "name": "channel",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated units to convert values to, one per dimension, e.g. °F,psi (default the unit preferences of the signed in user)",
+                        "name": "target_unit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Send at most one update per sensor per interval, e.g. 500ms or 5s",
+                        "name": "min_interval",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Only send an update when the value changed by more than deadband since the last one sent",
+                        "name": "deadband",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "How updates within min_interval are combined: latest (default), avg, min or max",
+                        "name": "downsample",
+                        "in": "query"
+                    }
+                ],
+                "responses": {}
+            }
+        },
+        "/api/sensor-groups": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get all sensor groups for the authenticated user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SensorGroups"
+                ],
+                "summary": "List sensor groups",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/types.SensorGroupResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new sensor group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SensorGroups"
+                ],
+                "summary": "Create sensor group",
+                "parameters": [
+                    {
+                        "description": "Group data",
+                        "name": "group",
+                        "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.CreateSensorRequest"
+                            "$ref": "#/definitions/types.CreateGroupRequest"
                         }
                     }
                 ],
                 "responses": {
                     "201": {
-                        "description": "Created sensor details",
+                        "description": "Created",
                         "schema": {
                             "type": "string"
                         }
@@ -1368,7 +1733,10 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -1380,31 +1748,34 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/sensors/{id}": {
+        "/api/sensor-groups/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Fetches a sensor from the Sensor Service by its ID.",
+                "description": "Get a specific sensor group with its sensors",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sensors"
+                    "SensorGroups"
                 ],
-                "summary": "GetSensor retrieves a sensor by ID.",
+                "summary": "Get sensor group",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1412,15 +1783,18 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Sensor details",
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/types.SensorResponse"
+                            "$ref": "#/definitions/types.SensorGroupResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -1432,13 +1806,19 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
@@ -1449,7 +1829,7 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Updates an existing sensor in the Sensor Service.",
+                "description": "Update an existing sensor group",
                 "consumes": [
                     "application/json"
                 ],
@@ -1457,38 +1837,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Sensors"
+                    "SensorGroups"
                 ],
-                "summary": "UpdateSensor updates an existing sensor.",
+                "summary": "Update sensor group",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Sensor fields to update",
-                        "name": "sensor",
+                        "description": "Group data",
+                        "name": "group",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/types.UpdateSensorRequest"
+                            "$ref": "#/definitions/types.UpdateGroupRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated sensor details",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/types.SensorGroupResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -1500,13 +1883,19 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
@@ -1517,15 +1906,15 @@ const docTemplate = `{
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Deletes a sensor from the Sensor Service by its ID.",
+                "description": "Delete a sensor group",
                 "tags": [
-                    "Sensors"
+                    "SensorGroups"
                 ],
-                "summary": "DeleteSensor deletes a sensor by ID.",
+                "summary": "Delete sensor group",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1533,15 +1922,15 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "204": {
-                        "description": "No Content",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -1553,53 +1942,74 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/sensors/{id}/activate": {
+        "/api/sensor-groups/{id}/sensors": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Marks a sensor as active in the Sensor Service.",
+                "description": "Add sensors to an existing group",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Sensors"
+                    "SensorGroups"
                 ],
-                "summary": "SetSensorActive sets a sensor as active.",
+                "summary": "Add sensors to group",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Sensor ID",
+                        "description": "Group ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Sensor IDs to add",
+                        "name": "sensors",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.AddSensorsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated sensor details",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/types.SensorGroupResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -1608,18 +2018,33 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/auth/forgot-password": {
-            "post": {
-                "description": "Request a password reset email",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Remove sensors from an existing group",
                 "consumes": [
                     "application/json"
                 ],
@@ -1627,97 +2052,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "SensorGroups"
                 ],
-                "summary": "Forgot Password",
+                "summary": "Remove sensors from group",
                 "parameters": [
                     {
-                        "description": "Email address",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "email": {
-                                    "type": "string"
-                                }
-                            }
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Success response",
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "message": {
-                                    "type": "string"
-                                },
-                                "success": {
-                                    "type": "boolean"
-                                }
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/auth/login": {
-            "post": {
-                "description": "Authenticates a user with email and password.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Login authenticates a user and returns a token.",
-                "parameters": [
+                        "type": "integer",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
-                        "description": "User credentials",
-                        "name": "credentials",
+                        "description": "Sensor IDs",
+                        "name": "sensors",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "email": {
-                                    "type": "string"
-                                },
-                                "password": {
-                                    "type": "string"
-                                }
-                            }
+                            "$ref": "#/definitions/types.AddSensorsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Authentication successful",
+                        "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "expires_at": {
-                                    "type": "string"
-                                },
-                                "token": {
-                                    "type": "string"
-                                },
-                                "user": {
-                                    "type": "object"
-                                }
-                            }
+                            "type": "string"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -1726,76 +2095,54 @@ const docTemplate = `{
                             "type": "string"
                         }
                     },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
             }
         },
-        "/auth/register": {
-            "post": {
-                "description": "Registers a new user with email, username, and password.",
-                "consumes": [
-                    "application/json"
+        "/api/sensor-types": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Get a list of all sensor types",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
-                ],
-                "summary": "Register creates a new user account.",
-                "parameters": [
-                    {
-                        "description": "User registration data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "email": {
-                                    "type": "string"
-                                },
-                                "first_name": {
-                                    "type": "string"
-                                },
-                                "last_name": {
-                                    "type": "string"
-                                },
-                                "password": {
-                                    "type": "string"
-                                },
-                                "username": {
-                                    "type": "string"
-                                }
-                            }
-                        }
-                    }
+                    "SensorTypes"
                 ],
+                "summary": "List Sensor Types",
                 "responses": {
-                    "201": {
-                        "description": "Registration successful",
+                    "200": {
+                        "description": "List of sensor types",
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "expires_at": {
-                                    "type": "string"
-                                },
-                                "token": {
-                                    "type": "string"
-                                },
-                                "user": {
-                                    "type": "object"
-                                }
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/types.SensorTypeResponse"
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "string"
                         }
@@ -1803,15 +2150,21 @@ const docTemplate = `{
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "type": "string"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/auth/reset-password": {
+            },
             "post": {
-                "description": "Reset password using a token",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Create a new sensor type",
                 "consumes": [
                     "application/json"
                 ],
@@ -1819,141 +2172,1537 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "SensorTypes"
                 ],
-                "summary": "Reset Password",
+                "summary": "Create Sensor Type",
                 "parameters": [
                     {
-                        "description": "Reset data",
-                        "name": "body",
+                        "description": "Sensor Type Data",
+                        "name": "sensorType",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "object",
-                            "properties": {
-                                "new_password": {
-                                    "type": "string"
-                                },
-                                "token": {
-                                    "type": "string"
-                                }
-                            }
+                            "type": "string"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Success response",
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
-                            "properties": {
-                                "message": {
-                                    "type": "string"
-                                },
-                                "success": {
-                                    "type": "boolean"
-                                }
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
                             }
                         }
                     }
                 }
             }
         },
-        "/auth/user": {
+        "/api/sensor-types/{id}": {
             "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Get current user profile",
+                "description": "Get details of a specific sensor type by ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "Auth"
+                    "SensorTypes"
+                ],
+                "summary": "Get Sensor Type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get User",
                 "responses": {
                     "200": {
-                        "description": "User Profile",
+                        "description": "Sensor type details",
                         "schema": {
-                            "$ref": "#/definitions/handlers.UserResponse"
+                            "$ref": "#/definitions/types.SensorTypeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update an existing sensor type by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "SensorTypes"
+                ],
+                "summary": "Update Sensor Type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sensor Type Data",
+                        "name": "sensorType",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Delete a sensor type by ID",
+                "tags": [
+                    "SensorTypes"
+                ],
+                "summary": "Delete Sensor Type",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor Type ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensors": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetches all sensors from the Sensor Service.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "ListSensors retrieves a list of all sensors.",
+                "responses": {
+                    "200": {
+                        "description": "List of sensors",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/types.SensorResponse"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Creates a new sensor in the Sensor Service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "CreateSensor creates a new sensor.",
+                "parameters": [
+                    {
+                        "description": "Sensor to create",
+                        "name": "sensor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.CreateSensorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created sensor details",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensors/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Fetches a sensor from the Sensor Service by its ID.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "GetSensor retrieves a sensor by ID.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Sensor details",
+                        "schema": {
+                            "$ref": "#/definitions/types.SensorResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Updates an existing sensor in the Sensor Service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "UpdateSensor updates an existing sensor.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sensor fields to update",
+                        "name": "sensor",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.UpdateSensorRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated sensor details",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a sensor from the Sensor Service by its ID.",
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "DeleteSensor deletes a sensor by ID.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Also delete the stored readings of the sensor",
+                        "name": "delete_readings",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Sensor is an input of a virtual sensor",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "503": {
+                        "description": "Readings could not be deleted, the sensor is kept",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensors/{id}/activate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Marks a sensor as active in the Sensor Service.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "SetSensorActive sets a sensor as active.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated sensor details",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensors/{id}/calibrations": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Returns the calibrations of a sensor ordered by channel and start of validity.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "ListCalibrations lists the calibrations of a sensor.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of calibrations",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/types.CalibrationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Readings reported within the validity period are calibrated at ingestion; the raw values are kept. Validity periods of a channel must not overlap.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "CreateCalibration adds a calibration to a sensor.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Calibration to create",
+                        "name": "calibration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.CalibrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created calibration",
+                        "schema": {
+                            "$ref": "#/definitions/types.CalibrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Validity period overlaps another calibration",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/sensors/{id}/calibrations/{calibration_id}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Stored readings are not recalculated; an administrator recalibrates them through the data service.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "UpdateCalibration replaces a calibration of a sensor.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Calibration ID",
+                        "name": "calibration_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New calibration",
+                        "name": "calibration",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/types.CalibrationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated calibration",
+                        "schema": {
+                            "$ref": "#/definitions/types.CalibrationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Validity period overlaps another calibration",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "tags": [
+                    "Sensors"
+                ],
+                "summary": "DeleteCalibration deletes a calibration of a sensor.",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Sensor ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Calibration ID",
+                        "name": "calibration_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/forgot-password": {
+            "post": {
+                "description": "Request a password reset email",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Forgot Password",
+                "parameters": [
+                    {
+                        "description": "Email address",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "email": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success response",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                },
+                                "success": {
+                                    "type": "boolean"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Authenticates a user with email and password.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Login authenticates a user and returns a token.",
+                "parameters": [
+                    {
+                        "description": "User credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "email": {
+                                    "type": "string"
+                                },
+                                "password": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Authentication successful",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "expires_at": {
+                                    "type": "string"
+                                },
+                                "token": {
+                                    "type": "string"
+                                },
+                                "user": {
+                                    "type": "object"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Registers a new user with email, username, and password.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Register creates a new user account.",
+                "parameters": [
+                    {
+                        "description": "User registration data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "email": {
+                                    "type": "string"
+                                },
+                                "first_name": {
+                                    "type": "string"
+                                },
+                                "last_name": {
+                                    "type": "string"
+                                },
+                                "password": {
+                                    "type": "string"
+                                },
+                                "username": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Registration successful",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "expires_at": {
+                                    "type": "string"
+                                },
+                                "token": {
+                                    "type": "string"
+                                },
+                                "user": {
+                                    "type": "object"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/reset-password": {
+            "post": {
+                "description": "Reset password using a token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Reset Password",
+                "parameters": [
+                    {
+                        "description": "Reset data",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "new_password": {
+                                    "type": "string"
+                                },
+                                "token": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Success response",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                },
+                                "success": {
+                                    "type": "boolean"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Get current user profile",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Get User",
+                "responses": {
+                    "200": {
+                        "description": "User Profile",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Update current user profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Update Profile",
+                "parameters": [
+                    {
+                        "description": "Update data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "first_name": {
+                                    "type": "string"
+                                },
+                                "last_name": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated User",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/user/units": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Replaces the units readings are displayed in for the current user, at most one per dimension and keyed by it. Data endpoints convert readings to these units unless a target_unit is requested explicitly. An empty object clears the preferences.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Auth"
+                ],
+                "summary": "Set Unit Preferences",
+                "parameters": [
+                    {
+                        "description": "Units by dimension, e.g. {\\",
+                        "name": "preferences",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated User",
+                        "schema": {
+                            "$ref": "#/definitions/handlers.UserResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "string"
                         }
                     }
                 }
-            },
-            "put": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Update current user profile",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Auth"
-                ],
-                "summary": "Update Profile",
-                "parameters": [
-                    {
-                        "description": "Update data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "properties": {
-                                "first_name": {
-                                    "type": "string"
-                                },
-                                "last_name": {
-                                    "type": "string"
-                                }
-                            }
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "Updated User",
-                        "schema": {
-                            "$ref": "#/definitions/handlers.UserResponse"
-                        }
+            }
+        }
+    },
+    "definitions": {
+        "handlers.UserResponse": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "first_name": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_name": {
+                    "type": "string"
+                },
+                "unit_preferences": {
+                    "description": "UnitPreferences maps dimensions to the unit readings are converted to, e.g.\n{\"temperature\": \"°F\"}.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "types.AddSensorsRequest": {
+            "type": "object",
+            "properties": {
+                "sensor_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "types.AlertResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_read": {
+                    "type": "boolean"
+                },
+                "last_triggered_at": {
+                    "type": "string"
+                },
+                "last_value": {
+                    "type": "number"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "resolved_at": {
+                    "type": "string"
+                },
+                "rule_id": {
+                    "type": "integer"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "triggered_at": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "types.AlertRuleRequest": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string"
+                },
+                "clear_threshold": {
+                    "type": "number"
+                },
+                "condition_type": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "for_readings": {
+                    "type": "integer"
+                },
+                "for_seconds": {
+                    "type": "integer"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "skip_bad_quality": {
+                    "type": "boolean"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "threshold_high": {
+                    "type": "number"
+                },
+                "window_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "types.AlertRuleResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string"
+                },
+                "clear_threshold": {
+                    "type": "number"
+                },
+                "condition_type": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "for_readings": {
+                    "type": "integer"
+                },
+                "for_seconds": {
+                    "type": "integer"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "is_enabled": {
+                    "type": "boolean"
+                },
+                "metric": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "skip_bad_quality": {
+                    "type": "boolean"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "threshold_high": {
+                    "type": "number"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "window_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "types.CalibrationRequest": {
+            "type": "object",
+            "properties": {
+                "certificate": {
+                    "type": "string"
+                },
+                "channel": {
+                    "description": "Channel is required for multi-channel sensors and must be empty otherwise.",
+                    "type": "string"
+                },
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
                     }
+                },
+                "gain": {
+                    "description": "Gain defaults to 1.",
+                    "type": "number"
+                },
+                "offset": {
+                    "type": "number"
+                },
+                "valid_from": {
+                    "type": "string"
+                },
+                "valid_to": {
+                    "description": "ValidTo is exclusive; the calibration is open-ended without it.",
+                    "type": "string"
                 }
             }
-        }
-    },
-    "definitions": {
-        "handlers.UserResponse": {
+        },
+        "types.CalibrationResponse": {
             "type": "object",
             "properties": {
-                "email": {
+                "certificate": {
                     "type": "string"
                 },
-                "first_name": {
+                "channel": {
+                    "type": "string"
+                },
+                "coefficients": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "created_at": {
                     "type": "string"
                 },
+                "gain": {
+                    "type": "number"
+                },
                 "id": {
                     "type": "integer"
                 },
-                "last_name": {
+                "offset": {
+                    "type": "number"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "valid_from": {
                     "type": "string"
                 },
-                "username": {
+                "valid_to": {
                     "type": "string"
                 }
             }
         },
-        "types.AddSensorsRequest": {
+        "types.CreateGroupRequest": {
             "type": "object",
             "properties": {
+                "color": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "icon": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
                 "sensor_ids": {
                     "type": "array",
                     "items": {
@@ -1962,121 +3711,294 @@ const docTemplate = `{
                 }
             }
         },
-        "types.AlertResponse": {
+        "types.CreateSensorRequest": {
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "description": {
+                    "type": "string"
                 },
-                "is_read": {
-                    "type": "boolean"
+                "expression": {
+                    "type": "string"
                 },
-                "message": {
+                "kind": {
+                    "description": "Kind is physical (default) or virtual. Virtual sensors compute their readings\nfrom Expression, e.g. \"s10 - s11\".",
                     "type": "string"
                 },
-                "rule_id": {
+                "location": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "sensor_type_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "types.DataGapResponse": {
+            "type": "object",
+            "properties": {
+                "duration_seconds": {
                     "type": "integer"
                 },
+                "end": {
+                    "type": "string"
+                },
+                "start": {
+                    "type": "string"
+                }
+            }
+        },
+        "types.DataGapsResponse": {
+            "type": "object",
+            "properties": {
+                "expected_interval": {
+                    "type": "string"
+                },
+                "gaps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.DataGapResponse"
+                    }
+                },
                 "sensor_id": {
                     "type": "integer"
+                }
+            }
+        },
+        "types.DataPointResponse": {
+            "type": "object",
+            "properties": {
+                "avg": {
+                    "type": "number"
+                },
+                "calibrated": {
+                    "description": "Calibrated marks raw readings changed by a calibration; RawValue and RawValues\nthen hold the values as reported.",
+                    "type": "boolean"
+                },
+                "count": {
+                    "type": "integer"
                 },
-                "triggered_at": {
+                "gap": {
+                    "description": "Gap marks a bucket without readings added by gap filling.",
+                    "type": "boolean"
+                },
+                "max": {
+                    "type": "number"
+                },
+                "min": {
+                    "description": "Min, Max and Avg are only set for buckets holding readings.",
+                    "type": "number"
+                },
+                "quality": {
+                    "description": "Quality is only set for raw readings, aggregated buckets leave it empty.",
+                    "type": "string"
+                },
+                "raw_value": {
+                    "type": "number"
+                },
+                "raw_values": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float32"
+                    }
+                },
+                "time": {
                     "type": "string"
                 },
                 "value": {
+                    "description": "Value is null for gap filled buckets left without a value.",
                     "type": "number"
+                },
+                "values": {
+                    "description": "Values holds every channel of a raw multi-channel sample.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float32"
+                    }
                 }
             }
         },
-        "types.AlertRuleRequest": {
+        "types.DeleteReadingsResponse": {
             "type": "object",
             "properties": {
-                "condition_type": {
+                "deleted": {
+                    "description": "Deleted counts the deleted readings, or the matching ones for a dry run.",
+                    "type": "integer"
+                },
+                "deletion": {
+                    "$ref": "#/definitions/types.ReadingDeletionResponse"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "types.GroupStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
                     "type": "string"
                 },
-                "description": {
+                "group_id": {
+                    "type": "integer"
+                },
+                "overall": {
+                    "$ref": "#/definitions/types.ReadingStatisticsResponse"
+                },
+                "sensors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.ReadingStatisticsResponse"
+                    }
+                }
+            }
+        },
+        "types.HistoricalReadingsResponse": {
+            "type": "object",
+            "properties": {
+                "aggregation": {
                     "type": "string"
                 },
-                "name": {
+                "channel": {
+                    "type": "string"
+                },
+                "data_points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.DataPointResponse"
+                    }
+                },
+                "fill": {
+                    "type": "string"
+                },
+                "interval": {
+                    "type": "string"
+                },
+                "next_page_token": {
+                    "description": "NextPageToken requests the following page of raw readings as page_token. It is\nempty on the last page.",
+                    "type": "string"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "unit": {
+                    "description": "Unit is the unit of the values, set when a channel or a target unit applies.",
+                    "type": "string"
+                }
+            }
+        },
+        "types.ImportJobResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "error": {
                     "type": "string"
                 },
-                "sensor_id": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.ImportRowErrorResponse"
+                    }
+                },
+                "filename": {
+                    "type": "string"
+                },
+                "finished_at": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "rows_duplicate": {
+                    "type": "integer"
+                },
+                "rows_failed": {
+                    "type": "integer"
+                },
+                "rows_imported": {
+                    "type": "integer"
+                },
+                "rows_processed": {
                     "type": "integer"
                 },
-                "threshold": {
-                    "type": "number"
+                "started_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
                 }
             }
         },
-        "types.AlertRuleResponse": {
+        "types.ImportRowErrorResponse": {
             "type": "object",
             "properties": {
-                "condition_type": {
-                    "type": "string"
-                },
-                "created_at": {
-                    "type": "string"
-                },
-                "description": {
+                "message": {
                     "type": "string"
                 },
-                "id": {
+                "row": {
                     "type": "integer"
-                },
-                "is_enabled": {
-                    "type": "boolean"
-                },
+                }
+            }
+        },
+        "types.MultiSensorColumnResponse": {
+            "type": "object",
+            "properties": {
                 "name": {
                     "type": "string"
                 },
                 "sensor_id": {
                     "type": "integer"
                 },
-                "threshold": {
-                    "type": "number"
-                },
-                "user_id": {
-                    "type": "integer"
+                "unit": {
+                    "type": "string"
                 }
             }
         },
-        "types.CreateGroupRequest": {
+        "types.MultiSensorReadingsResponse": {
             "type": "object",
             "properties": {
-                "color": {
+                "aggregation": {
                     "type": "string"
                 },
-                "description": {
+                "channel": {
                     "type": "string"
                 },
-                "icon": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.MultiSensorColumnResponse"
+                    }
+                },
+                "fill": {
                     "type": "string"
                 },
-                "name": {
+                "interval": {
                     "type": "string"
                 },
-                "sensor_ids": {
+                "rows": {
                     "type": "array",
                     "items": {
-                        "type": "integer"
+                        "$ref": "#/definitions/types.MultiSensorRowResponse"
                     }
                 }
             }
         },
-        "types.CreateSensorRequest": {
+        "types.MultiSensorRowResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "location": {
-                    "type": "string"
-                },
-                "name": {
+                "time": {
                     "type": "string"
                 },
-                "sensor_type_id": {
-                    "type": "integer"
+                "values": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
                 }
             }
         },
@@ -2120,6 +4042,123 @@ const docTemplate = `{
                 }
             }
         },
+        "types.ReadingDeletionResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "deleted": {
+                    "type": "integer"
+                },
+                "end_time": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "start_time": {
+                    "description": "StartTime is not set for deletions reaching back to the first reading.",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "integer"
+                },
+                "value": {
+                    "type": "number"
+                },
+                "value_operator": {
+                    "type": "string"
+                }
+            }
+        },
+        "types.ReadingErrorResponse": {
+            "type": "object",
+            "properties": {
+                "index": {
+                    "type": "integer"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "types.ReadingStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "first_time": {
+                    "type": "string"
+                },
+                "first_value": {
+                    "type": "number"
+                },
+                "last_time": {
+                    "type": "string"
+                },
+                "last_value": {
+                    "type": "number"
+                },
+                "max": {
+                    "type": "number"
+                },
+                "mean": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                },
+                "p50": {
+                    "type": "number"
+                },
+                "p90": {
+                    "type": "number"
+                },
+                "p95": {
+                    "type": "number"
+                },
+                "p99": {
+                    "type": "number"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "stddev": {
+                    "type": "number"
+                }
+            }
+        },
+        "types.SensorChannelResponse": {
+            "type": "object",
+            "properties": {
+                "max_value": {
+                    "type": "number"
+                },
+                "min_value": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "unit": {
+                    "type": "string"
+                }
+            }
+        },
         "types.SensorGroupResponse": {
             "type": "object",
             "properties": {
@@ -2164,15 +4203,35 @@ const docTemplate = `{
                 "active": {
                     "type": "boolean"
                 },
+                "calibrations": {
+                    "description": "Calibrations are included when a single sensor is fetched.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.CalibrationResponse"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
+                "expression": {
+                    "type": "string"
+                },
                 "id": {
                     "type": "integer"
                 },
+                "input_sensor_ids": {
+                    "description": "InputSensorIDs are the sensors the expression of a virtual sensor reads.",
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "kind": {
+                    "type": "string"
+                },
                 "last_updated": {
                     "type": "string"
                 },
@@ -2190,9 +4249,66 @@ const docTemplate = `{
                 }
             }
         },
+        "types.SensorStatisticsResponse": {
+            "type": "object",
+            "properties": {
+                "channel": {
+                    "type": "string"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "first_time": {
+                    "type": "string"
+                },
+                "first_value": {
+                    "type": "number"
+                },
+                "last_time": {
+                    "type": "string"
+                },
+                "last_value": {
+                    "type": "number"
+                },
+                "max": {
+                    "type": "number"
+                },
+                "mean": {
+                    "type": "number"
+                },
+                "min": {
+                    "type": "number"
+                },
+                "p50": {
+                    "type": "number"
+                },
+                "p90": {
+                    "type": "number"
+                },
+                "p95": {
+                    "type": "number"
+                },
+                "p99": {
+                    "type": "number"
+                },
+                "sensor_id": {
+                    "type": "integer"
+                },
+                "stddev": {
+                    "type": "number"
+                }
+            }
+        },
         "types.SensorTypeResponse": {
             "type": "object",
             "properties": {
+                "channels": {
+                    "description": "Channels are the named values of a multi-channel sensor type; the first one is primary.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.SensorChannelResponse"
+                    }
+                },
                 "created_at": {
                     "type": "string"
                 },
@@ -2217,6 +4333,9 @@ const docTemplate = `{
                 "name": {
                     "type": "string"
                 },
+                "out_of_range_policy": {
+                    "type": "string"
+                },
                 "unit": {
                     "type": "string"
                 }
@@ -2225,6 +4344,18 @@ const docTemplate = `{
         "types.StoreReadingRequest": {
             "type": "object",
             "properties": {
+                "idempotency_key": {
+                    "description": "IdempotencyKey lets clients retry safely; the Idempotency-Key header takes precedence.",
+                    "type": "string"
+                },
+                "quality": {
+                    "type": "string",
+                    "enum": [
+                        "good",
+                        "suspect",
+                        "interpolated"
+                    ]
+                },
                 "sensor_id": {
                     "type": "integer"
                 },
@@ -2233,32 +4364,106 @@ const docTemplate = `{
                 },
                 "value": {
                     "type": "number"
+                },
+                "values": {
+                    "description": "Values maps channel names to values for multi-channel sensor types and replaces Value.",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number",
+                        "format": "float32"
+                    }
+                }
+            }
+        },
+        "types.StoreReadingsBatchRequest": {
+            "type": "object",
+            "properties": {
+                "readings": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.StoreReadingRequest"
+                    }
+                }
+            }
+        },
+        "types.StoreReadingsBatchResponse": {
+            "type": "object",
+            "properties": {
+                "accepted": {
+                    "type": "integer"
+                },
+                "duplicates": {
+                    "type": "integer"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/types.ReadingErrorResponse"
+                    }
+                },
+                "rejected": {
+                    "type": "integer"
+                }
+            }
+        },
+        "types.UnitResponse": {
+            "type": "object",
+            "properties": {
+                "dimension": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
                 }
             }
         },
         "types.UpdateAlertRuleRequest": {
             "type": "object",
             "properties": {
+                "channel": {
+                    "type": "string"
+                },
+                "clear_threshold": {
+                    "type": "number"
+                },
                 "condition_type": {
                     "type": "string"
                 },
                 "description": {
                     "type": "string"
                 },
+                "for_readings": {
+                    "type": "integer"
+                },
+                "for_seconds": {
+                    "type": "integer"
+                },
                 "id": {
                     "type": "integer"
                 },
                 "is_enabled": {
                     "type": "boolean"
                 },
+                "metric": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
                 "sensor_id": {
                     "type": "integer"
                 },
+                "skip_bad_quality": {
+                    "type": "boolean"
+                },
                 "threshold": {
                     "type": "number"
+                },
+                "threshold_high": {
+                    "type": "number"
+                },
+                "window_seconds": {
+                    "type": "integer"
                 }
             }
         },
@@ -2294,6 +4499,10 @@ const docTemplate = `{
                 "description": {
                     "type": "string"
                 },
+                "expression": {
+                    "description": "Expression replaces the expression of a virtual sensor.",
+                    "type": "string"
+                },
                 "location": {
                     "type": "string"
                 },
@@ -9,7 +9,10 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
+	"github.com/skni-kod/iot-monitor-backend/internal/auth"
 	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/alert_service"
 	"github.com/skni-kod/iot-monitor-backend/internal/types"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
@@ -130,3 +133,100 @@ func (h *AlertHandler) MarkAlertAsRead(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(res.Success)
 }
+
+// @Summary Acknowledge Alert
+// @Description Acknowledge a firing alert of the authenticated user. Acknowledging an acknowledged alert changes nothing.
+// @Tags Alerts
+// @Produce json
+// @Param id path int true "Alert ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} types.AlertResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Alert not found"
+// @Failure 409 {string} string "Alert is already resolved"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/alerts/{id}/acknowledge [post]
+func (h *AlertHandler) AcknowledgeAlert(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	id, claims, ok := h.alertRequest(w, r, "AcknowledgeAlert")
+	if !ok {
+		return
+	}
+
+	res, err := h.client.AcknowledgeAlert(ctx, &pb.AcknowledgeAlertRequest{Id: id, UserId: int64(claims.UserId)})
+	if err != nil {
+		logger.Error("Failed to acknowledge alert", zap.Error(err), zap.Int64("alertId", id), zap.Int("userId", claims.UserId))
+		writeAlertError(w, "Failed to acknowledge alert", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.MapAlertFromProto(res.Alert))
+}
+
+// @Summary Resolve Alert
+// @Description Resolve an open alert of the authenticated user and re-arm its rule. A violation that is still ongoing raises a new alert. Resolving a resolved alert changes nothing.
+// @Tags Alerts
+// @Produce json
+// @Param id path int true "Alert ID"
+// @Security ApiKeyAuth
+// @Success 200 {object} types.AlertResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Alert not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/alerts/{id}/resolve [post]
+func (h *AlertHandler) ResolveAlert(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	id, claims, ok := h.alertRequest(w, r, "ResolveAlert")
+	if !ok {
+		return
+	}
+
+	res, err := h.client.ResolveAlert(ctx, &pb.ResolveAlertRequest{Id: id, UserId: int64(claims.UserId)})
+	if err != nil {
+		logger.Error("Failed to resolve alert", zap.Error(err), zap.Int64("alertId", id), zap.Int("userId", claims.UserId))
+		writeAlertError(w, "Failed to resolve alert", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.MapAlertFromProto(res.Alert))
+}
+
+// alertRequest parses the alert ID of a request and returns it with the caller's
+// claims. It writes the error response and returns false when either is missing.
+func (h *AlertHandler) alertRequest(w http.ResponseWriter, r *http.Request, op string) (int64, *auth.Claims, bool) {
+	idStr := chi.URLParam(r, "id")
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil || id <= 0 {
+		logger.Warn("Invalid alert ID in "+op, zap.String("id", idStr))
+		http.Error(w, "Invalid alert ID", http.StatusBadRequest)
+		return 0, nil, false
+	}
+
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		logger.Warn("Unauthorized access attempt to "+op, zap.Int64("alertId", id))
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return 0, nil, false
+	}
+	return id, claims, true
+}
+
+func writeAlertError(w http.ResponseWriter, msg string, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.NotFound:
+		http.Error(w, "Alert not found", http.StatusNotFound)
+	case codes.FailedPrecondition:
+		http.Error(w, st.Message(), http.StatusConflict)
+	default:
+		http.Error(w, msg, http.StatusInternalServerError)
+	}
+}
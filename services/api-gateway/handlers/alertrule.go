@@ -9,6 +9,9 @@ import (
 
 	"github.com/go-chi/chi/v5"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/alert_service"
 	"github.com/skni-kod/iot-monitor-backend/internal/types"
@@ -120,15 +123,27 @@ func (h *AlertRuleHandler) CreateAlertRule(w http.ResponseWriter, r *http.Reques
 	}
 
 	res, err := h.client.CreateAlertRule(ctx, &pb.CreateAlertRuleRequest{
-		Name:          req.Name,
-		UserId:        int64(claims.UserId),
-		SensorId:      req.SensorID,
-		ConditionType: req.Condition_Type,
-		Threshold:     req.Threshold,
-		Description:   req.Description,
+		Name:           req.Name,
+		UserId:         int64(claims.UserId),
+		SensorId:       req.SensorID,
+		Channel:        req.Channel,
+		ConditionType:  req.Condition_Type,
+		Threshold:      req.Threshold,
+		ThresholdHigh:  req.ThresholdHigh,
+		ForSeconds:     req.ForSeconds,
+		ForReadings:    req.ForReadings,
+		ClearThreshold: clearThreshold(req.ClearThreshold),
+		Metric:         req.Metric,
+		WindowSeconds:  req.WindowSeconds,
+		SkipBadQuality: req.SkipBadQuality,
+		Description:    req.Description,
 	})
 	if err != nil {
 		logger.Error("Failed to create alert rule in alert service", zap.Error(err), zap.Int("userId", claims.UserId))
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to create alert rule", http.StatusInternalServerError)
 		return
 	}
@@ -216,16 +231,28 @@ func (h *AlertRuleHandler) UpdateAlertRule(w http.ResponseWriter, r *http.Reques
 	}
 
 	res, err := h.client.UpdateAlertRule(ctx, &pb.UpdateAlertRuleRequest{
-		Id:            id,
-		Name:          req.Name,
-		SensorId:      req.SensorID,
-		ConditionType: req.Condition_Type,
-		Threshold:     req.Threshold,
-		Description:   req.Description,
-		IsEnabled:     req.IsEnabled,
+		Id:             id,
+		Name:           req.Name,
+		SensorId:       req.SensorID,
+		Channel:        req.Channel,
+		ConditionType:  req.Condition_Type,
+		Threshold:      req.Threshold,
+		ThresholdHigh:  req.ThresholdHigh,
+		ForSeconds:     req.ForSeconds,
+		ForReadings:    req.ForReadings,
+		ClearThreshold: clearThreshold(req.ClearThreshold),
+		Metric:         req.Metric,
+		WindowSeconds:  req.WindowSeconds,
+		SkipBadQuality: req.SkipBadQuality,
+		Description:    req.Description,
+		IsEnabled:      req.IsEnabled,
 	})
 	if err != nil {
 		logger.Error("Failed to update alert rule in alert service", zap.Error(err), zap.Int64("ruleId", id), zap.Int("userId", claims.UserId))
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to update alert rule", http.StatusInternalServerError)
 		return
 	}
@@ -274,3 +301,10 @@ func (h *AlertRuleHandler) GetAlertRule(w http.ResponseWriter, r *http.Request)
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(types.MapAlertRuleFromProto(res.AlertRule))
 }
+
+func clearThreshold(v *float64) *wrapperspb.DoubleValue {
+	if v == nil {
+		return nil
+	}
+	return wrapperspb.Double(*v)
+}
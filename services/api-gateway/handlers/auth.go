@@ -6,6 +6,9 @@ import (
 	"net/http"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/skni-kod/iot-monitor-backend/internal/proto/auth"
 	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
 )
@@ -24,6 +27,9 @@ type UserResponse struct {
 	Username  string `json:"username"`
 	FirstName string `json:"first_name"`
 	LastName  string `json:"last_name"`
+	// UnitPreferences maps dimensions to the unit readings are converted to, e.g.
+	// {"temperature": "°F"}.
+	UnitPreferences map[string]string `json:"unit_preferences,omitempty"`
 }
 
 // @Summary Login authenticates a user and returns a token.
@@ -249,6 +255,52 @@ func (h *AuthHandler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// @Summary Set Unit Preferences
+// @Description Replaces the units readings are displayed in for the current user, at most one per dimension and keyed by it. Data endpoints convert readings to these units unless a target_unit is requested explicitly. An empty object clears the preferences.
+// @Tags Auth
+// @Security ApiKeyAuth
+// @Accept json
+// @Produce json
+// @Param preferences body map[string]string true "Units by dimension, e.g. {\"temperature\": \"°F\", \"pressure\": \"psi\"}"
+// @Success 200 {object} UserResponse "Updated User"
+// @Failure 400 {string} string "Bad Request"
+// @Router /auth/user/units [put]
+func (h *AuthHandler) SetUnitPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var prefs map[string]string
+	if err := json.NewDecoder(r.Body).Decode(&prefs); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.Client.SetUnitPreferences(ctx, &auth.SetUnitPreferencesRequest{
+		UserId:          int64(claims.UserId),
+		UnitPreferences: prefs,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+		case codes.NotFound:
+			http.Error(w, "User not found", http.StatusNotFound)
+		default:
+			http.Error(w, "Failed to update unit preferences", http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(mapUserToResponse(resp.User))
+}
+
 // @Summary Forgot Password
 // @Description Request a password reset email
 // @Tags Auth
@@ -322,5 +374,7 @@ func mapUserToResponse(u *auth.User) UserResponse {
 		Username:  u.Username,
 		FirstName: u.FirstName,
 		LastName:  u.LastName,
+
+		UnitPreferences: u.UnitPreferences,
 	}
 }
@@ -0,0 +1,247 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/internal/types"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
+)
+
+// @Summary ListCalibrations lists the calibrations of a sensor.
+// @Description Returns the calibrations of a sensor ordered by channel and start of validity.
+// @Tags Sensors
+// @Produce json
+// @Param id path int true "Sensor ID"
+// @Security ApiKeyAuth
+// @Success 200 {array} types.CalibrationResponse "List of calibrations"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/sensors/{id}/calibrations [get]
+func (h *SensorHandler) ListCalibrations(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	sensor, ok := h.ownedSensor(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	response := types.MapCalibrationsFromProto(sensor.Calibrations)
+	if response == nil {
+		response = []types.CalibrationResponse{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// @Summary CreateCalibration adds a calibration to a sensor.
+// @Description Readings reported within the validity period are calibrated at ingestion; the raw values are kept. Validity periods of a channel must not overlap.
+// @Tags Sensors
+// @Accept json
+// @Produce json
+// @Param id path int true "Sensor ID"
+// @Param calibration body types.CalibrationRequest true "Calibration to create"
+// @Security ApiKeyAuth
+// @Success 201 {object} types.CalibrationResponse "Created calibration"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 409 {string} string "Validity period overlaps another calibration"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/sensors/{id}/calibrations [post]
+func (h *SensorHandler) CreateCalibration(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	sensor, ok := h.ownedSensor(ctx, w, r)
+	if !ok {
+		return
+	}
+
+	var req types.CalibrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validFrom, validTo := req.ValidityToProto()
+	res, err := h.client.CreateCalibration(ctx, &pb.CreateCalibrationRequest{
+		SensorId:     sensor.Id,
+		Channel:      req.Channel,
+		Offset:       req.Offset,
+		Gain:         req.Gain,
+		Coefficients: req.Coefficients,
+		ValidFrom:    validFrom,
+		ValidTo:      validTo,
+		Certificate:  req.Certificate,
+	})
+	if err != nil {
+		writeCalibrationError(w, "Failed to create calibration", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	json.NewEncoder(w).Encode(types.MapCalibrationFromProto(res.Calibration))
+}
+
+// @Summary UpdateCalibration replaces a calibration of a sensor.
+// @Description Stored readings are not recalculated; an administrator recalibrates them through the data service.
+// @Tags Sensors
+// @Accept json
+// @Produce json
+// @Param id path int true "Sensor ID"
+// @Param calibration_id path int true "Calibration ID"
+// @Param calibration body types.CalibrationRequest true "New calibration"
+// @Security ApiKeyAuth
+// @Success 200 {object} types.CalibrationResponse "Updated calibration"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 409 {string} string "Validity period overlaps another calibration"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/sensors/{id}/calibrations/{calibration_id} [put]
+func (h *SensorHandler) UpdateCalibration(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	sensor, ok := h.ownedSensor(ctx, w, r)
+	if !ok {
+		return
+	}
+	calibrationID, ok := sensorCalibrationID(w, r, sensor)
+	if !ok {
+		return
+	}
+
+	var req types.CalibrationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validFrom, validTo := req.ValidityToProto()
+	res, err := h.client.UpdateCalibration(ctx, &pb.UpdateCalibrationRequest{
+		Id:           calibrationID,
+		Channel:      req.Channel,
+		Offset:       req.Offset,
+		Gain:         req.Gain,
+		Coefficients: req.Coefficients,
+		ValidFrom:    validFrom,
+		ValidTo:      validTo,
+		Certificate:  req.Certificate,
+	})
+	if err != nil {
+		writeCalibrationError(w, "Failed to update calibration", err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.MapCalibrationFromProto(res.Calibration))
+}
+
+// @Summary DeleteCalibration deletes a calibration of a sensor.
+// @Tags Sensors
+// @Param id path int true "Sensor ID"
+// @Param calibration_id path int true "Calibration ID"
+// @Security ApiKeyAuth
+// @Success 204 {string} string "No Content"
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Not Found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/sensors/{id}/calibrations/{calibration_id} [delete]
+func (h *SensorHandler) DeleteCalibration(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	sensor, ok := h.ownedSensor(ctx, w, r)
+	if !ok {
+		return
+	}
+	calibrationID, ok := sensorCalibrationID(w, r, sensor)
+	if !ok {
+		return
+	}
+
+	if _, err := h.client.DeleteCalibration(ctx, &pb.DeleteCalibrationRequest{Id: calibrationID}); err != nil {
+		writeCalibrationError(w, "Failed to delete calibration", err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ownedSensor fetches the sensor of the {id} path parameter and checks that it belongs
+// to the authenticated user. On failure it writes the error response and returns false.
+func (h *SensorHandler) ownedSensor(ctx context.Context, w http.ResponseWriter, r *http.Request) (*pb.Sensor, bool) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return nil, false
+	}
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid sensor ID", http.StatusBadRequest)
+		return nil, false
+	}
+
+	res, err := h.client.GetSensor(ctx, &pb.GetSensorRequest{Id: id})
+	if err != nil || res.Sensor == nil {
+		http.Error(w, "Sensor not found", http.StatusNotFound)
+		return nil, false
+	}
+	if res.Sensor.UserId != int64(claims.UserId) {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return nil, false
+	}
+	return res.Sensor, true
+}
+
+// sensorCalibrationID parses the {calibration_id} path parameter and checks that the
+// calibration belongs to the sensor.
+func sensorCalibrationID(w http.ResponseWriter, r *http.Request, sensor *pb.Sensor) (int64, bool) {
+	id, err := strconv.ParseInt(chi.URLParam(r, "calibration_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid calibration ID", http.StatusBadRequest)
+		return 0, false
+	}
+	for _, c := range sensor.Calibrations {
+		if c.Id == id {
+			return id, true
+		}
+	}
+	http.Error(w, "Calibration not found", http.StatusNotFound)
+	return 0, false
+}
+
+func writeCalibrationError(w http.ResponseWriter, msg string, err error) {
+	st, _ := status.FromError(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		http.Error(w, st.Message(), http.StatusBadRequest)
+	case codes.NotFound:
+		http.Error(w, st.Message(), http.StatusNotFound)
+	case codes.FailedPrecondition:
+		http.Error(w, st.Message(), http.StatusConflict)
+	default:
+		http.Error(w, msg+": "+err.Error(), http.StatusInternalServerError)
+	}
+}
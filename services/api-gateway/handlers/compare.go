@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/internal/types"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
+)
+
+// maxRequestSensors bounds the sensors of a comparison or export, matching the data
+// service limit.
+const maxRequestSensors = 100
+
+// @Summary Compare sensor readings
+// @Description Aggregates several sensors, or the sensors of a group, into a time-aligned table with one row per bucket and one column per sensor. Every sensor has to belong to the authenticated user.
+// @Tags Data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param sensor_ids query string false "Comma-separated sensor IDs"
+// @Param sensor_group_id query int false "Sensor group ID, adds every sensor of the group"
+// @Param start_time query string false "Start time (RFC3339, default 24h ago)"
+// @Param end_time query string false "End time (RFC3339, default now)"
+// @Param interval query string true "Bucket interval, e.g. 5m, 1h, 1d"
+// @Param agg query string false "Bucket aggregate: avg, min, max, sum, count, first, last (default avg)"
+// @Param channel query string false "Channel of multi-channel sensors (default primary value)"
+// @Param fill query string false "Fill buckets without readings with null, previous or linear"
+// @Success 200 {object} types.MultiSensorReadingsResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor or group not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/compare [get]
+func (h *WebSocketHandler) CompareReadings(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		logger.Warn("Unauthorized access attempt to CompareReadings")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	userID := int64(claims.UserId)
+
+	query := r.URL.Query()
+
+	var sensorIDs []int64
+	if param := query.Get("sensor_ids"); param != "" {
+		for _, idStr := range strings.Split(param, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(idStr), 10, 64)
+			if err != nil || id <= 0 {
+				http.Error(w, "Invalid sensor_id: "+idStr, http.StatusBadRequest)
+				return
+			}
+			sensorIDs = append(sensorIDs, id)
+		}
+	}
+
+	var groupID int64
+	if param := query.Get("sensor_group_id"); param != "" {
+		var err error
+		groupID, err = strconv.ParseInt(param, 10, 64)
+		if err != nil || groupID <= 0 {
+			http.Error(w, "Invalid sensor_group_id", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if len(sensorIDs) == 0 && groupID == 0 {
+		http.Error(w, "sensor_ids or sensor_group_id is required", http.StatusBadRequest)
+		return
+	}
+
+	interval := query.Get("interval")
+	if interval == "" {
+		http.Error(w, "interval is required", http.StatusBadRequest)
+		return
+	}
+
+	endTime := time.Now()
+	if param := query.Get("end_time"); param != "" {
+		var err error
+		endTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid end_time format", http.StatusBadRequest)
+			return
+		}
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if param := query.Get("start_time"); param != "" {
+		var err error
+		startTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid start_time format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	if groupID > 0 {
+		group, ok := h.ownedGroup(ctx, w, userID, groupID)
+		if !ok {
+			return
+		}
+		sensorIDs = append(sensorIDs, group.SensorIds...)
+	}
+
+	sensors, ok := h.ownedSensors(ctx, w, userID, sensorIDs)
+	if !ok {
+		return
+	}
+
+	// Only sensors that passed the ownership check are sent to the data service, the
+	// group has already been expanded.
+	ids := make([]int64, 0, len(sensors))
+	for id := range sensors {
+		ids = append(ids, id)
+	}
+
+	res, err := h.dataClient.QueryMultiSensorReadings(ctx, &pb_data.MultiSensorReadingsRequest{
+		SensorIds:           ids,
+		StartTime:           timestamppb.New(startTime),
+		EndTime:             timestamppb.New(endTime),
+		AggregationInterval: interval,
+		Aggregation:         query.Get("agg"),
+		Channel:             query.Get("channel"),
+		Fill:                query.Get("fill"),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		case codes.NotFound:
+			http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to query readings: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	response := types.MapMultiSensorReadingsFromProto(res, sensors)
+	response.Channel = query.Get("channel")
+	response.Interval = interval
+	response.Aggregation = query.Get("agg")
+	if response.Aggregation == "" {
+		response.Aggregation = "avg"
+	}
+	response.Fill = query.Get("fill")
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// ownedSensors fetches the given sensors, dropping duplicates, and checks that each
+// belongs to the user. On failure it writes the error response and returns false.
+func (h *WebSocketHandler) ownedSensors(ctx context.Context, w http.ResponseWriter, userID int64, sensorIDs []int64) (map[int64]*pb_sensor.Sensor, bool) {
+	sensors := make(map[int64]*pb_sensor.Sensor, len(sensorIDs))
+	for _, id := range sensorIDs {
+		if _, seen := sensors[id]; seen {
+			continue
+		}
+		if len(sensors) == maxRequestSensors {
+			http.Error(w, "at most "+strconv.Itoa(maxRequestSensors)+" sensors can be requested at once", http.StatusBadRequest)
+			return nil, false
+		}
+
+		res, err := h.sensorClient.GetSensor(ctx, &pb_sensor.GetSensorRequest{Id: id})
+		if err != nil || res.Sensor == nil {
+			if status.Code(err) == codes.NotFound {
+				http.Error(w, "Sensor "+strconv.FormatInt(id, 10)+" not found", http.StatusNotFound)
+				return nil, false
+			}
+			logger.Error("Failed to get sensor", zap.Int64("sensor_id", id), zap.Error(err))
+			http.Error(w, "Failed to get sensor", http.StatusInternalServerError)
+			return nil, false
+		}
+		if res.Sensor.UserId != userID {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return nil, false
+		}
+		sensors[id] = res.Sensor
+	}
+
+	if len(sensors) == 0 {
+		http.Error(w, "no sensors requested", http.StatusBadRequest)
+		return nil, false
+	}
+	return sensors, true
+}
+
+// ownedGroup fetches a sensor group and checks that it belongs to the user. On failure
+// it writes the error response and returns false.
+func (h *WebSocketHandler) ownedGroup(ctx context.Context, w http.ResponseWriter, userID, groupID int64) (*pb_sensor.SensorGroup, bool) {
+	res, err := h.sensorClient.GetSensorGroup(ctx, &pb_sensor.GetSensorGroupRequest{Id: groupID})
+	if err != nil || res.Group == nil {
+		if status.Code(err) == codes.NotFound {
+			http.Error(w, "Sensor group not found", http.StatusNotFound)
+			return nil, false
+		}
+		logger.Error("Failed to get sensor group", zap.Int64("sensor_group_id", groupID), zap.Error(err))
+		http.Error(w, "Failed to get sensor group", http.StatusInternalServerError)
+		return nil, false
+	}
+	if res.Group.UserId != userID {
+		http.Error(w, "Forbidden", http.StatusForbidden)
+		return nil, false
+	}
+	return res.Group, true
+}
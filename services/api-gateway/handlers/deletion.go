@@ -0,0 +1,188 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/internal/types"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
+)
+
+// @Summary Delete sensor readings
+// @Description Deletes readings of a sensor between start_time and end_time, optionally only those whose value (or channel value) compares true against value, e.g. op=gt&value=1000 removes spikes. Either start_time or op is required; deleting a sensor with delete_readings removes all of them. With dry_run=true the matching readings are only counted. Every deletion is recorded with the user and reason.
+// @Tags Data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param sensor_id path int true "Sensor ID"
+// @Param start_time query string false "Start time (RFC3339, inclusive)"
+// @Param end_time query string false "End time (RFC3339, inclusive, default now)"
+// @Param op query string false "Value predicate: lt, lte, gt, gte, eq or ne"
+// @Param value query number false "Value compared by op"
+// @Param channel query string false "Channel of a multi-channel sensor compared by op"
+// @Param dry_run query bool false "Only count the matching readings"
+// @Param reason query string false "Reason kept in the audit record"
+// @Success 200 {object} types.DeleteReadingsResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/sensors/{sensor_id}/readings [delete]
+func (h *WebSocketHandler) DeleteReadings(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sensorID, err := strconv.ParseInt(chi.URLParam(r, "sensor_id"), 10, 64)
+	if err != nil || sensorID <= 0 {
+		http.Error(w, "Invalid sensor_id", http.StatusBadRequest)
+		return
+	}
+
+	query := r.URL.Query()
+	req := &pb_data.DeleteReadingsRequest{
+		SensorId:      sensorID,
+		ValueOperator: query.Get("op"),
+		Channel:       query.Get("channel"),
+		UserId:        int64(claims.UserId),
+		Reason:        query.Get("reason"),
+	}
+	if param := query.Get("start_time"); param != "" {
+		t, err := time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid start_time format", http.StatusBadRequest)
+			return
+		}
+		req.StartTime = timestamppb.New(t)
+	}
+	if param := query.Get("end_time"); param != "" {
+		t, err := time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid end_time format", http.StatusBadRequest)
+			return
+		}
+		req.EndTime = timestamppb.New(t)
+	}
+	if req.StartTime == nil && req.ValueOperator == "" {
+		http.Error(w, "start_time or op is required", http.StatusBadRequest)
+		return
+	}
+	if param := query.Get("value"); param != "" {
+		req.Value, err = strconv.ParseFloat(param, 64)
+		if err != nil {
+			http.Error(w, "Invalid value", http.StatusBadRequest)
+			return
+		}
+	} else if req.ValueOperator != "" {
+		http.Error(w, "op requires value", http.StatusBadRequest)
+		return
+	}
+	if param := query.Get("dry_run"); param != "" {
+		req.DryRun, err = strconv.ParseBool(param)
+		if err != nil {
+			http.Error(w, "Invalid dry_run", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 60*time.Second)
+	defer cancel()
+
+	if _, ok := h.ownedSensors(ctx, w, int64(claims.UserId), []int64{sensorID}); !ok {
+		return
+	}
+
+	res, err := h.dataClient.DeleteReadings(ctx, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		case codes.NotFound:
+			http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+			return
+		}
+		logger.Error("Failed to delete readings via gRPC", zap.Int64("sensor_id", sensorID), zap.Error(err))
+		http.Error(w, "Failed to delete readings", http.StatusInternalServerError)
+		return
+	}
+
+	response := types.DeleteReadingsResponse{Deleted: res.Deleted, DryRun: req.DryRun}
+	if res.Deletion != nil {
+		deletion := types.MapReadingDeletionFromProto(res.Deletion)
+		response.Deletion = &deletion
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// @Summary List reading deletions
+// @Description Lists the audit records of readings deleted from a sensor, newest first.
+// @Tags Data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param sensor_id path int true "Sensor ID"
+// @Param limit query int false "Maximum number of records (default 100, max 1000)"
+// @Success 200 {array} types.ReadingDeletionResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/sensors/{sensor_id}/deletions [get]
+func (h *WebSocketHandler) ListReadingDeletions(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sensorID, err := strconv.ParseInt(chi.URLParam(r, "sensor_id"), 10, 64)
+	if err != nil || sensorID <= 0 {
+		http.Error(w, "Invalid sensor_id", http.StatusBadRequest)
+		return
+	}
+
+	var limit int64
+	if param := r.URL.Query().Get("limit"); param != "" {
+		limit, err = strconv.ParseInt(param, 10, 64)
+		if err != nil || limit <= 0 {
+			http.Error(w, "Invalid limit parameter", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	if _, ok := h.ownedSensors(ctx, w, int64(claims.UserId), []int64{sensorID}); !ok {
+		return
+	}
+
+	res, err := h.dataClient.ListReadingDeletions(ctx, &pb_data.ListReadingDeletionsRequest{
+		SensorId: sensorID,
+		Limit:    limit,
+	})
+	if err != nil {
+		logger.Error("Failed to list reading deletions via gRPC", zap.Int64("sensor_id", sensorID), zap.Error(err))
+		http.Error(w, "Failed to list reading deletions", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.MapReadingDeletionsFromProto(res.Deletions))
+}
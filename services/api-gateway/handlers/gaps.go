@@ -0,0 +1,115 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/internal/types"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
+)
+
+// @Summary List data gaps of a sensor
+// @Description Returns the periods in which a sensor did not report for longer than threshold times its expected reporting interval. Without expected_interval the median spacing of the readings is used.
+// @Tags Data
+// @Produce json
+// @Param sensor_id path int true "Sensor ID"
+// @Param start_time query string false "Start time (RFC3339, default 24h ago)"
+// @Param end_time query string false "End time (RFC3339, default now)"
+// @Param expected_interval query string false "Expected reporting interval, e.g. 1m"
+// @Param threshold query number false "Multiple of the expected interval that counts as a gap (default 3)"
+// @Security ApiKeyAuth
+// @Success 200 {object} types.DataGapsResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 422 {string} string "Too few readings to estimate the interval"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/sensors/{sensor_id}/gaps [get]
+func (h *WebSocketHandler) ListDataGaps(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		logger.Warn("Unauthorized access attempt to ListDataGaps")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sensorID, err := strconv.ParseInt(chi.URLParam(r, "sensor_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid sensor_id", http.StatusBadRequest)
+		return
+	}
+
+	if _, ok := h.ownedSensors(r.Context(), w, int64(claims.UserId), []int64{sensorID}); !ok {
+		return
+	}
+
+	query := r.URL.Query()
+
+	endTime := time.Now()
+	if param := query.Get("end_time"); param != "" {
+		endTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid end_time format", http.StatusBadRequest)
+			return
+		}
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if param := query.Get("start_time"); param != "" {
+		startTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid start_time format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	var threshold float64
+	if param := query.Get("threshold"); param != "" {
+		threshold, err = strconv.ParseFloat(param, 64)
+		if err != nil {
+			http.Error(w, "Invalid threshold", http.StatusBadRequest)
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := h.dataClient.ListDataGaps(ctx, &pb_data.ListDataGapsRequest{
+		SensorId:         sensorID,
+		StartTime:        timestamppb.New(startTime),
+		EndTime:          timestamppb.New(endTime),
+		ExpectedInterval: query.Get("expected_interval"),
+		Threshold:        threshold,
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		case codes.NotFound:
+			http.Error(w, "Sensor not found", http.StatusNotFound)
+			return
+		case codes.FailedPrecondition:
+			http.Error(w, status.Convert(err).Message(), http.StatusUnprocessableEntity)
+			return
+		}
+		logger.Error("Failed to list data gaps", zap.Int64("sensor_id", sensorID), zap.Error(err))
+		http.Error(w, "Failed to list data gaps", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.MapDataGapsFromProto(sensorID, res))
+}
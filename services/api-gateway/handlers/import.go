@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/internal/types"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
+)
+
+const (
+	// maxImportUpload is the largest multipart body accepted for an import. The data
+	// service enforces its own limit on the file itself.
+	maxImportUpload = 260 << 20
+	// importChunkSize is the amount of file data sent per ImportReadingsRequest.
+	importChunkSize = 64 * 1024
+)
+
+// @Summary Import historical readings from a CSV file
+// @Description Uploads a CSV file and starts an import job that validates and bulk-loads its rows. Columns are mapped by header name. Every sensor of the file has to belong to the authenticated user. Imported readings are not published for alert evaluation. Poll the returned job for progress and row errors.
+// @Tags Data
+// @Accept multipart/form-data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param file formData file true "CSV file with a header row"
+// @Param sensor_id formData int false "Import every row for this sensor"
+// @Param sensor_column formData string false "Column holding the sensor ID of each row"
+// @Param time_column formData string false "Timestamp column (default time)"
+// @Param value_column formData string false "Value column (default value)"
+// @Param channel_columns formData string false "Channel to column mapping, e.g. temperature=temp_c,humidity=rh"
+// @Param quality_column formData string false "Quality column (default good)"
+// @Param time_format formData string false "rfc3339 (default), unix, unix_ms or a Go time layout such as 2006-01-02 15:04:05"
+// @Param timezone formData string false "IANA timezone of timestamps without an offset (default UTC)"
+// @Param delimiter formData string false "Field delimiter (default ,)"
+// @Success 202 {object} types.ImportJobResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 413 {string} string "File too large"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/imports [post]
+func (h *WebSocketHandler) ImportReadings(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		logger.Warn("Unauthorized access attempt to ImportReadings")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxImportUpload)
+	if err := r.ParseMultipartForm(32 << 20); err != nil {
+		http.Error(w, "Invalid multipart form: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "file is required", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	opts := &pb_data.ImportOptions{
+		Filename:      header.Filename,
+		SensorColumn:  r.FormValue("sensor_column"),
+		TimeColumn:    r.FormValue("time_column"),
+		ValueColumn:   r.FormValue("value_column"),
+		QualityColumn: r.FormValue("quality_column"),
+		TimeFormat:    r.FormValue("time_format"),
+		Timezone:      r.FormValue("timezone"),
+		Delimiter:     r.FormValue("delimiter"),
+		UserId:        int64(claims.UserId),
+	}
+	if v := r.FormValue("sensor_id"); v != "" {
+		if opts.SensorId, err = strconv.ParseInt(v, 10, 64); err != nil {
+			http.Error(w, "Invalid sensor_id", http.StatusBadRequest)
+			return
+		}
+	}
+	if v := r.FormValue("channel_columns"); v != "" {
+		opts.ChannelColumns = make(map[string]string)
+		for _, pair := range strings.Split(v, ",") {
+			channel, column, ok := strings.Cut(pair, "=")
+			if !ok || strings.TrimSpace(channel) == "" || strings.TrimSpace(column) == "" {
+				http.Error(w, "Invalid channel_columns, expected channel=column pairs", http.StatusBadRequest)
+				return
+			}
+			opts.ChannelColumns[strings.TrimSpace(channel)] = strings.TrimSpace(column)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 60*time.Second)
+	defer cancel()
+
+	// The data service checks the sensors of sensor_column once the file is uploaded.
+	if opts.SensorId > 0 {
+		if _, ok := h.ownedSensors(ctx, w, opts.UserId, []int64{opts.SensorId}); !ok {
+			return
+		}
+	}
+
+	stream, err := h.dataClient.ImportReadings(ctx)
+	if err == nil {
+		err = stream.Send(&pb_data.ImportReadingsRequest{Options: opts})
+	}
+	buf := make([]byte, importChunkSize)
+	for err == nil {
+		var n int
+		n, err = file.Read(buf)
+		if n > 0 {
+			if sendErr := stream.Send(&pb_data.ImportReadingsRequest{Data: buf[:n]}); sendErr != nil {
+				err = sendErr
+			}
+		}
+	}
+	// Send reports io.EOF when the server has already closed the stream; the actual
+	// status is returned by CloseAndRecv.
+	var job *pb_data.ImportJob
+	if err == io.EOF {
+		job, err = stream.CloseAndRecv()
+	}
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		case codes.PermissionDenied:
+			http.Error(w, status.Convert(err).Message(), http.StatusForbidden)
+			return
+		case codes.ResourceExhausted:
+			http.Error(w, status.Convert(err).Message(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		logger.Error("Failed to upload import file via gRPC", zap.Error(err))
+		http.Error(w, "Failed to import readings", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	json.NewEncoder(w).Encode(types.MapImportJobFromProto(job))
+}
+
+// @Summary Get an import job
+// @Description Returns the status, progress counters and the first row errors of an import job started by the authenticated user
+// @Tags Data
+// @Produce json
+// @Security ApiKeyAuth
+// @Param id path int true "Import job ID"
+// @Success 200 {object} types.ImportJobResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 404 {string} string "Import job not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/imports/{id} [get]
+func (h *WebSocketHandler) GetImportJob(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	id, err := strconv.ParseInt(chi.URLParam(r, "id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid id", http.StatusBadRequest)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+	defer cancel()
+
+	job, err := h.dataClient.GetImportJob(ctx, &pb_data.GetImportJobRequest{Id: id, UserId: int64(claims.UserId)})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		case codes.NotFound:
+			http.Error(w, "Import job not found", http.StatusNotFound)
+			return
+		}
+		http.Error(w, "Failed to get import job: "+err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.MapImportJobFromProto(job))
+}
@@ -184,10 +184,16 @@ func (h *SensorHandler) CreateSensor(w http.ResponseWriter, r *http.Request) {
 		SensorTypeId: req.SensorTypeId,
 		UserId:       int64(userId),
 		Active:       true,
+		Kind:         req.Kind,
+		Expression:   req.Expression,
 	}
 
 	res, err := h.client.CreateSensor(ctx, grpcReq)
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to create sensor: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -265,6 +271,9 @@ func (h *SensorHandler) UpdateSensor(w http.ResponseWriter, r *http.Request) {
 	if req.Active != nil {
 		grpcReq.Active = *req.Active
 	}
+	if req.Expression != nil {
+		grpcReq.Expression = *req.Expression
+	}
 	if req.SensorTypeId != nil {
 
 		_, err := h.client.GetSensorType(ctx, &pb.GetSensorTypeRequest{Id: *req.SensorTypeId})
@@ -297,6 +306,10 @@ func (h *SensorHandler) UpdateSensor(w http.ResponseWriter, r *http.Request) {
 			http.Error(w, "Sensor not found or related entity missing", http.StatusNotFound)
 			return
 		}
+		if ok && st.Code() == codes.InvalidArgument {
+			http.Error(w, st.Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to update sensor: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -311,31 +324,55 @@ func (h *SensorHandler) UpdateSensor(w http.ResponseWriter, r *http.Request) {
 // @Description Deletes a sensor from the Sensor Service by its ID.
 // @Tags Sensors
 // @Param id path int true "Sensor ID"
+// @Param delete_readings query bool false "Also delete the stored readings of the sensor"
 // @Security ApiKeyAuth
 // @Success 204 {string} string "No Content"
 // @Failure 400 {string} string "Bad Request"
 // @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
 // @Failure 404 {string} string "Not Found"
+// @Failure 409 {string} string "Sensor is an input of a virtual sensor"
 // @Failure 500 {string} string "Internal Server Error"
+// @Failure 503 {string} string "Readings could not be deleted, the sensor is kept"
 // @Router /api/sensors/{id} [delete]
 func (h *SensorHandler) DeleteSensor(w http.ResponseWriter, r *http.Request) {
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
 
-	idStr := chi.URLParam(r, "id")
-	id, err := strconv.Atoi(idStr)
-	if err != nil {
-		http.Error(w, "Invalid sensor ID", http.StatusBadRequest)
+	sensor, ok := h.ownedSensor(ctx, w, r)
+	if !ok {
 		return
 	}
 
-	_, err = h.client.DeleteSensor(ctx, &pb.DeleteSensorRequest{Id: int64(id)})
+	req := &pb.DeleteSensorRequest{Id: sensor.Id, UserId: sensor.UserId}
+	if param := r.URL.Query().Get("delete_readings"); param != "" {
+		var err error
+		req.DeleteReadings, err = strconv.ParseBool(param)
+		if err != nil {
+			http.Error(w, "Invalid delete_readings", http.StatusBadRequest)
+			return
+		}
+	}
+
+	_, err := h.client.DeleteSensor(ctx, req)
 	if err != nil {
 		st, ok := status.FromError(err)
 		if ok && st.Code() == codes.NotFound {
 			http.Error(w, "Sensor not found", http.StatusNotFound)
 			return
 		}
+		if ok && st.Code() == codes.PermissionDenied {
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+		if ok && st.Code() == codes.FailedPrecondition {
+			http.Error(w, st.Message(), http.StatusConflict)
+			return
+		}
+		if ok && st.Code() == codes.Unavailable {
+			http.Error(w, st.Message(), http.StatusServiceUnavailable)
+			return
+		}
 		http.Error(w, "Failed to delete sensor: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -45,15 +45,17 @@ func (h *SensorTypeHandler) ListSensorTypes(w http.ResponseWriter, r *http.Reque
 	sensorTypeResponses := make([]types.SensorTypeResponse, 0, len(res.SensorTypes))
 	for _, st := range res.SensorTypes {
 		sensorTypeResponses = append(sensorTypeResponses, types.SensorTypeResponse{
-			ID:           st.Id,
-			Name:         st.Name,
-			Model:        st.Model,
-			Manufacturer: st.Manufacturer,
-			Description:  st.Description,
-			Unit:         st.Unit,
-			MinValue:     st.MinValue,
-			MaxValue:     st.MaxValue,
-			CreatedAt:    st.CreatedAt.AsTime(),
+			ID:               st.Id,
+			Name:             st.Name,
+			Model:            st.Model,
+			Manufacturer:     st.Manufacturer,
+			Description:      st.Description,
+			Unit:             st.Unit,
+			MinValue:         st.MinValue,
+			MaxValue:         st.MaxValue,
+			OutOfRangePolicy: st.OutOfRangePolicy,
+			Channels:         types.MapSensorChannelsFromProto(st.Channels),
+			CreatedAt:        st.CreatedAt.AsTime(),
 		})
 	}
 
@@ -98,15 +100,17 @@ func (h *SensorTypeHandler) GetSensorType(w http.ResponseWriter, r *http.Request
 	}
 
 	sensorType := types.SensorTypeResponse{
-		ID:           res.SensorType.Id,
-		Name:         res.SensorType.Name,
-		Model:        res.SensorType.Model,
-		Manufacturer: res.SensorType.Manufacturer,
-		Description:  res.SensorType.Description,
-		Unit:         res.SensorType.Unit,
-		MinValue:     res.SensorType.MinValue,
-		MaxValue:     res.SensorType.MaxValue,
-		CreatedAt:    res.SensorType.CreatedAt.AsTime(),
+		ID:               res.SensorType.Id,
+		Name:             res.SensorType.Name,
+		Model:            res.SensorType.Model,
+		Manufacturer:     res.SensorType.Manufacturer,
+		Description:      res.SensorType.Description,
+		Unit:             res.SensorType.Unit,
+		MinValue:         res.SensorType.MinValue,
+		MaxValue:         res.SensorType.MaxValue,
+		OutOfRangePolicy: res.SensorType.OutOfRangePolicy,
+		Channels:         types.MapSensorChannelsFromProto(res.SensorType.Channels),
+		CreatedAt:        res.SensorType.CreatedAt.AsTime(),
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -144,6 +148,10 @@ func (h *SensorTypeHandler) CreateSensorType(w http.ResponseWriter, r *http.Requ
 
 	res, err := h.client.CreateSensorType(ctx, &req)
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to create sensor type: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -200,6 +208,10 @@ func (h *SensorTypeHandler) UpdateSensorType(w http.ResponseWriter, r *http.Requ
 			http.Error(w, "Sensor type not found", http.StatusNotFound)
 			return
 		}
+		if ok && st.Code() == codes.InvalidArgument {
+			http.Error(w, st.Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to update sensor type: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
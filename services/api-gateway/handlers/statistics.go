@@ -0,0 +1,175 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-chi/chi/v5"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/internal/types"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
+)
+
+// @Summary Get reading statistics for a sensor
+// @Description Returns count, min, max, mean, sample stddev, p50/p90/p95/p99 and the first and last reading of a sensor over a time range
+// @Tags Data
+// @Produce json
+// @Param sensor_id path int true "Sensor ID"
+// @Param start_time query string false "Start time (RFC3339, default 24h ago)"
+// @Param end_time query string false "End time (RFC3339, default now)"
+// @Param channel query string false "Channel of a multi-channel sensor (default primary value)"
+// @Security ApiKeyAuth
+// @Success 200 {object} types.SensorStatisticsResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/sensors/{sensor_id}/stats [get]
+func (h *WebSocketHandler) GetSensorStatistics(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		logger.Warn("Unauthorized access attempt to GetSensorStatistics")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	sensorID, err := strconv.ParseInt(chi.URLParam(r, "sensor_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid sensor_id", http.StatusBadRequest)
+		return
+	}
+
+	if _, ok := h.ownedSensors(r.Context(), w, int64(claims.UserId), []int64{sensorID}); !ok {
+		return
+	}
+
+	res, ok := h.readingStatistics(w, r, &pb_data.ReadingStatisticsRequest{SensorIds: []int64{sensorID}})
+	if !ok {
+		return
+	}
+
+	stats := types.ReadingStatisticsResponse{SensorID: sensorID}
+	if len(res.Statistics) > 0 {
+		stats = types.MapReadingStatisticsFromProto(res.Statistics[0])
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.SensorStatisticsResponse{
+		Channel:                   r.URL.Query().Get("channel"),
+		ReadingStatisticsResponse: stats,
+	})
+}
+
+// @Summary Get reading statistics for a sensor group
+// @Description Returns the reading statistics of every sensor in the group and of all their readings together
+// @Tags Data
+// @Produce json
+// @Param group_id path int true "Sensor group ID"
+// @Param start_time query string false "Start time (RFC3339, default 24h ago)"
+// @Param end_time query string false "End time (RFC3339, default now)"
+// @Param channel query string false "Channel of multi-channel sensors (default primary value)"
+// @Security ApiKeyAuth
+// @Success 200 {object} types.GroupStatisticsResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor group not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/groups/{group_id}/stats [get]
+func (h *WebSocketHandler) GetGroupStatistics(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		logger.Warn("Unauthorized access attempt to GetGroupStatistics")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	userID := int64(claims.UserId)
+
+	groupID, err := strconv.ParseInt(chi.URLParam(r, "group_id"), 10, 64)
+	if err != nil {
+		http.Error(w, "Invalid group_id", http.StatusBadRequest)
+		return
+	}
+
+	group, ok := h.ownedGroup(r.Context(), w, userID, groupID)
+	if !ok {
+		return
+	}
+	if _, ok := h.ownedSensors(r.Context(), w, userID, group.SensorIds); !ok {
+		return
+	}
+
+	res, ok := h.readingStatistics(w, r, &pb_data.ReadingStatisticsRequest{SensorGroupId: groupID})
+	if !ok {
+		return
+	}
+
+	response := types.GroupStatisticsResponse{
+		GroupID: groupID,
+		Channel: r.URL.Query().Get("channel"),
+		Overall: types.MapReadingStatisticsFromProto(res.Overall),
+		Sensors: make([]types.ReadingStatisticsResponse, 0, len(res.Statistics)),
+	}
+	for _, s := range res.Statistics {
+		response.Sensors = append(response.Sensors, types.MapReadingStatisticsFromProto(s))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// readingStatistics fills the time range and channel of req from the query string and
+// calls GetReadingStatistics. On failure it writes the error response and returns false.
+func (h *WebSocketHandler) readingStatistics(w http.ResponseWriter, r *http.Request, req *pb_data.ReadingStatisticsRequest) (*pb_data.ReadingStatisticsResponse, bool) {
+	query := r.URL.Query()
+
+	endTime := time.Now()
+	if param := query.Get("end_time"); param != "" {
+		var err error
+		endTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid end_time format", http.StatusBadRequest)
+			return nil, false
+		}
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if param := query.Get("start_time"); param != "" {
+		var err error
+		startTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid start_time format", http.StatusBadRequest)
+			return nil, false
+		}
+	}
+
+	req.StartTime = timestamppb.New(startTime)
+	req.EndTime = timestamppb.New(endTime)
+	req.Channel = query.Get("channel")
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := h.dataClient.GetReadingStatistics(ctx, req)
+	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return nil, false
+		case codes.NotFound:
+			http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+			return nil, false
+		}
+		http.Error(w, "Failed to get reading statistics: "+err.Error(), http.StatusInternalServerError)
+		return nil, false
+	}
+	return res, true
+}
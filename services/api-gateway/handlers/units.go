@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb_auth "github.com/skni-kod/iot-monitor-backend/internal/proto/auth"
+	"github.com/skni-kod/iot-monitor-backend/internal/types"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/pkg/units"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
+)
+
+// targetUnit returns the units the readings of a request are converted to: the
+// target_unit query parameter when given, otherwise the unit preferences of the signed
+// in user. Anonymous requests and users without preferences get unconverted values.
+func (h *WebSocketHandler) targetUnit(r *http.Request) (string, error) {
+	if param := r.URL.Query().Get("target_unit"); param != "" {
+		if _, err := units.ParseTargets(param); err != nil {
+			return "", fmt.Errorf("Invalid target_unit: %v", err)
+		}
+		return param, nil
+	}
+
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		return "", nil
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
+	defer cancel()
+
+	res, err := h.authClient.GetUser(ctx, &pb_auth.GetUserRequest{Id: int64(claims.UserId)})
+	if err != nil || res.User == nil {
+		// Readings are still served, only in the units of their sensors.
+		logger.Warn("Failed to load unit preferences", zap.Int("user_id", claims.UserId), zap.Error(err))
+		return "", nil
+	}
+
+	targets, err := units.ParsePreferences(res.User.UnitPreferences)
+	if err != nil {
+		logger.Warn("Ignoring invalid unit preferences", zap.Int("user_id", claims.UserId), zap.Error(err))
+		return "", nil
+	}
+	return targets.String(), nil
+}
+
+// @Summary List units
+// @Description Lists the units readings can be converted to with target_unit or the unit preferences of a user, grouped by dimension. Values only convert between units of the same dimension.
+// @Tags Data
+// @Produce json
+// @Success 200 {array} types.UnitResponse
+// @Router /api/data/units [get]
+func (h *WebSocketHandler) ListUnits(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(types.MapUnits(units.All()))
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
@@ -18,10 +20,12 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	pb_auth "github.com/skni-kod/iot-monitor-backend/internal/proto/auth"
 	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
 	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
 	"github.com/skni-kod/iot-monitor-backend/internal/types"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
 )
 
 var upgrader = websocket.Upgrader{
@@ -35,14 +39,17 @@ var upgrader = websocket.Upgrader{
 type WebSocketHandler struct {
 	dataClient   pb_data.DataServiceClient
 	sensorClient pb_sensor.SensorServiceClient
-	clients      map[*websocket.Conn]bool
-	clientsMu    sync.RWMutex
+	// authClient loads the unit preferences of signed in users.
+	authClient pb_auth.AuthServiceClient
+	clients    map[*websocket.Conn]bool
+	clientsMu  sync.RWMutex
 }
 
-func NewWebSocketHandler(dataClient pb_data.DataServiceClient, sensorClient pb_sensor.SensorServiceClient, alertMsgs <-chan amqp.Delivery) *WebSocketHandler {
+func NewWebSocketHandler(dataClient pb_data.DataServiceClient, sensorClient pb_sensor.SensorServiceClient, authClient pb_auth.AuthServiceClient, alertMsgs <-chan amqp.Delivery) *WebSocketHandler {
 	h := &WebSocketHandler{
 		dataClient:   dataClient,
 		sensorClient: sensorClient,
+		authClient:   authClient,
 		clients:      make(map[*websocket.Conn]bool),
 	}
 
@@ -57,9 +64,32 @@ func NewWebSocketHandler(dataClient pb_data.DataServiceClient, sensorClient pb_s
 // @Description Establishes a WebSocket connection for real-time sensor data streaming
 // @Tags Data
 // @Param sensor_ids query string false "Comma-separated sensor IDs"
+// @Param channel query string false "Channel of multi-channel sensors to stream as the value"
+// @Param target_unit query string false "Comma-separated units to convert values to, one per dimension, e.g. °F,psi (default the unit preferences of the signed in user)"
+// @Param min_interval query string false "Send at most one update per sensor per interval, e.g. 500ms or 5s"
+// @Param deadband query number false "Only send an update when the value changed by more than deadband since the last one sent"
+// @Param downsample query string false "How updates within min_interval are combined: latest (default), avg, min or max"
 // @Router /api/data/ws/readings [get]
 func (h *WebSocketHandler) HandleReadings(w http.ResponseWriter, r *http.Request) {
 	sensorIDsParam := r.URL.Query().Get("sensor_ids")
+	targetUnit, err := h.targetUnit(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	options := &pb_data.StreamReadingsRequest{
+		Channel:     r.URL.Query().Get("channel"),
+		TargetUnit:  targetUnit,
+		MinInterval: r.URL.Query().Get("min_interval"),
+		Downsample:  r.URL.Query().Get("downsample"),
+	}
+	if param := r.URL.Query().Get("deadband"); param != "" {
+		options.Deadband, err = strconv.ParseFloat(param, 64)
+		if err != nil {
+			http.Error(w, "Invalid deadband", http.StatusBadRequest)
+			return
+		}
+	}
 	var sensorIDs []int64
 	if sensorIDsParam != "" {
 		for _, idStr := range strings.Split(sensorIDsParam, ",") {
@@ -109,7 +139,7 @@ func (h *WebSocketHandler) HandleReadings(w http.ResponseWriter, r *http.Request
 	logger.Info("WebSocket client connected for sensors", zap.Int64s("sensors_ids", sensorIDs))
 
 	if len(sensorIDs) > 0 {
-		go h.streamToClient(conn, sensorIDs)
+		go h.streamToClient(conn, streamRequest(options, sensorIDs))
 	} else {
 		logger.Info("No active sensors found to stream")
 	}
@@ -126,20 +156,44 @@ func (h *WebSocketHandler) HandleReadings(w http.ResponseWriter, r *http.Request
 
 		if msg.Type == "subscribe" && len(msg.SensorIDs) > 0 {
 			logger.Info("Client subscribing to sensors", zap.Int64s("sensor_ids", msg.SensorIDs))
-			go h.streamToClient(conn, msg.SensorIDs)
+			req := streamRequest(options, msg.SensorIDs)
+			req.Channel = msg.Channel
+			if msg.TargetUnit != "" {
+				req.TargetUnit = msg.TargetUnit
+			}
+			if msg.MinInterval != "" {
+				req.MinInterval = msg.MinInterval
+			}
+			if msg.Deadband != 0 {
+				req.Deadband = msg.Deadband
+			}
+			if msg.Downsample != "" {
+				req.Downsample = msg.Downsample
+			}
+			go h.streamToClient(conn, req)
 		}
 	}
 }
 
-func (h *WebSocketHandler) streamToClient(conn *websocket.Conn, sensorIDs []int64) {
+// streamRequest returns a copy of the stream options of a connection for sensorIDs.
+func streamRequest(options *pb_data.StreamReadingsRequest, sensorIDs []int64) *pb_data.StreamReadingsRequest {
+	return &pb_data.StreamReadingsRequest{
+		SensorIds:   sensorIDs,
+		Channel:     options.Channel,
+		TargetUnit:  options.TargetUnit,
+		MinInterval: options.MinInterval,
+		Deadband:    options.Deadband,
+		Downsample:  options.Downsample,
+	}
+}
+
+func (h *WebSocketHandler) streamToClient(conn *websocket.Conn, req *pb_data.StreamReadingsRequest) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	logger.Info("Starting stream for sensors", zap.Int64s("sensor_ids", sensorIDs))
+	logger.Info("Starting stream for sensors", zap.Int64s("sensor_ids", req.SensorIds))
 
-	stream, err := h.dataClient.StreamReadings(ctx, &pb_data.StreamReadingsRequest{
-		SensorIds: sensorIDs,
-	})
+	stream, err := h.dataClient.StreamReadings(ctx, req)
 	if err != nil {
 		logger.Error("Failed to start stream", zap.Error(err))
 		return
@@ -172,6 +226,8 @@ func (h *WebSocketHandler) streamToClient(conn *websocket.Conn, sensorIDs []int6
 			SensorName: update.SensorName,
 			Location:   update.Location,
 			Unit:       update.Unit,
+			Quality:    update.Quality,
+			Values:     update.Values,
 		}
 
 		h.clientsMu.RLock()
@@ -196,15 +252,26 @@ func (h *WebSocketHandler) streamToClient(conn *websocket.Conn, sensorIDs []int6
 }
 
 // @Summary Get historical sensor readings
-// @Description Fetches historical data for a specific sensor
+// @Description Fetches historical data for a specific sensor, optionally rolled up into time buckets. Without interval, page_size and page_token the data points are returned as a bare array, as before buckets and pages were added, and X-Next-Page-Token is set when more readings follow.
 // @Tags Data
+// @Produce json
 // @Param sensor_id path int true "Sensor ID"
 // @Param start_time query string false "Start time (RFC3339)"
 // @Param end_time query string false "End time (RFC3339)"
-// @Success 200 {object} []string
+// @Param interval query string false "Bucket interval, e.g. 5m, 1h, 1d"
+// @Param agg query string false "Bucket aggregate: avg, min, max, sum, count, first, last (default avg)"
+// @Param channel query string false "Channel of a multi-channel sensor (default primary value)"
+// @Param fill query string false "Return every bucket, filling buckets without readings with null, previous or linear (requires interval)"
+// @Param target_unit query string false "Comma-separated units to convert values to, one per dimension, e.g. °F,psi (default the unit preferences of the signed in user)"
+// @Param page_size query int false "Raw readings per page (default 1000, max 10000, not for interval queries)"
+// @Param page_token query string false "next_page_token of the previous page"
+// @Success 200 {object} types.HistoricalReadingsResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 500 {string} string "Internal Server Error"
 // @Router /api/data/sensors/{sensor_id}/readings [get]
 func (h *WebSocketHandler) GetHistoricalReadings(w http.ResponseWriter, r *http.Request) {
-	sensorIDStr := r.URL.Query().Get("sensor_id")
+	sensorIDStr := chi.URLParam(r, "sensor_id")
 	sensorID, err := strconv.ParseInt(sensorIDStr, 10, 64)
 	if err != nil {
 		http.Error(w, "Invalid sensor_id", http.StatusBadRequest)
@@ -213,6 +280,11 @@ func (h *WebSocketHandler) GetHistoricalReadings(w http.ResponseWriter, r *http.
 
 	startTimeStr := r.URL.Query().Get("start_time")
 	endTimeStr := r.URL.Query().Get("end_time")
+	interval := r.URL.Query().Get("interval")
+	agg := r.URL.Query().Get("agg")
+	channel := r.URL.Query().Get("channel")
+	fill := r.URL.Query().Get("fill")
+	pageToken := r.URL.Query().Get("page_token")
 
 	var startTime, endTime time.Time
 	if startTimeStr != "" {
@@ -235,28 +307,247 @@ func (h *WebSocketHandler) GetHistoricalReadings(w http.ResponseWriter, r *http.
 		endTime = time.Now()
 	}
 
+	if agg != "" && interval == "" {
+		http.Error(w, "agg requires interval", http.StatusBadRequest)
+		return
+	}
+	if fill != "" && interval == "" {
+		http.Error(w, "fill requires interval", http.StatusBadRequest)
+		return
+	}
+	var pageSize int64
+	if param := r.URL.Query().Get("page_size"); param != "" {
+		pageSize, err = strconv.ParseInt(param, 10, 32)
+		if err != nil || pageSize <= 0 {
+			http.Error(w, "Invalid page_size", http.StatusBadRequest)
+			return
+		}
+	}
+	targetUnit, err := h.targetUnit(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
 
 	res, err := h.dataClient.QueryReadings(ctx, &pb_data.QueryReadingsRequest{
-		SensorId:  sensorID,
-		StartTime: timestamppb.New(startTime),
-		EndTime:   timestamppb.New(endTime),
+		SensorId:            sensorID,
+		StartTime:           timestamppb.New(startTime),
+		EndTime:             timestamppb.New(endTime),
+		AggregationInterval: interval,
+		Aggregation:         agg,
+		Channel:             channel,
+		Fill:                fill,
+		TargetUnit:          targetUnit,
+		PageSize:            int32(pageSize),
+		PageToken:           pageToken,
 	})
 	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		case codes.NotFound:
+			http.Error(w, "Sensor not found", http.StatusNotFound)
+			return
+		}
 		http.Error(w, "Failed to query readings: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
 
+	// Clients written before buckets and pages existed get the bare array they expect.
+	if interval == "" && pageToken == "" && pageSize == 0 {
+		if res.NextPageToken != "" {
+			w.Header().Set("X-Next-Page-Token", res.NextPageToken)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(res.DataPoints)
+		return
+	}
+
+	response := types.HistoricalReadingsResponse{
+		SensorID:   sensorID,
+		Channel:    channel,
+		Interval:   interval,
+		Fill:       fill,
+		Unit:       res.Unit,
+		DataPoints: make([]types.DataPointResponse, 0, len(res.DataPoints)),
+
+		NextPageToken: res.NextPageToken,
+	}
+	if interval != "" {
+		response.Aggregation = agg
+		if response.Aggregation == "" {
+			response.Aggregation = "avg"
+		}
+	}
+	for _, p := range res.DataPoints {
+		response.DataPoints = append(response.DataPoints, types.MapDataPointFromProto(p))
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(res.DataPoints)
+	json.NewEncoder(w).Encode(response)
+}
+
+// @Summary Export sensor readings
+// @Description Streams the raw readings of one or more sensors, or of a sensor group, as a CSV, NDJSON or Parquet download. Rows carry the sensor name, location and unit. Every sensor and the group have to belong to the signed in user.
+// @Tags Data
+// @Produce text/csv
+// @Produce application/x-ndjson
+// @Produce application/vnd.apache.parquet
+// @Param sensor_ids query string false "Comma-separated sensor IDs"
+// @Param sensor_group_id query int false "Sensor group ID, adds every sensor of the group"
+// @Param start_time query string false "Start time (RFC3339, default 24h ago)"
+// @Param end_time query string false "End time (RFC3339, default now)"
+// @Param format query string false "csv, ndjson or parquet (default csv)"
+// @Security ApiKeyAuth
+// @Success 200 {file} file
+// @Failure 400 {string} string "Bad Request"
+// @Failure 401 {string} string "Unauthorized"
+// @Failure 403 {string} string "Forbidden"
+// @Failure 404 {string} string "Sensor or group not found"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/export [get]
+func (h *WebSocketHandler) ExportReadings(w http.ResponseWriter, r *http.Request) {
+	claims, ok := authMiddleware.GetUserFromContext(r.Context())
+	if !ok {
+		logger.Warn("Unauthorized access attempt to ExportReadings")
+		http.Error(w, "Unauthorized", http.StatusUnauthorized)
+		return
+	}
+	userID := int64(claims.UserId)
+
+	query := r.URL.Query()
+
+	var sensorIDs []int64
+	if param := query.Get("sensor_ids"); param != "" {
+		for _, idStr := range strings.Split(param, ",") {
+			id, err := strconv.ParseInt(strings.TrimSpace(idStr), 10, 64)
+			if err != nil || id <= 0 {
+				http.Error(w, "Invalid sensor_id: "+idStr, http.StatusBadRequest)
+				return
+			}
+			sensorIDs = append(sensorIDs, id)
+		}
+	}
+
+	var groupID int64
+	if param := query.Get("sensor_group_id"); param != "" {
+		var err error
+		groupID, err = strconv.ParseInt(param, 10, 64)
+		if err != nil || groupID <= 0 {
+			http.Error(w, "Invalid sensor_group_id", http.StatusBadRequest)
+			return
+		}
+	}
+
+	if len(sensorIDs) == 0 && groupID == 0 {
+		http.Error(w, "sensor_ids or sensor_group_id is required", http.StatusBadRequest)
+		return
+	}
+
+	endTime := time.Now()
+	if param := query.Get("end_time"); param != "" {
+		var err error
+		endTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid end_time format", http.StatusBadRequest)
+			return
+		}
+	}
+	startTime := endTime.Add(-24 * time.Hour)
+	if param := query.Get("start_time"); param != "" {
+		var err error
+		startTime, err = time.Parse(time.RFC3339, param)
+		if err != nil {
+			http.Error(w, "Invalid start_time format", http.StatusBadRequest)
+			return
+		}
+	}
+
+	format := query.Get("format")
+	if format == "" {
+		format = "csv"
+	}
+
+	// The data service expands the group again, the check only covers its sensors.
+	ownedIDs := sensorIDs
+	if groupID > 0 {
+		group, ok := h.ownedGroup(r.Context(), w, userID, groupID)
+		if !ok {
+			return
+		}
+		ownedIDs = append(slices.Clone(sensorIDs), group.SensorIds...)
+	}
+	if _, ok := h.ownedSensors(r.Context(), w, userID, ownedIDs); !ok {
+		return
+	}
+
+	// The export runs for as long as the request does, bounded by the gateway's request timeout.
+	stream, err := h.dataClient.ExportReadings(r.Context(), &pb_data.ExportReadingsRequest{
+		SensorIds:     sensorIDs,
+		SensorGroupId: groupID,
+		StartTime:     timestamppb.New(startTime),
+		EndTime:       timestamppb.New(endTime),
+		Format:        format,
+	})
+	if err == nil {
+		var chunk *pb_data.ExportChunk
+		chunk, err = stream.Recv()
+		if err == nil {
+			w.Header().Set("Content-Type", chunk.ContentType)
+			w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", chunk.Filename))
+			err = h.copyExport(w, stream, chunk)
+			if err != nil {
+				// The response is already under way, so the client only sees a truncated file.
+				logger.Error("Failed to stream export", zap.Error(err))
+			}
+			return
+		}
+	}
+
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+	case codes.NotFound:
+		http.Error(w, status.Convert(err).Message(), http.StatusNotFound)
+	default:
+		http.Error(w, "Failed to export readings: "+err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// copyExport writes the first chunk and every following chunk of an export to w,
+// flushing after each so the download starts before the export is complete.
+func (h *WebSocketHandler) copyExport(w http.ResponseWriter, stream pb_data.DataService_ExportReadingsClient, chunk *pb_data.ExportChunk) error {
+	flusher, _ := w.(http.Flusher)
+	for {
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+
+		var err error
+		chunk, err = stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
 }
 
 // @Summary Get latest readings for multiple sensors
 // @Description Fetches the most recent reading for each specified sensor
 // @Tags Data
 // @Param sensor_ids query string true "Comma-separated sensor IDs"
+// @Param target_unit query string false "Comma-separated units to convert values to, one per dimension, e.g. °F,psi (default the unit preferences of the signed in user)"
 // @Success 200 {object} string
+// @Failure 400 {string} string "Bad Request"
 // @Router /api/data/readings/latest [get]
 func (h *WebSocketHandler) GetLatestReadings(w http.ResponseWriter, r *http.Request) {
 	sensorIDsParam := r.URL.Query().Get("sensor_ids")
@@ -276,13 +567,24 @@ func (h *WebSocketHandler) GetLatestReadings(w http.ResponseWriter, r *http.Requ
 		sensorIDs = append(sensorIDs, id)
 	}
 
+	targetUnit, err := h.targetUnit(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
 	ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
 	defer cancel()
 
 	res, err := h.dataClient.GetLatestReadingsBatch(ctx, &pb_data.LatestReadingsBatchRequest{
-		SensorIds: sensorIDs,
+		SensorIds:  sensorIDs,
+		TargetUnit: targetUnit,
 	})
 	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
 		http.Error(w, "Failed to get latest readings: "+err.Error(), http.StatusInternalServerError)
 		return
 	}
@@ -355,12 +657,16 @@ func (h *WebSocketHandler) WsHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // @Summary Store a new sensor reading
-// @Description Sends a sensor reading to the data processing service
+// @Description Sends a sensor reading to the data processing service. Retries carrying the same idempotency key, or a reading for an already stored sensor/timestamp pair, are acknowledged with status "duplicate" and not processed again.
 // @Tags Data
 // @Accept json
 // @Produce json
+// @Param Idempotency-Key header string false "Client supplied key identifying the reading across retries"
 // @Param reading body types.StoreReadingRequest true "Sensor Reading"
 // @Success 200 {object} map[string]string
+// @Failure 400 {string} string "Bad Request"
+// @Failure 404 {string} string "Sensor not found"
+// @Failure 500 {string} string "Internal Server Error"
 // @Router /api/data/readings [post]
 func (h *WebSocketHandler) StoreReading(w http.ResponseWriter, r *http.Request) {
 	var req types.StoreReadingRequest
@@ -374,24 +680,94 @@ func (h *WebSocketHandler) StoreReading(w http.ResponseWriter, r *http.Request)
 		req.Timestamp = time.Now()
 	}
 
+	if key := r.Header.Get("Idempotency-Key"); key != "" {
+		req.IdempotencyKey = key
+	}
+
 	ctx, cancel := context.WithTimeout(r.Context(), 5*time.Second)
 	defer cancel()
 
 	// Wywołanie usługi gRPC data-processing
-	_, err := h.dataClient.StoreReading(ctx, &pb_data.StoreReadingRequest{
-		SensorId:  req.SensorID,
-		Value:     req.Value,
-		Timestamp: timestamppb.New(req.Timestamp),
+	res, err := h.dataClient.StoreReading(ctx, &pb_data.StoreReadingRequest{
+		SensorId:       req.SensorID,
+		Value:          req.Value,
+		Timestamp:      timestamppb.New(req.Timestamp),
+		Quality:        req.Quality,
+		Values:         req.Values,
+		IdempotencyKey: req.IdempotencyKey,
 	})
 
 	if err != nil {
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		case codes.NotFound:
+			http.Error(w, "Sensor not found", http.StatusNotFound)
+			return
+		}
 		logger.Error("Failed to store reading via gRPC", zap.Error(err))
 		http.Error(w, "Failed to store reading", http.StatusInternalServerError)
 		return
 	}
 
+	result := "success"
+	if res.Duplicate {
+		result = "duplicate"
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]string{"status": result})
+}
+
+// @Summary Store a batch of sensor readings
+// @Description Sends many readings, possibly for different sensors, in a single request. Invalid readings are reported per index and do not fail the batch.
+// @Tags Data
+// @Accept json
+// @Produce json
+// @Param readings body types.StoreReadingsBatchRequest true "Sensor Readings"
+// @Success 200 {object} types.StoreReadingsBatchResponse
+// @Failure 400 {string} string "Bad Request"
+// @Failure 500 {string} string "Internal Server Error"
+// @Router /api/data/readings/batch [post]
+func (h *WebSocketHandler) StoreReadingsBatch(w http.ResponseWriter, r *http.Request) {
+	var req types.StoreReadingsBatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	readings := make([]*pb_data.StoreReadingRequest, 0, len(req.Readings))
+	for _, reading := range req.Readings {
+		pbReading := &pb_data.StoreReadingRequest{
+			SensorId:       reading.SensorID,
+			Value:          reading.Value,
+			Quality:        reading.Quality,
+			Values:         reading.Values,
+			IdempotencyKey: reading.IdempotencyKey,
+		}
+		if !reading.Timestamp.IsZero() {
+			pbReading.Timestamp = timestamppb.New(reading.Timestamp)
+		}
+		readings = append(readings, pbReading)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), 30*time.Second)
+	defer cancel()
+
+	res, err := h.dataClient.StoreReadingsBatch(ctx, &pb_data.StoreReadingsBatchRequest{Readings: readings})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			http.Error(w, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
+		logger.Error("Failed to store readings batch via gRPC", zap.Error(err))
+		http.Error(w, "Failed to store readings", http.StatusInternalServerError)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(map[string]string{"status": "success"})
+	json.NewEncoder(w).Encode(types.MapStoreReadingsBatchFromProto(res))
 }
 
 func (h *WebSocketHandler) broadcastAlerts(alertMsgs <-chan amqp.Delivery) {
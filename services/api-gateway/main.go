@@ -200,7 +200,7 @@ func main() {
 	r.Use(cors.Handler(cors.Options{
 		AllowedOrigins:   corsAllowedOrigins,
 		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token"},
+		AllowedHeaders:   []string{"Accept", "Authorization", "Content-Type", "X-CSRF-Token", "Idempotency-Key"},
 		ExposedHeaders:   []string{"Link"},
 		AllowCredentials: true,
 		MaxAge:           300,
@@ -220,7 +220,7 @@ func main() {
 	authHandler := handlers.NewAuthHandler(authClient)
 	alertHandler := handlers.NewAlertHandler(alertClient)
 	alertRuleHandler := handlers.NewAlertRuleHandler(alertClient)
-	dataHandler := handlers.NewWebSocketHandler(dataProcClient, sensorClient, alertMsgs)
+	dataHandler := handlers.NewWebSocketHandler(dataProcClient, sensorClient, authClient, alertMsgs)
 
 	apiRouter := chi.NewRouter()
 	apiRouter.Use(middleware.RequestID)
@@ -28,23 +28,7 @@ func NewAuthMiddleware() *AuthMiddleware {
 
 func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		var token string
-
-		authHeader := r.Header.Get("Authorization")
-		if authHeader != "" {
-			parts := strings.SplitN(authHeader, " ", 2)
-			if len(parts) == 2 && parts[0] == "Bearer" {
-				token = parts[1]
-			}
-		}
-
-		if token == "" {
-			cookie, err := r.Cookie("token")
-			if err == nil {
-				token = cookie.Value
-			}
-		}
-
+		token := tokenFromRequest(r)
 		if token == "" {
 			logger.Warn("Missing authentication token", zap.String("path", r.URL.Path))
 			http.Error(w, "Authorization header or token cookie required", http.StatusUnauthorized)
@@ -69,6 +53,43 @@ func (m *AuthMiddleware) Authenticate(next http.Handler) http.Handler {
 	})
 }
 
+// OptionalAuthenticate stores the user in the context like Authenticate when the
+// request carries a valid token, and passes anonymous requests through unchanged.
+func (m *AuthMiddleware) OptionalAuthenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := tokenFromRequest(r)
+		if token == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		claims, err := m.jwtService.ValidateToken(token)
+		if err != nil || (claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(time.Now())) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), UserContextKey, claims)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// tokenFromRequest returns the bearer token of the request, or the token cookie.
+func tokenFromRequest(r *http.Request) string {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader != "" {
+		parts := strings.SplitN(authHeader, " ", 2)
+		if len(parts) == 2 && parts[0] == "Bearer" {
+			return parts[1]
+		}
+	}
+
+	if cookie, err := r.Cookie("token"); err == nil {
+		return cookie.Value
+	}
+	return ""
+}
+
 func GetUserFromContext(ctx context.Context) (*auth.Claims, bool) {
 	claims, ok := ctx.Value(UserContextKey).(*auth.Claims)
 	return claims, ok
@@ -14,5 +14,7 @@ func SetupAlertRoutes(r chi.Router, handler *handlers.AlertHandler) {
 		r.Use(authMw.Authenticate)
 		r.Get("/", handler.ListAlerts)
 		r.Post("/{id}/read", handler.MarkAlertAsRead)
+		r.Post("/{id}/acknowledge", handler.AcknowledgeAlert)
+		r.Post("/{id}/resolve", handler.ResolveAlert)
 	})
 }
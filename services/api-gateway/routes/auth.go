@@ -18,5 +18,6 @@ func SetupAuthRoutes(r chi.Router, handler *handlers.AuthHandler) {
 		r.Use(authMw.Authenticate)
 		r.Get("/user", handler.GetUser)
 		r.Put("/user", handler.UpdateUser)
+		r.Put("/user/units", handler.SetUnitPreferences)
 	})
 }
@@ -4,16 +4,29 @@ import (
 	"github.com/go-chi/chi/v5"
 
 	"github.com/skni-kod/iot-monitor-backend/services/api-gateway/handlers"
+	authMiddleware "github.com/skni-kod/iot-monitor-backend/services/api-gateway/middleware"
 )
 
 func SetupDataRoutes(r chi.Router, handler *handlers.WebSocketHandler) {
+	authMw := authMiddleware.NewAuthMiddleware()
 	r.Route("/data", func(r chi.Router) {
-		r.Get("/ws/readings", handler.HandleReadings)
-		r.Get("/readings/latest", handler.GetLatestReadings)
+		r.With(authMw.OptionalAuthenticate).Get("/ws/readings", handler.HandleReadings)
+		r.With(authMw.OptionalAuthenticate).Get("/readings/latest", handler.GetLatestReadings)
 		r.Get("/sensors/{sensor_id}/latest", handler.GetSensorLatestReadings)
-		r.Get("/sensors/{sensor_id}/readings", handler.GetHistoricalReadings)
+		r.With(authMw.OptionalAuthenticate).Get("/sensors/{sensor_id}/readings", handler.GetHistoricalReadings)
+		r.With(authMw.Authenticate).Delete("/sensors/{sensor_id}/readings", handler.DeleteReadings)
+		r.With(authMw.Authenticate).Get("/sensors/{sensor_id}/deletions", handler.ListReadingDeletions)
+		r.Get("/units", handler.ListUnits)
+		r.With(authMw.Authenticate).Get("/sensors/{sensor_id}/stats", handler.GetSensorStatistics)
+		r.With(authMw.Authenticate).Get("/sensors/{sensor_id}/gaps", handler.ListDataGaps)
+		r.With(authMw.Authenticate).Get("/groups/{group_id}/stats", handler.GetGroupStatistics)
+		r.With(authMw.Authenticate).Get("/compare", handler.CompareReadings)
+		r.With(authMw.Authenticate).Get("/export", handler.ExportReadings)
+		r.With(authMw.Authenticate).Post("/imports", handler.ImportReadings)
+		r.With(authMw.Authenticate).Get("/imports/{id}", handler.GetImportJob)
 		r.Get("/ws/test", handler.WsHandler)
 		r.Post("/readings", handler.StoreReading)
+		r.Post("/readings/batch", handler.StoreReadingsBatch)
 	})
 
 }
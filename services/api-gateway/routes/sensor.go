@@ -19,6 +19,12 @@ func SetupSensorRoutes(r chi.Router, handler *handlers.SensorHandler) {
 			r.Put("/", handler.UpdateSensor)
 			r.Delete("/", handler.DeleteSensor)
 			r.Put("/active", handler.SetSensorActive)
+			r.Route("/calibrations", func(r chi.Router) {
+				r.Get("/", handler.ListCalibrations)
+				r.Post("/", handler.CreateCalibration)
+				r.Put("/{calibration_id}", handler.UpdateCalibration)
+				r.Delete("/{calibration_id}", handler.DeleteCalibration)
+			})
 		})
 	})
 }
@@ -23,6 +23,7 @@ var (
 		{Name: "refresh_token_expires", Type: field.TypeTime, Nullable: true},
 		{Name: "reset_token", Type: field.TypeString, Nullable: true},
 		{Name: "reset_token_expires", Type: field.TypeTime, Nullable: true},
+		{Name: "unit_preferences", Type: field.TypeJSON, Nullable: true},
 	}
 	// UsersTable holds the schema information for the "users" table.
 	UsersTable = &schema.Table{
@@ -45,6 +45,7 @@ type UserMutation struct {
 	refresh_token_expires *time.Time
 	reset_token           *string
 	reset_token_expires   *time.Time
+	unit_preferences      *map[string]string
 	clearedFields         map[string]struct{}
 	done                  bool
 	oldValue              func(context.Context) (*User, error)
@@ -659,6 +660,55 @@ func (m *UserMutation) ResetResetTokenExpires() {
 	delete(m.clearedFields, user.FieldResetTokenExpires)
 }
 
+// SetUnitPreferences sets the "unit_preferences" field.
+func (m *UserMutation) SetUnitPreferences(value map[string]string) {
+	m.unit_preferences = &value
+}
+
+// UnitPreferences returns the value of the "unit_preferences" field in the mutation.
+func (m *UserMutation) UnitPreferences() (r map[string]string, exists bool) {
+	v := m.unit_preferences
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnitPreferences returns the old "unit_preferences" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUnitPreferences(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnitPreferences is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnitPreferences requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnitPreferences: %w", err)
+	}
+	return oldValue.UnitPreferences, nil
+}
+
+// ClearUnitPreferences clears the value of the "unit_preferences" field.
+func (m *UserMutation) ClearUnitPreferences() {
+	m.unit_preferences = nil
+	m.clearedFields[user.FieldUnitPreferences] = struct{}{}
+}
+
+// UnitPreferencesCleared returns if the "unit_preferences" field was cleared in this mutation.
+func (m *UserMutation) UnitPreferencesCleared() bool {
+	_, ok := m.clearedFields[user.FieldUnitPreferences]
+	return ok
+}
+
+// ResetUnitPreferences resets all changes to the "unit_preferences" field.
+func (m *UserMutation) ResetUnitPreferences() {
+	m.unit_preferences = nil
+	delete(m.clearedFields, user.FieldUnitPreferences)
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -693,7 +743,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 12)
+	fields := make([]string, 0, 13)
 	if m.email != nil {
 		fields = append(fields, user.FieldEmail)
 	}
@@ -730,6 +780,9 @@ func (m *UserMutation) Fields() []string {
 	if m.reset_token_expires != nil {
 		fields = append(fields, user.FieldResetTokenExpires)
 	}
+	if m.unit_preferences != nil {
+		fields = append(fields, user.FieldUnitPreferences)
+	}
 	return fields
 }
 
@@ -762,6 +815,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.ResetToken()
 	case user.FieldResetTokenExpires:
 		return m.ResetTokenExpires()
+	case user.FieldUnitPreferences:
+		return m.UnitPreferences()
 	}
 	return nil, false
 }
@@ -795,6 +850,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldResetToken(ctx)
 	case user.FieldResetTokenExpires:
 		return m.OldResetTokenExpires(ctx)
+	case user.FieldUnitPreferences:
+		return m.OldUnitPreferences(ctx)
 	}
 	return nil, fmt.Errorf("unknown User field %s", name)
 }
@@ -888,6 +945,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetResetTokenExpires(v)
 		return nil
+	case user.FieldUnitPreferences:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnitPreferences(v)
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
@@ -936,6 +1000,9 @@ func (m *UserMutation) ClearedFields() []string {
 	if m.FieldCleared(user.FieldResetTokenExpires) {
 		fields = append(fields, user.FieldResetTokenExpires)
 	}
+	if m.FieldCleared(user.FieldUnitPreferences) {
+		fields = append(fields, user.FieldUnitPreferences)
+	}
 	return fields
 }
 
@@ -968,6 +1035,9 @@ func (m *UserMutation) ClearField(name string) error {
 	case user.FieldResetTokenExpires:
 		m.ClearResetTokenExpires()
 		return nil
+	case user.FieldUnitPreferences:
+		m.ClearUnitPreferences()
+		return nil
 	}
 	return fmt.Errorf("unknown User nullable field %s", name)
 }
@@ -1012,6 +1082,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldResetTokenExpires:
 		m.ResetResetTokenExpires()
 		return nil
+	case user.FieldUnitPreferences:
+		m.ResetUnitPreferences()
+		return nil
 	}
 	return fmt.Errorf("unknown User field %s", name)
 }
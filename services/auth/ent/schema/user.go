@@ -45,6 +45,9 @@ func (User) Fields() []ent.Field {
 			Sensitive(),
 		field.Time("reset_token_expires").
 			Optional(),
+		field.JSON("unit_preferences", map[string]string{}).
+			Optional().
+			Comment("Unit readings are shown in, keyed by dimension, e.g. temperature: °F"),
 	}
 }
 
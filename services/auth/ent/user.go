@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -41,7 +42,9 @@ type User struct {
 	ResetToken string `json:"-"`
 	// ResetTokenExpires holds the value of the "reset_token_expires" field.
 	ResetTokenExpires time.Time `json:"reset_token_expires,omitempty"`
-	selectValues      sql.SelectValues
+	// Unit readings are shown in, keyed by dimension, e.g. temperature: °F
+	UnitPreferences map[string]string `json:"unit_preferences,omitempty"`
+	selectValues    sql.SelectValues
 }
 
 // scanValues returns the types for scanning values from sql.Rows.
@@ -49,6 +52,8 @@ func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case user.FieldUnitPreferences:
+			values[i] = new([]byte)
 		case user.FieldActive:
 			values[i] = new(sql.NullBool)
 		case user.FieldID:
@@ -150,6 +155,14 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.ResetTokenExpires = value.Time
 			}
+		case user.FieldUnitPreferences:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field unit_preferences", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.UnitPreferences); err != nil {
+					return fmt.Errorf("unmarshal field unit_preferences: %w", err)
+				}
+			}
 		default:
 			u.selectValues.Set(columns[i], values[i])
 		}
@@ -218,6 +231,9 @@ func (u *User) String() string {
 	builder.WriteString(", ")
 	builder.WriteString("reset_token_expires=")
 	builder.WriteString(u.ResetTokenExpires.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("unit_preferences=")
+	builder.WriteString(fmt.Sprintf("%v", u.UnitPreferences))
 	builder.WriteByte(')')
 	return builder.String()
 }
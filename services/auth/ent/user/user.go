@@ -37,6 +37,8 @@ const (
 	FieldResetToken = "reset_token"
 	// FieldResetTokenExpires holds the string denoting the reset_token_expires field in the database.
 	FieldResetTokenExpires = "reset_token_expires"
+	// FieldUnitPreferences holds the string denoting the unit_preferences field in the database.
+	FieldUnitPreferences = "unit_preferences"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 )
@@ -56,6 +58,7 @@ var Columns = []string{
 	FieldRefreshTokenExpires,
 	FieldResetToken,
 	FieldResetTokenExpires,
+	FieldUnitPreferences,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -799,6 +799,16 @@ func ResetTokenExpiresNotNil() predicate.User {
 	return predicate.User(sql.FieldNotNull(FieldResetTokenExpires))
 }
 
+// UnitPreferencesIsNil applies the IsNil predicate on the "unit_preferences" field.
+func UnitPreferencesIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldUnitPreferences))
+}
+
+// UnitPreferencesNotNil applies the NotNil predicate on the "unit_preferences" field.
+func UnitPreferencesNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldUnitPreferences))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
@@ -164,6 +164,12 @@ func (uc *UserCreate) SetNillableResetTokenExpires(t *time.Time) *UserCreate {
 	return uc
 }
 
+// SetUnitPreferences sets the "unit_preferences" field.
+func (uc *UserCreate) SetUnitPreferences(m map[string]string) *UserCreate {
+	uc.mutation.SetUnitPreferences(m)
+	return uc
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uc *UserCreate) Mutation() *UserMutation {
 	return uc.mutation
@@ -322,6 +328,10 @@ func (uc *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldResetTokenExpires, field.TypeTime, value)
 		_node.ResetTokenExpires = value
 	}
+	if value, ok := uc.mutation.UnitPreferences(); ok {
+		_spec.SetField(user.FieldUnitPreferences, field.TypeJSON, value)
+		_node.UnitPreferences = value
+	}
 	return _node, _spec
 }
 
@@ -210,6 +210,18 @@ func (uu *UserUpdate) ClearResetTokenExpires() *UserUpdate {
 	return uu
 }
 
+// SetUnitPreferences sets the "unit_preferences" field.
+func (uu *UserUpdate) SetUnitPreferences(m map[string]string) *UserUpdate {
+	uu.mutation.SetUnitPreferences(m)
+	return uu
+}
+
+// ClearUnitPreferences clears the value of the "unit_preferences" field.
+func (uu *UserUpdate) ClearUnitPreferences() *UserUpdate {
+	uu.mutation.ClearUnitPreferences()
+	return uu
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uu *UserUpdate) Mutation() *UserMutation {
 	return uu.mutation
@@ -334,6 +346,12 @@ func (uu *UserUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if uu.mutation.ResetTokenExpiresCleared() {
 		_spec.ClearField(user.FieldResetTokenExpires, field.TypeTime)
 	}
+	if value, ok := uu.mutation.UnitPreferences(); ok {
+		_spec.SetField(user.FieldUnitPreferences, field.TypeJSON, value)
+	}
+	if uu.mutation.UnitPreferencesCleared() {
+		_spec.ClearField(user.FieldUnitPreferences, field.TypeJSON)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, uu.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -536,6 +554,18 @@ func (uuo *UserUpdateOne) ClearResetTokenExpires() *UserUpdateOne {
 	return uuo
 }
 
+// SetUnitPreferences sets the "unit_preferences" field.
+func (uuo *UserUpdateOne) SetUnitPreferences(m map[string]string) *UserUpdateOne {
+	uuo.mutation.SetUnitPreferences(m)
+	return uuo
+}
+
+// ClearUnitPreferences clears the value of the "unit_preferences" field.
+func (uuo *UserUpdateOne) ClearUnitPreferences() *UserUpdateOne {
+	uuo.mutation.ClearUnitPreferences()
+	return uuo
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (uuo *UserUpdateOne) Mutation() *UserMutation {
 	return uuo.mutation
@@ -690,6 +720,12 @@ func (uuo *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error)
 	if uuo.mutation.ResetTokenExpiresCleared() {
 		_spec.ClearField(user.FieldResetTokenExpires, field.TypeTime)
 	}
+	if value, ok := uuo.mutation.UnitPreferences(); ok {
+		_spec.SetField(user.FieldUnitPreferences, field.TypeJSON, value)
+	}
+	if uuo.mutation.UnitPreferencesCleared() {
+		_spec.ClearField(user.FieldUnitPreferences, field.TypeJSON)
+	}
 	_node = &User{config: uuo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
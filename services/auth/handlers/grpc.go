@@ -11,6 +11,7 @@ import (
 
 	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/auth"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/pkg/units"
 	"github.com/skni-kod/iot-monitor-backend/services/auth/ent"
 	"github.com/skni-kod/iot-monitor-backend/services/auth/services"
 )
@@ -137,6 +138,32 @@ func (h *AuthGrpcHandler) UpdateUser(ctx context.Context, req *pb.UpdateUserRequ
 	}, nil
 }
 
+// SetUnitPreferences stores the units the user wants readings displayed in, at most one
+// per dimension and keyed by it, e.g. {"temperature": "°F"}. An empty map clears them.
+func (h *AuthGrpcHandler) SetUnitPreferences(ctx context.Context, req *pb.SetUnitPreferencesRequest) (*pb.UserResponse, error) {
+	if req.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user id is required")
+	}
+
+	targets, err := units.ParsePreferences(req.UnitPreferences)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid unit preferences: %v", err)
+	}
+
+	user, err := h.authService.SetUnitPreferences(ctx, int(req.UserId), targets.Preferences())
+	if err != nil {
+		logger.Error("Failed to set unit preferences", zap.Int64("user_id", req.UserId), zap.Error(err))
+		if ent.IsNotFound(err) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, "failed to set unit preferences")
+	}
+
+	return &pb.UserResponse{
+		User: convertEntUserToProto(user),
+	}, nil
+}
+
 func (h *AuthGrpcHandler) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*pb.ForgotPasswordResponse, error) {
 	err := h.authService.ForgotPassword(ctx, req.Email)
 	if err != nil {
@@ -186,5 +213,7 @@ func convertEntUserToProto(user *ent.User) *pb.User {
 		Active:    user.Active,
 		CreatedAt: timestamppb.New(user.CreatedAt),
 		UpdatedAt: timestamppb.New(user.UpdatedAt),
+
+		UnitPreferences: user.UnitPreferences,
 	}
 }
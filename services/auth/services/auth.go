@@ -53,6 +53,8 @@ type IAuthService interface {
 	Update(ctx context.Context, userID int, req *UpdateRequest) (*ent.User, error)
 	ForgotPassword(ctx context.Context, email string) error
 	ResetPassword(ctx context.Context, token string, newPassword string) error
+	// SetUnitPreferences replaces the preferred display unit per dimension of the user.
+	SetUnitPreferences(ctx context.Context, userID int, prefs map[string]string) (*ent.User, error)
 }
 
 type AuthService struct {
@@ -209,6 +211,19 @@ func (s *AuthService) Update(ctx context.Context, userID int, req *UpdateRequest
 	return updatedUser, nil
 }
 
+func (s *AuthService) SetUnitPreferences(ctx context.Context, userID int, prefs map[string]string) (*ent.User, error) {
+	if _, err := s.userStorage.Get(ctx, userID); err != nil {
+		return nil, fmt.Errorf("user not found: %w", err)
+	}
+
+	updatedUser, err := s.userStorage.SetUnitPreferences(ctx, userID, prefs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update unit preferences: %w", err)
+	}
+
+	return updatedUser, nil
+}
+
 func (s *AuthService) ForgotPassword(ctx context.Context, email string) error {
 	u, err := s.userStorage.GetByEmail(ctx, email)
 	if err != nil {
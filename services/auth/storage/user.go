@@ -22,6 +22,7 @@ type IUserStorage interface {
 	SetResetToken(ctx context.Context, email string, token string, expiresAt time.Time) error
 	GetByResetToken(ctx context.Context, token string) (*ent.User, error)
 	UpdatePassword(ctx context.Context, userID int, passwordHash string) error
+	SetUnitPreferences(ctx context.Context, userID int, prefs map[string]string) (*ent.User, error)
 }
 
 type UserStorage struct {
@@ -113,3 +114,9 @@ func (s *UserStorage) UpdatePassword(ctx context.Context, userID int, passwordHa
 		ClearResetTokenExpires().
 		Exec(ctx)
 }
+
+func (s *UserStorage) SetUnitPreferences(ctx context.Context, userID int, prefs map[string]string) (*ent.User, error) {
+	return s.client.User.UpdateOneID(userID).
+		SetUnitPreferences(prefs).
+		Save(ctx)
+}
@@ -148,7 +148,8 @@ func (g *GeneratorService) generateData(ctx context.Context) {
 
 	activeSensors := 0
 	for _, sensor := range allSensors {
-		if !sensor.Active {
+		// Virtual sensors are computed by the data service from their inputs.
+		if !sensor.Active || sensor.Kind == "virtual" {
 			continue
 		}
 		activeSensors++
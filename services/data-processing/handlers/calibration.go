@@ -0,0 +1,219 @@
+package handlers
+
+import (
+	"context"
+	"maps"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/services"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+// recalibrateBatchSize is the number of recalibrated readings written per update.
+const recalibrateBatchSize = 1000
+
+// calibrate applies the calibrations of the sensor valid at the given time to the
+// reported values of a reading. A bare value of a multi-channel sensor is taken as its
+// primary channel first, as applyChannels does. The returned raw values are nil when no
+// calibration applied, in which case value and values are returned unchanged.
+func calibrate(sensor *pb_sensor.Sensor, at time.Time, value float32, values map[string]float32) (float32, map[string]float32, *storage.RawValues) {
+	if len(sensor.Calibrations) == 0 {
+		return value, values, nil
+	}
+
+	st := sensor.SensorType
+	multiChannel := st != nil && len(st.Channels) > 0
+	if multiChannel && len(values) == 0 {
+		values = map[string]float32{st.Channels[0].Name: value}
+	}
+
+	var calibrated map[string]float32
+	raw := &storage.RawValues{Value: value, Channels: values}
+	applied := false
+	for _, c := range sensor.Calibrations {
+		if !calibrationValidAt(c, at) {
+			continue
+		}
+		if !multiChannel {
+			if c.Channel == "" {
+				value = applyCalibration(c, value)
+				applied = true
+			}
+			continue
+		}
+
+		v, ok := values[c.Channel]
+		if !ok {
+			continue
+		}
+		if calibrated == nil {
+			calibrated = maps.Clone(values)
+		}
+		calibrated[c.Channel] = applyCalibration(c, v)
+		applied = true
+	}
+	if !applied {
+		return raw.Value, values, nil
+	}
+
+	if multiChannel {
+		raw.Value = values[st.Channels[0].Name]
+		values = calibrated
+		value = values[st.Channels[0].Name]
+	}
+	return value, values, raw
+}
+
+// calibrationValidAt reports whether at lies within [valid_from, valid_to) of c.
+func calibrationValidAt(c *pb_sensor.Calibration, at time.Time) bool {
+	if c.ValidFrom == nil || at.Before(c.ValidFrom.AsTime()) {
+		return false
+	}
+	return c.ValidTo == nil || at.Before(c.ValidTo.AsTime())
+}
+
+// applyCalibration returns gain * p(x) + offset, where p is the polynomial of the
+// calibration, or x itself when it has no coefficients.
+func applyCalibration(c *pb_sensor.Calibration, x float32) float32 {
+	p := float64(x)
+	if len(c.Coefficients) > 0 {
+		// Horner's scheme, coefficients are in ascending order of degree.
+		p = 0
+		for i := len(c.Coefficients) - 1; i >= 0; i-- {
+			p = p*float64(x) + c.Coefficients[i]
+		}
+	}
+	return float32(c.Gain*p + c.Offset)
+}
+
+// RecalibrateReadings recomputes stored readings of a sensor from their raw values
+// after its calibrations were corrected. Range policies are applied again to the
+// quality the reading was reported with, so a reading that is back in range loses its
+// out_of_range flag. Events are not published again.
+func (h *DataGrpcHandler) RecalibrateReadings(ctx context.Context, req *pb_data.RecalibrateReadingsRequest) (*pb_data.RecalibrateReadingsResponse, error) {
+	if req.SensorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "sensor_id must be positive")
+	}
+
+	start, end := time.Time{}, time.Now()
+	if req.StartTime != nil {
+		start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		end = req.EndTime.AsTime()
+	}
+	if end.Before(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must not be before start_time")
+	}
+
+	res, err := h.sensorClient.GetSensor(ctx, &pb_sensor.GetSensorRequest{Id: req.SensorId})
+	if err != nil || res.Sensor == nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "sensor not found")
+		}
+		logger.Error("Failed to resolve sensor", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "failed to resolve sensor")
+	}
+	sensor := res.Sensor
+	if sensor.Kind == services.SensorKindVirtual {
+		return nil, status.Error(codes.InvalidArgument, "virtual sensors are not calibrated")
+	}
+
+	var updated, skipped int64
+	var first, last time.Time
+	var batch []storage.Reading
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		n, err := h.store.UpdateReadingValues(ctx, batch)
+		if err != nil {
+			return err
+		}
+		updated += n
+		batch = batch[:0]
+		return nil
+	}
+
+	err = h.store.ScanReadings(ctx, []int64{req.SensorId}, start, end, func(r storage.Reading) error {
+		next, ok := recalibrate(sensor, r)
+		if !ok {
+			skipped++
+			return nil
+		}
+		if readingValuesEqual(r, next) {
+			return nil
+		}
+
+		if first.IsZero() {
+			first = r.Timestamp
+		}
+		last = r.Timestamp
+		batch = append(batch, next)
+		if len(batch) == recalibrateBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err == nil {
+		err = flush()
+	}
+	if err != nil {
+		logger.Error("Failed to recalibrate readings", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to recalibrate readings")
+	}
+
+	// Rollup policies only refresh recent buckets, so historical rows are rolled up here.
+	if updated > 0 {
+		if err := h.store.RefreshRollups(ctx, first, last); err != nil {
+			logger.Error("Failed to refresh rollups after recalibration", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+		}
+	}
+
+	logger.Info("Recalibrated readings",
+		zap.Int64("sensor_id", req.SensorId),
+		zap.Int64("updated", updated),
+		zap.Int64("skipped", skipped),
+	)
+	return &pb_data.RecalibrateReadingsResponse{Updated: updated, Skipped: skipped}, nil
+}
+
+// recalibrate computes the values of a stored reading from its raw values with the
+// current calibrations of the sensor. It returns false when the range policy of the
+// sensor type would reject the result.
+func recalibrate(sensor *pb_sensor.Sensor, r storage.Reading) (storage.Reading, bool) {
+	value, values := r.Value, r.Channels
+	if r.Raw != nil {
+		value, values = r.Raw.Value, r.Raw.Channels
+	}
+
+	value, values, raw := calibrate(sensor, r.Timestamp, value, values)
+	value, values, quality, _, err := applyChannels(sensor.SensorType, value, values, r.RawQuality)
+	if err != nil {
+		return storage.Reading{}, false
+	}
+
+	r.Value = value
+	r.Channels = values
+	r.Quality = quality
+	r.Raw = raw
+	return r, true
+}
+
+// readingValuesEqual reports whether two versions of a reading store the same values.
+func readingValuesEqual(a, b storage.Reading) bool {
+	if a.Value != b.Value || a.Quality != b.Quality || !maps.Equal(a.Channels, b.Channels) {
+		return false
+	}
+	if a.Raw == nil || b.Raw == nil {
+		return a.Raw == nil && b.Raw == nil
+	}
+	return a.Raw.Value == b.Raw.Value && maps.Equal(a.Raw.Channels, b.Raw.Channels)
+}
@@ -0,0 +1,130 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+func TestCalibrate(t *testing.T) {
+	jan := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	single := &pb_sensor.Sensor{
+		SensorType: &pb_sensor.SensorType{MinValue: 0, MaxValue: 100},
+		Calibrations: []*pb_sensor.Calibration{
+			{Gain: 2, Offset: 1, ValidFrom: timestamppb.New(jan), ValidTo: timestamppb.New(feb)},
+			{Gain: 1, Coefficients: []float64{0.5, 1, 0.1}, ValidFrom: timestamppb.New(feb)},
+		},
+	}
+	bme := &pb_sensor.Sensor{
+		SensorType: &pb_sensor.SensorType{
+			Channels: []*pb_sensor.SensorChannel{{Name: "temperature"}, {Name: "humidity"}},
+		},
+		Calibrations: []*pb_sensor.Calibration{
+			{Channel: "humidity", Gain: 1, Offset: -2, ValidFrom: timestamppb.New(jan)},
+		},
+	}
+
+	t.Run("Offset And Gain", func(t *testing.T) {
+		v, values, raw := calibrate(single, jan.Add(time.Hour), 10, nil)
+		assert.Equal(t, float32(21), v)
+		assert.Nil(t, values)
+		require.NotNil(t, raw)
+		assert.Equal(t, float32(10), raw.Value)
+	})
+
+	t.Run("Polynomial", func(t *testing.T) {
+		v, _, raw := calibrate(single, feb, 10, nil)
+		assert.InDelta(t, 20.5, v, 1e-4)
+		assert.NotNil(t, raw)
+	})
+
+	t.Run("Before Validity", func(t *testing.T) {
+		v, _, raw := calibrate(single, jan.Add(-time.Second), 10, nil)
+		assert.Equal(t, float32(10), v)
+		assert.Nil(t, raw)
+	})
+
+	t.Run("Channel", func(t *testing.T) {
+		reported := map[string]float32{"temperature": 20, "humidity": 50}
+		v, values, raw := calibrate(bme, feb, 0, reported)
+		assert.Equal(t, float32(20), v)
+		assert.Equal(t, map[string]float32{"temperature": 20, "humidity": 48}, values)
+		require.NotNil(t, raw)
+		assert.Equal(t, float32(20), raw.Value)
+		assert.Equal(t, reported, raw.Channels)
+		assert.Equal(t, float32(50), reported["humidity"], "reported values are not modified")
+	})
+
+	t.Run("Uncalibrated Channel", func(t *testing.T) {
+		v, values, raw := calibrate(bme, feb, 19, nil)
+		assert.Equal(t, float32(19), v)
+		assert.Equal(t, map[string]float32{"temperature": 19}, values)
+		assert.Nil(t, raw)
+	})
+}
+
+func TestRecalibrate(t *testing.T) {
+	at := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	sensor := &pb_sensor.Sensor{
+		SensorType: &pb_sensor.SensorType{MinValue: 0, MaxValue: 100, OutOfRangePolicy: "reject"},
+		Calibrations: []*pb_sensor.Calibration{
+			{Gain: 1, Offset: 0.5, ValidFrom: timestamppb.New(at.Add(-time.Hour))},
+		},
+	}
+
+	t.Run("From Raw Value", func(t *testing.T) {
+		stored := storage.Reading{SensorID: 1, Timestamp: at, Value: 11, Raw: &storage.RawValues{Value: 10}}
+		r, ok := recalibrate(sensor, stored)
+		require.True(t, ok)
+		assert.Equal(t, float32(10.5), r.Value)
+		assert.Equal(t, float32(10), r.Raw.Value)
+		assert.False(t, readingValuesEqual(stored, r))
+	})
+
+	t.Run("Uncalibrated Reading", func(t *testing.T) {
+		r, ok := recalibrate(sensor, storage.Reading{SensorID: 1, Timestamp: at, Value: 10})
+		require.True(t, ok)
+		assert.Equal(t, float32(10.5), r.Value)
+		assert.NotNil(t, r.Raw)
+	})
+
+	t.Run("Calibration Removed", func(t *testing.T) {
+		stored := storage.Reading{SensorID: 1, Timestamp: at.Add(-2 * time.Hour), Value: 11, Raw: &storage.RawValues{Value: 10}}
+		r, ok := recalibrate(sensor, stored)
+		require.True(t, ok)
+		assert.Equal(t, float32(10), r.Value)
+		assert.Nil(t, r.Raw)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		_, ok := recalibrate(sensor, storage.Reading{SensorID: 1, Timestamp: at, Value: 99.9})
+		assert.False(t, ok)
+	})
+	t.Run("Quality From Reported Quality", func(t *testing.T) {
+		flag := &pb_sensor.Sensor{
+			SensorType:   &pb_sensor.SensorType{MinValue: 0, MaxValue: 100, OutOfRangePolicy: "flag"},
+			Calibrations: []*pb_sensor.Calibration{{Gain: 1, Offset: -5, ValidFrom: timestamppb.New(at.Add(-time.Hour))}},
+		}
+		stored := storage.Reading{
+			SensorID: 1, Timestamp: at, Value: 102, Quality: storage.QualityOutOfRange,
+			RawQuality: storage.QualityGood, Raw: &storage.RawValues{Value: 102},
+		}
+		r, ok := recalibrate(flag, stored)
+		require.True(t, ok)
+		assert.Equal(t, float32(97), r.Value)
+		assert.Equal(t, storage.QualityGood, r.Quality)
+
+		stored.RawQuality = storage.QualitySuspect
+		r, ok = recalibrate(flag, stored)
+		require.True(t, ok)
+		assert.Equal(t, storage.QualitySuspect, r.Quality)
+	})
+}
@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+// applyChannels validates the value or channel values of a reading against its sensor
+// type and applies the range policy of every channel. Sensor types without channels
+// take a single value. Multi-channel types need at least their primary (first) channel,
+// which is also returned as the reading value; a bare value is taken as the primary
+// channel. A sample with any channel out of range is flagged as a whole, while the
+// returned channel qualities judge every channel of a multi-channel sample on its own.
+func applyChannels(st *pb_sensor.SensorType, value float32, values map[string]float32, quality storage.Quality) (float32, map[string]float32, storage.Quality, map[string]storage.Quality, error) {
+	if st == nil || len(st.Channels) == 0 {
+		if len(values) > 0 {
+			return 0, nil, quality, nil, fmt.Errorf("sensor type declares no channels")
+		}
+		v, q, ok := applyRangePolicy(st, value, quality)
+		if !ok {
+			return 0, nil, quality, nil, fmt.Errorf("value %v is outside the range of the sensor type", value)
+		}
+		return v, nil, q, nil, nil
+	}
+
+	primary := st.Channels[0].Name
+	if len(values) == 0 {
+		values = map[string]float32{primary: value}
+	}
+
+	declared := make(map[string]*pb_sensor.SensorChannel, len(st.Channels))
+	for _, c := range st.Channels {
+		declared[c.Name] = c
+	}
+
+	out := make(map[string]float32, len(values))
+	qualities := make(map[string]storage.Quality, len(values))
+	sampleQuality := quality
+	for name, v := range values {
+		c, ok := declared[name]
+		if !ok {
+			return 0, nil, quality, nil, fmt.Errorf("unknown channel %q", name)
+		}
+		clamped, q, ok := applyRange(c.MinValue, c.MaxValue, st.OutOfRangePolicy, v, quality)
+		if !ok {
+			return 0, nil, quality, nil, fmt.Errorf("value %v of channel %q is outside its range", v, name)
+		}
+		if q == storage.QualityOutOfRange {
+			sampleQuality = q
+		}
+		out[name] = clamped
+		qualities[name] = q
+	}
+
+	v, ok := out[primary]
+	if !ok {
+		return 0, nil, quality, nil, fmt.Errorf("missing value for primary channel %q", primary)
+	}
+	return v, out, sampleQuality, qualities, nil
+}
+
+// hasChannel reports whether the sensor type declares the named channel.
+func hasChannel(st *pb_sensor.SensorType, name string) bool {
+	if st == nil {
+		return false
+	}
+	for _, c := range st.Channels {
+		if c.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// channelUpdate returns a copy of update carrying the given channel as its value and
+// the unit of that channel as its unit, or false when the update has no value for it.
+// An empty channel keeps the update.
+func channelUpdate(update *pb_data.ReadingUpdate, st *pb_sensor.SensorType, channel string) (*pb_data.ReadingUpdate, bool) {
+	if channel == "" {
+		return update, true
+	}
+	v, ok := update.Values[channel]
+	if !ok {
+		return nil, false
+	}
+	c := proto.Clone(update).(*pb_data.ReadingUpdate)
+	c.Value = v
+	if st != nil {
+		c.Unit = channelUnit(st, channel)
+	}
+	return c, true
+}
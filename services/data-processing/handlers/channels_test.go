@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+func TestApplyChannels(t *testing.T) {
+	single := &pb_sensor.SensorType{MinValue: 0, MaxValue: 100, OutOfRangePolicy: "flag"}
+	bme := &pb_sensor.SensorType{
+		OutOfRangePolicy: "clamp",
+		Channels: []*pb_sensor.SensorChannel{
+			{Name: "temperature", MinValue: -40, MaxValue: 85},
+			{Name: "humidity", MinValue: 0, MaxValue: 100},
+			{Name: "pressure"},
+		},
+	}
+
+	t.Run("Single Value", func(t *testing.T) {
+		v, values, q, channelQuality, err := applyChannels(single, 120, nil, storage.QualityGood)
+		assert.NoError(t, err)
+		assert.Equal(t, float32(120), v)
+		assert.Nil(t, values)
+		assert.Equal(t, storage.QualityOutOfRange, q)
+		assert.Nil(t, channelQuality)
+	})
+
+	t.Run("Values For Single Value Type", func(t *testing.T) {
+		_, _, _, _, err := applyChannels(single, 0, map[string]float32{"temperature": 21}, storage.QualityGood)
+		assert.Error(t, err)
+	})
+
+	t.Run("Multi Channel", func(t *testing.T) {
+		v, values, q, _, err := applyChannels(bme, 0, map[string]float32{"temperature": 21.5, "humidity": 40, "pressure": 1013}, storage.QualityGood)
+		assert.NoError(t, err)
+		assert.Equal(t, float32(21.5), v)
+		assert.Equal(t, map[string]float32{"temperature": 21.5, "humidity": 40, "pressure": 1013}, values)
+		assert.Equal(t, storage.QualityGood, q)
+	})
+
+	t.Run("Bare Value Is Primary Channel", func(t *testing.T) {
+		v, values, _, _, err := applyChannels(bme, 19, nil, storage.QualityGood)
+		assert.NoError(t, err)
+		assert.Equal(t, float32(19), v)
+		assert.Equal(t, map[string]float32{"temperature": 19}, values)
+	})
+
+	t.Run("Clamps Channel And Flags Sample", func(t *testing.T) {
+		_, values, q, channelQuality, err := applyChannels(bme, 0, map[string]float32{"temperature": 20, "humidity": 130}, storage.QualitySuspect)
+		assert.NoError(t, err)
+		assert.Equal(t, float32(100), values["humidity"])
+		assert.Equal(t, storage.QualityOutOfRange, q)
+		assert.Equal(t, map[string]storage.Quality{
+			"temperature": storage.QualitySuspect,
+			"humidity":    storage.QualityOutOfRange,
+		}, channelQuality, "channels keep their own quality")
+	})
+
+	t.Run("Unknown Channel", func(t *testing.T) {
+		_, _, _, _, err := applyChannels(bme, 0, map[string]float32{"temperature": 20, "co2": 400}, storage.QualityGood)
+		assert.Error(t, err)
+	})
+
+	t.Run("Missing Primary Channel", func(t *testing.T) {
+		_, _, _, _, err := applyChannels(bme, 0, map[string]float32{"humidity": 40}, storage.QualityGood)
+		assert.Error(t, err)
+	})
+}
+
+func TestChannelUpdate(t *testing.T) {
+	bme := &pb_sensor.SensorType{
+		Unit: "°C",
+		Channels: []*pb_sensor.SensorChannel{
+			{Name: "temperature"},
+			{Name: "humidity", Unit: "%"},
+		},
+	}
+	update := &pb_data.ReadingUpdate{
+		SensorId: 1,
+		Value:    21,
+		Unit:     valueUnit(bme, ""),
+		Values:   map[string]float32{"temperature": 21, "humidity": 40},
+	}
+
+	t.Run("Selected Channel Has Its Unit", func(t *testing.T) {
+		c, ok := channelUpdate(update, bme, "humidity")
+		assert.True(t, ok)
+		assert.Equal(t, float32(40), c.Value)
+		assert.Equal(t, "%", c.Unit)
+		assert.Equal(t, "°C", update.Unit, "the shared update is not changed")
+	})
+
+	t.Run("Channel Without Unit Has Unit Of Type", func(t *testing.T) {
+		c, ok := channelUpdate(update, bme, "temperature")
+		assert.True(t, ok)
+		assert.Equal(t, "°C", c.Unit)
+	})
+
+	t.Run("Converted Channel Has Target Unit", func(t *testing.T) {
+		targets, err := parseTargetUnit("°F")
+		require.NoError(t, err)
+		c, _ := channelUpdate(update, bme, "temperature")
+		c = convertUpdate(c, bme, "temperature", targets)
+		assert.Equal(t, "°F", c.Unit)
+
+		h, _ := channelUpdate(update, bme, "humidity")
+		h = convertUpdate(h, bme, "humidity", targets)
+		assert.Equal(t, "%", h.Unit)
+		assert.Equal(t, float32(40), h.Value)
+	})
+
+	t.Run("Missing Channel", func(t *testing.T) {
+		_, ok := channelUpdate(update, bme, "co2")
+		assert.False(t, ok)
+	})
+}
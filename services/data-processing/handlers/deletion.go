@@ -0,0 +1,162 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+const (
+	// maxDeletionReasonLength bounds the reason kept in the audit record of a deletion.
+	maxDeletionReasonLength = 500
+
+	defaultDeletionsLimit = 100
+	maxDeletionsLimit     = 1000
+)
+
+// DeleteReadings deletes readings of a sensor by time range and an optional value
+// predicate, and records who deleted what. The sensor does not have to exist anymore,
+// so readings left behind by a deleted sensor can be removed as well. Events of the
+// deleted readings are not retracted.
+func (h *DataGrpcHandler) DeleteReadings(ctx context.Context, req *pb_data.DeleteReadingsRequest) (*pb_data.DeleteReadingsResponse, error) {
+	filter, err := readingFilter(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Reason) > maxDeletionReasonLength {
+		return nil, status.Errorf(codes.InvalidArgument, "reason must be at most %d characters", maxDeletionReasonLength)
+	}
+	if err := h.checkChannel(ctx, []int64{req.SensorId}, req.Channel); err != nil {
+		return nil, err
+	}
+
+	if req.DryRun {
+		count, err := h.store.CountReadings(ctx, filter)
+		if err != nil {
+			logger.Error("Failed to count readings", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to count readings")
+		}
+		return &pb_data.DeleteReadingsResponse{Deleted: count}, nil
+	}
+
+	deletion, first, last, err := h.store.DeleteReadings(ctx, storage.ReadingDeletion{
+		Filter: filter,
+		UserID: req.UserId,
+		Reason: req.Reason,
+	})
+	if err != nil {
+		logger.Error("Failed to delete readings", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete readings")
+	}
+
+	// Rollup policies only refresh recent buckets, so historical rows are rolled up here.
+	if deletion.Deleted > 0 {
+		if err := h.store.RefreshRollups(ctx, first, last); err != nil {
+			logger.Error("Failed to refresh rollups after deletion", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+		}
+	}
+
+	logger.Info("Deleted readings",
+		zap.Int64("sensor_id", req.SensorId),
+		zap.Int64("deleted", deletion.Deleted),
+		zap.Int64("user_id", req.UserId),
+		zap.Int64("deletion_id", deletion.ID),
+	)
+	return &pb_data.DeleteReadingsResponse{
+		Deleted:  deletion.Deleted,
+		Deletion: convertReadingDeletionToProto(deletion),
+	}, nil
+}
+
+func (h *DataGrpcHandler) ListReadingDeletions(ctx context.Context, req *pb_data.ListReadingDeletionsRequest) (*pb_data.ListReadingDeletionsResponse, error) {
+	if req.SensorId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "sensor_id must not be negative")
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDeletionsLimit
+	}
+	limit = min(limit, maxDeletionsLimit)
+
+	deletions, err := h.store.ListReadingDeletions(ctx, req.SensorId, limit)
+	if err != nil {
+		logger.Error("Failed to list reading deletions", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list reading deletions")
+	}
+
+	res := &pb_data.ListReadingDeletionsResponse{
+		Deletions: make([]*pb_data.ReadingDeletion, 0, len(deletions)),
+	}
+	for _, d := range deletions {
+		res.Deletions = append(res.Deletions, convertReadingDeletionToProto(d))
+	}
+	return res, nil
+}
+
+// readingFilter validates a DeleteReadings request and returns its filter.
+func readingFilter(req *pb_data.DeleteReadingsRequest) (storage.ReadingFilter, error) {
+	if req.SensorId <= 0 {
+		return storage.ReadingFilter{}, status.Error(codes.InvalidArgument, "sensor_id must be positive")
+	}
+
+	filter := storage.ReadingFilter{
+		SensorID: req.SensorId,
+		End:      time.Now(),
+		Operator: req.ValueOperator,
+		Value:    req.Value,
+		Channel:  req.Channel,
+	}
+	if req.StartTime != nil {
+		filter.Start = req.StartTime.AsTime()
+	}
+	if req.EndTime != nil {
+		filter.End = req.EndTime.AsTime()
+	}
+	if filter.End.Before(filter.Start) {
+		return storage.ReadingFilter{}, status.Error(codes.InvalidArgument, "end_time must not be before start_time")
+	}
+
+	if filter.Operator == "" {
+		if filter.Channel != "" {
+			return storage.ReadingFilter{}, status.Error(codes.InvalidArgument, "channel requires value_operator")
+		}
+		return filter, nil
+	}
+	if !storage.IsValidValueOperator(filter.Operator) {
+		return storage.ReadingFilter{}, status.Errorf(codes.InvalidArgument, "unsupported value_operator %q, expected one of lt, lte, gt, gte, eq, ne", filter.Operator)
+	}
+	if math.IsNaN(filter.Value) || math.IsInf(filter.Value, 0) {
+		return storage.ReadingFilter{}, status.Error(codes.InvalidArgument, "value must be a finite number")
+	}
+	return filter, nil
+}
+
+func convertReadingDeletionToProto(d *storage.ReadingDeletion) *pb_data.ReadingDeletion {
+	res := &pb_data.ReadingDeletion{
+		Id:            d.ID,
+		SensorId:      d.Filter.SensorID,
+		EndTime:       timestamppb.New(d.Filter.End),
+		ValueOperator: d.Filter.Operator,
+		Value:         d.Filter.Value,
+		Channel:       d.Filter.Channel,
+		Deleted:       d.Deleted,
+		UserId:        d.UserID,
+		Reason:        d.Reason,
+		CreatedAt:     timestamppb.New(d.CreatedAt),
+	}
+	// A deletion without a start covers every reading up to its end.
+	if !d.Filter.Start.IsZero() {
+		res.StartTime = timestamppb.New(d.Filter.Start)
+	}
+	return res
+}
@@ -0,0 +1,285 @@
+package handlers
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/pkg/parquet"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+// exportChunkSize is the amount of file data sent per ExportChunk.
+const exportChunkSize = 64 * 1024
+
+// exportContentTypes maps the supported export formats to their content types.
+var exportContentTypes = map[string]string{
+	"csv":     "text/csv",
+	"ndjson":  "application/x-ndjson",
+	"parquet": "application/vnd.apache.parquet",
+}
+
+// exportColumns are the columns of every export format, in order.
+var exportColumns = []parquet.Column{
+	{Name: "time", Type: parquet.Timestamp},
+	{Name: "sensor_id", Type: parquet.Int64},
+	{Name: "sensor_name", Type: parquet.String},
+	{Name: "location", Type: parquet.String},
+	{Name: "unit", Type: parquet.String},
+	{Name: "value", Type: parquet.Float},
+	{Name: "quality", Type: parquet.String},
+	{Name: "values", Type: parquet.String},
+	{Name: "raw_value", Type: parquet.Float},
+}
+
+// exportRow is a stored reading joined with the metadata of its sensor.
+type exportRow struct {
+	Time       time.Time          `json:"time"`
+	SensorID   int64              `json:"sensor_id"`
+	SensorName string             `json:"sensor_name"`
+	Location   string             `json:"location"`
+	Unit       string             `json:"unit"`
+	Value      float32            `json:"value"`
+	Quality    string             `json:"quality"`
+	Values     map[string]float32 `json:"values,omitempty"`
+	// RawValue is the value before calibration, equal to Value for uncalibrated readings.
+	RawValue float32 `json:"raw_value"`
+}
+
+// encodedValues returns the channel values as a JSON object, or "" for single value readings.
+func (r exportRow) encodedValues() (string, error) {
+	if len(r.Values) == 0 {
+		return "", nil
+	}
+	b, err := json.Marshal(r.Values)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// exportWriter encodes rows in one of the export formats.
+type exportWriter interface {
+	Write(r exportRow) error
+	Close() error
+}
+
+func newExportWriter(format string, w io.Writer) (exportWriter, error) {
+	switch format {
+	case "csv":
+		cw := csv.NewWriter(w)
+		header := make([]string, len(exportColumns))
+		for i, c := range exportColumns {
+			header[i] = c.Name
+		}
+		if err := cw.Write(header); err != nil {
+			return nil, err
+		}
+		return &csvExportWriter{w: cw}, nil
+	case "ndjson":
+		return &ndjsonExportWriter{enc: json.NewEncoder(w)}, nil
+	case "parquet":
+		pw, err := parquet.NewWriter(w, exportColumns, parquet.DefaultRowGroupSize)
+		if err != nil {
+			return nil, err
+		}
+		return &parquetExportWriter{w: pw}, nil
+	}
+	return nil, fmt.Errorf("unsupported export format %q", format)
+}
+
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (e *csvExportWriter) Write(r exportRow) error {
+	values, err := r.encodedValues()
+	if err != nil {
+		return err
+	}
+	return e.w.Write([]string{
+		r.Time.UTC().Format(time.RFC3339Nano),
+		strconv.FormatInt(r.SensorID, 10),
+		r.SensorName,
+		r.Location,
+		r.Unit,
+		strconv.FormatFloat(float64(r.Value), 'g', -1, 32),
+		r.Quality,
+		values,
+		strconv.FormatFloat(float64(r.RawValue), 'g', -1, 32),
+	})
+}
+
+func (e *csvExportWriter) Close() error {
+	e.w.Flush()
+	return e.w.Error()
+}
+
+type ndjsonExportWriter struct {
+	enc *json.Encoder
+}
+
+func (e *ndjsonExportWriter) Write(r exportRow) error {
+	r.Time = r.Time.UTC()
+	return e.enc.Encode(r)
+}
+
+func (e *ndjsonExportWriter) Close() error {
+	return nil
+}
+
+type parquetExportWriter struct {
+	w *parquet.Writer
+}
+
+func (e *parquetExportWriter) Write(r exportRow) error {
+	values, err := r.encodedValues()
+	if err != nil {
+		return err
+	}
+	return e.w.Write(r.Time, r.SensorID, r.SensorName, r.Location, r.Unit, r.Value, r.Quality, values, r.RawValue)
+}
+
+func (e *parquetExportWriter) Close() error {
+	return e.w.Close()
+}
+
+// chunkWriter buffers export data and sends it as ExportChunk messages. The first
+// chunk carries the filename and content type.
+type chunkWriter struct {
+	stream      pb_data.DataService_ExportReadingsServer
+	filename    string
+	contentType string
+	buf         []byte
+	sent        bool
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= exportChunkSize {
+		if err := w.send(w.buf[:exportChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = w.buf[exportChunkSize:]
+	}
+	return len(p), nil
+}
+
+// Flush sends the buffered data. It always sends at least one chunk, so an empty
+// export still tells the client its filename.
+func (w *chunkWriter) Flush() error {
+	if len(w.buf) == 0 && w.sent {
+		return nil
+	}
+	err := w.send(w.buf)
+	w.buf = nil
+	return err
+}
+
+func (w *chunkWriter) send(data []byte) error {
+	chunk := &pb_data.ExportChunk{Data: append([]byte(nil), data...)}
+	if !w.sent {
+		chunk.Filename = w.filename
+		chunk.ContentType = w.contentType
+		w.sent = true
+	}
+	return w.stream.Send(chunk)
+}
+
+// exportFilename names the export after its sensors and time range.
+func exportFilename(req *pb_data.ExportReadingsRequest, sensorIDs []int64, start, end time.Time) string {
+	subject := fmt.Sprintf("%d-sensors", len(sensorIDs))
+	switch {
+	case req.SensorGroupId > 0 && len(req.SensorIds) == 0:
+		subject = fmt.Sprintf("group-%d", req.SensorGroupId)
+	case len(sensorIDs) == 1:
+		subject = fmt.Sprintf("sensor-%d", sensorIDs[0])
+	}
+	const layout = "20060102T150405Z"
+	return fmt.Sprintf("readings-%s-%s-%s.%s", subject, start.UTC().Format(layout), end.UTC().Format(layout), req.Format)
+}
+
+func (h *DataGrpcHandler) ExportReadings(req *pb_data.ExportReadingsRequest, stream pb_data.DataService_ExportReadingsServer) error {
+	ctx := stream.Context()
+
+	contentType, ok := exportContentTypes[req.Format]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unsupported format %q, expected one of csv, ndjson, parquet", req.Format)
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+	if end.Before(start) {
+		return status.Error(codes.InvalidArgument, "end_time must not be before start_time")
+	}
+
+	ids, err := h.resolveSensorIDs(ctx, req.SensorIds, req.SensorGroupId)
+	if err != nil {
+		return err
+	}
+
+	sensors, err := h.sensors.GetMany(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to resolve sensors", zap.Int64s("sensor_ids", ids), zap.Error(err))
+		return status.Error(codes.Unavailable, "failed to resolve sensor")
+	}
+	for _, id := range ids {
+		if _, ok := sensors[id]; !ok {
+			return status.Errorf(codes.NotFound, "sensor %d not found", id)
+		}
+	}
+
+	out := &chunkWriter{
+		stream:      stream,
+		filename:    exportFilename(req, ids, start, end),
+		contentType: contentType,
+	}
+	w, err := newExportWriter(req.Format, out)
+	if err != nil {
+		logger.Error("Failed to start export", zap.Error(err))
+		return status.Error(codes.Internal, "failed to export readings")
+	}
+
+	err = h.store.ScanReadings(ctx, ids, start, end, func(r storage.Reading) error {
+		sensor := sensors[r.SensorID]
+		row := exportRow{
+			Time:       r.Timestamp,
+			SensorID:   r.SensorID,
+			SensorName: sensor.Name,
+			Location:   sensor.Location,
+			Value:      r.Value,
+			Quality:    r.Quality.String(),
+			Values:     r.Channels,
+			RawValue:   r.Value,
+		}
+		if r.Raw != nil {
+			row.RawValue = r.Raw.Value
+		}
+		row.Unit = valueUnit(sensor.SensorType, "")
+		return w.Write(row)
+	})
+	if err == nil {
+		err = w.Close()
+	}
+	if err == nil {
+		err = out.Flush()
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return status.FromContextError(ctx.Err()).Err()
+		}
+		logger.Error("Failed to export readings", zap.Error(err))
+		return status.Error(codes.Internal, "failed to export readings")
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package handlers
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+func TestExportWriter(t *testing.T) {
+	rows := []exportRow{
+		{Time: time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC), SensorID: 5, SensorName: "boiler, room 2", Location: "basement", Unit: "°C", Value: 21.5, Quality: "good", RawValue: 21.3},
+		{Time: time.Date(2025, 3, 1, 12, 1, 0, 0, time.UTC), SensorID: 6, SensorName: "bme", Unit: "°C", Value: 20, Quality: "good", Values: map[string]float32{"temperature": 20, "humidity": 40}, RawValue: 20},
+	}
+
+	write := func(format string) string {
+		var buf bytes.Buffer
+		w, err := newExportWriter(format, &buf)
+		require.NoError(t, err)
+		for _, r := range rows {
+			require.NoError(t, w.Write(r))
+		}
+		require.NoError(t, w.Close())
+		return buf.String()
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		assert.Equal(t, "time,sensor_id,sensor_name,location,unit,value,quality,values,raw_value\n"+
+			"2025-03-01T12:00:00Z,5,\"boiler, room 2\",basement,°C,21.5,good,,21.3\n"+
+			"2025-03-01T12:01:00Z,6,bme,,°C,20,good,\"{\"\"humidity\"\":40,\"\"temperature\"\":20}\",20\n",
+			write("csv"))
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		assert.Equal(t, `{"time":"2025-03-01T12:00:00Z","sensor_id":5,"sensor_name":"boiler, room 2","location":"basement","unit":"°C","value":21.5,"quality":"good","raw_value":21.3}`+"\n"+
+			`{"time":"2025-03-01T12:01:00Z","sensor_id":6,"sensor_name":"bme","location":"","unit":"°C","value":20,"quality":"good","values":{"humidity":40,"temperature":20},"raw_value":20}`+"\n",
+			write("ndjson"))
+	})
+
+	t.Run("Parquet", func(t *testing.T) {
+		out := write("parquet")
+		assert.True(t, len(out) > 8 && out[:4] == "PAR1" && out[len(out)-4:] == "PAR1")
+	})
+
+	t.Run("Unknown Format", func(t *testing.T) {
+		_, err := newExportWriter("xlsx", &bytes.Buffer{})
+		assert.Error(t, err)
+	})
+}
+
+func TestExportFilename(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	assert.Equal(t, "readings-sensor-5-20250301T000000Z-20250302T000000Z.csv",
+		exportFilename(&pb_data.ExportReadingsRequest{SensorIds: []int64{5}, Format: "csv"}, []int64{5}, start, end))
+	assert.Equal(t, "readings-group-3-20250301T000000Z-20250302T000000Z.parquet",
+		exportFilename(&pb_data.ExportReadingsRequest{SensorGroupId: 3, Format: "parquet"}, []int64{5, 6}, start, end))
+	assert.Equal(t, "readings-2-sensors-20250301T000000Z-20250302T000000Z.ndjson",
+		exportFilename(&pb_data.ExportReadingsRequest{SensorIds: []int64{5, 6}, Format: "ndjson"}, []int64{5, 6}, start, end))
+}
@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+// defaultGapThreshold is the multiple of the expected interval a silence has to exceed
+// to be reported as a gap.
+const defaultGapThreshold = 3
+
+func (h *DataGrpcHandler) ListDataGaps(ctx context.Context, req *pb_data.ListDataGapsRequest) (*pb_data.ListDataGapsResponse, error) {
+	if req.SensorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "sensor_id must be positive")
+	}
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+	// The future is not a gap yet.
+	if now := time.Now(); end.After(now) {
+		end = now
+	}
+	if !end.After(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must be after start_time")
+	}
+
+	threshold := req.Threshold
+	if threshold == 0 {
+		threshold = defaultGapThreshold
+	}
+	if threshold < 1 {
+		return nil, status.Error(codes.InvalidArgument, "threshold must be at least 1")
+	}
+
+	_, err := h.sensors.Get(ctx, req.SensorId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "sensor not found")
+		}
+		logger.Error("Failed to resolve sensor", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "failed to resolve sensor")
+	}
+
+	var expected time.Duration
+	if req.ExpectedInterval != "" {
+		if expected, err = storage.ParseInterval(req.ExpectedInterval); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		var ok bool
+		expected, ok, err = h.store.MedianReadingInterval(ctx, req.SensorId, start, end)
+		if err != nil {
+			logger.Error("Failed to estimate reading interval", zap.Error(err))
+			return nil, status.Error(codes.Internal, "failed to list data gaps")
+		}
+		if !ok {
+			return nil, status.Error(codes.FailedPrecondition, "too few readings to estimate the reporting period, set expected_interval")
+		}
+		expected = expected.Round(time.Millisecond)
+	}
+
+	gaps, err := h.store.ListDataGaps(ctx, req.SensorId, start, end, time.Duration(float64(expected)*threshold))
+	if err != nil {
+		logger.Error("Failed to list data gaps", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list data gaps")
+	}
+
+	res := &pb_data.ListDataGapsResponse{
+		Gaps:             make([]*pb_data.DataGap, 0, len(gaps)),
+		ExpectedInterval: formatInterval(expected),
+	}
+	for _, g := range gaps {
+		res.Gaps = append(res.Gaps, &pb_data.DataGap{
+			Start:           timestamppb.New(g.Start),
+			End:             timestamppb.New(g.End),
+			DurationSeconds: int64(g.End.Sub(g.Start) / time.Second),
+		})
+	}
+	return res, nil
+}
@@ -2,7 +2,6 @@ package handlers
 
 import (
 	"context"
-	"sync"
 	"time"
 
 	"encoding/json"
@@ -12,40 +11,43 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
-	"github.com/rabbitmq/amqp091-go"
-
 	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
 	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/services"
 	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
 )
 
 type DataGrpcHandler struct {
 	pb_data.UnimplementedDataServiceServer
-	store         storage.ITimeScaleStorage
-	sensorClient  pb_sensor.SensorServiceClient
-	subscribers   map[string]chan *pb_data.ReadingUpdate
-	subscribersMu sync.RWMutex
-	channel       *amqp091.Channel
-	queue         amqp091.Queue
-}
-
-type SensorReading struct {
-	SensorId   int64     `json:"sensor_id"`
-	Value      float64   `json:"value"`
-	Timestamp  time.Time `json:"timestamp"`
-	SensorName string    `json:"sensor_name,omitempty"`
-	Location   string    `json:"location,omitempty"`
-	Unit       string    `json:"unit,omitempty"`
+	store        storage.ITimeScaleStorage
+	sensorClient pb_sensor.SensorServiceClient
+	// sensors caches the sensors resolved on the hot paths. Lookups that must see the
+	// latest sensor, like recalibration, go to sensorClient.
+	sensors services.ISensorCache
+	// relay publishes the reading events queued in the outbox by the store.
+	relay *outbox.Relay
+	// hub delivers the readings published by every replica to stream subscribers.
+	hub services.IReadingHub
+	// importSlots bounds the number of import jobs running at once.
+	importSlots chan struct{}
+	// virtualSensors finds the virtual sensors to compute when a reading is stored.
+	virtualSensors services.IVirtualSensorIndex
+	// virtualInputMaxAge is how old an input reading of a virtual sensor may be.
+	virtualInputMaxAge time.Duration
 }
 
-func NewDataGrpcHandler(s *grpc.Server, store storage.ITimeScaleStorage, sensorClient pb_sensor.SensorServiceClient, channel *amqp091.Channel, queue amqp091.Queue) {
+func NewDataGrpcHandler(s *grpc.Server, store storage.ITimeScaleStorage, sensorClient pb_sensor.SensorServiceClient, sensors services.ISensorCache, relay *outbox.Relay, hub services.IReadingHub, virtualSensors services.IVirtualSensorIndex, virtualInputMaxAge time.Duration) {
 	handler := &DataGrpcHandler{
-		store:        store,
-		sensorClient: sensorClient,
-		subscribers:  make(map[string]chan *pb_data.ReadingUpdate),
-		channel:      channel,
-		queue:        queue,
+		store:              store,
+		sensorClient:       sensorClient,
+		sensors:            sensors,
+		relay:              relay,
+		hub:                hub,
+		importSlots:        make(chan struct{}, maxConcurrentImports),
+		virtualSensors:     virtualSensors,
+		virtualInputMaxAge: virtualInputMaxAge,
 	}
 	pb_data.RegisterDataServiceServer(s, handler)
 }
@@ -55,56 +57,60 @@ func (h *DataGrpcHandler) StoreReading(ctx context.Context, req *pb_data.StoreRe
 		return nil, status.Error(codes.InvalidArgument, "sensor_id must be positive")
 	}
 
-	err := h.store.StoreReading(ctx, req.SensorId, req.Value, req.Timestamp.AsTime())
-	if err != nil {
-		logger.Error("Failed to store reading", zap.Error(err))
-		return nil, status.Error(codes.Internal, "failed to store reading")
+	reported, ok := storage.ParseQuality(req.Quality)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown quality %q", req.Quality)
+	}
+	if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+		return nil, status.Errorf(codes.InvalidArgument, "idempotency_key must be at most %d characters", maxIdempotencyKeyLength)
 	}
 
-	sensor, err := h.sensorClient.GetSensor(ctx, &pb_sensor.GetSensorRequest{Id: req.SensorId})
-	if err == nil && sensor.Sensor != nil {
-		update := &pb_data.ReadingUpdate{
-			SensorId:   req.SensorId,
-			Value:      req.Value,
-			Timestamp:  req.Timestamp,
-			SensorName: sensor.Sensor.Name,
-			Location:   sensor.Sensor.Location,
+	sensor, err := h.sensors.Get(ctx, req.SensorId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Error(codes.NotFound, "sensor not found")
 		}
+		logger.Error("Failed to resolve sensor", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+		return nil, status.Error(codes.Unavailable, "failed to resolve sensor")
+	}
+	if sensor.Kind == services.SensorKindVirtual {
+		return nil, status.Error(codes.InvalidArgument, errVirtualSensorWrite)
+	}
 
-		if sensor.Sensor.SensorType != nil {
-			update.Unit = sensor.Sensor.SensorType.Unit
-		}
+	ts := req.Timestamp.AsTime().Truncate(time.Microsecond)
+	value, values, raw := calibrate(sensor, ts, req.Value, req.Values)
+	value, values, quality, channelQuality, err := applyChannels(sensor.SensorType, value, values, reported)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 
-		h.broadcastUpdate(update)
-		reading := SensorReading{
-			SensorId:   req.SensorId,
-			Value:      float64(req.Value),
-			Timestamp:  req.Timestamp.AsTime(),
-			SensorName: sensor.Sensor.Name,
-			Location:   sensor.Sensor.Location,
-			Unit:       update.Unit,
-		}
+	reading := storage.Reading{
+		SensorID:       req.SensorId,
+		Value:          value,
+		Timestamp:      ts,
+		Quality:        quality,
+		RawQuality:     reported,
+		Channels:       values,
+		ChannelQuality: channelQuality,
+		Raw:            raw,
+		IdempotencyKey: req.IdempotencyKey,
+	}
+	reading.Event = newReadingEvent(sensor, reading)
+	stored, err := h.store.StoreReading(ctx, reading)
+	if err != nil {
+		logger.Error("Failed to store reading", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to store reading")
+	}
 
-		body, err := json.Marshal(reading)
-		if err != nil {
-			logger.Error("Failed to marshal reading", zap.Error(err))
-		} else {
-			err = h.channel.Publish(
-				"readings_exchange",
-				"",
-				false,
-				false,
-				amqp091.Publishing{
-					ContentType: "application/json",
-					Body:        body,
-				},
-			)
-			if err != nil {
-				logger.Error("Failed to publish update to RabbitMQ", zap.Error(err))
-			}
-		}
+	// Retries are acknowledged without being published again, so they cannot
+	// raise the same alert twice.
+	if !stored {
+		return &pb_data.StoreReadingResponse{Duplicate: true}, nil
 	}
 
+	h.relay.Notify()
+	h.deriveReadings(ctx, []storage.Reading{reading})
+
 	return &pb_data.StoreReadingResponse{}, nil
 }
 
@@ -113,15 +119,80 @@ func (h *DataGrpcHandler) QueryReadings(ctx context.Context, req *pb_data.QueryR
 		return nil, status.Error(codes.InvalidArgument, "sensor_id must be positive")
 	}
 
-	readings, err := h.store.QueryReadings(ctx, req.SensorId, req.StartTime.AsTime(), req.EndTime.AsTime())
+	targets, err := parseTargetUnit(req.TargetUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	var sensorType *pb_sensor.SensorType
+	if req.Channel != "" || len(targets) > 0 {
+		sensor, err := h.sensors.Get(ctx, req.SensorId)
+		if err != nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "sensor not found")
+			}
+			logger.Error("Failed to resolve sensor", zap.Int64("sensor_id", req.SensorId), zap.Error(err))
+			return nil, status.Error(codes.Unavailable, "failed to resolve sensor")
+		}
+		if req.Channel != "" && !hasChannel(sensor.SensorType, req.Channel) {
+			return nil, status.Errorf(codes.InvalidArgument, "sensor has no channel %q", req.Channel)
+		}
+		sensorType = sensor.SensorType
+	}
+
+	var readings []*pb_data.DataPoint
+	var function, nextPageToken string
+	if req.AggregationInterval == "" {
+		if req.Aggregation != "" {
+			return nil, status.Error(codes.InvalidArgument, "aggregation requires aggregation_interval")
+		}
+		if req.Fill != "" {
+			return nil, status.Error(codes.InvalidArgument, "fill requires aggregation_interval")
+		}
+		after, tokenErr := decodePageToken(req.PageToken)
+		if tokenErr != nil {
+			return nil, status.Error(codes.InvalidArgument, tokenErr.Error())
+		}
+
+		// One reading more than the page tells whether another page follows.
+		size := pageSize(req.PageSize)
+		readings, err = h.store.QueryReadings(ctx, req.SensorId, req.StartTime.AsTime(), req.EndTime.AsTime(), req.Channel, after, size+1)
+		if len(readings) > size {
+			readings = readings[:size]
+			nextPageToken = encodePageToken(readings[size-1].Time.AsTime())
+		}
+	} else {
+		if req.PageSize != 0 || req.PageToken != "" {
+			return nil, status.Error(codes.InvalidArgument, "page_size and page_token only apply to raw readings")
+		}
+		agg, aggErr := parseAggregation(req.AggregationInterval, req.Aggregation, req.Fill)
+		if aggErr != nil {
+			return nil, aggErr
+		}
+		if agg.Fill != storage.FillNone {
+			if req.StartTime == nil || req.EndTime == nil {
+				return nil, status.Error(codes.InvalidArgument, "fill requires start_time and end_time")
+			}
+			if buckets := req.EndTime.AsTime().Sub(req.StartTime.AsTime()) / agg.Interval; buckets > maxFilledBuckets {
+				return nil, status.Errorf(codes.InvalidArgument, "fill would return more than %d buckets, use a larger aggregation_interval", maxFilledBuckets)
+			}
+		}
+		function = agg.Function
+		readings, err = h.store.QueryAggregatedReadings(ctx, req.SensorId, req.StartTime.AsTime(), req.EndTime.AsTime(), agg, req.Channel)
+	}
 	if err != nil {
 		logger.Error("Failed to query readings", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to query readings")
 	}
 
-	return &pb_data.QueryReadingsResponse{
-		DataPoints: readings,
-	}, nil
+	res := &pb_data.QueryReadingsResponse{
+		DataPoints:    readings,
+		NextPageToken: nextPageToken,
+	}
+	if sensorType != nil {
+		res.Unit = convertDataPoints(readings, sensorType, req.Channel, function, targets)
+	}
+	return res, nil
 }
 
 func (h *DataGrpcHandler) StreamReadings(req *pb_data.StreamReadingsRequest, stream pb_data.DataService_StreamReadingsServer) error {
@@ -129,55 +200,85 @@ func (h *DataGrpcHandler) StreamReadings(req *pb_data.StreamReadingsRequest, str
 		return status.Error(codes.InvalidArgument, "at least one sensor_id is required")
 	}
 
-	ch := make(chan *pb_data.ReadingUpdate, 100)
-	id := generateSubscriberID()
+	targets, err := parseTargetUnit(req.TargetUnit)
+	if err != nil {
+		return err
+	}
 
-	h.subscribersMu.Lock()
-	h.subscribers[id] = ch
-	h.subscribersMu.Unlock()
+	filter, err := newStreamFilter(req)
+	if err != nil {
+		return err
+	}
+
+	// Sensors are looked up once, conversions use the units as of the start of the
+	// stream.
+	sensors, err := h.sensors.GetMany(stream.Context(), req.SensorIds)
+	if err != nil {
+		logger.Warn("Failed to resolve streamed sensors", zap.Error(err))
+	}
+	sensorTypes := make(map[int64]*pb_sensor.SensorType, len(sensors))
+	for id, sensor := range sensors {
+		sensorTypes[id] = sensor.SensorType
+	}
 
-	defer func() {
-		h.subscribersMu.Lock()
-		delete(h.subscribers, id)
-		close(ch)
-		h.subscribersMu.Unlock()
-	}()
+	sub := h.hub.Subscribe(req.SensorIds)
+	defer h.hub.Unsubscribe(sub)
 
 	initialReadings, err := h.store.GetLatestReadingsBatch(stream.Context(), req.SensorIds)
 	if err == nil {
 		for _, reading := range initialReadings {
-			sensor, err := h.sensorClient.GetSensor(stream.Context(), &pb_sensor.GetSensorRequest{Id: reading.SensorId})
-			if err == nil && sensor.Sensor != nil {
-				reading.SensorName = sensor.Sensor.Name
-				reading.Location = sensor.Sensor.Location
-				if sensor.Sensor.SensorType != nil {
-					reading.Unit = sensor.Sensor.SensorType.Unit
-				}
+			if sensor, ok := sensors[reading.SensorId]; ok {
+				reading.SensorName = sensor.Name
+				reading.Location = sensor.Location
+				reading.Unit = valueUnit(sensor.SensorType, "")
 			}
-			if err := stream.Send(reading); err != nil {
+			update, ok := channelUpdate(reading, sensorTypes[reading.SensorId], req.Channel)
+			if !ok {
+				continue
+			}
+			update = convertUpdate(update, sensorTypes[update.SensorId], req.Channel, targets)
+			// Initial readings are sent right away, they only seed the deadband.
+			if !filter.allow(update) {
+				continue
+			}
+			if err := stream.Send(update); err != nil {
 				return err
 			}
 		}
 	}
 
-	sensorIDMap := make(map[int64]bool)
-	for _, id := range req.SensorIds {
-		sensorIDMap[id] = true
+	var tick <-chan time.Time
+	if filter.interval > 0 {
+		ticker := time.NewTicker(filter.interval)
+		defer ticker.Stop()
+		tick = ticker.C
 	}
 
 	for {
 		select {
 		case <-stream.Context().Done():
 			return nil
-		case update, ok := <-ch:
-			if !ok {
-				return nil
-			}
-			if sensorIDMap[update.SensorId] {
+		case <-tick:
+			for _, update := range filter.flush() {
 				if err := stream.Send(update); err != nil {
 					return err
 				}
 			}
+		case update, ok := <-sub.C:
+			if !ok {
+				return nil
+			}
+			update, ok = channelUpdate(update, sensorTypes[update.SensorId], req.Channel)
+			if !ok {
+				continue
+			}
+			update = convertUpdate(update, sensorTypes[update.SensorId], req.Channel, targets)
+			if update = filter.add(update); update == nil {
+				continue
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
 		}
 	}
 }
@@ -187,20 +288,27 @@ func (h *DataGrpcHandler) GetLatestReadingsBatch(ctx context.Context, req *pb_da
 		return nil, status.Error(codes.InvalidArgument, "at least one sensor_id is required")
 	}
 
+	targets, err := parseTargetUnit(req.TargetUnit)
+	if err != nil {
+		return nil, err
+	}
+
 	readings, err := h.store.GetLatestReadingsBatch(ctx, req.SensorIds)
 	if err != nil {
 		logger.Error("Failed to get latest readings batch", zap.Error(err))
 		return nil, status.Error(codes.Internal, "failed to get latest readings")
 	}
 
-	for _, reading := range readings {
-		sensor, err := h.sensorClient.GetSensor(ctx, &pb_sensor.GetSensorRequest{Id: reading.SensorId})
-		if err == nil && sensor.Sensor != nil {
-			reading.SensorName = sensor.Sensor.Name
-			reading.Location = sensor.Sensor.Location
-			if sensor.Sensor.SensorType != nil {
-				reading.Unit = sensor.Sensor.SensorType.Unit
-			}
+	sensors, err := h.sensors.GetMany(ctx, req.SensorIds)
+	if err != nil {
+		logger.Warn("Failed to resolve sensors of latest readings", zap.Error(err))
+	}
+	for i, reading := range readings {
+		if sensor, ok := sensors[reading.SensorId]; ok {
+			reading.SensorName = sensor.Name
+			reading.Location = sensor.Location
+			reading.Unit = valueUnit(sensor.SensorType, "")
+			readings[i] = convertUpdate(reading, sensor.SensorType, "", targets)
 		}
 	}
 
@@ -225,14 +333,12 @@ func (h *DataGrpcHandler) GetLatestReadingsBySensor(ctx context.Context, req *pb
 		return nil, status.Error(codes.Internal, "failed to get sensor readings")
 	}
 
-	sensor, err := h.sensorClient.GetSensor(ctx, &pb_sensor.GetSensorRequest{Id: req.SensorId})
-	if err == nil && sensor.Sensor != nil {
+	sensor, err := h.sensors.Get(ctx, req.SensorId)
+	if err == nil {
 		for _, reading := range readings {
-			reading.SensorName = sensor.Sensor.Name
-			reading.Location = sensor.Sensor.Location
-			if sensor.Sensor.SensorType != nil {
-				reading.Unit = sensor.Sensor.SensorType.Unit
-			}
+			reading.SensorName = sensor.Name
+			reading.Location = sensor.Location
+			reading.Unit = valueUnit(sensor.SensorType, "")
 		}
 	}
 
@@ -241,18 +347,117 @@ func (h *DataGrpcHandler) GetLatestReadingsBySensor(ctx context.Context, req *pb
 	}, nil
 }
 
-func (h *DataGrpcHandler) broadcastUpdate(update *pb_data.ReadingUpdate) {
-	h.subscribersMu.RLock()
-	defer h.subscribersMu.RUnlock()
+// maxRequestSensors bounds the number of sensors a single export, statistics or
+// multi-sensor request covers.
+const maxRequestSensors = 100
+
+// resolveSensorIDs merges sensorIDs with the sensors of the group, if any, and drops
+// duplicates. It fails when no sensor remains or there are more than maxRequestSensors.
+func (h *DataGrpcHandler) resolveSensorIDs(ctx context.Context, sensorIDs []int64, groupID int64) ([]int64, error) {
+	ids := append([]int64(nil), sensorIDs...)
+	if groupID > 0 {
+		group, err := h.sensorClient.GetSensorGroup(ctx, &pb_sensor.GetSensorGroupRequest{Id: groupID})
+		if err != nil || group.Group == nil {
+			if status.Code(err) == codes.NotFound {
+				return nil, status.Error(codes.NotFound, "sensor group not found")
+			}
+			logger.Error("Failed to resolve sensor group", zap.Int64("sensor_group_id", groupID), zap.Error(err))
+			return nil, status.Error(codes.Unavailable, "failed to resolve sensor group")
+		}
+		ids = append(ids, group.Group.SensorIds...)
+	}
 
-	for _, ch := range h.subscribers {
-		select {
-		case ch <- update:
-		default:
+	seen := make(map[int64]bool, len(ids))
+	unique := ids[:0]
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "sensor_ids must be positive")
+		}
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
 		}
 	}
+
+	if len(unique) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one sensor_id or a sensor_group_id is required")
+	}
+	if len(unique) > maxRequestSensors {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d sensors can be requested at once", maxRequestSensors)
+	}
+	return unique, nil
+}
+
+// checkChannel verifies that every sensor declares the channel. An empty channel, the
+// primary value, is always valid.
+func (h *DataGrpcHandler) checkChannel(ctx context.Context, sensorIDs []int64, channel string) error {
+	if channel == "" {
+		return nil
+	}
+	sensors, err := h.sensors.GetMany(ctx, sensorIDs)
+	if err != nil {
+		logger.Error("Failed to resolve sensors", zap.Int64s("sensor_ids", sensorIDs), zap.Error(err))
+		return status.Error(codes.Unavailable, "failed to resolve sensor")
+	}
+	for _, id := range sensorIDs {
+		sensor, ok := sensors[id]
+		if !ok {
+			return status.Errorf(codes.NotFound, "sensor %d not found", id)
+		}
+		if !hasChannel(sensor.SensorType, channel) {
+			return status.Errorf(codes.InvalidArgument, "sensor %d has no channel %q", id, channel)
+		}
+	}
+	return nil
 }
 
-func generateSubscriberID() string {
-	return time.Now().Format("20060102150405.000000")
+// newReadingEvent builds the readings_exchange event for a reading that is about to be stored.
+func newReadingEvent(sensor *pb_sensor.Sensor, r storage.Reading) *outbox.Message {
+	reading := services.ReadingEvent{
+		SensorId:   sensor.Id,
+		Value:      float64(r.Value),
+		Timestamp:  r.Timestamp,
+		SensorName: sensor.Name,
+		Location:   sensor.Location,
+		Quality:    r.Quality.String(),
+		Values:     r.Channels,
+	}
+	if len(r.ChannelQuality) > 0 {
+		reading.ChannelQuality = make(map[string]string, len(r.ChannelQuality))
+		for name, q := range r.ChannelQuality {
+			reading.ChannelQuality[name] = q.String()
+		}
+	}
+	reading.Unit = valueUnit(sensor.SensorType, "")
+
+	body, err := json.Marshal(reading)
+	if err != nil {
+		logger.Error("Failed to marshal reading", zap.Error(err))
+		return nil
+	}
+	return &outbox.Message{Exchange: services.ReadingsExchange, Payload: body}
+}
+
+// maxFilledBuckets bounds the buckets of a gap filled query, which returns every
+// bucket of the range whether it holds readings or not.
+const maxFilledBuckets = 100000
+
+func parseAggregation(interval, fn, fill string) (storage.Aggregation, error) {
+	d, err := storage.ParseInterval(interval)
+	if err != nil {
+		return storage.Aggregation{}, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if fn == "" {
+		fn = storage.DefaultAggregateFunction
+	}
+	if !storage.IsValidAggregateFunction(fn) {
+		return storage.Aggregation{}, status.Errorf(codes.InvalidArgument, "unsupported aggregation %q, expected one of avg, min, max, sum, count, first, last", fn)
+	}
+
+	if !storage.IsValidFill(fill) {
+		return storage.Aggregation{}, status.Errorf(codes.InvalidArgument, "unsupported fill %q, expected one of null, previous, linear", fill)
+	}
+
+	return storage.Aggregation{Interval: d, Function: fn, Fill: fill}, nil
 }
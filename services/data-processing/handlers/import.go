@@ -0,0 +1,387 @@
+package handlers
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+const (
+	// maxImportSize limits the size of an uploaded import file.
+	maxImportSize = 256 << 20
+	// maxImportErrors is how many row errors are kept per import job.
+	maxImportErrors = 1000
+	// maxConcurrentImports is how many import jobs run at the same time; others wait.
+	maxConcurrentImports = 2
+)
+
+// ImportReadings receives a CSV file, stores it in a temporary file and starts an
+// import job for it. The job runs in the background and is returned as pending.
+func (h *DataGrpcHandler) ImportReadings(stream pb_data.DataService_ImportReadingsServer) error {
+	ctx := stream.Context()
+
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) || (err == nil && first.Options == nil) {
+		return status.Error(codes.InvalidArgument, "the first message must carry the import options")
+	}
+	if err != nil {
+		return err
+	}
+
+	if first.Options.UserId <= 0 {
+		return status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	format, err := newImportFormat(first.Options)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	file, err := os.CreateTemp("", "readings-import-*.csv")
+	if err != nil {
+		logger.Error("Failed to create import file", zap.Error(err))
+		return status.Error(codes.Internal, "failed to store import file")
+	}
+	path := file.Name()
+	keep := false
+	defer func() {
+		if !keep {
+			os.Remove(path)
+		}
+	}()
+
+	size, err := receiveImportFile(stream, file, first.Data)
+	if closeErr := file.Close(); err == nil && closeErr != nil {
+		logger.Error("Failed to write import file", zap.Error(closeErr))
+		return status.Error(codes.Internal, "failed to store import file")
+	}
+	if err != nil {
+		return err
+	}
+	if size == 0 {
+		return status.Error(codes.InvalidArgument, "import file is empty")
+	}
+
+	if err := h.checkImportSensors(ctx, path, format); err != nil {
+		return err
+	}
+
+	job, err := h.store.CreateImportJob(ctx, first.Options.UserId, first.Options.Filename)
+	if err != nil {
+		logger.Error("Failed to create import job", zap.Error(err))
+		return status.Error(codes.Internal, "failed to create import job")
+	}
+
+	keep = true
+	go h.runImport(job, path, format)
+
+	return stream.SendAndClose(convertImportJobToProto(job))
+}
+
+// receiveImportFile writes data and the data of the remaining messages of stream to file.
+func receiveImportFile(stream pb_data.DataService_ImportReadingsServer, file *os.File, data []byte) (int64, error) {
+	var size int64
+	for {
+		size += int64(len(data))
+		if size > maxImportSize {
+			return size, status.Errorf(codes.ResourceExhausted, "import file exceeds %d bytes", maxImportSize)
+		}
+		if _, err := file.Write(data); err != nil {
+			logger.Error("Failed to write import file", zap.Error(err))
+			return size, status.Error(codes.Internal, "failed to store import file")
+		}
+
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return size, nil
+		}
+		if err != nil {
+			return size, err
+		}
+		data = req.Data
+	}
+}
+
+// checkImportSensors checks that every sensor referenced by the file at path belongs
+// to the user of the import. Sensors that do not exist are left to the import, which
+// rejects their rows.
+func (h *DataGrpcHandler) checkImportSensors(ctx context.Context, path string, format *importFormat) error {
+	ids, err := importSensorIDs(path, format)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sensors, err := h.sensors.GetMany(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to resolve sensors of import", zap.Error(err))
+		return status.Error(codes.Unavailable, "failed to resolve sensors of import")
+	}
+	for _, id := range ids {
+		if sensor := sensors[id]; sensor != nil && sensor.UserId != format.opts.UserId {
+			return status.Errorf(codes.PermissionDenied, "sensor %d does not belong to the user", id)
+		}
+	}
+	return nil
+}
+
+// importSensorIDs returns the distinct sensors referenced by the file at path. Cells
+// that are not sensor IDs are skipped, the import rejects their rows.
+func importSensorIDs(path string, format *importFormat) ([]int64, error) {
+	if format.opts.SensorId > 0 {
+		return []int64{format.opts.SensorId}, nil
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("opening upload: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = format.delimiter
+	r.FieldsPerRecord = -1
+	r.ReuseRecord = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("reading header: %w", err)
+	}
+	mapping, err := format.bind(header)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[int64]bool)
+	var ids []int64
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			return ids, nil
+		}
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading file: %w", err)
+		}
+		if mapping.sensorCol >= len(record) {
+			continue
+		}
+
+		id, err := strconv.ParseInt(strings.TrimSpace(record[mapping.sensorCol]), 10, 64)
+		if err != nil || id <= 0 || seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+}
+
+func (h *DataGrpcHandler) GetImportJob(ctx context.Context, req *pb_data.GetImportJobRequest) (*pb_data.ImportJob, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be positive")
+	}
+
+	job, err := h.store.GetImportJob(ctx, req.Id, req.UserId)
+	if errors.Is(err, storage.ErrImportJobNotFound) {
+		return nil, status.Error(codes.NotFound, "import job not found")
+	}
+	if err != nil {
+		logger.Error("Failed to get import job", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get import job")
+	}
+
+	return convertImportJobToProto(job), nil
+}
+
+// runImport processes an uploaded file and removes it afterwards. Imports outlive the
+// upload request, so they run on their own context.
+func (h *DataGrpcHandler) runImport(job *storage.ImportJob, path string, format *importFormat) {
+	defer os.Remove(path)
+
+	h.importSlots <- struct{}{}
+	defer func() { <-h.importSlots }()
+
+	ctx := context.Background()
+	started := time.Now()
+	job.Status = storage.ImportStatusRunning
+	job.StartedAt = &started
+	if err := h.store.UpdateImportJob(ctx, job, nil); err != nil {
+		if errors.Is(err, storage.ErrImportJobLost) {
+			logger.Warn("Import job was failed by another instance", zap.Int64("job_id", job.ID))
+			return
+		}
+		logger.Error("Failed to update import job", zap.Int64("job_id", job.ID), zap.Error(err))
+	}
+
+	err := h.importFile(ctx, job, path, format)
+	if errors.Is(err, storage.ErrImportJobLost) {
+		logger.Warn("Import job was failed by another instance", zap.Int64("job_id", job.ID))
+		return
+	}
+
+	finished := time.Now()
+	job.FinishedAt = &finished
+	job.Status = storage.ImportStatusCompleted
+	if err != nil {
+		job.Status = storage.ImportStatusFailed
+		job.Error = status.Convert(err).Message()
+	}
+	if err := h.store.UpdateImportJob(ctx, job, nil); err != nil {
+		logger.Error("Failed to update import job", zap.Int64("job_id", job.ID), zap.Error(err))
+	}
+
+	logger.Info("Finished import job",
+		zap.Int64("job_id", job.ID),
+		zap.String("status", job.Status),
+		zap.Int64("rows_imported", job.RowsImported),
+		zap.Int64("rows_failed", job.RowsFailed),
+		zap.Duration("duration", finished.Sub(started)),
+	)
+}
+
+// importFile reads the CSV file at path and stores its rows in batches, saving the job
+// progress after every batch. Historical rows are not published to readings_exchange.
+func (h *DataGrpcHandler) importFile(ctx context.Context, job *storage.ImportJob, path string, format *importFormat) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening upload: %w", err)
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = format.delimiter
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return fmt.Errorf("reading header: %w", err)
+	}
+	mapping, err := format.bind(header)
+	if err != nil {
+		return err
+	}
+
+	sensors := make(map[int64]*pb_sensor.Sensor)
+	batch := make([]*pb_data.StoreReadingRequest, 0, ingestChunkSize)
+	lines := make([]int64, 0, ingestChunkSize)
+	var rowErrors []storage.ImportRowError
+	var first, last time.Time
+
+	reject := func(line int64, msg string) {
+		job.RowsFailed++
+		if job.RowsFailed <= maxImportErrors {
+			rowErrors = append(rowErrors, storage.ImportRowError{Row: line, Message: msg})
+		}
+	}
+
+	flush := func() error {
+		if len(batch) > 0 {
+			res := &pb_data.StoreReadingsBatchResponse{}
+			if err := h.ingestBatch(ctx, batch, 0, sensors, false, res); err != nil {
+				return err
+			}
+			job.RowsImported += int64(res.Accepted)
+			job.RowsDuplicate += int64(res.Duplicates)
+			for _, e := range res.Errors {
+				reject(lines[e.Index], e.Message)
+			}
+		}
+
+		err := h.store.UpdateImportJob(ctx, job, rowErrors)
+		batch, lines, rowErrors = batch[:0], lines[:0], nil
+		return err
+	}
+
+	for {
+		record, err := r.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		var parseErr *csv.ParseError
+		if errors.As(err, &parseErr) {
+			job.RowsProcessed++
+			reject(int64(parseErr.StartLine), parseErr.Err.Error())
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("reading file: %w", err)
+		}
+
+		job.RowsProcessed++
+		line, _ := r.FieldPos(0)
+		req, err := mapping.reading(record)
+		if err != nil {
+			reject(int64(line), err.Error())
+			continue
+		}
+
+		ts := req.Timestamp.AsTime()
+		if first.IsZero() || ts.Before(first) {
+			first = ts
+		}
+		if ts.After(last) {
+			last = ts
+		}
+
+		batch = append(batch, req)
+		lines = append(lines, int64(line))
+		if len(batch) == ingestChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return err
+	}
+
+	// Rollup policies only refresh recent buckets, so historical rows are rolled up here.
+	if job.RowsImported > 0 {
+		if err := h.store.RefreshRollups(ctx, first, last); err != nil {
+			logger.Error("Failed to refresh rollups after import", zap.Int64("job_id", job.ID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+func convertImportJobToProto(job *storage.ImportJob) *pb_data.ImportJob {
+	res := &pb_data.ImportJob{
+		Id:            job.ID,
+		Status:        job.Status,
+		Filename:      job.Filename,
+		RowsProcessed: job.RowsProcessed,
+		RowsImported:  job.RowsImported,
+		RowsDuplicate: job.RowsDuplicate,
+		RowsFailed:    job.RowsFailed,
+		Error:         job.Error,
+		CreatedAt:     timestamppb.New(job.CreatedAt),
+	}
+	if job.StartedAt != nil {
+		res.StartedAt = timestamppb.New(*job.StartedAt)
+	}
+	if job.FinishedAt != nil {
+		res.FinishedAt = timestamppb.New(*job.FinishedAt)
+	}
+	for _, e := range job.Errors {
+		res.Errors = append(res.Errors, &pb_data.ImportRowError{Row: e.Row, Message: e.Message})
+	}
+	return res
+}
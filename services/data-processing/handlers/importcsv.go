@@ -0,0 +1,218 @@
+package handlers
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+// importFormat holds the validated import options. The column names are resolved to
+// positions once the header of the file is known, see bind.
+type importFormat struct {
+	opts      *pb_data.ImportOptions
+	delimiter rune
+	parseTime func(string) (time.Time, error)
+}
+
+func newImportFormat(opts *pb_data.ImportOptions) (*importFormat, error) {
+	f := &importFormat{opts: opts, delimiter: ','}
+
+	if opts.SensorId < 0 {
+		return nil, fmt.Errorf("sensor_id must be positive")
+	}
+	if (opts.SensorId == 0) == (opts.SensorColumn == "") {
+		return nil, fmt.Errorf("exactly one of sensor_id and sensor_column is required")
+	}
+
+	if opts.Delimiter != "" {
+		r, size := utf8.DecodeRuneInString(opts.Delimiter)
+		if size != len(opts.Delimiter) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+			return nil, fmt.Errorf("delimiter must be a single character other than a quote or line break")
+		}
+		f.delimiter = r
+	}
+
+	loc := time.UTC
+	if opts.Timezone != "" {
+		var err error
+		if loc, err = time.LoadLocation(opts.Timezone); err != nil {
+			return nil, fmt.Errorf("unknown timezone %q", opts.Timezone)
+		}
+	}
+
+	switch opts.TimeFormat {
+	case "", "rfc3339":
+		f.parseTime = func(s string) (time.Time, error) {
+			return time.Parse(time.RFC3339Nano, s)
+		}
+	case "unix":
+		f.parseTime = func(s string) (time.Time, error) {
+			v, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			sec, frac := math.Modf(v)
+			return time.Unix(int64(sec), int64(frac*1e9)), nil
+		}
+	case "unix_ms":
+		f.parseTime = func(s string) (time.Time, error) {
+			v, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return time.UnixMilli(v), nil
+		}
+	default:
+		layout := opts.TimeFormat
+		f.parseTime = func(s string) (time.Time, error) {
+			return time.ParseInLocation(layout, s, loc)
+		}
+	}
+
+	return f, nil
+}
+
+// importMapping maps the columns of one file to reading fields. A position of -1 marks
+// an unused column.
+type importMapping struct {
+	sensorID    int64
+	sensorCol   int
+	timeCol     int
+	valueCol    int
+	qualityCol  int
+	channelCols map[string]int
+	parseTime   func(string) (time.Time, error)
+}
+
+// bind resolves the configured column names against the header of the file.
+func (f *importFormat) bind(header []string) (*importMapping, error) {
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(name)
+		if i == 0 {
+			name = strings.TrimPrefix(name, "\ufeff")
+		}
+		if _, dup := index[name]; !dup {
+			index[name] = i
+		}
+	}
+
+	column := func(name, fallback string, required bool) (int, error) {
+		if name == "" {
+			name = fallback
+		}
+		if name == "" {
+			return -1, nil
+		}
+		i, ok := index[name]
+		if !ok {
+			if required {
+				return -1, fmt.Errorf("column %q not found in header", name)
+			}
+			return -1, nil
+		}
+		return i, nil
+	}
+
+	opts := f.opts
+	m := &importMapping{sensorID: opts.SensorId, parseTime: f.parseTime}
+
+	var err error
+	if m.sensorCol, err = column(opts.SensorColumn, "", true); err != nil {
+		return nil, err
+	}
+	if m.timeCol, err = column(opts.TimeColumn, "time", true); err != nil {
+		return nil, err
+	}
+	// The value column is only optional when channel columns carry the values.
+	if m.valueCol, err = column(opts.ValueColumn, "value", len(opts.ChannelColumns) == 0 || opts.ValueColumn != ""); err != nil {
+		return nil, err
+	}
+	if m.qualityCol, err = column(opts.QualityColumn, "", true); err != nil {
+		return nil, err
+	}
+
+	if len(opts.ChannelColumns) > 0 {
+		m.channelCols = make(map[string]int, len(opts.ChannelColumns))
+		for channel, name := range opts.ChannelColumns {
+			i, err := column(name, "", true)
+			if err != nil {
+				return nil, err
+			}
+			m.channelCols[channel] = i
+		}
+	}
+
+	return m, nil
+}
+
+// reading parses one record. Empty channel cells are skipped so sparse logger files
+// can be imported; the remaining checks happen when the reading is ingested.
+func (m *importMapping) reading(record []string) (*pb_data.StoreReadingRequest, error) {
+	cell := func(i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	req := &pb_data.StoreReadingRequest{SensorId: m.sensorID}
+	if m.sensorCol >= 0 {
+		id, err := strconv.ParseInt(cell(m.sensorCol), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid sensor id %q", cell(m.sensorCol))
+		}
+		req.SensorId = id
+	}
+
+	ts, err := m.parseTime(cell(m.timeCol))
+	if err != nil {
+		return nil, fmt.Errorf("invalid time %q", cell(m.timeCol))
+	}
+	req.Timestamp = timestamppb.New(ts)
+
+	if m.valueCol >= 0 && (m.channelCols == nil || cell(m.valueCol) != "") {
+		v, err := parseImportValue(cell(m.valueCol))
+		if err != nil {
+			return nil, err
+		}
+		req.Value = v
+	}
+
+	for channel, i := range m.channelCols {
+		if cell(i) == "" {
+			continue
+		}
+		v, err := parseImportValue(cell(i))
+		if err != nil {
+			return nil, fmt.Errorf("channel %q: %w", channel, err)
+		}
+		if req.Values == nil {
+			req.Values = make(map[string]float32, len(m.channelCols))
+		}
+		req.Values[channel] = v
+	}
+	if m.channelCols != nil && req.Values == nil && (m.valueCol < 0 || cell(m.valueCol) == "") {
+		return nil, fmt.Errorf("row has no values")
+	}
+
+	if m.qualityCol >= 0 {
+		req.Quality = cell(m.qualityCol)
+	}
+	return req, nil
+}
+
+func parseImportValue(s string) (float32, error) {
+	v, err := strconv.ParseFloat(s, 32)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+	return float32(v), nil
+}
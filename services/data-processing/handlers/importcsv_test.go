@@ -0,0 +1,97 @@
+package handlers
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+func TestImportMapping(t *testing.T) {
+	t.Run("Options", func(t *testing.T) {
+		_, err := newImportFormat(&pb_data.ImportOptions{})
+		assert.Error(t, err, "sensor is required")
+		_, err = newImportFormat(&pb_data.ImportOptions{SensorId: 1, SensorColumn: "sensor"})
+		assert.Error(t, err, "sensor_id and sensor_column are exclusive")
+		_, err = newImportFormat(&pb_data.ImportOptions{SensorId: 1, Delimiter: ";;"})
+		assert.Error(t, err)
+		_, err = newImportFormat(&pb_data.ImportOptions{SensorId: 1, Timezone: "Mars/Olympus"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Fixed Sensor With Layout And Timezone", func(t *testing.T) {
+		f, err := newImportFormat(&pb_data.ImportOptions{
+			SensorId:    7,
+			TimeColumn:  "Date",
+			ValueColumn: "Temp",
+			TimeFormat:  "2006-01-02 15:04:05",
+			Timezone:    "Europe/Warsaw",
+		})
+		require.NoError(t, err)
+		m, err := f.bind([]string{"\ufeffDate", " Temp "})
+		require.NoError(t, err)
+
+		req, err := m.reading([]string{"2025-01-15 12:00:00", "21.5"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), req.SensorId)
+		assert.Equal(t, float32(21.5), req.Value)
+		assert.Equal(t, time.Date(2025, 1, 15, 11, 0, 0, 0, time.UTC), req.Timestamp.AsTime())
+
+		_, err = m.reading([]string{"15.01.2025", "21.5"})
+		assert.Error(t, err)
+		_, err = m.reading([]string{"2025-01-15 12:00:00", "warm"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Sensor Column Channels And Quality", func(t *testing.T) {
+		f, err := newImportFormat(&pb_data.ImportOptions{
+			SensorColumn:   "sensor",
+			TimeFormat:     "unix_ms",
+			ChannelColumns: map[string]string{"temperature": "t", "humidity": "rh"},
+			QualityColumn:  "q",
+		})
+		require.NoError(t, err)
+		m, err := f.bind([]string{"sensor", "time", "t", "rh", "q"})
+		require.NoError(t, err)
+
+		req, err := m.reading([]string{"3", "1736942400000", "20", "", "suspect"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), req.SensorId)
+		assert.Equal(t, map[string]float32{"temperature": 20}, req.Values)
+		assert.Equal(t, "suspect", req.Quality)
+		assert.Equal(t, time.UnixMilli(1736942400000).UTC(), req.Timestamp.AsTime())
+
+		_, err = m.reading([]string{"3", "1736942400000", "", "", ""})
+		assert.Error(t, err, "row without values")
+	})
+
+	t.Run("Missing Column", func(t *testing.T) {
+		f, err := newImportFormat(&pb_data.ImportOptions{SensorId: 1})
+		require.NoError(t, err)
+		_, err = f.bind([]string{"timestamp", "value"})
+		assert.Error(t, err)
+	})
+
+	t.Run("Sensors Of File", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "import.csv")
+		content := "sensor;time;value\n3;1;20\n4;2;21\n3;3;22\nx;4;23\n5\n"
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+
+		f, err := newImportFormat(&pb_data.ImportOptions{SensorColumn: "sensor", Delimiter: ";", TimeFormat: "unix"})
+		require.NoError(t, err)
+		ids, err := importSensorIDs(path, f)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 4, 5}, ids)
+
+		f, err = newImportFormat(&pb_data.ImportOptions{SensorId: 7})
+		require.NoError(t, err)
+		ids, err = importSensorIDs(path, f)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{7}, ids, "a fixed sensor does not read the file")
+	})
+}
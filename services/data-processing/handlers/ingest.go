@@ -0,0 +1,216 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/services"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+const (
+	// maxBatchSize limits a single StoreReadingsBatch call.
+	maxBatchSize = 10000
+	// maxIdempotencyKeyLength bounds client supplied idempotency keys.
+	maxIdempotencyKeyLength = 128
+	// ingestChunkSize is how many streamed readings IngestReadings buffers before writing them.
+	ingestChunkSize = 1000
+)
+
+func (h *DataGrpcHandler) StoreReadingsBatch(ctx context.Context, req *pb_data.StoreReadingsBatchRequest) (*pb_data.StoreReadingsBatchResponse, error) {
+	if len(req.Readings) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one reading is required")
+	}
+	if len(req.Readings) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "batch exceeds the maximum of %d readings", maxBatchSize)
+	}
+
+	res := &pb_data.StoreReadingsBatchResponse{}
+	if err := h.ingestBatch(ctx, req.Readings, 0, make(map[int64]*pb_sensor.Sensor), true, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (h *DataGrpcHandler) IngestReadings(stream pb_data.DataService_IngestReadingsServer) error {
+	ctx := stream.Context()
+	res := &pb_data.StoreReadingsBatchResponse{}
+	sensors := make(map[int64]*pb_sensor.Sensor)
+	buf := make([]*pb_data.StoreReadingRequest, 0, ingestChunkSize)
+	offset := 0
+
+	flush := func() error {
+		if len(buf) == 0 {
+			return nil
+		}
+		if err := h.ingestBatch(ctx, buf, offset, sensors, true, res); err != nil {
+			return err
+		}
+		offset += len(buf)
+		buf = buf[:0]
+		return nil
+	}
+
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			if err := flush(); err != nil {
+				return err
+			}
+			return stream.SendAndClose(res)
+		}
+		if err != nil {
+			return err
+		}
+
+		buf = append(buf, req)
+		if len(buf) == ingestChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ingestBatch validates, stores and publishes a slice of readings, appending per-reading
+// failures to res. offset is the position of reqs[0] in the caller's overall input, and
+// sensors caches sensor lookups across calls so every sensor is resolved once. Unless
+// live is set the readings are only stored: they are neither published to
+// readings_exchange, which feeds stream subscribers, nor used to compute virtual sensors.
+func (h *DataGrpcHandler) ingestBatch(ctx context.Context, reqs []*pb_data.StoreReadingRequest, offset int, sensors map[int64]*pb_sensor.Sensor, live bool, res *pb_data.StoreReadingsBatchResponse) error {
+	reject := func(i int, sensorID int64, msg string) {
+		res.Rejected++
+		res.Errors = append(res.Errors, &pb_data.ReadingError{
+			Index:    int32(offset + i),
+			SensorId: sensorID,
+			Message:  msg,
+		})
+	}
+
+	if err := h.resolveSensors(ctx, reqs, sensors); err != nil {
+		logger.Warn("Failed to resolve sensors for batch ingestion", zap.Error(err))
+		return status.Error(codes.Unavailable, "failed to look up sensors")
+	}
+
+	now := time.Now()
+	readings := make([]storage.Reading, 0, len(reqs))
+	for i, req := range reqs {
+		if req.SensorId <= 0 {
+			reject(i, req.SensorId, "sensor_id must be positive")
+			continue
+		}
+		sensor := sensors[req.SensorId]
+		if sensor == nil {
+			reject(i, req.SensorId, "sensor not found")
+			continue
+		}
+		if sensor.Kind == services.SensorKindVirtual {
+			reject(i, req.SensorId, errVirtualSensorWrite)
+			continue
+		}
+		reported, ok := storage.ParseQuality(req.Quality)
+		if !ok {
+			reject(i, req.SensorId, fmt.Sprintf("unknown quality %q", req.Quality))
+			continue
+		}
+		if len(req.IdempotencyKey) > maxIdempotencyKeyLength {
+			reject(i, req.SensorId, fmt.Sprintf("idempotency_key must be at most %d characters", maxIdempotencyKeyLength))
+			continue
+		}
+
+		ts := now
+		if req.Timestamp != nil {
+			ts = req.Timestamp.AsTime()
+		}
+		ts = ts.Truncate(time.Microsecond)
+
+		value, values, raw := calibrate(sensor, ts, req.Value, req.Values)
+		value, values, quality, channelQuality, err := applyChannels(sensor.SensorType, value, values, reported)
+		if err != nil {
+			reject(i, req.SensorId, err.Error())
+			continue
+		}
+
+		reading := storage.Reading{
+			SensorID:       req.SensorId,
+			Value:          value,
+			Timestamp:      ts,
+			Quality:        quality,
+			RawQuality:     reported,
+			Channels:       values,
+			ChannelQuality: channelQuality,
+			Raw:            raw,
+			IdempotencyKey: req.IdempotencyKey,
+		}
+		if live {
+			reading.Event = newReadingEvent(sensor, reading)
+		}
+		readings = append(readings, reading)
+	}
+
+	stored, err := h.store.StoreReadings(ctx, readings)
+	if err != nil {
+		logger.Error("Failed to store readings batch", zap.Int("count", len(readings)), zap.Error(err))
+		return status.Error(codes.Internal, "failed to store readings")
+	}
+
+	var accepted []storage.Reading
+	for i, r := range readings {
+		if !stored[i] {
+			res.Duplicates++
+			continue
+		}
+		res.Accepted++
+		if live {
+			accepted = append(accepted, r)
+		}
+	}
+	if len(accepted) > 0 {
+		h.relay.Notify()
+		h.deriveReadings(ctx, accepted)
+	}
+
+	return nil
+}
+
+// resolveSensors looks up every sensor referenced by reqs that is not yet in sensors,
+// in one call. Sensors that do not exist are stored as nil so they are not looked up
+// again. A failed lookup leaves sensors unchanged, so the next call retries it.
+func (h *DataGrpcHandler) resolveSensors(ctx context.Context, reqs []*pb_data.StoreReadingRequest, sensors map[int64]*pb_sensor.Sensor) error {
+	var ids []int64
+	for _, req := range reqs {
+		if req.SensorId <= 0 {
+			continue
+		}
+		if _, seen := sensors[req.SensorId]; seen || slices.Contains(ids, req.SensorId) {
+			continue
+		}
+		ids = append(ids, req.SensorId)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	found, err := h.sensors.GetMany(lookupCtx, ids)
+	if err != nil {
+		return fmt.Errorf("looking up sensors %v: %w", ids, err)
+	}
+	for _, id := range ids {
+		sensors[id] = found[id]
+	}
+	return nil
+}
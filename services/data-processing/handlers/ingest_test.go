@@ -0,0 +1,69 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/services"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(logger.Config{
+		Level:       "info",
+		Environment: "development",
+		ServiceName: "data-processing-test",
+		OutputPaths: []string{"stdout"},
+	})
+	m.Run()
+}
+
+// flakySensorCache fails its first failures lookups and then knows only the given sensors.
+type flakySensorCache struct {
+	services.ISensorCache
+	sensors  map[int64]*pb_sensor.Sensor
+	failures int
+}
+
+func (c *flakySensorCache) GetMany(_ context.Context, ids []int64) (map[int64]*pb_sensor.Sensor, error) {
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("sensor service unavailable")
+	}
+	found := make(map[int64]*pb_sensor.Sensor)
+	for _, id := range ids {
+		if s, ok := c.sensors[id]; ok {
+			found[id] = s
+		}
+	}
+	return found, nil
+}
+
+func TestResolveSensors(t *testing.T) {
+	cache := &flakySensorCache{sensors: map[int64]*pb_sensor.Sensor{5: {Id: 5}}, failures: 1}
+	h := &DataGrpcHandler{sensors: cache}
+	reqs := []*pb_data.StoreReadingRequest{{SensorId: 5}, {SensorId: 7}, {SensorId: 5}}
+	sensors := make(map[int64]*pb_sensor.Sensor)
+
+	t.Run("Failed Lookup Is Unavailable", func(t *testing.T) {
+		err := h.ingestBatch(context.Background(), reqs, 0, sensors, false, &pb_data.StoreReadingsBatchResponse{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+		assert.Empty(t, sensors, "a failed lookup is not cached")
+	})
+
+	t.Run("Retry Resolves Sensors", func(t *testing.T) {
+		require.NoError(t, h.resolveSensors(context.Background(), reqs, sensors))
+		assert.Equal(t, int64(5), sensors[5].GetId())
+		v, ok := sensors[7]
+		assert.True(t, ok, "a missing sensor is cached")
+		assert.Nil(t, v)
+	})
+}
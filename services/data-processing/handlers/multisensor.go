@@ -0,0 +1,68 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+// QueryMultiSensorReadings aggregates several sensors into a table with one row per
+// bucket and one column per sensor, ordered by sensor id, for comparison charts.
+func (h *DataGrpcHandler) QueryMultiSensorReadings(ctx context.Context, req *pb_data.MultiSensorReadingsRequest) (*pb_data.MultiSensorReadingsResponse, error) {
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+	if end.Before(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must not be before start_time")
+	}
+	if req.AggregationInterval == "" {
+		return nil, status.Error(codes.InvalidArgument, "aggregation_interval is required")
+	}
+	agg, err := parseAggregation(req.AggregationInterval, req.Aggregation, req.Fill)
+	if err != nil {
+		return nil, err
+	}
+	// Buckets are aligned across sensors, so the table is bounded like a filled query.
+	if buckets := end.Sub(start) / agg.Interval; buckets > maxFilledBuckets {
+		return nil, status.Errorf(codes.InvalidArgument, "query would return more than %d buckets, use a larger aggregation_interval", maxFilledBuckets)
+	}
+
+	ids, err := h.resolveSensorIDs(ctx, req.SensorIds, req.SensorGroupId)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.checkChannel(ctx, ids, req.Channel); err != nil {
+		return nil, err
+	}
+	slices.Sort(ids)
+
+	rows, err := h.store.QueryMultiSensorReadings(ctx, ids, start, end, agg, req.Channel)
+	if err != nil {
+		logger.Error("Failed to query multi-sensor readings", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to query readings")
+	}
+
+	res := &pb_data.MultiSensorReadingsResponse{
+		SensorIds: ids,
+		Rows:      make([]*pb_data.MultiSensorRow, 0, len(rows)),
+	}
+	for _, r := range rows {
+		row := &pb_data.MultiSensorRow{
+			Time:   timestamppb.New(r.Time),
+			Values: make(map[int64]float32, len(r.Values)),
+		}
+		for id, v := range r.Values {
+			row.Values[id] = float32(v)
+		}
+		res.Rows = append(res.Rows, row)
+	}
+	return res, nil
+}
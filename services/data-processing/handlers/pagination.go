@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultPageSize and maxPageSize bound the raw readings of a QueryReadings page.
+	defaultPageSize = 1000
+	maxPageSize     = 10000
+
+	pageTokenPrefix = "t1:"
+)
+
+// pageSize returns the page size of a request, applying the default and the maximum.
+func pageSize(requested int32) int {
+	if requested <= 0 {
+		return defaultPageSize
+	}
+	return min(int(requested), maxPageSize)
+}
+
+// encodePageToken returns the token of the page following the reading taken at t.
+// Readings are stored with microsecond precision, so the time is kept exactly.
+func encodePageToken(t time.Time) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(pageTokenPrefix + strconv.FormatInt(t.UnixMicro(), 10)))
+}
+
+// decodePageToken returns the time of the last reading of the previous page. An empty
+// token is the first page and decodes to the zero time.
+func decodePageToken(token string) (time.Time, error) {
+	if token == "" {
+		return time.Time{}, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || !strings.HasPrefix(string(b), pageTokenPrefix) {
+		return time.Time{}, fmt.Errorf("invalid page_token")
+	}
+	micros, err := strconv.ParseInt(strings.TrimPrefix(string(b), pageTokenPrefix), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid page_token")
+	}
+	return time.UnixMicro(micros).UTC(), nil
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageToken(t *testing.T) {
+	at := time.Date(2025, 3, 1, 12, 30, 0, 123456000, time.UTC)
+
+	after, err := decodePageToken(encodePageToken(at))
+	require.NoError(t, err)
+	assert.True(t, at.Equal(after))
+
+	after, err = decodePageToken("")
+	require.NoError(t, err)
+	assert.True(t, after.IsZero())
+
+	for _, token := range []string{"not base64!", "MTIz", encodePageToken(at) + "x"} {
+		_, err := decodePageToken(token)
+		assert.Error(t, err, token)
+	}
+}
+
+func TestPageSize(t *testing.T) {
+	assert.Equal(t, defaultPageSize, pageSize(0))
+	assert.Equal(t, defaultPageSize, pageSize(-5))
+	assert.Equal(t, 50, pageSize(50))
+	assert.Equal(t, maxPageSize, pageSize(maxPageSize+1))
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+// Out-of-range policies configured on a sensor type. Any other value, including the
+// default "flag", stores the reading unchanged and marks it out_of_range.
+const (
+	outOfRangeClamp  = "clamp"
+	outOfRangeReject = "reject"
+)
+
+// applyRangePolicy checks value against the min/max of the sensor type and applies the
+// type's out-of-range policy. It returns false when the reading has to be rejected.
+// Sensor types with min_value >= max_value have no range configured and accept any value.
+func applyRangePolicy(st *pb_sensor.SensorType, value float32, quality storage.Quality) (float32, storage.Quality, bool) {
+	if st == nil {
+		return value, quality, true
+	}
+	return applyRange(st.MinValue, st.MaxValue, st.OutOfRangePolicy, value, quality)
+}
+
+func applyRange(minValue, maxValue float32, policy string, value float32, quality storage.Quality) (float32, storage.Quality, bool) {
+	if minValue >= maxValue {
+		return value, quality, true
+	}
+	if value >= minValue && value <= maxValue {
+		return value, quality, true
+	}
+
+	switch policy {
+	case outOfRangeReject:
+		return value, quality, false
+	case outOfRangeClamp:
+		if value > maxValue {
+			value = maxValue
+		} else {
+			value = minValue
+		}
+	}
+	return value, storage.QualityOutOfRange, true
+}
@@ -0,0 +1,102 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+func (h *DataGrpcHandler) SetRetentionPolicy(ctx context.Context, req *pb_data.SetRetentionPolicyRequest) (*pb_data.SetRetentionPolicyResponse, error) {
+	if req.Scope != storage.RetentionScopeSensor && req.Scope != storage.RetentionScopeSensorType {
+		return nil, status.Errorf(codes.InvalidArgument, "scope must be %q or %q", storage.RetentionScopeSensor, storage.RetentionScopeSensorType)
+	}
+	if req.TargetId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "target_id must be positive")
+	}
+
+	retention, err := storage.ParseInterval(req.RawRetention)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if retention < storage.MinRawRetention {
+		return nil, status.Errorf(codes.InvalidArgument, "raw_retention must be at least %s", formatInterval(storage.MinRawRetention))
+	}
+
+	policy, err := h.store.UpsertRetentionPolicy(ctx, storage.RetentionPolicy{
+		Scope:        req.Scope,
+		TargetID:     req.TargetId,
+		RawRetention: retention,
+	})
+	if err != nil {
+		logger.Error("Failed to save retention policy", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to save retention policy")
+	}
+
+	return &pb_data.SetRetentionPolicyResponse{
+		Policy: convertRetentionPolicyToProto(policy),
+	}, nil
+}
+
+func (h *DataGrpcHandler) ListRetentionPolicies(ctx context.Context, req *pb_data.ListRetentionPoliciesRequest) (*pb_data.ListRetentionPoliciesResponse, error) {
+	policies, err := h.store.ListRetentionPolicies(ctx)
+	if err != nil {
+		logger.Error("Failed to list retention policies", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list retention policies")
+	}
+
+	protoPolicies := make([]*pb_data.RetentionPolicy, 0, len(policies))
+	for _, p := range policies {
+		protoPolicies = append(protoPolicies, convertRetentionPolicyToProto(p))
+	}
+
+	return &pb_data.ListRetentionPoliciesResponse{
+		Policies: protoPolicies,
+	}, nil
+}
+
+func (h *DataGrpcHandler) DeleteRetentionPolicy(ctx context.Context, req *pb_data.DeleteRetentionPolicyRequest) (*pb_data.DeleteRetentionPolicyResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "id must be positive")
+	}
+
+	err := h.store.DeleteRetentionPolicy(ctx, req.Id)
+	if errors.Is(err, storage.ErrRetentionPolicyNotFound) {
+		return nil, status.Error(codes.NotFound, "retention policy not found")
+	}
+	if err != nil {
+		logger.Error("Failed to delete retention policy", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete retention policy")
+	}
+
+	return &pb_data.DeleteRetentionPolicyResponse{}, nil
+}
+
+func convertRetentionPolicyToProto(p *storage.RetentionPolicy) *pb_data.RetentionPolicy {
+	return &pb_data.RetentionPolicy{
+		Id:           p.ID,
+		Scope:        p.Scope,
+		TargetId:     p.TargetID,
+		RawRetention: formatInterval(p.RawRetention),
+		CreatedAt:    timestamppb.New(p.CreatedAt),
+		UpdatedAt:    timestamppb.New(p.UpdatedAt),
+	}
+}
+
+// formatInterval renders whole days the way they are accepted by storage.ParseInterval.
+func formatInterval(d time.Duration) string {
+	const day = 24 * time.Hour
+	if d%day == 0 {
+		return fmt.Sprintf("%dd", d/day)
+	}
+	return d.String()
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+func (h *DataGrpcHandler) GetReadingStatistics(ctx context.Context, req *pb_data.ReadingStatisticsRequest) (*pb_data.ReadingStatisticsResponse, error) {
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_time and end_time are required")
+	}
+	start, end := req.StartTime.AsTime(), req.EndTime.AsTime()
+	if end.Before(start) {
+		return nil, status.Error(codes.InvalidArgument, "end_time must not be before start_time")
+	}
+
+	ids, err := h.resolveSensorIDs(ctx, req.SensorIds, req.SensorGroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.checkChannel(ctx, ids, req.Channel); err != nil {
+		return nil, err
+	}
+
+	perSensor, overall, err := h.store.GetReadingStatistics(ctx, ids, start, end, req.Channel)
+	if err != nil {
+		logger.Error("Failed to compute reading statistics", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to compute reading statistics")
+	}
+
+	// Sensors without readings in the range get an entry with a zero count.
+	bySensor := make(map[int64]*storage.ReadingStatistics, len(perSensor))
+	for _, s := range perSensor {
+		bySensor[s.SensorID] = s
+	}
+	res := &pb_data.ReadingStatisticsResponse{
+		Statistics: make([]*pb_data.ReadingStatistics, 0, len(ids)),
+		Overall:    convertReadingStatisticsToProto(overall),
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		s, ok := bySensor[id]
+		if !ok {
+			s = &storage.ReadingStatistics{SensorID: id}
+		}
+		res.Statistics = append(res.Statistics, convertReadingStatisticsToProto(s))
+	}
+	return res, nil
+}
+
+func convertReadingStatisticsToProto(s *storage.ReadingStatistics) *pb_data.ReadingStatistics {
+	res := &pb_data.ReadingStatistics{SensorId: s.SensorID, Count: s.Count}
+	if s.Count == 0 {
+		return res
+	}
+	res.Min = s.Min
+	res.Max = s.Max
+	res.Mean = s.Mean
+	res.Stddev = s.StdDev
+	res.P50 = s.P50
+	res.P90 = s.P90
+	res.P95 = s.P95
+	res.P99 = s.P99
+	res.FirstValue = s.FirstValue
+	res.FirstTime = timestamppb.New(s.FirstTime)
+	res.LastValue = s.LastValue
+	res.LastTime = timestamppb.New(s.LastTime)
+	return res
+}
@@ -0,0 +1,77 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+// statisticsStore returns fixed statistics, as the storage does for the rows of its
+// GROUPING SETS query.
+type statisticsStore struct {
+	storage.ITimeScaleStorage
+	perSensor []*storage.ReadingStatistics
+	overall   *storage.ReadingStatistics
+	sensorIDs []int64
+}
+
+func (s *statisticsStore) GetReadingStatistics(_ context.Context, sensorIDs []int64, _, _ time.Time, _ string) ([]*storage.ReadingStatistics, *storage.ReadingStatistics, error) {
+	s.sensorIDs = slices.Clone(sensorIDs)
+	return s.perSensor, s.overall, nil
+}
+
+func TestGetReadingStatistics(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	first, last := start.Add(time.Hour), start.Add(2*time.Hour)
+
+	store := &statisticsStore{
+		perSensor: []*storage.ReadingStatistics{
+			{SensorID: 5, Count: 2, Min: 20, Max: 22, Mean: 21, P50: 21, FirstValue: 20, FirstTime: first, LastValue: 22, LastTime: last},
+		},
+		overall: &storage.ReadingStatistics{Count: 2, Min: 20, Max: 22, Mean: 21, P50: 21, FirstValue: 20, FirstTime: first, LastValue: 22, LastTime: last},
+	}
+	h := &DataGrpcHandler{store: store}
+
+	res, err := h.GetReadingStatistics(context.Background(), &pb_data.ReadingStatisticsRequest{
+		SensorIds: []int64{9, 5, 9},
+		StartTime: timestamppb.New(start),
+		EndTime:   timestamppb.New(end),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{9, 5}, store.sensorIDs, "duplicate sensors are queried once")
+
+	t.Run("Sensor Without Readings", func(t *testing.T) {
+		require.Len(t, res.Statistics, 2)
+		assert.Equal(t, int64(5), res.Statistics[0].SensorId)
+		assert.Equal(t, int64(2), res.Statistics[0].Count)
+		assert.Equal(t, 22.0, res.Statistics[0].Max)
+		assert.Equal(t, &pb_data.ReadingStatistics{SensorId: 9}, res.Statistics[1], "a sensor without readings has a zero count")
+	})
+
+	t.Run("Overall", func(t *testing.T) {
+		require.NotNil(t, res.Overall)
+		assert.Equal(t, int64(0), res.Overall.SensorId)
+		assert.Equal(t, int64(2), res.Overall.Count)
+		assert.Equal(t, 20.0, res.Overall.Min)
+		assert.Equal(t, 21.0, res.Overall.Mean)
+		assert.Equal(t, last, res.Overall.LastTime.AsTime())
+	})
+
+	t.Run("Invalid Range", func(t *testing.T) {
+		_, err := h.GetReadingStatistics(context.Background(), &pb_data.ReadingStatisticsRequest{
+			SensorIds: []int64{5},
+			StartTime: timestamppb.New(end),
+			EndTime:   timestamppb.New(start),
+		})
+		assert.Error(t, err)
+	})
+}
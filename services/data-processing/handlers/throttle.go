@@ -0,0 +1,212 @@
+package handlers
+
+import (
+	"math"
+	"slices"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+// minStreamInterval bounds min_interval of StreamReadings from below.
+const minStreamInterval = 100 * time.Millisecond
+
+var downsampleModes = map[string]bool{
+	"latest": true,
+	"avg":    true,
+	"min":    true,
+	"max":    true,
+}
+
+// streamFilter applies the throttling options of a StreamReadings request to the
+// updates of one stream. It is not safe for concurrent use.
+type streamFilter struct {
+	interval time.Duration
+	deadband float64
+	mode     string
+
+	// sent holds the last update sent per sensor, deadband compares against it.
+	sent    map[int64]*pb_data.ReadingUpdate
+	windows map[int64]*updateWindow
+}
+
+// updateWindow combines the updates of a sensor received within one interval.
+type updateWindow struct {
+	latest *pb_data.ReadingUpdate
+	value  float64
+	count  int
+	values map[string]float64
+	counts map[string]int
+}
+
+// newStreamFilter validates the throttling options of req.
+func newStreamFilter(req *pb_data.StreamReadingsRequest) (*streamFilter, error) {
+	f := &streamFilter{
+		deadband: req.Deadband,
+		mode:     req.Downsample,
+		sent:     make(map[int64]*pb_data.ReadingUpdate),
+		windows:  make(map[int64]*updateWindow),
+	}
+
+	if req.MinInterval != "" {
+		interval, err := time.ParseDuration(req.MinInterval)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid min_interval %q", req.MinInterval)
+		}
+		if interval < minStreamInterval {
+			return nil, status.Errorf(codes.InvalidArgument, "min_interval must be at least %s", minStreamInterval)
+		}
+		f.interval = interval
+	}
+
+	if math.IsNaN(f.deadband) || math.IsInf(f.deadband, 0) || f.deadband < 0 {
+		return nil, status.Error(codes.InvalidArgument, "deadband must be a non-negative finite number")
+	}
+
+	if f.mode == "" {
+		f.mode = "latest"
+	}
+	if !downsampleModes[f.mode] {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported downsample %q, expected one of latest, avg, min, max", f.mode)
+	}
+	if f.mode != "latest" && f.interval == 0 {
+		return nil, status.Error(codes.InvalidArgument, "downsample requires min_interval")
+	}
+
+	return f, nil
+}
+
+// add passes update through the filter. Without an interval it returns update when it
+// is to be sent right away, otherwise update is kept for the next flush and nil is
+// returned.
+func (f *streamFilter) add(update *pb_data.ReadingUpdate) *pb_data.ReadingUpdate {
+	if f.interval == 0 {
+		if !f.allow(update) {
+			return nil
+		}
+		return update
+	}
+
+	w, ok := f.windows[update.SensorId]
+	if !ok {
+		w = &updateWindow{}
+		f.windows[update.SensorId] = w
+	}
+	w.add(update, f.mode)
+	return nil
+}
+
+// flush returns the combined updates of the current interval that pass the deadband,
+// ordered by sensor id, and starts a new interval.
+func (f *streamFilter) flush() []*pb_data.ReadingUpdate {
+	if len(f.windows) == 0 {
+		return nil
+	}
+
+	ids := make([]int64, 0, len(f.windows))
+	for id := range f.windows {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	updates := make([]*pb_data.ReadingUpdate, 0, len(ids))
+	for _, id := range ids {
+		update := f.windows[id].result(f.mode)
+		if f.allow(update) {
+			updates = append(updates, update)
+		}
+	}
+	f.windows = make(map[int64]*updateWindow)
+	return updates
+}
+
+// allow reports whether update passes the deadband and, if so, records it as sent.
+func (f *streamFilter) allow(update *pb_data.ReadingUpdate) bool {
+	if f.deadband > 0 && !f.changed(update) {
+		return false
+	}
+	f.sent[update.SensorId] = update
+	return true
+}
+
+// changed reports whether update differs from the last update sent for its sensor by
+// more than the deadband. A change of quality or of the set of channels always counts.
+func (f *streamFilter) changed(update *pb_data.ReadingUpdate) bool {
+	last, ok := f.sent[update.SensorId]
+	if !ok || last.Quality != update.Quality || len(last.Values) != len(update.Values) {
+		return true
+	}
+	if math.Abs(float64(update.Value)-float64(last.Value)) > f.deadband {
+		return true
+	}
+	for name, v := range update.Values {
+		prev, ok := last.Values[name]
+		if !ok || math.Abs(float64(v)-float64(prev)) > f.deadband {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *updateWindow) add(update *pb_data.ReadingUpdate, mode string) {
+	w.latest = update
+	if mode == "latest" {
+		return
+	}
+
+	w.value = combine(mode, w.value, float64(update.Value), w.count)
+	w.count++
+	for name, v := range update.Values {
+		if w.values == nil {
+			w.values = make(map[string]float64)
+			w.counts = make(map[string]int)
+		}
+		w.values[name] = combine(mode, w.values[name], float64(v), w.counts[name])
+		w.counts[name]++
+	}
+}
+
+// result returns the update of the window. Combined values are set on a copy of the
+// latest update, which carries the timestamp and quality.
+func (w *updateWindow) result(mode string) *pb_data.ReadingUpdate {
+	if mode == "latest" {
+		return w.latest
+	}
+
+	update := proto.Clone(w.latest).(*pb_data.ReadingUpdate)
+	update.Value = float32(finish(mode, w.value, w.count))
+	for name, v := range w.values {
+		if update.Values == nil {
+			update.Values = make(map[string]float32)
+		}
+		update.Values[name] = float32(finish(mode, v, w.counts[name]))
+	}
+	return update
+}
+
+// combine folds v into acc, which already combines n values.
+func combine(mode string, acc, v float64, n int) float64 {
+	if n == 0 {
+		return v
+	}
+	switch mode {
+	case "min":
+		return math.Min(acc, v)
+	case "max":
+		return math.Max(acc, v)
+	default:
+		return acc + v
+	}
+}
+
+// finish turns acc, which combines n values, into the value of the window.
+func finish(mode string, acc float64, n int) float64 {
+	if mode == "avg" && n > 0 {
+		return acc / float64(n)
+	}
+	return acc
+}
@@ -0,0 +1,79 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+)
+
+func TestNewStreamFilter(t *testing.T) {
+	f, err := newStreamFilter(&pb_data.StreamReadingsRequest{MinInterval: "500ms", Downsample: "avg"})
+	require.NoError(t, err)
+	assert.Equal(t, 500*time.Millisecond, f.interval)
+
+	for _, req := range []*pb_data.StreamReadingsRequest{
+		{MinInterval: "fast"},
+		{MinInterval: "10ms"},
+		{Deadband: -1},
+		{Downsample: "median", MinInterval: "1s"},
+		{Downsample: "avg"},
+	} {
+		_, err := newStreamFilter(req)
+		assert.Error(t, err, "%v", req)
+	}
+}
+
+func TestStreamFilterDeadband(t *testing.T) {
+	f, err := newStreamFilter(&pb_data.StreamReadingsRequest{Deadband: 0.5})
+	require.NoError(t, err)
+
+	assert.NotNil(t, f.add(&pb_data.ReadingUpdate{SensorId: 1, Value: 20}))
+	assert.Nil(t, f.add(&pb_data.ReadingUpdate{SensorId: 1, Value: 20.3}))
+	assert.Nil(t, f.add(&pb_data.ReadingUpdate{SensorId: 1, Value: 20.5}))
+	assert.NotNil(t, f.add(&pb_data.ReadingUpdate{SensorId: 1, Value: 20.6}), "drift since the last sent update")
+	assert.NotNil(t, f.add(&pb_data.ReadingUpdate{SensorId: 2, Value: 20.6}))
+	assert.NotNil(t, f.add(&pb_data.ReadingUpdate{SensorId: 1, Value: 20.6, Quality: "bad"}))
+
+	f.sent[3] = &pb_data.ReadingUpdate{SensorId: 3, Value: 20, Values: map[string]float32{"temperature": 20, "humidity": 40}}
+	assert.Nil(t, f.add(&pb_data.ReadingUpdate{SensorId: 3, Value: 20, Values: map[string]float32{"temperature": 20, "humidity": 40.2}}))
+	assert.NotNil(t, f.add(&pb_data.ReadingUpdate{SensorId: 3, Value: 20, Values: map[string]float32{"temperature": 20, "humidity": 41}}))
+}
+
+func TestStreamFilterDownsample(t *testing.T) {
+	updates := func(f *streamFilter) {
+		for _, v := range []float32{10, 30, 20} {
+			assert.Nil(t, f.add(&pb_data.ReadingUpdate{SensorId: 2, Value: v, Values: map[string]float32{"humidity": v * 2}}))
+		}
+		assert.Nil(t, f.add(&pb_data.ReadingUpdate{SensorId: 1, Value: 5}))
+	}
+
+	for mode, want := range map[string]float32{"latest": 20, "avg": 20, "min": 10, "max": 30} {
+		t.Run(mode, func(t *testing.T) {
+			f, err := newStreamFilter(&pb_data.StreamReadingsRequest{MinInterval: "1s", Downsample: mode})
+			require.NoError(t, err)
+
+			updates(f)
+			flushed := f.flush()
+			require.Len(t, flushed, 2)
+			assert.Equal(t, int64(1), flushed[0].SensorId)
+			assert.Equal(t, float32(5), flushed[0].Value)
+			assert.Equal(t, int64(2), flushed[1].SensorId)
+			assert.InDelta(t, want, flushed[1].Value, 1e-3)
+			assert.InDelta(t, want*2, flushed[1].Values["humidity"], 1e-3)
+
+			assert.Empty(t, f.flush())
+		})
+	}
+
+	shared := &pb_data.ReadingUpdate{SensorId: 1, Value: 10}
+	f, err := newStreamFilter(&pb_data.StreamReadingsRequest{MinInterval: "1s", Downsample: "max"})
+	require.NoError(t, err)
+	f.add(&pb_data.ReadingUpdate{SensorId: 1, Value: 20})
+	f.add(shared)
+	f.flush()
+	assert.Equal(t, float32(10), shared.Value, "shared update must not change")
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/units"
+)
+
+// parseTargetUnit parses the target_unit of a request.
+func parseTargetUnit(s string) (units.Targets, error) {
+	targets, err := units.ParseTargets(s)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid target_unit: %v", err)
+	}
+	return targets, nil
+}
+
+// channelUnit returns the unit of a channel, which defaults to the unit of the type.
+// An empty name is the value of a type without channels.
+func channelUnit(st *pb_sensor.SensorType, name string) string {
+	if st == nil {
+		return ""
+	}
+	for _, c := range st.Channels {
+		if c.Name == name && c.Unit != "" {
+			return c.Unit
+		}
+	}
+	return st.Unit
+}
+
+// valueUnit returns the unit of the value of a reading: the selected channel, or the
+// primary channel of a multi-channel type when none is selected.
+func valueUnit(st *pb_sensor.SensorType, channel string) string {
+	if st != nil && channel == "" && len(st.Channels) > 0 {
+		channel = st.Channels[0].Name
+	}
+	return channelUnit(st, channel)
+}
+
+// convertValues converts every channel value to the target of its unit's dimension.
+// The map is converted in place.
+func convertValues(st *pb_sensor.SensorType, values map[string]float32, targets units.Targets) {
+	for name, v := range values {
+		if src, dst, ok := targets.For(channelUnit(st, name)); ok {
+			values[name] = float32(src.Convert(float64(v), dst))
+		}
+	}
+}
+
+// convertDataPoints converts the points of a QueryReadings response in place and
+// returns the unit of their values. Counts are not converted and sums are converted
+// as a sum of count converted values.
+func convertDataPoints(points []*pb_data.DataPoint, st *pb_sensor.SensorType, channel, function string, targets units.Targets) string {
+	unit := valueUnit(st, channel)
+	src, dst, ok := targets.For(unit)
+	if ok {
+		unit = dst.Symbol
+	}
+
+	for _, p := range points {
+		convertValues(st, p.Values, targets)
+		convertValues(st, p.RawValues, targets)
+		if !ok || p.NoValue {
+			continue
+		}
+
+		a, b := src.Linear(dst)
+		conv := func(v float32) float32 { return float32(a*float64(v) + b) }
+		switch function {
+		case "":
+			p.Value = conv(p.Value)
+			p.RawValue = conv(p.RawValue)
+			continue
+		case "count":
+		case "sum":
+			p.Value = float32(a*float64(p.Value) + b*float64(p.Count))
+		default:
+			p.Value = conv(p.Value)
+		}
+		if p.Count > 0 {
+			p.Min = conv(p.Min)
+			p.Max = conv(p.Max)
+			p.Avg = conv(p.Avg)
+		}
+	}
+	return unit
+}
+
+// convertUpdate returns update with its values converted to the targets, or update
+// itself when nothing converts. Updates are shared between stream subscribers, so the
+// result is a copy.
+func convertUpdate(update *pb_data.ReadingUpdate, st *pb_sensor.SensorType, channel string, targets units.Targets) *pb_data.ReadingUpdate {
+	if len(targets) == 0 || st == nil {
+		return update
+	}
+
+	c := proto.Clone(update).(*pb_data.ReadingUpdate)
+	convertValues(st, c.Values, targets)
+	if src, dst, ok := targets.For(valueUnit(st, channel)); ok {
+		c.Value = float32(src.Convert(float64(c.Value), dst))
+		c.Unit = dst.Symbol
+	}
+	return c
+}
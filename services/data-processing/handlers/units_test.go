@@ -0,0 +1,89 @@
+package handlers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+)
+
+func TestConvertDataPoints(t *testing.T) {
+	celsius := &pb_sensor.SensorType{Unit: "°C"}
+	targets, err := parseTargetUnit("°F, psi")
+	require.NoError(t, err)
+
+	t.Run("Raw", func(t *testing.T) {
+		points := []*pb_data.DataPoint{{Value: 100, RawValue: 0}}
+		unit := convertDataPoints(points, celsius, "", "", targets)
+		assert.Equal(t, "°F", unit)
+		assert.InDelta(t, 212, points[0].Value, 1e-3)
+		assert.InDelta(t, 32, points[0].RawValue, 1e-3)
+	})
+
+	t.Run("Aggregates", func(t *testing.T) {
+		sum := []*pb_data.DataPoint{{Value: 30, Min: 10, Max: 20, Avg: 15, Count: 2}}
+		convertDataPoints(sum, celsius, "", "sum", targets)
+		assert.InDelta(t, 118, sum[0].Value, 1e-3)
+		assert.InDelta(t, 50, sum[0].Min, 1e-3)
+		assert.InDelta(t, 68, sum[0].Max, 1e-3)
+		assert.InDelta(t, 59, sum[0].Avg, 1e-3)
+
+		count := []*pb_data.DataPoint{{Value: 2, Min: 10, Max: 20, Avg: 15, Count: 2}}
+		convertDataPoints(count, celsius, "", "count", targets)
+		assert.Equal(t, float32(2), count[0].Value)
+
+		gap := []*pb_data.DataPoint{{NoValue: true, Gap: true}}
+		convertDataPoints(gap, celsius, "", "avg", targets)
+		assert.Zero(t, gap[0].Value)
+	})
+
+	t.Run("Channels", func(t *testing.T) {
+		bme := &pb_sensor.SensorType{
+			Unit: "°C",
+			Channels: []*pb_sensor.SensorChannel{
+				{Name: "temperature"},
+				{Name: "humidity", Unit: "%"},
+				{Name: "pressure", Unit: "hPa"},
+			},
+		}
+		points := []*pb_data.DataPoint{{
+			Value:  25,
+			Values: map[string]float32{"temperature": 25, "humidity": 40, "pressure": 1000},
+		}}
+		assert.Equal(t, "°F", convertDataPoints(points, bme, "", "", targets))
+		assert.InDelta(t, 77, points[0].Value, 1e-3)
+		assert.InDelta(t, 77, points[0].Values["temperature"], 1e-3)
+		assert.Equal(t, float32(40), points[0].Values["humidity"])
+		assert.InDelta(t, 14.5038, points[0].Values["pressure"], 1e-3)
+
+		humidity := []*pb_data.DataPoint{{Value: 40}}
+		assert.Equal(t, "%", convertDataPoints(humidity, bme, "humidity", "", targets))
+		assert.Equal(t, float32(40), humidity[0].Value)
+	})
+
+	t.Run("Unknown Unit", func(t *testing.T) {
+		points := []*pb_data.DataPoint{{Value: 5}}
+		assert.Equal(t, "ppm", convertDataPoints(points, &pb_sensor.SensorType{Unit: "ppm"}, "", "", targets))
+		assert.Equal(t, float32(5), points[0].Value)
+	})
+}
+
+func TestConvertUpdate(t *testing.T) {
+	st := &pb_sensor.SensorType{Unit: "°C"}
+	targets, err := parseTargetUnit("K")
+	require.NoError(t, err)
+
+	update := &pb_data.ReadingUpdate{SensorId: 1, Value: 20, Unit: "°C"}
+	converted := convertUpdate(update, st, "", targets)
+	assert.InDelta(t, 293.15, converted.Value, 1e-3)
+	assert.Equal(t, "K", converted.Unit)
+	assert.Equal(t, float32(20), update.Value, "shared update must not change")
+
+	assert.Same(t, update, convertUpdate(update, st, "", nil))
+
+	_, err = parseTargetUnit("°C,K")
+	assert.Error(t, err)
+}
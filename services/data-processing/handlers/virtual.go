@@ -0,0 +1,128 @@
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/expr"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/services"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+// errVirtualSensorWrite rejects readings sent for a virtual sensor.
+const errVirtualSensorWrite = "readings of virtual sensors are computed and cannot be stored"
+
+// derivation is one virtual sensor reading to compute.
+type derivation struct {
+	sensor *services.VirtualSensor
+	at     time.Time
+}
+
+// deriveReadings computes the readings of the virtual sensors that read any of the
+// given stored readings. Each virtual sensor is evaluated at the time of its input
+// reading, using the latest value of every other input at that time. Results are
+// stored and published like reported readings. Failures are logged, they never fail
+// the ingestion of the inputs.
+func (h *DataGrpcHandler) deriveReadings(ctx context.Context, inputs []storage.Reading) {
+	if h.virtualSensors == nil {
+		return
+	}
+
+	type key struct {
+		sensorID int64
+		at       int64
+	}
+	seen := make(map[key]bool)
+	var pending []derivation
+	for _, r := range inputs {
+		for _, v := range h.virtualSensors.Dependents(r.SensorID) {
+			k := key{sensorID: v.Sensor.Id, at: r.Timestamp.UnixMicro()}
+			if !seen[k] {
+				seen[k] = true
+				pending = append(pending, derivation{sensor: v, at: r.Timestamp})
+			}
+		}
+	}
+	if len(pending) == 0 {
+		return
+	}
+
+	readings := make([]storage.Reading, 0, len(pending))
+	for _, d := range pending {
+		if r, ok := h.evaluateVirtualSensor(ctx, d); ok {
+			readings = append(readings, r)
+		}
+	}
+	if len(readings) == 0 {
+		return
+	}
+
+	stored, err := h.store.StoreReadings(ctx, readings)
+	if err != nil {
+		logger.Error("Failed to store virtual sensor readings", zap.Int("count", len(readings)), zap.Error(err))
+		return
+	}
+
+	for _, ok := range stored {
+		if ok {
+			h.relay.Notify()
+			return
+		}
+	}
+}
+
+// evaluateVirtualSensor computes one virtual sensor reading. The reading is flagged as
+// suspect when any input is not good, and it is skipped when an input has no value
+// within virtualInputMaxAge or the result is rejected by the range of the sensor type.
+func (h *DataGrpcHandler) evaluateVirtualSensor(ctx context.Context, d derivation) (storage.Reading, bool) {
+	sensor := d.sensor.Sensor
+	inputs, err := h.store.ReadingsAt(ctx, d.sensor.Expr.SensorIDs(), d.at, h.virtualInputMaxAge)
+	if err != nil {
+		logger.Error("Failed to load virtual sensor inputs", zap.Int64("sensor_id", sensor.Id), zap.Error(err))
+		return storage.Reading{}, false
+	}
+
+	quality := storage.QualityGood
+	value, err := d.sensor.Expr.Eval(func(in expr.Input) (float64, bool) {
+		r, ok := inputs[in.SensorID]
+		if !ok {
+			return 0, false
+		}
+		if r.Quality != storage.QualityGood {
+			quality = storage.QualitySuspect
+		}
+		if in.Channel == "" {
+			return float64(r.Value), true
+		}
+		v, ok := r.Channels[in.Channel]
+		return float64(v), ok
+	})
+	if err != nil {
+		logger.Debug("Skipping virtual sensor reading",
+			zap.Int64("sensor_id", sensor.Id),
+			zap.Time("time", d.at),
+			zap.Error(err),
+		)
+		return storage.Reading{}, false
+	}
+
+	v, values, quality, channelQuality, err := applyChannels(sensor.SensorType, float32(value), nil, quality)
+	if err != nil {
+		logger.Debug("Rejected virtual sensor reading", zap.Int64("sensor_id", sensor.Id), zap.Error(err))
+		return storage.Reading{}, false
+	}
+
+	reading := storage.Reading{
+		SensorID:       sensor.Id,
+		Value:          v,
+		Timestamp:      d.at,
+		Quality:        quality,
+		Channels:       values,
+		ChannelQuality: channelQuality,
+	}
+	reading.Event = newReadingEvent(sensor, reading)
+	return reading, true
+}
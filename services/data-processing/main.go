@@ -1,10 +1,13 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"net"
 	"os"
+	"time"
+	_ "time/tzdata"
 
 	_ "github.com/lib/pq"
 	"go.uber.org/zap"
@@ -12,11 +15,11 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 
-	amqp "github.com/rabbitmq/amqp091-go"
-
 	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
 	"github.com/skni-kod/iot-monitor-backend/services/data-processing/handlers"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/services"
 	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
 )
 
@@ -75,41 +78,50 @@ func main() {
 	}
 	defer logger.Sync()
 
-	conn, err := amqp.Dial(rabbitMQURL)
+	compressAfter, err := durationEnv("DATA_COMPRESS_AFTER", "7d")
 	if err != nil {
-		logger.Fatal("Failed to connect to RabbitMQ", zap.Error(err))
+		logger.Fatal("Invalid DATA_COMPRESS_AFTER", zap.Error(err))
 	}
-	defer conn.Close()
 
-	ch, err := conn.Channel()
+	rawRetention, err := durationEnv("DATA_RAW_RETENTION", "0")
 	if err != nil {
-		logger.Fatal("Failed to open a channel", zap.Error(err))
-	}
-	defer ch.Close()
-
-	err = ch.ExchangeDeclare(
-		"readings_exchange", // name
-		"fanout",            // type
-		true,                // durable
-		false,               // auto-deleted
-		false,               // internal
-		false,               // no-wait
-		nil,                 // arguments
-	)
+		logger.Fatal("Invalid DATA_RAW_RETENTION", zap.Error(err))
+	}
+	if rawRetention > 0 && rawRetention < storage.MinRawRetention {
+		logger.Fatal("DATA_RAW_RETENTION is shorter than the minimum raw retention",
+			zap.Duration("raw_retention", rawRetention),
+			zap.Duration("min_raw_retention", storage.MinRawRetention),
+		)
+	}
+
+	duplicatePolicy, err := storage.ParseDuplicatePolicy(os.Getenv("DATA_DUPLICATE_POLICY"))
 	if err != nil {
-		logger.Fatal("Failed to declare exchange", zap.Error(err))
+		logger.Fatal("Invalid DATA_DUPLICATE_POLICY", zap.Error(err))
+	}
+
+	retentionInterval, err := durationEnv("DATA_RETENTION_JOB_INTERVAL", "1h")
+	if err != nil || retentionInterval <= 0 {
+		logger.Fatal("Invalid DATA_RETENTION_JOB_INTERVAL", zap.Error(err))
+	}
+
+	virtualRefresh, err := durationEnv("DATA_VIRTUAL_SENSOR_REFRESH", "1m")
+	if err != nil || virtualRefresh <= 0 {
+		logger.Fatal("Invalid DATA_VIRTUAL_SENSOR_REFRESH", zap.Error(err))
+	}
+
+	virtualInputMaxAge, err := durationEnv("DATA_VIRTUAL_INPUT_MAX_AGE", "15m")
+	if err != nil || virtualInputMaxAge <= 0 {
+		logger.Fatal("Invalid DATA_VIRTUAL_INPUT_MAX_AGE", zap.Error(err))
+	}
+
+	importJobLease, err := durationEnv("DATA_IMPORT_JOB_LEASE", "1m")
+	if err != nil || importJobLease <= 0 {
+		logger.Fatal("Invalid DATA_IMPORT_JOB_LEASE", zap.Error(err))
 	}
 
-	q, err := ch.QueueDeclare(
-		"readings_queue", // name
-		false,            // durable
-		true,             // delete when unused
-		true,             // exclusive
-		false,            // no-wait
-		nil,              // arguments
-	)
+	sensorCacheTTL, err := durationEnv("DATA_SENSOR_CACHE_TTL", "5m")
 	if err != nil {
-		logger.Fatal("Failed to declare queue", zap.Error(err))
+		logger.Fatal("Invalid DATA_SENSOR_CACHE_TTL", zap.Error(err))
 	}
 
 	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
@@ -142,12 +154,49 @@ func main() {
 		)
 	}
 
+	dataStore := storage.NewTimescaleStorage(db, duplicatePolicy)
+	if err := dataStore.Migrate(context.Background(), storage.SchemaConfig{CompressAfter: compressAfter}); err != nil {
+		logger.Fatal("Failed to migrate database schema", zap.Error(err))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	services.NewRetentionService(dataStore, sensorClient, retentionInterval, rawRetention).Start(ctx)
+	services.NewImportLeaseService(dataStore, importJobLease).Start(ctx)
+
+	relay := outbox.NewRelay(dataStore, outbox.Config{
+		URL:       rabbitMQURL,
+		Exchanges: []string{services.ReadingsExchange},
+	})
+	relay.Start(ctx)
+
+	hub := services.NewReadingHub(rabbitMQURL)
+	hub.Start(ctx)
+
+	sensorCache := services.NewSensorCache(sensorClient, rabbitMQURL, sensorCacheTTL)
+	sensorCache.Start(ctx)
+
+	virtualSensors := services.NewVirtualSensorIndex(sensorClient, virtualRefresh)
+	virtualSensors.Start(ctx)
+
 	grpcServer := grpc.NewServer()
-	dataStore := storage.NewTimescaleStorage(db)
-	handlers.NewDataGrpcHandler(grpcServer, dataStore, sensorClient, ch, q)
+	handlers.NewDataGrpcHandler(grpcServer, dataStore, sensorClient, sensorCache, relay, hub, virtualSensors, virtualInputMaxAge)
 
 	logger.Info("Starting Data Service gRPC server on port", zap.String("port", grpcPort))
 	if err := grpcServer.Serve(lis); err != nil {
 		logger.Fatal("Failed to serve", zap.Error(err))
 	}
 }
+
+// durationEnv reads an interval such as "12h" or "30d" from the environment. "0" disables the setting.
+func durationEnv(key, fallback string) (time.Duration, error) {
+	v := os.Getenv(key)
+	if v == "" {
+		v = fallback
+	}
+	if v == "0" {
+		return 0, nil
+	}
+	return storage.ParseInterval(v)
+}
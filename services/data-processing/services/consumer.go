@@ -0,0 +1,107 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"go.uber.org/zap"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
+)
+
+// maxReconnectBackoff caps the delay between attempts to reach RabbitMQ.
+const maxReconnectBackoff = time.Minute
+
+// exchangeConsumer receives every message published to a fanout exchange through a
+// server-named, exclusive queue, so every replica gets its own copy. The queue goes
+// away with the connection, which is re-established with backoff.
+type exchangeConsumer struct {
+	url      string
+	exchange string
+	// connected is called once the queue is bound, before the first delivery of a
+	// connection. Messages published while disconnected are lost.
+	connected func()
+	handle    func(body []byte)
+}
+
+// run consumes the exchange until ctx is done.
+func (c *exchangeConsumer) run(ctx context.Context) {
+	attempts := 0
+	for {
+		established, err := c.consume(ctx)
+		if ctx.Err() != nil {
+			logger.Info("Context cancelled, stopping consumer", zap.String("exchange", c.exchange))
+			return
+		}
+		if established {
+			attempts = 0
+		}
+
+		attempts++
+		delay := outbox.Backoff(attempts, maxReconnectBackoff)
+		logger.Warn("Cannot consume from RabbitMQ",
+			zap.String("exchange", c.exchange),
+			zap.Duration("retry_in", delay),
+			zap.Error(err),
+		)
+
+		select {
+		case <-ctx.Done():
+			logger.Info("Context cancelled, stopping consumer", zap.String("exchange", c.exchange))
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// consume handles the deliveries of one connection. It reports whether the
+// connection was established before it failed.
+func (c *exchangeConsumer) consume(ctx context.Context) (bool, error) {
+	conn, err := amqp.Dial(c.url)
+	if err != nil {
+		return false, fmt.Errorf("dial: %w", err)
+	}
+	defer conn.Close()
+
+	ch, err := conn.Channel()
+	if err != nil {
+		return false, fmt.Errorf("open channel: %w", err)
+	}
+	defer ch.Close()
+
+	if err := ch.ExchangeDeclare(c.exchange, "fanout", true, false, false, false, nil); err != nil {
+		return false, fmt.Errorf("declare exchange: %w", err)
+	}
+	q, err := ch.QueueDeclare("", false, true, true, false, nil)
+	if err != nil {
+		return false, fmt.Errorf("declare queue: %w", err)
+	}
+	if err := ch.QueueBind(q.Name, "", c.exchange, false, nil); err != nil {
+		return false, fmt.Errorf("bind queue: %w", err)
+	}
+	msgs, err := ch.Consume(q.Name, "", true, true, false, false, nil)
+	if err != nil {
+		return false, fmt.Errorf("consume: %w", err)
+	}
+
+	logger.Info("Consuming from RabbitMQ", zap.String("exchange", c.exchange), zap.String("queue", q.Name))
+	if c.connected != nil {
+		c.connected()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, nil
+		case d, ok := <-msgs:
+			if !ok {
+				return true, errors.New("delivery channel closed")
+			}
+			c.handle(d.Body)
+		}
+	}
+}
@@ -0,0 +1,213 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+// ReadingsExchange receives every stored reading, mainly for alert evaluation and
+// live streams.
+const ReadingsExchange = "readings_exchange"
+
+const (
+	// subscriptionBuffer is how many updates a subscriber may fall behind before
+	// further updates are dropped for it.
+	subscriptionBuffer = 100
+	// dropReportInterval is how often subscribers that dropped updates are reported.
+	dropReportInterval = time.Minute
+)
+
+// ReadingEvent is the readings_exchange payload of a stored reading.
+type ReadingEvent struct {
+	SensorId   int64     `json:"sensor_id"`
+	Value      float64   `json:"value"`
+	Timestamp  time.Time `json:"timestamp"`
+	SensorName string    `json:"sensor_name,omitempty"`
+	Location   string    `json:"location,omitempty"`
+	Unit       string    `json:"unit,omitempty"`
+	Quality    string    `json:"quality,omitempty"`
+	// Values carries every channel of a multi-channel sample, and ChannelQuality the
+	// quality of each of them.
+	Values         map[string]float32 `json:"values,omitempty"`
+	ChannelQuality map[string]string  `json:"channel_quality,omitempty"`
+}
+
+// Update returns the event as a stream update.
+func (e *ReadingEvent) Update() *pb_data.ReadingUpdate {
+	return &pb_data.ReadingUpdate{
+		SensorId:   e.SensorId,
+		Value:      float32(e.Value),
+		Timestamp:  timestamppb.New(e.Timestamp),
+		SensorName: e.SensorName,
+		Location:   e.Location,
+		Unit:       e.Unit,
+		Quality:    e.Quality,
+		Values:     e.Values,
+	}
+}
+
+type IReadingHub interface {
+	Start(ctx context.Context)
+	// Subscribe registers a subscriber for the readings of sensorIDs.
+	Subscribe(sensorIDs []int64) *Subscription
+	// Unsubscribe removes the subscriber and closes its channel.
+	Unsubscribe(sub *Subscription)
+}
+
+// Subscription receives the updates of the sensors it was registered for on C.
+// Updates are dropped, and counted, while C is full.
+type Subscription struct {
+	ID uint64
+	C  <-chan *pb_data.ReadingUpdate
+
+	ch        chan *pb_data.ReadingUpdate
+	sensorIDs []int64
+	// dropped counts the updates dropped since the last report, total those dropped
+	// over the lifetime of the subscription.
+	dropped atomic.Int64
+	total   atomic.Int64
+}
+
+// ReadingHub fans the readings published to readings_exchange out to stream
+// subscribers. Every replica consumes the exchange through its own exclusive queue,
+// so subscribers see the readings stored by any replica.
+type ReadingHub struct {
+	url    string
+	nextID atomic.Uint64
+
+	mu            sync.RWMutex
+	subscriptions map[uint64]*Subscription
+	bySensor      map[int64]map[uint64]*Subscription
+}
+
+func NewReadingHub(url string) IReadingHub {
+	return &ReadingHub{
+		url:           url,
+		subscriptions: make(map[uint64]*Subscription),
+		bySensor:      make(map[int64]map[uint64]*Subscription),
+	}
+}
+
+func (x *ReadingHub) Start(ctx context.Context) {
+	consumer := &exchangeConsumer{
+		url:      x.url,
+		exchange: ReadingsExchange,
+		handle:   x.handle,
+	}
+	go consumer.run(ctx)
+
+	ticker := time.NewTicker(dropReportInterval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				x.reportDrops()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (x *ReadingHub) Subscribe(sensorIDs []int64) *Subscription {
+	ch := make(chan *pb_data.ReadingUpdate, subscriptionBuffer)
+	sub := &Subscription{
+		ID:        x.nextID.Add(1),
+		C:         ch,
+		ch:        ch,
+		sensorIDs: sensorIDs,
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.subscriptions[sub.ID] = sub
+	for _, id := range sensorIDs {
+		subs, ok := x.bySensor[id]
+		if !ok {
+			subs = make(map[uint64]*Subscription)
+			x.bySensor[id] = subs
+		}
+		subs[sub.ID] = sub
+	}
+	return sub
+}
+
+func (x *ReadingHub) Unsubscribe(sub *Subscription) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if _, ok := x.subscriptions[sub.ID]; !ok {
+		return
+	}
+	delete(x.subscriptions, sub.ID)
+	for _, id := range sub.sensorIDs {
+		delete(x.bySensor[id], sub.ID)
+		if len(x.bySensor[id]) == 0 {
+			delete(x.bySensor, id)
+		}
+	}
+	close(sub.ch)
+
+	if total := sub.total.Load(); total > 0 {
+		logger.Warn("Stream subscriber dropped updates",
+			zap.Uint64("subscription_id", sub.ID),
+			zap.Int("sensors", len(sub.sensorIDs)),
+			zap.Int64("dropped_total", total),
+		)
+	}
+}
+
+// Publish delivers update to the subscribers of its sensor without blocking.
+func (x *ReadingHub) Publish(update *pb_data.ReadingUpdate) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	for _, sub := range x.bySensor[update.SensorId] {
+		select {
+		case sub.ch <- update:
+		default:
+			sub.dropped.Add(1)
+			sub.total.Add(1)
+		}
+	}
+}
+
+// reportDrops logs the subscribers that dropped updates since the last report. They
+// consume slower than their sensors report.
+func (x *ReadingHub) reportDrops() {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	for _, sub := range x.subscriptions {
+		if dropped := sub.dropped.Swap(0); dropped > 0 {
+			logger.Warn("Slow stream subscriber is dropping updates",
+				zap.Uint64("subscription_id", sub.ID),
+				zap.Int("sensors", len(sub.sensorIDs)),
+				zap.Int64("dropped", dropped),
+				zap.Int64("dropped_total", sub.total.Load()),
+				zap.Duration("interval", dropReportInterval),
+			)
+		}
+	}
+}
+
+// handle publishes a readings_exchange message.
+func (x *ReadingHub) handle(body []byte) {
+	var event ReadingEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		logger.Warn("Failed to decode reading event", zap.Error(err))
+		return
+	}
+	x.Publish(event.Update())
+}
@@ -0,0 +1,92 @@
+package services
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(logger.Config{
+		Level:       "info",
+		Environment: "development",
+		ServiceName: "data-processing-test",
+		OutputPaths: []string{"stdout"},
+	})
+	m.Run()
+}
+
+func TestReadingHubPublish(t *testing.T) {
+	hub := NewReadingHub("").(*ReadingHub)
+
+	a := hub.Subscribe([]int64{1, 2})
+	b := hub.Subscribe([]int64{2})
+	assert.NotEqual(t, a.ID, b.ID)
+
+	hub.Publish(&pb_data.ReadingUpdate{SensorId: 1, Value: 10})
+	hub.Publish(&pb_data.ReadingUpdate{SensorId: 2, Value: 20})
+	hub.Publish(&pb_data.ReadingUpdate{SensorId: 3, Value: 30})
+
+	require.Len(t, a.C, 2)
+	assert.Equal(t, int64(1), (<-a.C).SensorId)
+	assert.Equal(t, int64(2), (<-a.C).SensorId)
+	require.Len(t, b.C, 1)
+	assert.Equal(t, int64(2), (<-b.C).SensorId)
+
+	hub.Unsubscribe(a)
+	_, open := <-a.C
+	assert.False(t, open)
+	assert.NotContains(t, hub.bySensor, int64(1))
+	assert.Contains(t, hub.bySensor, int64(2))
+
+	hub.Unsubscribe(a)
+	hub.Unsubscribe(b)
+	assert.Empty(t, hub.bySensor)
+	assert.Empty(t, hub.subscriptions)
+}
+
+func TestReadingHubDropsWhenFull(t *testing.T) {
+	hub := NewReadingHub("").(*ReadingHub)
+	slow := hub.Subscribe([]int64{1})
+
+	for i := 0; i < subscriptionBuffer+5; i++ {
+		hub.Publish(&pb_data.ReadingUpdate{SensorId: 1, Value: float32(i)})
+	}
+
+	assert.Len(t, slow.C, subscriptionBuffer)
+	assert.Equal(t, int64(5), slow.dropped.Load())
+	assert.Equal(t, int64(5), slow.total.Load())
+
+	hub.reportDrops()
+	assert.Zero(t, slow.dropped.Load())
+	assert.Equal(t, int64(5), slow.total.Load())
+}
+
+func TestReadingEventUpdate(t *testing.T) {
+	ts := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	body, err := json.Marshal(ReadingEvent{
+		SensorId:  7,
+		Value:     21.5,
+		Timestamp: ts,
+		Unit:      "°C",
+		Quality:   "good",
+		Values:    map[string]float32{"humidity": 40},
+	})
+	require.NoError(t, err)
+
+	var event ReadingEvent
+	require.NoError(t, json.Unmarshal(body, &event))
+	update := event.Update()
+	assert.Equal(t, int64(7), update.SensorId)
+	assert.Equal(t, float32(21.5), update.Value)
+	assert.Equal(t, ts, update.Timestamp.AsTime())
+	assert.Equal(t, "°C", update.Unit)
+	assert.Equal(t, "good", update.Quality)
+	assert.Equal(t, float32(40), update.Values["humidity"])
+}
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+type IImportLeaseService interface {
+	Start(ctx context.Context)
+}
+
+// ImportLeaseService keeps the leases of the import jobs run by this instance alive
+// and fails the jobs of instances that stopped renewing theirs. Replicas share the
+// import_jobs table, so a job is only given up once its lease has expired.
+type ImportLeaseService struct {
+	store storage.ITimeScaleStorage
+	lease time.Duration
+}
+
+func NewImportLeaseService(store storage.ITimeScaleStorage, lease time.Duration) IImportLeaseService {
+	return &ImportLeaseService{store: store, lease: lease}
+}
+
+// Start renews the leases three times per lease period, so a single missed renewal
+// does not expire them.
+func (s *ImportLeaseService) Start(ctx context.Context) {
+	ticker := time.NewTicker(s.lease / 3)
+
+	go func() {
+		defer ticker.Stop()
+		logger.Info("Started import lease job", zap.Duration("lease", s.lease))
+
+		s.renew(ctx)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.renew(ctx)
+			case <-ctx.Done():
+				logger.Info("Context cancelled, stopping import lease job")
+				return
+			}
+		}
+	}()
+}
+
+func (s *ImportLeaseService) renew(ctx context.Context) {
+	if _, err := s.store.RenewImportJobLeases(ctx); err != nil {
+		logger.Error("Failed to renew import job leases", zap.Error(err))
+	}
+
+	failed, err := s.store.FailExpiredImportJobs(ctx, s.lease)
+	if err != nil {
+		logger.Error("Failed to fail expired import jobs", zap.Error(err))
+		return
+	}
+	if failed > 0 {
+		logger.Warn("Marked interrupted import jobs as failed", zap.Int64("count", failed))
+	}
+}
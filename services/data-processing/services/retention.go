@@ -0,0 +1,162 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/data-processing/storage"
+)
+
+type IRetentionService interface {
+	Start(ctx context.Context)
+}
+
+// RetentionService periodically deletes raw readings that fall outside the configured
+// retention. Rollups are kept, so aggregated queries still cover the deleted range.
+// Expired idempotency keys are purged on the same schedule.
+type RetentionService struct {
+	store        storage.ITimeScaleStorage
+	sensorClient pb_sensor.SensorServiceClient
+	interval     time.Duration
+	// defaultRetention applies to sensors without a policy. Zero keeps their readings forever.
+	defaultRetention time.Duration
+}
+
+func NewRetentionService(store storage.ITimeScaleStorage, sensorClient pb_sensor.SensorServiceClient, interval, defaultRetention time.Duration) IRetentionService {
+	return &RetentionService{
+		store:            store,
+		sensorClient:     sensorClient,
+		interval:         interval,
+		defaultRetention: defaultRetention,
+	}
+}
+
+func (r *RetentionService) Start(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+
+	go func() {
+		defer ticker.Stop()
+		logger.Info("Started retention job", zap.Duration("interval", r.interval))
+
+		r.enforce(ctx)
+
+		for {
+			select {
+			case <-ticker.C:
+				r.enforce(ctx)
+			case <-ctx.Done():
+				logger.Info("Context cancelled, stopping retention job")
+				return
+			}
+		}
+	}()
+}
+
+func (r *RetentionService) enforce(ctx context.Context) {
+	r.purgeIdempotencyKeys(ctx)
+
+	policies, err := r.store.ListRetentionPolicies(ctx)
+	if err != nil {
+		logger.Error("Failed to list retention policies", zap.Error(err))
+		return
+	}
+
+	retention, complete := r.resolve(ctx, policies)
+
+	bySpan := make(map[time.Duration][]int64)
+	covered := make([]int64, 0, len(retention))
+	for sensorID, d := range retention {
+		bySpan[d] = append(bySpan[d], sensorID)
+		covered = append(covered, sensorID)
+	}
+
+	now := time.Now()
+	for d, sensorIDs := range bySpan {
+		deleted, err := r.store.DeleteReadingsBefore(ctx, sensorIDs, now.Add(-d))
+		if err != nil {
+			logger.Error("Failed to apply retention policy", zap.Duration("raw_retention", d), zap.Error(err))
+			continue
+		}
+		if deleted > 0 {
+			logger.Info("Deleted expired readings",
+				zap.Duration("raw_retention", d),
+				zap.Int("sensors", len(sensorIDs)),
+				zap.Int64("rows", deleted),
+			)
+		}
+	}
+
+	if r.defaultRetention <= 0 {
+		return
+	}
+	if !complete {
+		// Sensors of an unresolved sensor type would otherwise fall back to the default.
+		logger.Warn("Skipping default retention, not every sensor type policy could be resolved")
+		return
+	}
+
+	deleted, err := r.store.DeleteReadingsBeforeExcept(ctx, covered, now.Add(-r.defaultRetention))
+	if err != nil {
+		logger.Error("Failed to apply default retention", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		logger.Info("Deleted expired readings",
+			zap.Duration("raw_retention", r.defaultRetention),
+			zap.Int64("rows", deleted),
+		)
+	}
+}
+
+func (r *RetentionService) purgeIdempotencyKeys(ctx context.Context) {
+	deleted, err := r.store.DeleteIdempotencyKeysBefore(ctx, time.Now().Add(-storage.IdempotencyKeyTTL))
+	if err != nil {
+		logger.Error("Failed to purge idempotency keys", zap.Error(err))
+		return
+	}
+	if deleted > 0 {
+		logger.Debug("Purged expired idempotency keys", zap.Int64("rows", deleted))
+	}
+}
+
+// resolve maps every sensor covered by a policy to its raw retention. Sensor type
+// policies are expanded through the sensor service and are overridden by sensor policies.
+// complete is false when a sensor type could not be expanded.
+func (r *RetentionService) resolve(ctx context.Context, policies []*storage.RetentionPolicy) (retention map[int64]time.Duration, complete bool) {
+	retention = make(map[int64]time.Duration)
+	complete = true
+
+	for _, p := range policies {
+		if p.Scope != storage.RetentionScopeSensorType {
+			continue
+		}
+
+		lookupCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		res, err := r.sensorClient.ListSensors(lookupCtx, &pb_sensor.ListSensorsRequest{SensorTypeId: p.TargetID})
+		cancel()
+		if err != nil {
+			logger.Error("Failed to list sensors for retention policy",
+				zap.Int64("sensor_type_id", p.TargetID),
+				zap.Error(err),
+			)
+			complete = false
+			continue
+		}
+
+		for _, s := range res.Sensors {
+			retention[s.Id] = p.RawRetention
+		}
+	}
+
+	for _, p := range policies {
+		if p.Scope == storage.RetentionScopeSensor {
+			retention[p.TargetID] = p.RawRetention
+		}
+	}
+
+	return retention, complete
+}
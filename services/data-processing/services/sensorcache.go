@@ -0,0 +1,241 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+// SensorsExchange receives an event from the sensor service whenever a sensor, its
+// type or its calibrations change.
+const SensorsExchange = "sensors_exchange"
+
+// maxGetSensors is the most sensors one GetSensors call looks up.
+const maxGetSensors = 1000
+
+// SensorEvent is the sensors_exchange payload. It names the sensor, or the sensor type
+// whose sensors, changed.
+type SensorEvent struct {
+	SensorID     int64 `json:"sensor_id,omitempty"`
+	SensorTypeID int64 `json:"sensor_type_id,omitempty"`
+	Deleted      bool  `json:"deleted,omitempty"`
+}
+
+type ISensorCache interface {
+	Start(ctx context.Context)
+	// Get returns a sensor. Errors of the sensor service are returned unchanged and a
+	// missing sensor is reported as codes.NotFound.
+	Get(ctx context.Context, id int64) (*pb_sensor.Sensor, error)
+	// GetMany returns the sensors among ids that exist, looking up the ones not cached
+	// in bulk.
+	GetMany(ctx context.Context, ids []int64) (map[int64]*pb_sensor.Sensor, error)
+}
+
+type cachedSensor struct {
+	sensor  *pb_sensor.Sensor
+	expires time.Time
+}
+
+// SensorCache keeps the sensors looked up by the data service, with their types and
+// calibrations, for up to ttl. Changes announced on sensors_exchange evict the affected
+// sensors right away, so the ttl only bounds staleness when events are lost. Cached
+// sensors are shared and must not be modified. A zero ttl disables caching.
+type SensorCache struct {
+	sensorClient pb_sensor.SensorServiceClient
+	url          string
+	ttl          time.Duration
+
+	mu      sync.RWMutex
+	sensors map[int64]cachedSensor
+	// generation is bumped by every eviction. Lookups that started before an eviction
+	// do not fill the cache, as they may have read the sensor before it changed.
+	generation uint64
+}
+
+func NewSensorCache(sensorClient pb_sensor.SensorServiceClient, url string, ttl time.Duration) ISensorCache {
+	return &SensorCache{
+		sensorClient: sensorClient,
+		url:          url,
+		ttl:          ttl,
+		sensors:      make(map[int64]cachedSensor),
+	}
+}
+
+func (x *SensorCache) Start(ctx context.Context) {
+	if x.ttl <= 0 {
+		return
+	}
+
+	consumer := &exchangeConsumer{
+		url:      x.url,
+		exchange: SensorsExchange,
+		// Events published while disconnected are lost, so nothing cached before can
+		// be trusted.
+		connected: x.clear,
+		handle:    x.handle,
+	}
+	go consumer.run(ctx)
+
+	ticker := time.NewTicker(x.ttl)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				x.sweep()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (x *SensorCache) Get(ctx context.Context, id int64) (*pb_sensor.Sensor, error) {
+	if sensor, ok := x.cached(id); ok {
+		return sensor, nil
+	}
+
+	generation := x.currentGeneration()
+	res, err := x.sensorClient.GetSensor(ctx, &pb_sensor.GetSensorRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+	if res.Sensor == nil {
+		return nil, status.Errorf(codes.NotFound, "sensor %d not found", id)
+	}
+
+	x.fill(generation, res.Sensor)
+	return res.Sensor, nil
+}
+
+func (x *SensorCache) GetMany(ctx context.Context, ids []int64) (map[int64]*pb_sensor.Sensor, error) {
+	sensors := make(map[int64]*pb_sensor.Sensor, len(ids))
+	var missing []int64
+	for _, id := range ids {
+		if _, seen := sensors[id]; seen {
+			continue
+		}
+		if sensor, ok := x.cached(id); ok {
+			sensors[id] = sensor
+			continue
+		}
+		sensors[id] = nil
+		missing = append(missing, id)
+	}
+
+	generation := x.currentGeneration()
+	for start := 0; start < len(missing); start += maxGetSensors {
+		end := min(start+maxGetSensors, len(missing))
+		res, err := x.sensorClient.GetSensors(ctx, &pb_sensor.GetSensorsRequest{Ids: missing[start:end]})
+		if err != nil {
+			return nil, err
+		}
+		x.fill(generation, res.Sensors...)
+		for _, sensor := range res.Sensors {
+			sensors[sensor.Id] = sensor
+		}
+	}
+
+	for id, sensor := range sensors {
+		if sensor == nil {
+			delete(sensors, id)
+		}
+	}
+	return sensors, nil
+}
+
+func (x *SensorCache) cached(id int64) (*pb_sensor.Sensor, bool) {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+
+	entry, ok := x.sensors[id]
+	if !ok || time.Now().After(entry.expires) {
+		return nil, false
+	}
+	return entry.sensor, true
+}
+
+func (x *SensorCache) currentGeneration() uint64 {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.generation
+}
+
+// fill caches sensors looked up at generation unless something was evicted since.
+func (x *SensorCache) fill(generation uint64, sensors ...*pb_sensor.Sensor) {
+	if x.ttl <= 0 {
+		return
+	}
+
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if generation != x.generation {
+		return
+	}
+	expires := time.Now().Add(x.ttl)
+	for _, sensor := range sensors {
+		x.sensors[sensor.Id] = cachedSensor{sensor: sensor, expires: expires}
+	}
+}
+
+// Evict drops the sensors named by event.
+func (x *SensorCache) Evict(event SensorEvent) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.generation++
+	if event.SensorID > 0 {
+		delete(x.sensors, event.SensorID)
+	}
+	if event.SensorTypeID > 0 {
+		for id, entry := range x.sensors {
+			if entry.sensor.SensorTypeId == event.SensorTypeID {
+				delete(x.sensors, id)
+			}
+		}
+	}
+}
+
+func (x *SensorCache) clear() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.generation++
+	x.sensors = make(map[int64]cachedSensor)
+}
+
+// sweep drops expired sensors, which are otherwise only replaced when looked up again.
+func (x *SensorCache) sweep() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	now := time.Now()
+	for id, entry := range x.sensors {
+		if now.After(entry.expires) {
+			delete(x.sensors, id)
+		}
+	}
+}
+
+// handle evicts the sensors named by a sensors_exchange message.
+func (x *SensorCache) handle(body []byte) {
+	var event SensorEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		logger.Warn("Failed to decode sensor event", zap.Error(err))
+		return
+	}
+	logger.Debug("Evicting changed sensors from cache",
+		zap.Int64("sensor_id", event.SensorID),
+		zap.Int64("sensor_type_id", event.SensorTypeID),
+	)
+	x.Evict(event)
+}
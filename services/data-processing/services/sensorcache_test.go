@@ -0,0 +1,114 @@
+package services
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+)
+
+// fakeSensorClient serves sensors from a map and counts the lookups.
+type fakeSensorClient struct {
+	pb_sensor.SensorServiceClient
+	sensors map[int64]*pb_sensor.Sensor
+	calls   int
+}
+
+func (c *fakeSensorClient) GetSensor(_ context.Context, req *pb_sensor.GetSensorRequest, _ ...grpc.CallOption) (*pb_sensor.GetSensorResponse, error) {
+	c.calls++
+	sensor, ok := c.sensors[req.Id]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "sensor not found")
+	}
+	return &pb_sensor.GetSensorResponse{Sensor: sensor}, nil
+}
+
+func (c *fakeSensorClient) GetSensors(_ context.Context, req *pb_sensor.GetSensorsRequest, _ ...grpc.CallOption) (*pb_sensor.GetSensorsResponse, error) {
+	c.calls++
+	res := &pb_sensor.GetSensorsResponse{}
+	for _, id := range req.Ids {
+		if sensor, ok := c.sensors[id]; ok {
+			res.Sensors = append(res.Sensors, sensor)
+		}
+	}
+	return res, nil
+}
+
+func newFakeSensorClient() *fakeSensorClient {
+	return &fakeSensorClient{sensors: map[int64]*pb_sensor.Sensor{
+		1: {Id: 1, Name: "a", SensorTypeId: 10},
+		2: {Id: 2, Name: "b", SensorTypeId: 10},
+		3: {Id: 3, Name: "c", SensorTypeId: 20},
+	}}
+}
+
+func TestSensorCacheGet(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeSensorClient()
+	cache := NewSensorCache(client, "", time.Minute).(*SensorCache)
+
+	sensor, err := cache.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "a", sensor.Name)
+	_, err = cache.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 1, client.calls)
+
+	_, err = cache.Get(ctx, 9)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	client.sensors[1] = &pb_sensor.Sensor{Id: 1, Name: "renamed", SensorTypeId: 10}
+	cache.Evict(SensorEvent{SensorID: 1})
+	sensor, err = cache.Get(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "renamed", sensor.Name)
+}
+
+func TestSensorCacheGetMany(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeSensorClient()
+	cache := NewSensorCache(client, "", time.Minute).(*SensorCache)
+
+	_, err := cache.Get(ctx, 1)
+	require.NoError(t, err)
+
+	sensors, err := cache.GetMany(ctx, []int64{1, 2, 2, 3, 9})
+	require.NoError(t, err)
+	assert.Len(t, sensors, 3)
+	assert.NotContains(t, sensors, int64(9))
+	assert.Equal(t, 2, client.calls, "missing sensors are looked up in one call")
+
+	cache.Evict(SensorEvent{SensorTypeID: 10})
+	assert.NotContains(t, cache.sensors, int64(1))
+	assert.NotContains(t, cache.sensors, int64(2))
+	assert.Contains(t, cache.sensors, int64(3))
+}
+
+func TestSensorCacheSkipsFillAfterEviction(t *testing.T) {
+	cache := NewSensorCache(newFakeSensorClient(), "", time.Minute).(*SensorCache)
+
+	generation := cache.currentGeneration()
+	cache.Evict(SensorEvent{SensorID: 1})
+	cache.fill(generation, &pb_sensor.Sensor{Id: 1})
+	assert.Empty(t, cache.sensors)
+}
+
+func TestSensorCacheDisabled(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeSensorClient()
+	cache := NewSensorCache(client, "", 0).(*SensorCache)
+
+	for range 2 {
+		_, err := cache.Get(ctx, 1)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 2, client.calls)
+	assert.Empty(t, cache.sensors)
+}
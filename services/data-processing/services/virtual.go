@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	pb_sensor "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/expr"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+// SensorKindVirtual marks sensors whose readings are computed from an expression.
+const SensorKindVirtual = "virtual"
+
+// VirtualSensor is an active virtual sensor with its parsed expression.
+type VirtualSensor struct {
+	Sensor *pb_sensor.Sensor
+	Expr   *expr.Expr
+}
+
+type IVirtualSensorIndex interface {
+	Start(ctx context.Context)
+	// Dependents returns the virtual sensors whose expression reads the sensor.
+	Dependents(sensorID int64) []*VirtualSensor
+}
+
+// VirtualSensorIndex keeps the active virtual sensors indexed by their input sensors.
+// It is reloaded from the sensor service periodically, so new or changed virtual
+// sensors are picked up within one interval.
+type VirtualSensorIndex struct {
+	sensorClient pb_sensor.SensorServiceClient
+	interval     time.Duration
+
+	mu      sync.RWMutex
+	byInput map[int64][]*VirtualSensor
+}
+
+func NewVirtualSensorIndex(sensorClient pb_sensor.SensorServiceClient, interval time.Duration) IVirtualSensorIndex {
+	return &VirtualSensorIndex{
+		sensorClient: sensorClient,
+		interval:     interval,
+		byInput:      make(map[int64][]*VirtualSensor),
+	}
+}
+
+func (x *VirtualSensorIndex) Start(ctx context.Context) {
+	x.refresh(ctx)
+
+	ticker := time.NewTicker(x.interval)
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				x.refresh(ctx)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+func (x *VirtualSensorIndex) Dependents(sensorID int64) []*VirtualSensor {
+	x.mu.RLock()
+	defer x.mu.RUnlock()
+	return x.byInput[sensorID]
+}
+
+// refresh reloads the virtual sensors. On failure the previous index is kept.
+func (x *VirtualSensorIndex) refresh(ctx context.Context) {
+	lookupCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	res, err := x.sensorClient.ListSensors(lookupCtx, &pb_sensor.ListSensorsRequest{Kind: SensorKindVirtual})
+	if err != nil {
+		logger.Error("Failed to load virtual sensors", zap.Error(err))
+		return
+	}
+
+	byInput := make(map[int64][]*VirtualSensor)
+	count := 0
+	for _, s := range res.Sensors {
+		if !s.Active {
+			continue
+		}
+		e, err := expr.Parse(s.Expression)
+		if err != nil {
+			logger.Warn("Skipping virtual sensor with invalid expression", zap.Int64("sensor_id", s.Id), zap.Error(err))
+			continue
+		}
+		v := &VirtualSensor{Sensor: s, Expr: e}
+		for _, id := range e.SensorIDs() {
+			byInput[id] = append(byInput[id], v)
+		}
+		count++
+	}
+
+	x.mu.Lock()
+	x.byInput = byInput
+	x.mu.Unlock()
+
+	logger.Debug("Loaded virtual sensors", zap.Int("count", count))
+}
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultAggregateFunction is used when a bucket interval is requested without an explicit function.
+const DefaultAggregateFunction = "avg"
+
+// Gap filling modes for aggregated queries. FillNone only returns buckets with readings.
+const (
+	FillNone     = ""
+	FillNull     = "null"
+	FillPrevious = "previous"
+	FillLinear   = "linear"
+)
+
+// Aggregation describes how raw readings are rolled up into time buckets.
+type Aggregation struct {
+	Interval time.Duration
+	Function string
+	// Fill selects how buckets without readings are returned, see the Fill constants.
+	Fill string
+}
+
+// IsValidFill reports whether fill is one of the supported gap filling modes.
+func IsValidFill(fill string) bool {
+	switch fill {
+	case FillNone, FillNull, FillPrevious, FillLinear:
+		return true
+	}
+	return false
+}
+
+// bucketExpression returns the bucket column and the aggregate value expression of a
+// bucketed query over column. With gap filling the buckets come from
+// time_bucket_gapfill over [$2, $3], and locf or interpolate fill in the value.
+func (a Aggregation) bucketExpression(column, expr string) (string, string) {
+	value := fmt.Sprintf("(%s)::double precision", expr)
+	switch a.Fill {
+	case FillNone:
+		return fmt.Sprintf("time_bucket($4::interval, %s)", column), value
+	case FillPrevious:
+		value = fmt.Sprintf("locf(%s)", value)
+	case FillLinear:
+		value = fmt.Sprintf("interpolate(%s)", value)
+	}
+	return fmt.Sprintf("time_bucket_gapfill($4::interval, %s, time_bucket($4::interval, $2::timestamptz), $3::timestamptz)", column), value
+}
+
+var aggregateExpressions = map[string]string{
+	"avg":   "avg(value)",
+	"min":   "min(value)",
+	"max":   "max(value)",
+	"sum":   "sum(value)",
+	"count": "count(value)",
+	"first": "first(value, time)",
+	"last":  "last(value, time)",
+}
+
+// rollupExpressions derive the same aggregates from the partial results stored in
+// the continuous aggregates (see Migrate).
+var rollupExpressions = map[string]string{
+	"avg":   "sum(sum) / sum(count)",
+	"min":   "min(min)",
+	"max":   "max(max)",
+	"sum":   "sum(sum)",
+	"count": "sum(count)",
+	"first": "first(first, bucket)",
+	"last":  "last(last, bucket)",
+}
+
+// IsValidAggregateFunction reports whether fn is one of the supported bucket aggregates.
+func IsValidAggregateFunction(fn string) bool {
+	_, ok := aggregateExpressions[fn]
+	return ok
+}
+
+// ParseInterval parses bucket intervals such as "30s", "5m", "1h", "1d" or "1w".
+// Day and week suffixes are accepted on top of the units understood by time.ParseDuration.
+func ParseInterval(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, fmt.Errorf("interval is empty")
+	}
+
+	var unit time.Duration
+	switch {
+	case strings.HasSuffix(s, "d"):
+		unit = 24 * time.Hour
+	case strings.HasSuffix(s, "w"):
+		unit = 7 * 24 * time.Hour
+	}
+
+	var d time.Duration
+	if unit > 0 {
+		n, err := strconv.Atoi(s[:len(s)-1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+		d = time.Duration(n) * unit
+	} else {
+		var err error
+		d, err = time.ParseDuration(s)
+		if err != nil {
+			return 0, fmt.Errorf("invalid interval %q", s)
+		}
+	}
+
+	if d < time.Second {
+		return 0, fmt.Errorf("interval %q must be at least 1s", s)
+	}
+	return d, nil
+}
+
+func (a Aggregation) pgInterval() string {
+	return fmt.Sprintf("%d seconds", int64(a.Interval/time.Second))
+}
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected time.Duration
+		wantErr  bool
+	}{
+		{"30s", 30 * time.Second, false},
+		{"5m", 5 * time.Minute, false},
+		{"1h", time.Hour, false},
+		{"1d", 24 * time.Hour, false},
+		{"2w", 14 * 24 * time.Hour, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"", 0, true},
+		{"abc", 0, true},
+		{"xd", 0, true},
+		{"500ms", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			d, err := ParseInterval(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+}
+
+func TestIsValidAggregateFunction(t *testing.T) {
+	for _, fn := range []string{"avg", "min", "max", "sum", "count", "first", "last"} {
+		assert.True(t, IsValidAggregateFunction(fn), fn)
+	}
+	assert.False(t, IsValidAggregateFunction("median"))
+	assert.False(t, IsValidAggregateFunction(""))
+}
+
+func TestRollupFor(t *testing.T) {
+	tests := []struct {
+		interval time.Duration
+		expected string
+	}{
+		{5 * time.Minute, ""},
+		{90 * time.Minute, ""},
+		{time.Hour, hourlyRollupTable},
+		{6 * time.Hour, hourlyRollupTable},
+		{36 * time.Hour, hourlyRollupTable},
+		{24 * time.Hour, dailyRollupTable},
+		{7 * 24 * time.Hour, dailyRollupTable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.interval.String(), func(t *testing.T) {
+			r := rollupFor(tt.interval)
+			if tt.expected == "" {
+				assert.Nil(t, r)
+				return
+			}
+			if assert.NotNil(t, r) {
+				assert.Equal(t, tt.expected, r.table)
+			}
+		})
+	}
+}
+
+func TestBucketExpression(t *testing.T) {
+	agg := Aggregation{Interval: time.Hour, Function: "avg"}
+	bucket, value := agg.bucketExpression("time", "avg(value)")
+	assert.Equal(t, "time_bucket($4::interval, time)", bucket)
+	assert.Equal(t, "(avg(value))::double precision", value)
+
+	gapfill := "time_bucket_gapfill($4::interval, time, time_bucket($4::interval, $2::timestamptz), $3::timestamptz)"
+	tests := map[string]string{
+		FillNull:     "(avg(value))::double precision",
+		FillPrevious: "locf((avg(value))::double precision)",
+		FillLinear:   "interpolate((avg(value))::double precision)",
+	}
+	for fill, expected := range tests {
+		agg.Fill = fill
+		bucket, value := agg.bucketExpression("time", "avg(value)")
+		assert.Equal(t, gapfill, bucket, fill)
+		assert.Equal(t, expected, value, fill)
+	}
+
+	assert.True(t, IsValidFill(FillNone))
+	assert.False(t, IsValidFill("zero"))
+}
+
+func TestBucketQuery(t *testing.T) {
+	start := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	query, args, err := bucketQuery(Aggregation{Interval: time.Hour, Function: "avg"}, "", "sensor_id = ANY($1)", []int64{1, 2}, start, end)
+	assert.NoError(t, err)
+	assert.Contains(t, query, "FROM "+hourlyRollupTable)
+	assert.Contains(t, query, "WHERE sensor_id = ANY($1)")
+	assert.Contains(t, query, "GROUP BY sensor_id, outer_bucket")
+	assert.Contains(t, query, "bucket + INTERVAL '3600 seconds' <= $3", "rollup buckets end by endTime")
+	assert.Len(t, args, 4)
+
+	// Channels are not rolled up, so they are bucketed from raw readings.
+	query, args, err = bucketQuery(Aggregation{Interval: time.Hour, Function: "max"}, "humidity", "sensor_id = $1", int64(1), start, end)
+	assert.NoError(t, err)
+	assert.Contains(t, query, "channel_readings")
+	assert.Contains(t, query, "GROUP BY sensor_id, bucket")
+	assert.Equal(t, "humidity", args[len(args)-1])
+
+	_, _, err = bucketQuery(Aggregation{Interval: time.Minute, Function: "median"}, "", "sensor_id = $1", int64(1), start, end)
+	assert.Error(t, err)
+}
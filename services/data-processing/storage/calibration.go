@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// UpdateReadingValues overwrites the value, quality, channels and raw values of stored
+// readings, matched by sensor and time. It returns the number of rows updated.
+func (s *TimescaleStorage) UpdateReadingValues(ctx context.Context, readings []Reading) (int64, error) {
+	if len(readings) == 0 {
+		return 0, nil
+	}
+
+	sensorIDs := make([]int64, 0, len(readings))
+	times := make([]string, 0, len(readings))
+	values := make([]float32, 0, len(readings))
+	qualities := make([]int64, 0, len(readings))
+	channels := make([]sql.NullString, 0, len(readings))
+	rawValues := make([]sql.NullFloat64, 0, len(readings))
+	rawChannels := make([]sql.NullString, 0, len(readings))
+	for _, r := range readings {
+		c, err := encodeChannels(r.Channels)
+		if err != nil {
+			return 0, err
+		}
+		rawValue, rawC, err := encodeRaw(r.Raw)
+		if err != nil {
+			return 0, err
+		}
+
+		sensorIDs = append(sensorIDs, r.SensorID)
+		times = append(times, r.Timestamp.Format(time.RFC3339Nano))
+		values = append(values, r.Value)
+		qualities = append(qualities, int64(r.Quality))
+		channels = append(channels, nullString(c))
+		rawChannels = append(rawChannels, nullString(rawC))
+		if v, ok := rawValue.(float32); ok {
+			rawValues = append(rawValues, sql.NullFloat64{Float64: float64(v), Valid: true})
+		} else {
+			rawValues = append(rawValues, sql.NullFloat64{})
+		}
+	}
+
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE sensor_readings AS r
+		 SET value = u.value, quality = u.quality, channels = u.channels,
+		     raw_value = u.raw_value, raw_channels = u.raw_channels
+		 FROM unnest($1::bigint[], $2::timestamptz[], $3::real[], $4::smallint[], $5::jsonb[], $6::real[], $7::jsonb[])
+		      AS u(sensor_id, time, value, quality, channels, raw_value, raw_channels)
+		 WHERE r.sensor_id = u.sensor_id AND r.time = u.time`,
+		pq.Array(sensorIDs), pq.Array(times), pq.Array(values), pq.Array(qualities),
+		pq.Array(channels), pq.Array(rawValues), pq.Array(rawChannels))
+	if err != nil {
+		return 0, fmt.Errorf("update error: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// nullString converts an encoded JSONB document, a string or nil, for use in an array.
+func nullString(v any) sql.NullString {
+	s, ok := v.(string)
+	return sql.NullString{String: s, Valid: ok}
+}
@@ -0,0 +1,75 @@
+package storage
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+)
+
+// readingsSource returns the relation queries read readings from, followed by args
+// extended with the parameters it references. Named channels are projected from the
+// channels document into the value column, so aggregate expressions work unchanged.
+func readingsSource(channel string, args ...any) (string, []any) {
+	if channel == "" {
+		return "sensor_readings", args
+	}
+
+	n := len(args) + 1
+	source := fmt.Sprintf(`(
+		SELECT time, sensor_id, (channels->>$%d)::real AS value, quality, channels,
+			(raw_channels->>$%d)::real AS raw_value, raw_channels
+		FROM sensor_readings
+		WHERE channels->>$%d IS NOT NULL
+	) AS channel_readings`, n, n, n)
+	return source, append(args, channel)
+}
+
+// encodeChannels renders channel values for the channels JSONB column. Single-value
+// readings are stored as NULL. The document is passed as a string because lib/pq
+// would send a []byte as bytea.
+func encodeChannels(channels map[string]float32) (any, error) {
+	if len(channels) == 0 {
+		return nil, nil
+	}
+	b, err := json.Marshal(channels)
+	if err != nil {
+		return nil, fmt.Errorf("encode channels: %w", err)
+	}
+	return string(b), nil
+}
+
+func decodeChannels(b []byte) (map[string]float32, error) {
+	if len(b) == 0 {
+		return nil, nil
+	}
+	var channels map[string]float32
+	if err := json.Unmarshal(b, &channels); err != nil {
+		return nil, fmt.Errorf("decode channels: %w", err)
+	}
+	return channels, nil
+}
+
+// encodeRaw renders the raw values of a reading for the raw_value and raw_channels
+// columns, which are NULL for readings that were not calibrated.
+func encodeRaw(raw *RawValues) (any, any, error) {
+	if raw == nil {
+		return nil, nil, nil
+	}
+	channels, err := encodeChannels(raw.Channels)
+	if err != nil {
+		return nil, nil, err
+	}
+	return raw.Value, channels, nil
+}
+
+// decodeRaw is the inverse of encodeRaw.
+func decodeRaw(value sql.NullFloat64, channels []byte) (*RawValues, error) {
+	if !value.Valid {
+		return nil, nil
+	}
+	values, err := decodeChannels(channels)
+	if err != nil {
+		return nil, err
+	}
+	return &RawValues{Value: float32(value.Float64), Channels: values}, nil
+}
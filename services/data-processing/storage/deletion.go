@@ -0,0 +1,154 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// valueOperators maps the operators of a ReadingFilter to SQL.
+var valueOperators = map[string]string{
+	"lt":  "<",
+	"lte": "<=",
+	"gt":  ">",
+	"gte": ">=",
+	"eq":  "=",
+	"ne":  "<>",
+}
+
+// IsValidValueOperator reports whether op is a supported ReadingFilter operator.
+func IsValidValueOperator(op string) bool {
+	_, ok := valueOperators[op]
+	return ok
+}
+
+// ReadingFilter selects the readings of a sensor within [Start, End]. With an Operator
+// only readings whose value, or the value of Channel, compares true against Value match.
+type ReadingFilter struct {
+	SensorID int64
+	Start    time.Time
+	End      time.Time
+	Operator string
+	Value    float64
+	Channel  string
+}
+
+// where renders the filter as a WHERE clause over sensor_readings and its arguments.
+func (f ReadingFilter) where() (string, []any, error) {
+	clause := "sensor_id = $1 AND time >= $2 AND time <= $3"
+	args := []any{f.SensorID, f.Start, f.End}
+	if f.Operator == "" {
+		return clause, args, nil
+	}
+
+	op, ok := valueOperators[f.Operator]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported value operator %q", f.Operator)
+	}
+	args = append(args, f.Value)
+	if f.Channel == "" {
+		return clause + " AND value " + op + " $4", args, nil
+	}
+	args = append(args, f.Channel)
+	return clause + " AND (channels->>$5)::real " + op + " $4", args, nil
+}
+
+// ReadingDeletion is the audit record of a deletion of readings.
+type ReadingDeletion struct {
+	ID        int64
+	Filter    ReadingFilter
+	Deleted   int64
+	UserID    int64
+	Reason    string
+	CreatedAt time.Time
+}
+
+// CountReadings returns the number of readings matched by the filter.
+func (s *TimescaleStorage) CountReadings(ctx context.Context, filter ReadingFilter) (int64, error) {
+	where, args, err := filter.where()
+	if err != nil {
+		return 0, err
+	}
+
+	var count int64
+	if err := s.db.QueryRowContext(ctx, "SELECT count(*) FROM sensor_readings WHERE "+where, args...).Scan(&count); err != nil {
+		return 0, fmt.Errorf("query error: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteReadings deletes the readings matched by the filter of deletion and stores
+// deletion, with its count, as the audit record in the same transaction. It returns
+// the stored record and the time range of the deleted readings, which is empty when
+// nothing was deleted.
+func (s *TimescaleStorage) DeleteReadings(ctx context.Context, deletion ReadingDeletion) (*ReadingDeletion, time.Time, time.Time, error) {
+	where, args, err := deletion.Filter.where()
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, err
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, fmt.Errorf("begin error: %w", err)
+	}
+	defer tx.Rollback()
+
+	var first, last sql.NullTime
+	err = tx.QueryRowContext(ctx,
+		`WITH deleted AS (DELETE FROM sensor_readings WHERE `+where+` RETURNING time)
+		 SELECT count(*), min(time), max(time) FROM deleted`,
+		args...).Scan(&deletion.Deleted, &first, &last)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, fmt.Errorf("delete error: %w", err)
+	}
+
+	f := deletion.Filter
+	err = tx.QueryRowContext(ctx,
+		`INSERT INTO reading_deletions (sensor_id, start_time, end_time, value_operator, value, channel, deleted, user_id, reason)
+		 VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		 RETURNING id, created_at`,
+		f.SensorID, f.Start, f.End, f.Operator, f.Value, f.Channel, deletion.Deleted, deletion.UserID, deletion.Reason,
+	).Scan(&deletion.ID, &deletion.CreatedAt)
+	if err != nil {
+		return nil, time.Time{}, time.Time{}, fmt.Errorf("insert error: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, time.Time{}, time.Time{}, fmt.Errorf("commit error: %w", err)
+	}
+	return &deletion, first.Time, last.Time, nil
+}
+
+// ListReadingDeletions returns up to limit audit records, newest first. A sensorID of
+// 0 returns the records of every sensor.
+func (s *TimescaleStorage) ListReadingDeletions(ctx context.Context, sensorID int64, limit int64) ([]*ReadingDeletion, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, sensor_id, start_time, end_time, value_operator, value, channel, deleted, user_id, reason, created_at
+		 FROM reading_deletions
+		 WHERE $1 = 0 OR sensor_id = $1
+		 ORDER BY created_at DESC, id DESC
+		 LIMIT $2`,
+		sensorID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var deletions []*ReadingDeletion
+	for rows.Next() {
+		d := &ReadingDeletion{}
+		f := &d.Filter
+		if err := rows.Scan(&d.ID, &f.SensorID, &f.Start, &f.End, &f.Operator, &f.Value, &f.Channel,
+			&d.Deleted, &d.UserID, &d.Reason, &d.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		deletions = append(deletions, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return deletions, nil
+}
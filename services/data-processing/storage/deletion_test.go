@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadingFilterWhere(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+
+	where, args, err := ReadingFilter{SensorID: 7, Start: start, End: end}.where()
+	require.NoError(t, err)
+	assert.Equal(t, "sensor_id = $1 AND time >= $2 AND time <= $3", where)
+	assert.Equal(t, []any{int64(7), start, end}, args)
+
+	where, args, err = ReadingFilter{SensorID: 7, Start: start, End: end, Operator: "gte", Value: 1000}.where()
+	require.NoError(t, err)
+	assert.Equal(t, "sensor_id = $1 AND time >= $2 AND time <= $3 AND value >= $4", where)
+	assert.Equal(t, []any{int64(7), start, end, 1000.0}, args)
+
+	where, args, err = ReadingFilter{SensorID: 7, Start: start, End: end, Operator: "lt", Value: 0, Channel: "humidity"}.where()
+	require.NoError(t, err)
+	assert.Equal(t, "sensor_id = $1 AND time >= $2 AND time <= $3 AND (channels->>$5)::real < $4", where)
+	assert.Equal(t, []any{int64(7), start, end, 0.0, "humidity"}, args)
+
+	_, _, err = ReadingFilter{SensorID: 7, Operator: "like"}.where()
+	assert.Error(t, err)
+}
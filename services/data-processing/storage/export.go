@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ScanReadings calls fn for every raw reading of the sensors in the time range, ordered
+// by sensor and time. Rows are streamed from the database, so the range may be larger
+// than what fits in memory. An error returned by fn stops the scan and is returned.
+func (s *TimescaleStorage) ScanReadings(ctx context.Context, sensorIDs []int64, startTime, endTime time.Time, fn func(Reading) error) error {
+	if len(sensorIDs) == 0 {
+		return nil
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT sensor_id, time, value, quality, channels, raw_value, raw_channels, raw_quality FROM sensor_readings
+		 WHERE sensor_id = ANY($1) AND time >= $2 AND time <= $3
+		 ORDER BY sensor_id, time`,
+		pq.Array(sensorIDs), startTime, endTime)
+	if err != nil {
+		return fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r Reading
+		var channels, rawChannels []byte
+		var rawValue sql.NullFloat64
+		var rawQuality sql.NullInt16
+		if err := rows.Scan(&r.SensorID, &r.Timestamp, &r.Value, &r.Quality, &channels, &rawValue, &rawChannels, &rawQuality); err != nil {
+			return fmt.Errorf("scan error: %w", err)
+		}
+		// Rows stored before raw_quality existed only have the flagged quality.
+		r.RawQuality = r.Quality
+		if rawQuality.Valid {
+			r.RawQuality = Quality(rawQuality.Int16)
+		}
+		if r.Channels, err = decodeChannels(channels); err != nil {
+			return err
+		}
+		if r.Raw, err = decodeRaw(rawValue, rawChannels); err != nil {
+			return err
+		}
+		if err := fn(r); err != nil {
+			return err
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("rows iteration error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// maxDataGaps bounds the number of gaps returned by ListDataGaps.
+const maxDataGaps = 10000
+
+// DataGap is a period in which a sensor did not report.
+type DataGap struct {
+	Start time.Time
+	End   time.Time
+}
+
+// ListDataGaps returns the silences of a sensor longer than minGap within [startTime,
+// endTime], oldest first. The range bounds count as readings, so a sensor that stopped
+// reporting has a gap up to endTime and one without readings is a single gap.
+func (s *TimescaleStorage) ListDataGaps(ctx context.Context, sensorID int64, startTime, endTime time.Time, minGap time.Duration) ([]DataGap, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`WITH points AS (
+			SELECT time FROM sensor_readings WHERE sensor_id = $1 AND time > $2 AND time < $3
+			UNION ALL SELECT $2::timestamptz
+			UNION ALL SELECT $3::timestamptz
+		), spaced AS (
+			SELECT lag(time) OVER (ORDER BY time) AS gap_start, time AS gap_end FROM points
+		)
+		SELECT gap_start, gap_end FROM spaced
+		WHERE gap_end - gap_start > $4::interval
+		ORDER BY gap_start
+		LIMIT $5`,
+		sensorID, startTime, endTime, fmt.Sprintf("%d milliseconds", minGap.Milliseconds()), maxDataGaps)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var gaps []DataGap
+	for rows.Next() {
+		var g DataGap
+		if err := rows.Scan(&g.Start, &g.End); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		gaps = append(gaps, g)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return gaps, nil
+}
+
+// MedianReadingInterval estimates the reporting period of a sensor as the median
+// spacing of its readings in the range. ok is false with fewer than two readings.
+func (s *TimescaleStorage) MedianReadingInterval(ctx context.Context, sensorID int64, startTime, endTime time.Time) (time.Duration, bool, error) {
+	var seconds sql.NullFloat64
+	err := s.db.QueryRowContext(ctx,
+		`SELECT percentile_cont(0.5) WITHIN GROUP (ORDER BY extract(epoch FROM time - prev))
+		 FROM (
+			SELECT time, lag(time) OVER (ORDER BY time) AS prev
+			FROM sensor_readings
+			WHERE sensor_id = $1 AND time >= $2 AND time <= $3
+		 ) spacing
+		 WHERE prev IS NOT NULL`,
+		sensorID, startTime, endTime,
+	).Scan(&seconds)
+	if err != nil {
+		return 0, false, fmt.Errorf("query error: %w", err)
+	}
+	if !seconds.Valid {
+		return 0, false, nil
+	}
+	return time.Duration(seconds.Float64 * float64(time.Second)), true, nil
+}
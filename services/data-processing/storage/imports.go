@@ -0,0 +1,198 @@
+package storage
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Import job statuses.
+const (
+	ImportStatusPending   = "pending"
+	ImportStatusRunning   = "running"
+	ImportStatusCompleted = "completed"
+	ImportStatusFailed    = "failed"
+)
+
+var (
+	ErrImportJobNotFound = errors.New("import job not found")
+	// ErrImportJobLost is returned when a job was failed by another instance after its
+	// lease expired, so its owner has to stop running it.
+	ErrImportJobLost = errors.New("import job lease lost")
+)
+
+// ImportRowError records why a row of an import file was rejected.
+type ImportRowError struct {
+	Row     int64
+	Message string
+}
+
+// ImportJob tracks the progress of a bulk historical import. Owner is the instance
+// running the job, which keeps its lease alive until the job finishes.
+type ImportJob struct {
+	ID            int64
+	UserID        int64
+	Owner         string
+	Status        string
+	Filename      string
+	RowsProcessed int64
+	RowsImported  int64
+	RowsDuplicate int64
+	RowsFailed    int64
+	Error         string
+	// Errors holds the stored row errors. Only GetImportJob fills it.
+	Errors     []ImportRowError
+	CreatedAt  time.Time
+	StartedAt  *time.Time
+	FinishedAt *time.Time
+}
+
+func (s *TimescaleStorage) CreateImportJob(ctx context.Context, userID int64, filename string) (*ImportJob, error) {
+	job := &ImportJob{UserID: userID, Owner: s.instanceID, Status: ImportStatusPending, Filename: filename}
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO import_jobs (user_id, owner, status, filename) VALUES ($1, $2, $3, $4) RETURNING id, created_at`,
+		job.UserID, job.Owner, job.Status, job.Filename,
+	).Scan(&job.ID, &job.CreatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert error: %w", err)
+	}
+	return job, nil
+}
+
+// UpdateImportJob saves the status and counters of job, renews its lease and appends
+// rowErrors to its errors. It returns ErrImportJobLost when the job is no longer
+// unfinished, which happens once another instance has failed it.
+func (s *TimescaleStorage) UpdateImportJob(ctx context.Context, job *ImportJob, rowErrors []ImportRowError) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin error: %w", err)
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx,
+		`UPDATE import_jobs
+		 SET status = $3, rows_processed = $4, rows_imported = $5, rows_duplicate = $6,
+		     rows_failed = $7, error = $8, started_at = $9, finished_at = $10, heartbeat_at = now()
+		 WHERE id = $1 AND owner = $2 AND status IN ($11, $12)`,
+		job.ID, job.Owner, job.Status, job.RowsProcessed, job.RowsImported, job.RowsDuplicate,
+		job.RowsFailed, job.Error, job.StartedAt, job.FinishedAt, ImportStatusPending, ImportStatusRunning)
+	if err != nil {
+		return fmt.Errorf("update error: %w", err)
+	}
+	updated, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("update error: %w", err)
+	}
+	if updated == 0 {
+		return ErrImportJobLost
+	}
+
+	for start := 0; start < len(rowErrors); start += insertBatchSize {
+		chunk := rowErrors[start:min(start+insertBatchSize, len(rowErrors))]
+
+		args := make([]any, 0, len(chunk)*3)
+		for _, e := range chunk {
+			args = append(args, job.ID, e.Row, e.Message)
+		}
+
+		query := valuesQuery("INSERT INTO import_job_errors (job_id, line, message)", len(chunk), 3, "ON CONFLICT DO NOTHING")
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("insert error: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit error: %w", err)
+	}
+	return nil
+}
+
+// GetImportJob returns a job of userID with its row errors ordered by row. Jobs of
+// other users are reported as not found.
+func (s *TimescaleStorage) GetImportJob(ctx context.Context, id, userID int64) (*ImportJob, error) {
+	job := &ImportJob{}
+	err := s.db.QueryRowContext(ctx,
+		`SELECT id, user_id, status, filename, rows_processed, rows_imported, rows_duplicate, rows_failed,
+		        error, created_at, started_at, finished_at
+		 FROM import_jobs WHERE id = $1 AND user_id = $2`, id, userID,
+	).Scan(&job.ID, &job.UserID, &job.Status, &job.Filename, &job.RowsProcessed, &job.RowsImported, &job.RowsDuplicate,
+		&job.RowsFailed, &job.Error, &job.CreatedAt, &job.StartedAt, &job.FinishedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrImportJobNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT line, message FROM import_job_errors WHERE job_id = $1 ORDER BY line`, id)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var e ImportRowError
+		if err := rows.Scan(&e.Row, &e.Message); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		job.Errors = append(job.Errors, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return job, nil
+}
+
+// RenewImportJobLeases extends the lease of every unfinished job owned by this instance.
+func (s *TimescaleStorage) RenewImportJobLeases(ctx context.Context) (int64, error) {
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE import_jobs SET heartbeat_at = now() WHERE owner = $1 AND status IN ($2, $3)`,
+		s.instanceID, ImportStatusPending, ImportStatusRunning)
+	if err != nil {
+		return 0, fmt.Errorf("update error: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// FailExpiredImportJobs marks pending or running jobs whose lease was not renewed
+// within lease as failed. Their owner stopped, and its uploads are gone with it, so
+// they cannot be resumed. Jobs of live instances keep renewing and are left alone.
+func (s *TimescaleStorage) FailExpiredImportJobs(ctx context.Context, lease time.Duration) (int64, error) {
+	res, err := s.db.ExecContext(ctx,
+		`UPDATE import_jobs SET status = $1, error = 'interrupted by a service restart', finished_at = now()
+		 WHERE status IN ($2, $3) AND heartbeat_at < now() - make_interval(secs => $4)`,
+		ImportStatusFailed, ImportStatusPending, ImportStatusRunning, lease.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("update error: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// newInstanceID returns an owner for the import jobs of this process. The hostname is
+// kept for logs; the random suffix tells restarts of the same container apart.
+func newInstanceID() string {
+	host, _ := os.Hostname()
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return fmt.Sprintf("%s-%s", host, hex.EncodeToString(b))
+}
+
+// RefreshRollups recomputes the continuous aggregates over [start, end]. Their policies
+// only refresh recent buckets, so rows written further back have to be rolled up here.
+func (s *TimescaleStorage) RefreshRollups(ctx context.Context, start, end time.Time) error {
+	for _, r := range rollups {
+		from := start.Truncate(r.bucket)
+		to := end.Truncate(r.bucket).Add(r.bucket)
+		if _, err := s.db.ExecContext(ctx, fmt.Sprintf(`CALL refresh_continuous_aggregate('%s', $1::timestamptz, $2::timestamptz)`, r.table), from, to); err != nil {
+			return fmt.Errorf("refresh %s error: %w", r.table, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,319 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// insertBatchSize caps the rows per INSERT statement to stay well below the
+// PostgreSQL limit of 65535 bind parameters.
+const insertBatchSize = 1000
+
+// IdempotencyKeyTTL is how long idempotency keys are remembered. A retry that
+// arrives later is stored as a new reading unless its (sensor_id, time) already exists.
+const IdempotencyKeyTTL = 24 * time.Hour
+
+// DuplicatePolicy decides what happens to a reading whose (sensor_id, time) is already stored.
+type DuplicatePolicy string
+
+const (
+	// DuplicateIgnore keeps the stored reading and drops the new one.
+	DuplicateIgnore DuplicatePolicy = "ignore"
+	// DuplicateOverwrite replaces the stored value and quality with the new ones.
+	DuplicateOverwrite DuplicatePolicy = "overwrite"
+)
+
+// ParseDuplicatePolicy parses a policy name. An empty name selects DuplicateIgnore.
+func ParseDuplicatePolicy(s string) (DuplicatePolicy, error) {
+	switch DuplicatePolicy(s) {
+	case "", DuplicateIgnore:
+		return DuplicateIgnore, nil
+	case DuplicateOverwrite:
+		return DuplicateOverwrite, nil
+	}
+	return "", fmt.Errorf("unknown duplicate policy %q, expected ignore or overwrite", s)
+}
+
+type readingKey struct {
+	sensorID int64
+	micros   int64
+}
+
+type idempotencyKey struct {
+	sensorID int64
+	key      string
+}
+
+func keyOf(r Reading) readingKey {
+	return readingKey{sensorID: r.SensorID, micros: r.Timestamp.UnixMicro()}
+}
+
+// StoreReading stores a single reading and reports whether it was new.
+func (s *TimescaleStorage) StoreReading(ctx context.Context, reading Reading) (bool, error) {
+	stored, err := s.StoreReadings(ctx, []Reading{reading})
+	if err != nil {
+		return false, err
+	}
+	return stored[0], nil
+}
+
+// StoreReadings writes readings in a single transaction and reports for each of them
+// whether it was new. Events of new readings are queued in the outbox within the same
+// transaction, so a reading is never stored without its event. A reading is a duplicate when its idempotency key was already
+// seen for the sensor or when its (sensor_id, time) is already stored; the latter is
+// then left alone or overwritten according to the duplicate policy.
+// Timestamps are truncated in place to microseconds, the precision of TIMESTAMPTZ.
+func (s *TimescaleStorage) StoreReadings(ctx context.Context, readings []Reading) ([]bool, error) {
+	stored := make([]bool, len(readings))
+	if len(readings) == 0 {
+		return stored, nil
+	}
+
+	for i := range readings {
+		readings[i].Timestamp = readings[i].Timestamp.Truncate(time.Microsecond)
+	}
+	candidates := s.dedupeBatch(readings)
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	candidates, err = claimIdempotencyKeys(ctx, tx, readings, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing map[readingKey]bool
+	if s.duplicatePolicy == DuplicateOverwrite {
+		// Overwritten rows are returned by the insert as well, so find them up front.
+		existing, err = existingReadings(ctx, tx, readings, candidates)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	written, err := s.insertReadings(ctx, tx, readings, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, i := range candidates {
+		k := keyOf(readings[i])
+		stored[i] = written[k] && !existing[k]
+	}
+
+	if err := enqueueEvents(ctx, tx, readings, stored); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit tx: %w", err)
+	}
+	return stored, nil
+}
+
+func (s *TimescaleStorage) DeleteIdempotencyKeysBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := s.db.ExecContext(ctx, "DELETE FROM reading_idempotency_keys WHERE created_at < $1", before)
+	if err != nil {
+		return 0, fmt.Errorf("delete error: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// dedupeBatch returns the indices of readings that are not duplicates of another
+// reading in the same batch. Ignore keeps the first of a (sensor_id, time) pair, overwrite the last.
+func (s *TimescaleStorage) dedupeBatch(readings []Reading) []int {
+	byRow := make(map[readingKey]int, len(readings))
+	byKey := make(map[idempotencyKey]bool)
+	candidates := make([]int, 0, len(readings))
+
+	for i, r := range readings {
+		if r.IdempotencyKey != "" {
+			k := idempotencyKey{sensorID: r.SensorID, key: r.IdempotencyKey}
+			if byKey[k] {
+				continue
+			}
+			byKey[k] = true
+		}
+
+		k := keyOf(r)
+		if pos, ok := byRow[k]; ok {
+			if s.duplicatePolicy == DuplicateOverwrite {
+				candidates[pos] = i
+			}
+			continue
+		}
+		byRow[k] = len(candidates)
+		candidates = append(candidates, i)
+	}
+	return candidates
+}
+
+// claimIdempotencyKeys records the idempotency keys of candidates and drops the
+// candidates whose key has been recorded before.
+func claimIdempotencyKeys(ctx context.Context, tx *sql.Tx, readings []Reading, candidates []int) ([]int, error) {
+	keyed := make([]int, 0, len(candidates))
+	for _, i := range candidates {
+		if readings[i].IdempotencyKey != "" {
+			keyed = append(keyed, i)
+		}
+	}
+	if len(keyed) == 0 {
+		return candidates, nil
+	}
+
+	claimed := make(map[idempotencyKey]bool, len(keyed))
+	for start := 0; start < len(keyed); start += insertBatchSize {
+		chunk := keyed[start:min(start+insertBatchSize, len(keyed))]
+
+		args := make([]any, 0, len(chunk)*2)
+		for _, i := range chunk {
+			args = append(args, readings[i].SensorID, readings[i].IdempotencyKey)
+		}
+
+		query := valuesQuery("INSERT INTO reading_idempotency_keys (sensor_id, key)", len(chunk), 2,
+			"ON CONFLICT (sensor_id, key) DO NOTHING RETURNING sensor_id, key")
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("idempotency key error: %w", err)
+		}
+		for rows.Next() {
+			var k idempotencyKey
+			if err := rows.Scan(&k.sensorID, &k.key); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("scan error: %w", err)
+			}
+			claimed[k] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("rows iteration error: %w", err)
+		}
+	}
+
+	remaining := candidates[:0]
+	for _, i := range candidates {
+		r := readings[i]
+		if r.IdempotencyKey == "" || claimed[idempotencyKey{sensorID: r.SensorID, key: r.IdempotencyKey}] {
+			remaining = append(remaining, i)
+		}
+	}
+	return remaining, nil
+}
+
+// existingReadings returns which of the candidates are already stored.
+func existingReadings(ctx context.Context, tx *sql.Tx, readings []Reading, candidates []int) (map[readingKey]bool, error) {
+	existing := make(map[readingKey]bool)
+	if len(candidates) == 0 {
+		return existing, nil
+	}
+
+	sensorIDs := make([]int64, 0, len(candidates))
+	times := make([]string, 0, len(candidates))
+	for _, i := range candidates {
+		sensorIDs = append(sensorIDs, readings[i].SensorID)
+		times = append(times, readings[i].Timestamp.Format(time.RFC3339Nano))
+	}
+
+	rows, err := tx.QueryContext(ctx,
+		`SELECT sensor_id, time FROM sensor_readings
+		 WHERE (sensor_id, time) IN (SELECT * FROM unnest($1::bigint[], $2::timestamptz[]))`,
+		pq.Array(sensorIDs), pq.Array(times))
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var sensorID int64
+		var t time.Time
+		if err := rows.Scan(&sensorID, &t); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		existing[readingKey{sensorID: sensorID, micros: t.UnixMicro()}] = true
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return existing, nil
+}
+
+// insertReadings inserts the candidates and returns the rows that were written.
+func (s *TimescaleStorage) insertReadings(ctx context.Context, tx *sql.Tx, readings []Reading, candidates []int) (map[readingKey]bool, error) {
+	onConflict := "ON CONFLICT (sensor_id, time) DO NOTHING"
+	if s.duplicatePolicy == DuplicateOverwrite {
+		onConflict = "ON CONFLICT (sensor_id, time) DO UPDATE SET value = EXCLUDED.value, quality = EXCLUDED.quality, channels = EXCLUDED.channels, " +
+			"raw_value = EXCLUDED.raw_value, raw_channels = EXCLUDED.raw_channels, raw_quality = EXCLUDED.raw_quality"
+	}
+
+	written := make(map[readingKey]bool, len(candidates))
+	for start := 0; start < len(candidates); start += insertBatchSize {
+		chunk := candidates[start:min(start+insertBatchSize, len(candidates))]
+
+		args := make([]any, 0, len(chunk)*8)
+		for _, i := range chunk {
+			r := readings[i]
+			channels, err := encodeChannels(r.Channels)
+			if err != nil {
+				return nil, err
+			}
+			rawValue, rawChannels, err := encodeRaw(r.Raw)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, r.Timestamp, r.SensorID, r.Value, r.Quality, channels, rawValue, rawChannels, r.RawQuality)
+		}
+
+		query := valuesQuery("INSERT INTO sensor_readings (time, sensor_id, value, quality, channels, raw_value, raw_channels, raw_quality)", len(chunk), 8,
+			onConflict+" RETURNING sensor_id, time")
+		rows, err := tx.QueryContext(ctx, query, args...)
+		if err != nil {
+			return nil, fmt.Errorf("insert error: %w", err)
+		}
+		for rows.Next() {
+			var sensorID int64
+			var t time.Time
+			if err := rows.Scan(&sensorID, &t); err != nil {
+				rows.Close()
+				return nil, fmt.Errorf("scan error: %w", err)
+			}
+			written[readingKey{sensorID: sensorID, micros: t.UnixMicro()}] = true
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, fmt.Errorf("rows iteration error: %w", err)
+		}
+	}
+	return written, nil
+}
+
+// valuesQuery builds "<prefix> VALUES ($1, ..., $cols), ... <suffix>" for a multi-row statement.
+func valuesQuery(prefix string, rows, cols int, suffix string) string {
+	var sb strings.Builder
+	sb.WriteString(prefix)
+	sb.WriteString(" VALUES ")
+	for r := 0; r < rows; r++ {
+		if r > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		for c := 0; c < cols; c++ {
+			if c > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "$%d", r*cols+c+1)
+		}
+		sb.WriteByte(')')
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(suffix)
+	return sb.String()
+}
@@ -0,0 +1,44 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDuplicatePolicy(t *testing.T) {
+	p, err := ParseDuplicatePolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, DuplicateIgnore, p)
+
+	p, err = ParseDuplicatePolicy("overwrite")
+	assert.NoError(t, err)
+	assert.Equal(t, DuplicateOverwrite, p)
+
+	_, err = ParseDuplicatePolicy("merge")
+	assert.Error(t, err)
+}
+
+func TestDedupeBatch(t *testing.T) {
+	t0 := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	readings := []Reading{
+		{SensorID: 1, Value: 1, Timestamp: t0},
+		{SensorID: 1, Value: 2, Timestamp: t0},
+		{SensorID: 2, Value: 3, Timestamp: t0},
+		{SensorID: 1, Value: 4, Timestamp: t0.Add(time.Second), IdempotencyKey: "a"},
+		{SensorID: 1, Value: 5, Timestamp: t0.Add(2 * time.Second), IdempotencyKey: "a"},
+		{SensorID: 2, Value: 6, Timestamp: t0.Add(2 * time.Second), IdempotencyKey: "a"},
+	}
+
+	ignore := &TimescaleStorage{duplicatePolicy: DuplicateIgnore}
+	assert.Equal(t, []int{0, 2, 3, 5}, ignore.dedupeBatch(readings))
+
+	overwrite := &TimescaleStorage{duplicatePolicy: DuplicateOverwrite}
+	assert.Equal(t, []int{1, 2, 3, 5}, overwrite.dedupeBatch(readings))
+}
+
+func TestValuesQuery(t *testing.T) {
+	q := valuesQuery("INSERT INTO t (a, b)", 2, 2, "RETURNING a")
+	assert.Equal(t, "INSERT INTO t (a, b) VALUES ($1, $2), ($3, $4) RETURNING a", q)
+}
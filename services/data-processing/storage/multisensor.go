@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// MultiSensorRow is one bucket of an aligned multi-sensor query. Values is keyed by
+// sensor id and misses sensors without a value in the bucket.
+type MultiSensorRow struct {
+	Time   time.Time
+	Values map[int64]float64
+}
+
+// QueryMultiSensorReadings buckets the readings of several sensors on a shared time
+// axis, oldest bucket first. It uses the same rollups and fill options as
+// QueryAggregatedReadings.
+func (s *TimescaleStorage) QueryMultiSensorReadings(ctx context.Context, sensorIDs []int64, startTime, endTime time.Time, agg Aggregation, channel string) ([]*MultiSensorRow, error) {
+	query, args, err := bucketQuery(agg, channel, "sensor_id = ANY($1)", pq.Array(sensorIDs), startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var result []*MultiSensorRow
+	for rows.Next() {
+		var b bucketRow
+		if err := b.scan(rows); err != nil {
+			return nil, err
+		}
+		// Rows are ordered by bucket, so each bucket is a run of consecutive rows.
+		if len(result) == 0 || !result[len(result)-1].Time.Equal(b.time) {
+			result = append(result, &MultiSensorRow{Time: b.time, Values: make(map[int64]float64, len(sensorIDs))})
+		}
+		if b.value.Valid {
+			result[len(result)-1].Values[b.sensorID] = b.value.Float64
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
+)
+
+// enqueueEvents queues the events of the stored readings in outbox_events.
+func enqueueEvents(ctx context.Context, tx *sql.Tx, readings []Reading, stored []bool) error {
+	events := make([]*outbox.Message, 0, len(readings))
+	for i, r := range readings {
+		if stored[i] && r.Event != nil {
+			events = append(events, r.Event)
+		}
+	}
+
+	for start := 0; start < len(events); start += insertBatchSize {
+		chunk := events[start:min(start+insertBatchSize, len(events))]
+
+		args := make([]any, 0, len(chunk)*3)
+		for _, e := range chunk {
+			args = append(args, e.Exchange, e.RoutingKey, e.Payload)
+		}
+
+		query := valuesQuery("INSERT INTO outbox_events (exchange, routing_key, payload)", len(chunk), 3, "")
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("outbox insert error: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *TimescaleStorage) Claim(ctx context.Context, limit int, lease time.Duration) ([]outbox.Message, error) {
+	// Rows locked by a concurrent claim are skipped rather than waited for, and the
+	// lease keeps them from being claimed again once that claim commits.
+	rows, err := s.db.QueryContext(ctx,
+		`UPDATE outbox_events SET next_attempt_at = now() + $2 * INTERVAL '1 microsecond'
+		 WHERE id IN (
+			SELECT id FROM outbox_events
+			WHERE next_attempt_at <= now()
+			ORDER BY id
+			LIMIT $1
+			FOR UPDATE SKIP LOCKED
+		 )
+		 RETURNING id, exchange, routing_key, payload, attempts`, limit, lease.Microseconds())
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var msgs []outbox.Message
+	for rows.Next() {
+		var m outbox.Message
+		if err := rows.Scan(&m.ID, &m.Exchange, &m.RoutingKey, &m.Payload, &m.Attempts); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		msgs = append(msgs, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	slices.SortFunc(msgs, func(a, b outbox.Message) int { return cmp.Compare(a.ID, b.ID) })
+	return msgs, nil
+}
+
+func (s *TimescaleStorage) MarkPublished(ctx context.Context, id int64) error {
+	if _, err := s.db.ExecContext(ctx, "DELETE FROM outbox_events WHERE id = $1", id); err != nil {
+		return fmt.Errorf("delete error: %w", err)
+	}
+	return nil
+}
+
+func (s *TimescaleStorage) MarkFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error {
+	_, err := s.db.ExecContext(ctx,
+		`UPDATE outbox_events
+		 SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3
+		 WHERE id = $1`, id, reason, retryAt)
+	if err != nil {
+		return fmt.Errorf("update error: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package storage
+
+// Quality flags a stored reading. It is kept as a SMALLINT in sensor_readings.quality.
+type Quality int16
+
+const (
+	QualityGood Quality = iota
+	QualityOutOfRange
+	QualitySuspect
+	QualityInterpolated
+)
+
+var qualityNames = map[Quality]string{
+	QualityGood:         "good",
+	QualityOutOfRange:   "out_of_range",
+	QualitySuspect:      "suspect",
+	QualityInterpolated: "interpolated",
+}
+
+func (q Quality) String() string {
+	if name, ok := qualityNames[q]; ok {
+		return name
+	}
+	return qualityNames[QualityGood]
+}
+
+// ParseQuality maps a quality name to its flag. An empty name means good.
+func ParseQuality(s string) (Quality, bool) {
+	if s == "" {
+		return QualityGood, true
+	}
+	for q, name := range qualityNames {
+		if name == s {
+			return q, true
+		}
+	}
+	return QualityGood, false
+}
@@ -0,0 +1,121 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// Retention policy scopes. A sensor policy takes precedence over the policy of its sensor type.
+const (
+	RetentionScopeSensor     = "sensor"
+	RetentionScopeSensorType = "sensor_type"
+)
+
+var ErrRetentionPolicyNotFound = errors.New("retention policy not found")
+
+// RetentionPolicy limits how long raw readings are kept for a sensor or for all
+// sensors of a sensor type. Rollups are not affected.
+type RetentionPolicy struct {
+	ID           int64
+	Scope        string
+	TargetID     int64
+	RawRetention time.Duration
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (s *TimescaleStorage) UpsertRetentionPolicy(ctx context.Context, policy RetentionPolicy) (*RetentionPolicy, error) {
+	var seconds int64
+	res := &RetentionPolicy{}
+	err := s.db.QueryRowContext(ctx,
+		`INSERT INTO retention_policies (scope, target_id, raw_retention_seconds)
+		 VALUES ($1, $2, $3)
+		 ON CONFLICT (scope, target_id) DO UPDATE
+		 SET raw_retention_seconds = EXCLUDED.raw_retention_seconds, updated_at = now()
+		 RETURNING id, scope, target_id, raw_retention_seconds, created_at, updated_at`,
+		policy.Scope, policy.TargetID, int64(policy.RawRetention/time.Second),
+	).Scan(&res.ID, &res.Scope, &res.TargetID, &seconds, &res.CreatedAt, &res.UpdatedAt)
+	if err != nil {
+		return nil, fmt.Errorf("upsert error: %w", err)
+	}
+
+	res.RawRetention = time.Duration(seconds) * time.Second
+	return res, nil
+}
+
+func (s *TimescaleStorage) ListRetentionPolicies(ctx context.Context) ([]*RetentionPolicy, error) {
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT id, scope, target_id, raw_retention_seconds, created_at, updated_at
+		 FROM retention_policies
+		 ORDER BY scope, target_id`)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var policies []*RetentionPolicy
+	for rows.Next() {
+		var seconds int64
+		p := &RetentionPolicy{}
+		if err := rows.Scan(&p.ID, &p.Scope, &p.TargetID, &seconds, &p.CreatedAt, &p.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		p.RawRetention = time.Duration(seconds) * time.Second
+		policies = append(policies, p)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return policies, nil
+}
+
+func (s *TimescaleStorage) DeleteRetentionPolicy(ctx context.Context, id int64) error {
+	var deleted int64
+	err := s.db.QueryRowContext(ctx, "DELETE FROM retention_policies WHERE id = $1 RETURNING id", id).Scan(&deleted)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrRetentionPolicyNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("delete error: %w", err)
+	}
+	return nil
+}
+
+// DeleteReadingsBefore removes raw readings of the given sensors older than before.
+func (s *TimescaleStorage) DeleteReadingsBefore(ctx context.Context, sensorIDs []int64, before time.Time) (int64, error) {
+	if len(sensorIDs) == 0 {
+		return 0, nil
+	}
+
+	res, err := s.db.ExecContext(ctx,
+		"DELETE FROM sensor_readings WHERE sensor_id = ANY($1) AND time < $2",
+		pq.Array(sensorIDs), before)
+	if err != nil {
+		return 0, fmt.Errorf("delete error: %w", err)
+	}
+	return res.RowsAffected()
+}
+
+// DeleteReadingsBeforeExcept removes raw readings older than before for every sensor
+// not listed in excludedSensorIDs.
+func (s *TimescaleStorage) DeleteReadingsBeforeExcept(ctx context.Context, excludedSensorIDs []int64, before time.Time) (int64, error) {
+	if excludedSensorIDs == nil {
+		// pq encodes a nil slice as NULL, which would make the predicate match nothing.
+		excludedSensorIDs = []int64{}
+	}
+
+	res, err := s.db.ExecContext(ctx,
+		"DELETE FROM sensor_readings WHERE time < $1 AND NOT (sensor_id = ANY($2))",
+		before, pq.Array(excludedSensorIDs))
+	if err != nil {
+		return 0, fmt.Errorf("delete error: %w", err)
+	}
+	return res.RowsAffected()
+}
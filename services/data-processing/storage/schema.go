@@ -0,0 +1,278 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+const (
+	hourlyRollupTable = "sensor_readings_hourly"
+	dailyRollupTable  = "sensor_readings_daily"
+
+	// MinRawRetention is the shortest raw retention accepted. It has to stay
+	// above the refresh window of the continuous aggregates, otherwise a refresh
+	// would run over deleted raw rows and wipe the rollups built from them.
+	MinRawRetention = 7 * 24 * time.Hour
+)
+
+// SchemaConfig controls the TimescaleDB policies installed by Migrate.
+type SchemaConfig struct {
+	// CompressAfter is the age after which raw chunks are compressed. Zero disables compression.
+	CompressAfter time.Duration
+}
+
+// rollup describes a continuous aggregate maintained over sensor_readings.
+type rollup struct {
+	table       string
+	bucket      time.Duration
+	startOffset string
+	endOffset   string
+	schedule    string
+}
+
+var rollups = []rollup{
+	{table: hourlyRollupTable, bucket: time.Hour, startOffset: "3 days", endOffset: "1 hour", schedule: "30 minutes"},
+	{table: dailyRollupTable, bucket: 24 * time.Hour, startOffset: "3 days", endOffset: "1 hour", schedule: "1 hour"},
+}
+
+// Migrate creates the tables, continuous aggregates and policies the service relies on.
+// Every statement is idempotent so it is safe to run on each start.
+func (s *TimescaleStorage) Migrate(ctx context.Context, cfg SchemaConfig) error {
+	statements := []string{
+		`ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS quality SMALLINT NOT NULL DEFAULT 0`,
+		`ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS channels JSONB`,
+		`ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS raw_value REAL`,
+		`ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS raw_channels JSONB`,
+		`ALTER TABLE sensor_readings ADD COLUMN IF NOT EXISTS raw_quality SMALLINT`,
+		`CREATE TABLE IF NOT EXISTS retention_policies (
+			id                     BIGSERIAL    PRIMARY KEY,
+			scope                  TEXT         NOT NULL,
+			target_id              BIGINT       NOT NULL,
+			raw_retention_seconds  BIGINT       NOT NULL,
+			created_at             TIMESTAMPTZ  NOT NULL DEFAULT now(),
+			updated_at             TIMESTAMPTZ  NOT NULL DEFAULT now(),
+			UNIQUE (scope, target_id)
+		)`,
+		`CREATE TABLE IF NOT EXISTS reading_idempotency_keys (
+			sensor_id   BIGINT       NOT NULL,
+			key         TEXT         NOT NULL,
+			created_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+			PRIMARY KEY (sensor_id, key)
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_reading_idempotency_keys_created_at ON reading_idempotency_keys (created_at)`,
+		`CREATE TABLE IF NOT EXISTS outbox_events (
+			id               BIGSERIAL    PRIMARY KEY,
+			exchange         TEXT         NOT NULL,
+			routing_key      TEXT         NOT NULL DEFAULT '',
+			payload          BYTEA        NOT NULL,
+			attempts         INTEGER      NOT NULL DEFAULT 0,
+			last_error       TEXT,
+			next_attempt_at  TIMESTAMPTZ  NOT NULL DEFAULT now(),
+			created_at       TIMESTAMPTZ  NOT NULL DEFAULT now()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_outbox_events_next_attempt_at ON outbox_events (next_attempt_at, id)`,
+		`CREATE TABLE IF NOT EXISTS import_jobs (
+			id              BIGSERIAL    PRIMARY KEY,
+			user_id         BIGINT       NOT NULL DEFAULT 0,
+			status          TEXT         NOT NULL,
+			filename        TEXT         NOT NULL DEFAULT '',
+			rows_processed  BIGINT       NOT NULL DEFAULT 0,
+			rows_imported   BIGINT       NOT NULL DEFAULT 0,
+			rows_duplicate  BIGINT       NOT NULL DEFAULT 0,
+			rows_failed     BIGINT       NOT NULL DEFAULT 0,
+			error           TEXT         NOT NULL DEFAULT '',
+			created_at      TIMESTAMPTZ  NOT NULL DEFAULT now(),
+			started_at      TIMESTAMPTZ,
+			finished_at     TIMESTAMPTZ
+		)`,
+		`ALTER TABLE import_jobs ADD COLUMN IF NOT EXISTS user_id BIGINT NOT NULL DEFAULT 0`,
+		`ALTER TABLE import_jobs ADD COLUMN IF NOT EXISTS owner TEXT NOT NULL DEFAULT ''`,
+		`ALTER TABLE import_jobs ADD COLUMN IF NOT EXISTS heartbeat_at TIMESTAMPTZ NOT NULL DEFAULT now()`,
+		`CREATE TABLE IF NOT EXISTS reading_deletions (
+			id              BIGSERIAL         PRIMARY KEY,
+			sensor_id       BIGINT            NOT NULL,
+			start_time      TIMESTAMPTZ       NOT NULL,
+			end_time        TIMESTAMPTZ       NOT NULL,
+			value_operator  TEXT              NOT NULL DEFAULT '',
+			value           DOUBLE PRECISION  NOT NULL DEFAULT 0,
+			channel         TEXT              NOT NULL DEFAULT '',
+			deleted         BIGINT            NOT NULL,
+			user_id         BIGINT            NOT NULL DEFAULT 0,
+			reason          TEXT              NOT NULL DEFAULT '',
+			created_at      TIMESTAMPTZ       NOT NULL DEFAULT now()
+		)`,
+		`CREATE INDEX IF NOT EXISTS idx_reading_deletions_sensor_id ON reading_deletions (sensor_id, created_at DESC)`,
+		`CREATE TABLE IF NOT EXISTS rollup_backfills (
+			view_name     TEXT         PRIMARY KEY,
+			refreshed_at  TIMESTAMPTZ  NOT NULL DEFAULT now()
+		)`,
+		`CREATE TABLE IF NOT EXISTS import_job_errors (
+			job_id   BIGINT  NOT NULL REFERENCES import_jobs (id) ON DELETE CASCADE,
+			line     BIGINT  NOT NULL,
+			message  TEXT    NOT NULL,
+			PRIMARY KEY (job_id, line)
+		)`,
+	}
+
+	// Continuous aggregates keep the building blocks of every supported
+	// aggregate so that coarser buckets can be derived from them.
+	for _, r := range rollups {
+		statements = append(statements,
+			fmt.Sprintf(`CREATE MATERIALIZED VIEW IF NOT EXISTS %s
+				WITH (timescaledb.continuous, timescaledb.materialized_only = false) AS
+				SELECT
+					time_bucket(INTERVAL '%d seconds', time) AS bucket,
+					sensor_id,
+					sum(value)         AS sum,
+					count(*)           AS count,
+					min(value)         AS min,
+					max(value)         AS max,
+					first(value, time) AS first,
+					last(value, time)  AS last
+				FROM sensor_readings
+				GROUP BY bucket, sensor_id
+				WITH NO DATA`, r.table, int64(r.bucket/time.Second)),
+			fmt.Sprintf(`SELECT add_continuous_aggregate_policy('%s',
+				start_offset => INTERVAL '%s',
+				end_offset => INTERVAL '%s',
+				schedule_interval => INTERVAL '%s',
+				if_not_exists => TRUE)`, r.table, r.startOffset, r.endOffset, r.schedule),
+		)
+	}
+
+	for _, stmt := range statements {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("migration error: %w", err)
+		}
+	}
+
+	if err := s.backfillRollups(ctx); err != nil {
+		return err
+	}
+
+	if err := s.ensureUniqueReadings(ctx); err != nil {
+		return err
+	}
+
+	if cfg.CompressAfter > 0 {
+		return s.enableCompression(ctx, cfg.CompressAfter)
+	}
+	return nil
+}
+
+// backfillRollups materializes the whole history of every continuous aggregate once.
+// The aggregates are created WITH NO DATA and their policies only refresh the last
+// startOffset, so readings older than that at the time a rollup was added would
+// otherwise never be rolled up. The first start after a rollup is added can take a
+// while on a large table.
+func (s *TimescaleStorage) backfillRollups(ctx context.Context) error {
+	for _, r := range rollups {
+		var done bool
+		err := s.db.QueryRowContext(ctx,
+			`SELECT EXISTS (SELECT 1 FROM rollup_backfills WHERE view_name = $1)`, r.table).Scan(&done)
+		if err != nil {
+			return fmt.Errorf("rollup backfill lookup error: %w", err)
+		}
+		if done {
+			continue
+		}
+
+		// CALL cannot run inside a transaction, so the view is recorded afterwards;
+		// an interrupted backfill is simply run again.
+		_, err = s.db.ExecContext(ctx, fmt.Sprintf(`CALL refresh_continuous_aggregate('%s', NULL, now() - INTERVAL '%s')`,
+			r.table, r.endOffset))
+		if err != nil {
+			return fmt.Errorf("rollup backfill error: %w", err)
+		}
+		_, err = s.db.ExecContext(ctx,
+			`INSERT INTO rollup_backfills (view_name) VALUES ($1) ON CONFLICT DO NOTHING`, r.table)
+		if err != nil {
+			return fmt.Errorf("rollup backfill error: %w", err)
+		}
+	}
+	return nil
+}
+
+// ensureUniqueReadings makes (sensor_id, time) unique in sensor_readings. Databases
+// created before the constraint existed are deduplicated first, keeping one row per pair.
+func (s *TimescaleStorage) ensureUniqueReadings(ctx context.Context) error {
+	var exists bool
+	err := s.db.QueryRowContext(ctx,
+		`SELECT EXISTS (
+			SELECT 1 FROM pg_indexes
+			WHERE tablename = 'sensor_readings' AND indexname = 'sensor_readings_sensor_id_time_key'
+		)`).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("index lookup error: %w", err)
+	}
+	if exists {
+		return nil
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx: %w", err)
+	}
+	defer tx.Rollback()
+
+	statements := []string{
+		`DELETE FROM sensor_readings a
+		 USING sensor_readings b
+		 WHERE a.sensor_id = b.sensor_id AND a.time = b.time AND a.ctid < b.ctid`,
+		`CREATE UNIQUE INDEX sensor_readings_sensor_id_time_key ON sensor_readings (sensor_id, time DESC)`,
+		// The unique index serves the same queries as the old one.
+		`DROP INDEX IF EXISTS idx_sensor_readings_sensor_time`,
+	}
+	for _, stmt := range statements {
+		if _, err := tx.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("unique index migration error: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx: %w", err)
+	}
+	return nil
+}
+
+// enableCompression turns on native compression for sensor_readings. The settings
+// cannot be changed once chunks are compressed, so they are only applied the first time.
+func (s *TimescaleStorage) enableCompression(ctx context.Context, after time.Duration) error {
+	var enabled bool
+	err := s.db.QueryRowContext(ctx,
+		`SELECT compression_enabled FROM timescaledb_information.hypertables
+		 WHERE hypertable_name = 'sensor_readings'`).Scan(&enabled)
+	if err != nil {
+		return fmt.Errorf("compression lookup error: %w", err)
+	}
+
+	if !enabled {
+		_, err := s.db.ExecContext(ctx, `ALTER TABLE sensor_readings SET (
+			timescaledb.compress,
+			timescaledb.compress_segmentby = 'sensor_id',
+			timescaledb.compress_orderby = 'time DESC'
+		)`)
+		if err != nil {
+			return fmt.Errorf("compression settings error: %w", err)
+		}
+	}
+
+	_, err = s.db.ExecContext(ctx, fmt.Sprintf(`SELECT add_compression_policy('sensor_readings', INTERVAL '%d seconds', if_not_exists => TRUE)`,
+		int64(after/time.Second)))
+	if err != nil {
+		return fmt.Errorf("compression policy error: %w", err)
+	}
+	return nil
+}
+
+// rollupFor returns the coarsest continuous aggregate whose buckets evenly divide
+// interval, or nil when the buckets have to be computed from raw rows.
+func rollupFor(interval time.Duration) *rollup {
+	for i := len(rollups) - 1; i >= 0; i-- {
+		if interval%rollups[i].bucket == 0 {
+			return &rollups[i]
+		}
+	}
+	return nil
+}
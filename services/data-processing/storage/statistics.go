@@ -0,0 +1,102 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ReadingStatistics summarises the readings of a sensor over a time range. Only Count
+// is meaningful when there are no readings.
+type ReadingStatistics struct {
+	SensorID   int64
+	Count      int64
+	Min        float64
+	Max        float64
+	Mean       float64
+	StdDev     float64
+	P50        float64
+	P90        float64
+	P95        float64
+	P99        float64
+	FirstValue float64
+	FirstTime  time.Time
+	LastValue  float64
+	LastTime   time.Time
+}
+
+// GetReadingStatistics computes the statistics of every sensor with readings in the
+// range, ordered by sensor id, and of all their readings together. Percentiles are
+// interpolated (percentile_cont) and stddev is the sample standard deviation.
+func (s *TimescaleStorage) GetReadingStatistics(ctx context.Context, sensorIDs []int64, startTime, endTime time.Time, channel string) ([]*ReadingStatistics, *ReadingStatistics, error) {
+	source, args := readingsSource(channel, pq.Array(sensorIDs), startTime, endTime)
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT
+			sensor_id,
+			count(*),
+			min(value),
+			max(value),
+			avg(value),
+			coalesce(stddev_samp(value), 0),
+			percentile_cont(ARRAY[0.5, 0.9, 0.95, 0.99]) WITHIN GROUP (ORDER BY value),
+			first(value, time),
+			min(time),
+			last(value, time),
+			max(time)
+		 FROM `+source+`
+		 WHERE sensor_id = ANY($1) AND time >= $2 AND time <= $3
+		 GROUP BY GROUPING SETS ((sensor_id), ())
+		 ORDER BY sensor_id NULLS FIRST`,
+		args...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var perSensor []*ReadingStatistics
+	overall := &ReadingStatistics{}
+	for rows.Next() {
+		var (
+			sensorID                 sql.NullInt64
+			count                    int64
+			minV, maxV, mean, stddev sql.NullFloat64
+			percentiles              pq.Float64Array
+			firstValue, lastValue    sql.NullFloat64
+			firstTime, lastTime      sql.NullTime
+		)
+		if err := rows.Scan(&sensorID, &count, &minV, &maxV, &mean, &stddev, &percentiles,
+			&firstValue, &firstTime, &lastValue, &lastTime); err != nil {
+			return nil, nil, fmt.Errorf("scan error: %w", err)
+		}
+
+		stats := &ReadingStatistics{
+			SensorID:   sensorID.Int64,
+			Count:      count,
+			Min:        minV.Float64,
+			Max:        maxV.Float64,
+			Mean:       mean.Float64,
+			StdDev:     stddev.Float64,
+			FirstValue: firstValue.Float64,
+			FirstTime:  firstTime.Time,
+			LastValue:  lastValue.Float64,
+			LastTime:   lastTime.Time,
+		}
+		if len(percentiles) == 4 {
+			stats.P50, stats.P90, stats.P95, stats.P99 = percentiles[0], percentiles[1], percentiles[2], percentiles[3]
+		}
+
+		if sensorID.Valid {
+			perSensor = append(perSensor, stats)
+		} else {
+			overall = stats
+		}
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return perSensor, overall, nil
+}
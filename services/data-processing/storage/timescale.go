@@ -10,56 +10,248 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
 )
 
 type TimescaleStorage struct {
-	db *sql.DB
+	db              *sql.DB
+	duplicatePolicy DuplicatePolicy
+	// instanceID identifies this process as the owner of the import jobs it runs.
+	instanceID string
+}
+
+// Reading is a single sample to be written to sensor_readings.
+type Reading struct {
+	SensorID  int64
+	Value     float32
+	Timestamp time.Time
+	Quality   Quality
+	// RawQuality is the quality the reading was reported with, before range policies
+	// flagged it. Recalibration derives Quality from it again.
+	RawQuality Quality
+	// Channels holds every channel value of a multi-channel sample, including the
+	// primary channel that is also stored as Value. It is nil for single-value sensors.
+	Channels map[string]float32
+	// ChannelQuality holds the quality of every channel of a multi-channel sample. It is
+	// only published with the event, the stored quality covers the whole sample.
+	ChannelQuality map[string]Quality
+	// Raw holds the values as reported when a calibration changed them, nil otherwise.
+	Raw *RawValues
+	// IdempotencyKey optionally identifies the reading across client retries.
+	IdempotencyKey string
+	// Event is queued in the outbox in the same transaction when the reading is stored.
+	Event *outbox.Message
+}
+
+// RawValues are the values of a reading before calibration.
+type RawValues struct {
+	Value    float32
+	Channels map[string]float32
 }
 
 type ITimeScaleStorage interface {
-	StoreReading(ctx context.Context, sensorID int64, value float32, timestamp time.Time) error
-	QueryReadings(ctx context.Context, sensorID int64, startTime, endTime time.Time) ([]*pb_data.DataPoint, error)
+	outbox.Store
+	StoreReading(ctx context.Context, reading Reading) (bool, error)
+	StoreReadings(ctx context.Context, readings []Reading) ([]bool, error)
+	QueryReadings(ctx context.Context, sensorID int64, startTime, endTime time.Time, channel string, after time.Time, limit int) ([]*pb_data.DataPoint, error)
+	QueryAggregatedReadings(ctx context.Context, sensorID int64, startTime, endTime time.Time, agg Aggregation, channel string) ([]*pb_data.DataPoint, error)
+	QueryMultiSensorReadings(ctx context.Context, sensorIDs []int64, startTime, endTime time.Time, agg Aggregation, channel string) ([]*MultiSensorRow, error)
+	ListDataGaps(ctx context.Context, sensorID int64, startTime, endTime time.Time, minGap time.Duration) ([]DataGap, error)
+	MedianReadingInterval(ctx context.Context, sensorID int64, startTime, endTime time.Time) (time.Duration, bool, error)
+	GetReadingStatistics(ctx context.Context, sensorIDs []int64, startTime, endTime time.Time, channel string) ([]*ReadingStatistics, *ReadingStatistics, error)
+	ScanReadings(ctx context.Context, sensorIDs []int64, startTime, endTime time.Time, fn func(Reading) error) error
+	ReadingsAt(ctx context.Context, sensorIDs []int64, at time.Time, maxAge time.Duration) (map[int64]Reading, error)
+	UpdateReadingValues(ctx context.Context, readings []Reading) (int64, error)
+	CountReadings(ctx context.Context, filter ReadingFilter) (int64, error)
+	DeleteReadings(ctx context.Context, deletion ReadingDeletion) (*ReadingDeletion, time.Time, time.Time, error)
+	ListReadingDeletions(ctx context.Context, sensorID int64, limit int64) ([]*ReadingDeletion, error)
+	CreateImportJob(ctx context.Context, userID int64, filename string) (*ImportJob, error)
+	UpdateImportJob(ctx context.Context, job *ImportJob, rowErrors []ImportRowError) error
+	GetImportJob(ctx context.Context, id, userID int64) (*ImportJob, error)
+	RenewImportJobLeases(ctx context.Context) (int64, error)
+	FailExpiredImportJobs(ctx context.Context, lease time.Duration) (int64, error)
+	RefreshRollups(ctx context.Context, start, end time.Time) error
+	Migrate(ctx context.Context, cfg SchemaConfig) error
+	UpsertRetentionPolicy(ctx context.Context, policy RetentionPolicy) (*RetentionPolicy, error)
+	ListRetentionPolicies(ctx context.Context) ([]*RetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, id int64) error
+	DeleteReadingsBefore(ctx context.Context, sensorIDs []int64, before time.Time) (int64, error)
+	DeleteReadingsBeforeExcept(ctx context.Context, excludedSensorIDs []int64, before time.Time) (int64, error)
+	DeleteIdempotencyKeysBefore(ctx context.Context, before time.Time) (int64, error)
 	GetLatestReadingsBatch(ctx context.Context, sensorIDs []int64) ([]*pb_data.ReadingUpdate, error)
 	GetLatestReadingsBySensor(ctx context.Context, sensorID int64, limit int64) ([]*pb_data.ReadingUpdate, error)
 }
 
-func NewTimescaleStorage(db *sql.DB) ITimeScaleStorage {
-	return &TimescaleStorage{db: db}
+func NewTimescaleStorage(db *sql.DB, duplicatePolicy DuplicatePolicy) ITimeScaleStorage {
+	return &TimescaleStorage{db: db, duplicatePolicy: duplicatePolicy, instanceID: newInstanceID()}
 }
 
-func (s *TimescaleStorage) StoreReading(ctx context.Context, sensorID int64, value float32, timestamp time.Time) error {
-	_, err := s.db.ExecContext(ctx,
-		"INSERT INTO sensor_readings (time, sensor_id, value) VALUES ($1, $2, $3)",
-		timestamp, sensorID, value)
-	return err
-}
-
-func (s *TimescaleStorage) QueryReadings(ctx context.Context, sensorID int64, startTime, endTime time.Time) ([]*pb_data.DataPoint, error) {
+// QueryReadings returns up to limit raw readings of a sensor taken after the given
+// time, which pages through a range by the time of the last reading returned. A
+// non-empty channel returns that channel as the value and skips samples that do not
+// carry it.
+func (s *TimescaleStorage) QueryReadings(ctx context.Context, sensorID int64, startTime, endTime time.Time, channel string, after time.Time, limit int) ([]*pb_data.DataPoint, error) {
+	source, args := readingsSource(channel, sensorID, startTime, endTime, after, limit)
 	rows, err := s.db.QueryContext(ctx,
-		`SELECT time, value FROM sensor_readings 
-         WHERE sensor_id = $1 AND time >= $2 AND time <= $3 
-         ORDER BY time ASC`,
-		sensorID, startTime, endTime)
+		`SELECT time, value, quality, channels, raw_value, raw_channels FROM `+source+` 
+         WHERE sensor_id = $1 AND time >= $2 AND time <= $3 AND time > $4
+         ORDER BY time ASC
+         LIMIT $5`,
+		args...)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var dataPoints []*pb_data.DataPoint
+	dataPoints := make([]*pb_data.DataPoint, 0, limit)
 	for rows.Next() {
 		var t time.Time
 		var v float32
-		if err := rows.Scan(&t, &v); err != nil {
+		var q Quality
+		var channels, rawChannels []byte
+		var rawValue sql.NullFloat64
+		if err := rows.Scan(&t, &v, &q, &channels, &rawValue, &rawChannels); err != nil {
 			return nil, err
 		}
-		dataPoints = append(dataPoints, &pb_data.DataPoint{
-			Time:  timestamppb.New(t),
-			Value: v,
-		})
+		values, err := decodeChannels(channels)
+		if err != nil {
+			return nil, err
+		}
+		raw, err := decodeRaw(rawValue, rawChannels)
+		if err != nil {
+			return nil, err
+		}
+		dp := &pb_data.DataPoint{
+			Time:      timestamppb.New(t),
+			Value:     v,
+			Quality:   q.String(),
+			Values:    values,
+			RawValue:  v,
+			RawValues: values,
+		}
+		if raw != nil {
+			dp.RawValue = raw.Value
+			dp.RawValues = raw.Channels
+			dp.Calibrated = true
+		}
+		dataPoints = append(dataPoints, dp)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
 	}
 	return dataPoints, nil
 }
 
+// QueryAggregatedReadings buckets the readings of a sensor. Rollups only cover the
+// primary value, so named channels are always aggregated from raw readings.
+func (s *TimescaleStorage) QueryAggregatedReadings(ctx context.Context, sensorID int64, startTime, endTime time.Time, agg Aggregation, channel string) ([]*pb_data.DataPoint, error) {
+	query, args, err := bucketQuery(agg, channel, "sensor_id = $1", sensorID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	var dataPoints []*pb_data.DataPoint
+	for rows.Next() {
+		var b bucketRow
+		if err := b.scan(rows); err != nil {
+			return nil, err
+		}
+		dataPoints = append(dataPoints, b.dataPoint())
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+
+	return dataPoints, nil
+}
+
+// bucketQuery builds a query bucketing the readings of the sensors matched by filter,
+// which refers to sensorArg as $1. Rows hold sensor_id, bucket, the aggregate value,
+// min, max, avg and count, ordered by bucket and sensor.
+func bucketQuery(agg Aggregation, channel, filter string, sensorArg any, startTime, endTime time.Time) (string, []any, error) {
+	source, args := readingsSource(channel, sensorArg, startTime, endTime, agg.pgInterval())
+
+	if r := rollupFor(agg.Interval); r != nil && channel == "" {
+		// Buckets are read from the rollup as a whole, so the bucket holding
+		// startTime is included in full rather than from startTime onwards, while
+		// a rollup bucket reaching past endTime is left out.
+		expr, ok := rollupExpressions[agg.Function]
+		if !ok {
+			return "", nil, fmt.Errorf("unsupported aggregate function %q", agg.Function)
+		}
+		bucket, value := agg.bucketExpression("bucket", expr)
+		return fmt.Sprintf(`
+			SELECT
+				sensor_id,
+				%s AS outer_bucket,
+				%s,
+				min(min),
+				max(max),
+				sum(sum) / sum(count),
+				sum(count)::bigint
+			FROM %s
+			WHERE %s AND bucket >= time_bucket($4::interval, $2::timestamptz)
+				AND bucket + INTERVAL '%d seconds' <= $3
+			GROUP BY sensor_id, outer_bucket
+			ORDER BY outer_bucket ASC, sensor_id`, bucket, value, r.table, filter, int64(r.bucket/time.Second)), args, nil
+	}
+
+	expr, ok := aggregateExpressions[agg.Function]
+	if !ok {
+		return "", nil, fmt.Errorf("unsupported aggregate function %q", agg.Function)
+	}
+	bucket, value := agg.bucketExpression("time", expr)
+	return fmt.Sprintf(`
+		SELECT
+			sensor_id,
+			%s AS bucket,
+			%s,
+			min(value),
+			max(value),
+			avg(value),
+			count(*)
+		FROM %s
+		WHERE %s AND time >= $2 AND time <= $3
+		GROUP BY sensor_id, bucket
+		ORDER BY bucket ASC, sensor_id`, bucket, value, source, filter), args, nil
+}
+
+// bucketRow is a row of a bucketQuery. Everything but the sensor and bucket is NULL
+// for buckets added by gap filling.
+type bucketRow struct {
+	sensorID               int64
+	time                   time.Time
+	value, minV, maxV, avg sql.NullFloat64
+	count                  sql.NullInt64
+}
+
+func (b *bucketRow) scan(rows *sql.Rows) error {
+	if err := rows.Scan(&b.sensorID, &b.time, &b.value, &b.minV, &b.maxV, &b.avg, &b.count); err != nil {
+		return fmt.Errorf("scan error: %w", err)
+	}
+	return nil
+}
+
+func (b *bucketRow) dataPoint() *pb_data.DataPoint {
+	return &pb_data.DataPoint{
+		Time:    timestamppb.New(b.time),
+		Value:   float32(b.value.Float64),
+		Min:     float32(b.minV.Float64),
+		Max:     float32(b.maxV.Float64),
+		Avg:     float32(b.avg.Float64),
+		Count:   b.count.Int64,
+		Gap:     b.count.Int64 == 0,
+		NoValue: !b.value.Valid,
+	}
+}
+
 func (s *TimescaleStorage) GetLatestReadingsBatch(ctx context.Context, sensorIDs []int64) ([]*pb_data.ReadingUpdate, error) {
 	if len(sensorIDs) == 0 {
 		return []*pb_data.ReadingUpdate{}, nil
@@ -71,11 +263,13 @@ func (s *TimescaleStorage) GetLatestReadingsBatch(ctx context.Context, sensorIDs
 				sensor_id, 
 				value, 
 				time,
+				quality,
+				channels,
 				ROW_NUMBER() OVER (PARTITION BY sensor_id ORDER BY time DESC) as rn
 			FROM sensor_readings 
 			WHERE sensor_id = ANY($1)
 		)
-		SELECT sensor_id, value, time
+		SELECT sensor_id, value, time, quality, channels
 		FROM ranked_readings
 		WHERE rn = 1
 		ORDER BY sensor_id`
@@ -91,15 +285,24 @@ func (s *TimescaleStorage) GetLatestReadingsBatch(ctx context.Context, sensorIDs
 		var sensorID int64
 		var v float32
 		var t time.Time
+		var q Quality
+		var channels []byte
 
-		if err := rows.Scan(&sensorID, &v, &t); err != nil {
+		if err := rows.Scan(&sensorID, &v, &t, &q, &channels); err != nil {
 			return nil, fmt.Errorf("scan error: %w", err)
 		}
 
+		values, err := decodeChannels(channels)
+		if err != nil {
+			return nil, err
+		}
+
 		readings = append(readings, &pb_data.ReadingUpdate{
 			SensorId:  sensorID,
 			Value:     v,
 			Timestamp: timestamppb.New(t),
+			Quality:   q.String(),
+			Values:    values,
 		})
 	}
 
@@ -115,7 +318,7 @@ func (s *TimescaleStorage) GetLatestReadingsBySensor(ctx context.Context, sensor
 		limit = 1
 	}
 
-	query := `SELECT time, value FROM sensor_readings 
+	query := `SELECT time, value, quality, channels FROM sensor_readings 
               WHERE sensor_id = $1 
               ORDER BY time DESC 
               LIMIT $2`
@@ -130,15 +333,24 @@ func (s *TimescaleStorage) GetLatestReadingsBySensor(ctx context.Context, sensor
 	for rows.Next() {
 		var t time.Time
 		var v float32
+		var q Quality
+		var channels []byte
 
-		if err := rows.Scan(&t, &v); err != nil {
+		if err := rows.Scan(&t, &v, &q, &channels); err != nil {
 			return nil, fmt.Errorf("scan error: %w", err)
 		}
 
+		values, err := decodeChannels(channels)
+		if err != nil {
+			return nil, err
+		}
+
 		readings = append(readings, &pb_data.ReadingUpdate{
 			SensorId:  sensorID,
 			Value:     v,
 			Timestamp: timestamppb.New(t),
+			Quality:   q.String(),
+			Values:    values,
 		})
 	}
 
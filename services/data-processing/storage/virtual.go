@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+// ReadingsAt returns the latest reading of every sensor at or before at, keyed by sensor
+// id. Readings older than maxAge before at are ignored, so sensors without a recent
+// reading are missing from the result.
+func (s *TimescaleStorage) ReadingsAt(ctx context.Context, sensorIDs []int64, at time.Time, maxAge time.Duration) (map[int64]Reading, error) {
+	readings := make(map[int64]Reading, len(sensorIDs))
+	if len(sensorIDs) == 0 {
+		return readings, nil
+	}
+
+	rows, err := s.db.QueryContext(ctx,
+		`SELECT r.sensor_id, r.time, r.value, r.quality, r.channels
+		 FROM unnest($1::bigint[]) AS ids(id)
+		 CROSS JOIN LATERAL (
+			SELECT sensor_id, time, value, quality, channels FROM sensor_readings
+			WHERE sensor_id = ids.id AND time <= $2 AND time > $3
+			ORDER BY time DESC
+			LIMIT 1
+		 ) r`,
+		pq.Array(sensorIDs), at, at.Add(-maxAge))
+	if err != nil {
+		return nil, fmt.Errorf("query error: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var r Reading
+		var channels []byte
+		if err := rows.Scan(&r.SensorID, &r.Timestamp, &r.Value, &r.Quality, &channels); err != nil {
+			return nil, fmt.Errorf("scan error: %w", err)
+		}
+		if r.Channels, err = decodeChannels(channels); err != nil {
+			return nil, err
+		}
+		readings[r.SensorID] = r
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", err)
+	}
+	return readings, nil
+}
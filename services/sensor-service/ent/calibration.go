@@ -0,0 +1,244 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
+)
+
+// Calibration is the model entity for the Calibration schema.
+type Calibration struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ID of the calibrated sensor
+	SensorID int `json:"sensor_id,omitempty"`
+	// Channel the calibration applies to; empty for the reading value
+	Channel string `json:"channel,omitempty"`
+	// Offset holds the value of the "offset" field.
+	Offset float64 `json:"offset,omitempty"`
+	// Gain holds the value of the "gain" field.
+	Gain float64 `json:"gain,omitempty"`
+	// Polynomial coefficients in ascending order of degree
+	Coefficients []float64 `json:"coefficients,omitempty"`
+	// Start of the validity period, inclusive
+	ValidFrom time.Time `json:"valid_from,omitempty"`
+	// End of the validity period, exclusive; open-ended when unset
+	ValidTo *time.Time `json:"valid_to,omitempty"`
+	// Reference of the calibration certificate
+	Certificate string `json:"certificate,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CalibrationQuery when eager-loading is set.
+	Edges        CalibrationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CalibrationEdges holds the relations/edges for other nodes in the graph.
+type CalibrationEdges struct {
+	// The calibrated sensor
+	Sensor *Sensor `json:"sensor,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// SensorOrErr returns the Sensor value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CalibrationEdges) SensorOrErr() (*Sensor, error) {
+	if e.Sensor != nil {
+		return e.Sensor, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: sensor.Label}
+	}
+	return nil, &NotLoadedError{edge: "sensor"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Calibration) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case calibration.FieldCoefficients:
+			values[i] = new([]byte)
+		case calibration.FieldOffset, calibration.FieldGain:
+			values[i] = new(sql.NullFloat64)
+		case calibration.FieldID, calibration.FieldSensorID:
+			values[i] = new(sql.NullInt64)
+		case calibration.FieldChannel, calibration.FieldCertificate:
+			values[i] = new(sql.NullString)
+		case calibration.FieldValidFrom, calibration.FieldValidTo, calibration.FieldCreatedAt, calibration.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Calibration fields.
+func (c *Calibration) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case calibration.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			c.ID = int(value.Int64)
+		case calibration.FieldSensorID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field sensor_id", values[i])
+			} else if value.Valid {
+				c.SensorID = int(value.Int64)
+			}
+		case calibration.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				c.Channel = value.String
+			}
+		case calibration.FieldOffset:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field offset", values[i])
+			} else if value.Valid {
+				c.Offset = value.Float64
+			}
+		case calibration.FieldGain:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field gain", values[i])
+			} else if value.Valid {
+				c.Gain = value.Float64
+			}
+		case calibration.FieldCoefficients:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field coefficients", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &c.Coefficients); err != nil {
+					return fmt.Errorf("unmarshal field coefficients: %w", err)
+				}
+			}
+		case calibration.FieldValidFrom:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_from", values[i])
+			} else if value.Valid {
+				c.ValidFrom = value.Time
+			}
+		case calibration.FieldValidTo:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field valid_to", values[i])
+			} else if value.Valid {
+				c.ValidTo = new(time.Time)
+				*c.ValidTo = value.Time
+			}
+		case calibration.FieldCertificate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field certificate", values[i])
+			} else if value.Valid {
+				c.Certificate = value.String
+			}
+		case calibration.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				c.CreatedAt = value.Time
+			}
+		case calibration.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				c.UpdatedAt = value.Time
+			}
+		default:
+			c.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Calibration.
+// This includes values selected through modifiers, order, etc.
+func (c *Calibration) Value(name string) (ent.Value, error) {
+	return c.selectValues.Get(name)
+}
+
+// QuerySensor queries the "sensor" edge of the Calibration entity.
+func (c *Calibration) QuerySensor() *SensorQuery {
+	return NewCalibrationClient(c.config).QuerySensor(c)
+}
+
+// Update returns a builder for updating this Calibration.
+// Note that you need to call Calibration.Unwrap() before calling this method if this Calibration
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (c *Calibration) Update() *CalibrationUpdateOne {
+	return NewCalibrationClient(c.config).UpdateOne(c)
+}
+
+// Unwrap unwraps the Calibration entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (c *Calibration) Unwrap() *Calibration {
+	_tx, ok := c.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Calibration is not a transactional entity")
+	}
+	c.config.driver = _tx.drv
+	return c
+}
+
+// String implements the fmt.Stringer.
+func (c *Calibration) String() string {
+	var builder strings.Builder
+	builder.WriteString("Calibration(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", c.ID))
+	builder.WriteString("sensor_id=")
+	builder.WriteString(fmt.Sprintf("%v", c.SensorID))
+	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(c.Channel)
+	builder.WriteString(", ")
+	builder.WriteString("offset=")
+	builder.WriteString(fmt.Sprintf("%v", c.Offset))
+	builder.WriteString(", ")
+	builder.WriteString("gain=")
+	builder.WriteString(fmt.Sprintf("%v", c.Gain))
+	builder.WriteString(", ")
+	builder.WriteString("coefficients=")
+	builder.WriteString(fmt.Sprintf("%v", c.Coefficients))
+	builder.WriteString(", ")
+	builder.WriteString("valid_from=")
+	builder.WriteString(c.ValidFrom.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := c.ValidTo; v != nil {
+		builder.WriteString("valid_to=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("certificate=")
+	builder.WriteString(c.Certificate)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(c.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(c.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Calibrations is a parsable slice of Calibration.
+type Calibrations []*Calibration
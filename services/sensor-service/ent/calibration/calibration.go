@@ -0,0 +1,153 @@
+// Code generated by ent, DO NOT EDIT.
+
+package calibration
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+)
+
+const (
+	// Label holds the string label denoting the calibration type in the database.
+	Label = "calibration"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSensorID holds the string denoting the sensor_id field in the database.
+	FieldSensorID = "sensor_id"
+	// FieldChannel holds the string denoting the channel field in the database.
+	FieldChannel = "channel"
+	// FieldOffset holds the string denoting the offset field in the database.
+	FieldOffset = "offset"
+	// FieldGain holds the string denoting the gain field in the database.
+	FieldGain = "gain"
+	// FieldCoefficients holds the string denoting the coefficients field in the database.
+	FieldCoefficients = "coefficients"
+	// FieldValidFrom holds the string denoting the valid_from field in the database.
+	FieldValidFrom = "valid_from"
+	// FieldValidTo holds the string denoting the valid_to field in the database.
+	FieldValidTo = "valid_to"
+	// FieldCertificate holds the string denoting the certificate field in the database.
+	FieldCertificate = "certificate"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeSensor holds the string denoting the sensor edge name in mutations.
+	EdgeSensor = "sensor"
+	// Table holds the table name of the calibration in the database.
+	Table = "calibrations"
+	// SensorTable is the table that holds the sensor relation/edge.
+	SensorTable = "calibrations"
+	// SensorInverseTable is the table name for the Sensor entity.
+	// It exists in this package in order to avoid circular dependency with the "sensor" package.
+	SensorInverseTable = "sensors"
+	// SensorColumn is the table column denoting the sensor relation/edge.
+	SensorColumn = "sensor_id"
+)
+
+// Columns holds all SQL columns for calibration fields.
+var Columns = []string{
+	FieldID,
+	FieldSensorID,
+	FieldChannel,
+	FieldOffset,
+	FieldGain,
+	FieldCoefficients,
+	FieldValidFrom,
+	FieldValidTo,
+	FieldCertificate,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultOffset holds the default value on creation for the "offset" field.
+	DefaultOffset float64
+	// DefaultGain holds the default value on creation for the "gain" field.
+	DefaultGain float64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+)
+
+// OrderOption defines the ordering options for the Calibration queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySensorID orders the results by the sensor_id field.
+func BySensorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSensorID, opts...).ToFunc()
+}
+
+// ByChannel orders the results by the channel field.
+func ByChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannel, opts...).ToFunc()
+}
+
+// ByOffset orders the results by the offset field.
+func ByOffset(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOffset, opts...).ToFunc()
+}
+
+// ByGain orders the results by the gain field.
+func ByGain(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGain, opts...).ToFunc()
+}
+
+// ByValidFrom orders the results by the valid_from field.
+func ByValidFrom(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidFrom, opts...).ToFunc()
+}
+
+// ByValidTo orders the results by the valid_to field.
+func ByValidTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldValidTo, opts...).ToFunc()
+}
+
+// ByCertificate orders the results by the certificate field.
+func ByCertificate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCertificate, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// BySensorField orders the results by sensor field.
+func BySensorField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSensorStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newSensorStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SensorInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SensorTable, SensorColumn),
+	)
+}
@@ -0,0 +1,569 @@
+// Code generated by ent, DO NOT EDIT.
+
+package calibration
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldID, id))
+}
+
+// SensorID applies equality check predicate on the "sensor_id" field. It's identical to SensorIDEQ.
+func SensorID(v int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldSensorID, v))
+}
+
+// Channel applies equality check predicate on the "channel" field. It's identical to ChannelEQ.
+func Channel(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldChannel, v))
+}
+
+// Offset applies equality check predicate on the "offset" field. It's identical to OffsetEQ.
+func Offset(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldOffset, v))
+}
+
+// Gain applies equality check predicate on the "gain" field. It's identical to GainEQ.
+func Gain(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldGain, v))
+}
+
+// ValidFrom applies equality check predicate on the "valid_from" field. It's identical to ValidFromEQ.
+func ValidFrom(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidTo applies equality check predicate on the "valid_to" field. It's identical to ValidToEQ.
+func ValidTo(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldValidTo, v))
+}
+
+// Certificate applies equality check predicate on the "certificate" field. It's identical to CertificateEQ.
+func Certificate(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldCertificate, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// SensorIDEQ applies the EQ predicate on the "sensor_id" field.
+func SensorIDEQ(v int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldSensorID, v))
+}
+
+// SensorIDNEQ applies the NEQ predicate on the "sensor_id" field.
+func SensorIDNEQ(v int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldSensorID, v))
+}
+
+// SensorIDIn applies the In predicate on the "sensor_id" field.
+func SensorIDIn(vs ...int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldSensorID, vs...))
+}
+
+// SensorIDNotIn applies the NotIn predicate on the "sensor_id" field.
+func SensorIDNotIn(vs ...int) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldSensorID, vs...))
+}
+
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// ChannelGT applies the GT predicate on the "channel" field.
+func ChannelGT(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldChannel, v))
+}
+
+// ChannelGTE applies the GTE predicate on the "channel" field.
+func ChannelGTE(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldChannel, v))
+}
+
+// ChannelLT applies the LT predicate on the "channel" field.
+func ChannelLT(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldChannel, v))
+}
+
+// ChannelLTE applies the LTE predicate on the "channel" field.
+func ChannelLTE(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldChannel, v))
+}
+
+// ChannelContains applies the Contains predicate on the "channel" field.
+func ChannelContains(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldContains(FieldChannel, v))
+}
+
+// ChannelHasPrefix applies the HasPrefix predicate on the "channel" field.
+func ChannelHasPrefix(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldHasPrefix(FieldChannel, v))
+}
+
+// ChannelHasSuffix applies the HasSuffix predicate on the "channel" field.
+func ChannelHasSuffix(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldHasSuffix(FieldChannel, v))
+}
+
+// ChannelIsNil applies the IsNil predicate on the "channel" field.
+func ChannelIsNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldIsNull(FieldChannel))
+}
+
+// ChannelNotNil applies the NotNil predicate on the "channel" field.
+func ChannelNotNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotNull(FieldChannel))
+}
+
+// ChannelEqualFold applies the EqualFold predicate on the "channel" field.
+func ChannelEqualFold(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEqualFold(FieldChannel, v))
+}
+
+// ChannelContainsFold applies the ContainsFold predicate on the "channel" field.
+func ChannelContainsFold(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldContainsFold(FieldChannel, v))
+}
+
+// OffsetEQ applies the EQ predicate on the "offset" field.
+func OffsetEQ(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldOffset, v))
+}
+
+// OffsetNEQ applies the NEQ predicate on the "offset" field.
+func OffsetNEQ(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldOffset, v))
+}
+
+// OffsetIn applies the In predicate on the "offset" field.
+func OffsetIn(vs ...float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldOffset, vs...))
+}
+
+// OffsetNotIn applies the NotIn predicate on the "offset" field.
+func OffsetNotIn(vs ...float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldOffset, vs...))
+}
+
+// OffsetGT applies the GT predicate on the "offset" field.
+func OffsetGT(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldOffset, v))
+}
+
+// OffsetGTE applies the GTE predicate on the "offset" field.
+func OffsetGTE(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldOffset, v))
+}
+
+// OffsetLT applies the LT predicate on the "offset" field.
+func OffsetLT(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldOffset, v))
+}
+
+// OffsetLTE applies the LTE predicate on the "offset" field.
+func OffsetLTE(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldOffset, v))
+}
+
+// GainEQ applies the EQ predicate on the "gain" field.
+func GainEQ(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldGain, v))
+}
+
+// GainNEQ applies the NEQ predicate on the "gain" field.
+func GainNEQ(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldGain, v))
+}
+
+// GainIn applies the In predicate on the "gain" field.
+func GainIn(vs ...float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldGain, vs...))
+}
+
+// GainNotIn applies the NotIn predicate on the "gain" field.
+func GainNotIn(vs ...float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldGain, vs...))
+}
+
+// GainGT applies the GT predicate on the "gain" field.
+func GainGT(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldGain, v))
+}
+
+// GainGTE applies the GTE predicate on the "gain" field.
+func GainGTE(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldGain, v))
+}
+
+// GainLT applies the LT predicate on the "gain" field.
+func GainLT(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldGain, v))
+}
+
+// GainLTE applies the LTE predicate on the "gain" field.
+func GainLTE(v float64) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldGain, v))
+}
+
+// CoefficientsIsNil applies the IsNil predicate on the "coefficients" field.
+func CoefficientsIsNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldIsNull(FieldCoefficients))
+}
+
+// CoefficientsNotNil applies the NotNil predicate on the "coefficients" field.
+func CoefficientsNotNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotNull(FieldCoefficients))
+}
+
+// ValidFromEQ applies the EQ predicate on the "valid_from" field.
+func ValidFromEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldValidFrom, v))
+}
+
+// ValidFromNEQ applies the NEQ predicate on the "valid_from" field.
+func ValidFromNEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldValidFrom, v))
+}
+
+// ValidFromIn applies the In predicate on the "valid_from" field.
+func ValidFromIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldValidFrom, vs...))
+}
+
+// ValidFromNotIn applies the NotIn predicate on the "valid_from" field.
+func ValidFromNotIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldValidFrom, vs...))
+}
+
+// ValidFromGT applies the GT predicate on the "valid_from" field.
+func ValidFromGT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldValidFrom, v))
+}
+
+// ValidFromGTE applies the GTE predicate on the "valid_from" field.
+func ValidFromGTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldValidFrom, v))
+}
+
+// ValidFromLT applies the LT predicate on the "valid_from" field.
+func ValidFromLT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldValidFrom, v))
+}
+
+// ValidFromLTE applies the LTE predicate on the "valid_from" field.
+func ValidFromLTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldValidFrom, v))
+}
+
+// ValidToEQ applies the EQ predicate on the "valid_to" field.
+func ValidToEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldValidTo, v))
+}
+
+// ValidToNEQ applies the NEQ predicate on the "valid_to" field.
+func ValidToNEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldValidTo, v))
+}
+
+// ValidToIn applies the In predicate on the "valid_to" field.
+func ValidToIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldValidTo, vs...))
+}
+
+// ValidToNotIn applies the NotIn predicate on the "valid_to" field.
+func ValidToNotIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldValidTo, vs...))
+}
+
+// ValidToGT applies the GT predicate on the "valid_to" field.
+func ValidToGT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldValidTo, v))
+}
+
+// ValidToGTE applies the GTE predicate on the "valid_to" field.
+func ValidToGTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldValidTo, v))
+}
+
+// ValidToLT applies the LT predicate on the "valid_to" field.
+func ValidToLT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldValidTo, v))
+}
+
+// ValidToLTE applies the LTE predicate on the "valid_to" field.
+func ValidToLTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldValidTo, v))
+}
+
+// ValidToIsNil applies the IsNil predicate on the "valid_to" field.
+func ValidToIsNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldIsNull(FieldValidTo))
+}
+
+// ValidToNotNil applies the NotNil predicate on the "valid_to" field.
+func ValidToNotNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotNull(FieldValidTo))
+}
+
+// CertificateEQ applies the EQ predicate on the "certificate" field.
+func CertificateEQ(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldCertificate, v))
+}
+
+// CertificateNEQ applies the NEQ predicate on the "certificate" field.
+func CertificateNEQ(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldCertificate, v))
+}
+
+// CertificateIn applies the In predicate on the "certificate" field.
+func CertificateIn(vs ...string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldCertificate, vs...))
+}
+
+// CertificateNotIn applies the NotIn predicate on the "certificate" field.
+func CertificateNotIn(vs ...string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldCertificate, vs...))
+}
+
+// CertificateGT applies the GT predicate on the "certificate" field.
+func CertificateGT(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldCertificate, v))
+}
+
+// CertificateGTE applies the GTE predicate on the "certificate" field.
+func CertificateGTE(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldCertificate, v))
+}
+
+// CertificateLT applies the LT predicate on the "certificate" field.
+func CertificateLT(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldCertificate, v))
+}
+
+// CertificateLTE applies the LTE predicate on the "certificate" field.
+func CertificateLTE(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldCertificate, v))
+}
+
+// CertificateContains applies the Contains predicate on the "certificate" field.
+func CertificateContains(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldContains(FieldCertificate, v))
+}
+
+// CertificateHasPrefix applies the HasPrefix predicate on the "certificate" field.
+func CertificateHasPrefix(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldHasPrefix(FieldCertificate, v))
+}
+
+// CertificateHasSuffix applies the HasSuffix predicate on the "certificate" field.
+func CertificateHasSuffix(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldHasSuffix(FieldCertificate, v))
+}
+
+// CertificateIsNil applies the IsNil predicate on the "certificate" field.
+func CertificateIsNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldIsNull(FieldCertificate))
+}
+
+// CertificateNotNil applies the NotNil predicate on the "certificate" field.
+func CertificateNotNil() predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotNull(FieldCertificate))
+}
+
+// CertificateEqualFold applies the EqualFold predicate on the "certificate" field.
+func CertificateEqualFold(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEqualFold(FieldCertificate, v))
+}
+
+// CertificateContainsFold applies the ContainsFold predicate on the "certificate" field.
+func CertificateContainsFold(v string) predicate.Calibration {
+	return predicate.Calibration(sql.FieldContainsFold(FieldCertificate, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Calibration {
+	return predicate.Calibration(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasSensor applies the HasEdge predicate on the "sensor" edge.
+func HasSensor() predicate.Calibration {
+	return predicate.Calibration(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SensorTable, SensorColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSensorWith applies the HasEdge predicate on the "sensor" edge with a given conditions (other predicates).
+func HasSensorWith(preds ...predicate.Sensor) predicate.Calibration {
+	return predicate.Calibration(func(s *sql.Selector) {
+		step := newSensorStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Calibration) predicate.Calibration {
+	return predicate.Calibration(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Calibration) predicate.Calibration {
+	return predicate.Calibration(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Calibration) predicate.Calibration {
+	return predicate.Calibration(sql.NotPredicates(p))
+}
@@ -0,0 +1,389 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
+)
+
+// CalibrationCreate is the builder for creating a Calibration entity.
+type CalibrationCreate struct {
+	config
+	mutation *CalibrationMutation
+	hooks    []Hook
+}
+
+// SetSensorID sets the "sensor_id" field.
+func (cc *CalibrationCreate) SetSensorID(i int) *CalibrationCreate {
+	cc.mutation.SetSensorID(i)
+	return cc
+}
+
+// SetChannel sets the "channel" field.
+func (cc *CalibrationCreate) SetChannel(s string) *CalibrationCreate {
+	cc.mutation.SetChannel(s)
+	return cc
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (cc *CalibrationCreate) SetNillableChannel(s *string) *CalibrationCreate {
+	if s != nil {
+		cc.SetChannel(*s)
+	}
+	return cc
+}
+
+// SetOffset sets the "offset" field.
+func (cc *CalibrationCreate) SetOffset(f float64) *CalibrationCreate {
+	cc.mutation.SetOffset(f)
+	return cc
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (cc *CalibrationCreate) SetNillableOffset(f *float64) *CalibrationCreate {
+	if f != nil {
+		cc.SetOffset(*f)
+	}
+	return cc
+}
+
+// SetGain sets the "gain" field.
+func (cc *CalibrationCreate) SetGain(f float64) *CalibrationCreate {
+	cc.mutation.SetGain(f)
+	return cc
+}
+
+// SetNillableGain sets the "gain" field if the given value is not nil.
+func (cc *CalibrationCreate) SetNillableGain(f *float64) *CalibrationCreate {
+	if f != nil {
+		cc.SetGain(*f)
+	}
+	return cc
+}
+
+// SetCoefficients sets the "coefficients" field.
+func (cc *CalibrationCreate) SetCoefficients(f []float64) *CalibrationCreate {
+	cc.mutation.SetCoefficients(f)
+	return cc
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (cc *CalibrationCreate) SetValidFrom(t time.Time) *CalibrationCreate {
+	cc.mutation.SetValidFrom(t)
+	return cc
+}
+
+// SetValidTo sets the "valid_to" field.
+func (cc *CalibrationCreate) SetValidTo(t time.Time) *CalibrationCreate {
+	cc.mutation.SetValidTo(t)
+	return cc
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (cc *CalibrationCreate) SetNillableValidTo(t *time.Time) *CalibrationCreate {
+	if t != nil {
+		cc.SetValidTo(*t)
+	}
+	return cc
+}
+
+// SetCertificate sets the "certificate" field.
+func (cc *CalibrationCreate) SetCertificate(s string) *CalibrationCreate {
+	cc.mutation.SetCertificate(s)
+	return cc
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (cc *CalibrationCreate) SetNillableCertificate(s *string) *CalibrationCreate {
+	if s != nil {
+		cc.SetCertificate(*s)
+	}
+	return cc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (cc *CalibrationCreate) SetCreatedAt(t time.Time) *CalibrationCreate {
+	cc.mutation.SetCreatedAt(t)
+	return cc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (cc *CalibrationCreate) SetNillableCreatedAt(t *time.Time) *CalibrationCreate {
+	if t != nil {
+		cc.SetCreatedAt(*t)
+	}
+	return cc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cc *CalibrationCreate) SetUpdatedAt(t time.Time) *CalibrationCreate {
+	cc.mutation.SetUpdatedAt(t)
+	return cc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (cc *CalibrationCreate) SetNillableUpdatedAt(t *time.Time) *CalibrationCreate {
+	if t != nil {
+		cc.SetUpdatedAt(*t)
+	}
+	return cc
+}
+
+// SetSensor sets the "sensor" edge to the Sensor entity.
+func (cc *CalibrationCreate) SetSensor(s *Sensor) *CalibrationCreate {
+	return cc.SetSensorID(s.ID)
+}
+
+// Mutation returns the CalibrationMutation object of the builder.
+func (cc *CalibrationCreate) Mutation() *CalibrationMutation {
+	return cc.mutation
+}
+
+// Save creates the Calibration in the database.
+func (cc *CalibrationCreate) Save(ctx context.Context) (*Calibration, error) {
+	cc.defaults()
+	return withHooks(ctx, cc.sqlSave, cc.mutation, cc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (cc *CalibrationCreate) SaveX(ctx context.Context) *Calibration {
+	v, err := cc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (cc *CalibrationCreate) Exec(ctx context.Context) error {
+	_, err := cc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cc *CalibrationCreate) ExecX(ctx context.Context) {
+	if err := cc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cc *CalibrationCreate) defaults() {
+	if _, ok := cc.mutation.Offset(); !ok {
+		v := calibration.DefaultOffset
+		cc.mutation.SetOffset(v)
+	}
+	if _, ok := cc.mutation.Gain(); !ok {
+		v := calibration.DefaultGain
+		cc.mutation.SetGain(v)
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		v := calibration.DefaultCreatedAt()
+		cc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		v := calibration.DefaultUpdatedAt()
+		cc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cc *CalibrationCreate) check() error {
+	if _, ok := cc.mutation.SensorID(); !ok {
+		return &ValidationError{Name: "sensor_id", err: errors.New(`ent: missing required field "Calibration.sensor_id"`)}
+	}
+	if _, ok := cc.mutation.Offset(); !ok {
+		return &ValidationError{Name: "offset", err: errors.New(`ent: missing required field "Calibration.offset"`)}
+	}
+	if _, ok := cc.mutation.Gain(); !ok {
+		return &ValidationError{Name: "gain", err: errors.New(`ent: missing required field "Calibration.gain"`)}
+	}
+	if _, ok := cc.mutation.ValidFrom(); !ok {
+		return &ValidationError{Name: "valid_from", err: errors.New(`ent: missing required field "Calibration.valid_from"`)}
+	}
+	if _, ok := cc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Calibration.created_at"`)}
+	}
+	if _, ok := cc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Calibration.updated_at"`)}
+	}
+	if len(cc.mutation.SensorIDs()) == 0 {
+		return &ValidationError{Name: "sensor", err: errors.New(`ent: missing required edge "Calibration.sensor"`)}
+	}
+	return nil
+}
+
+func (cc *CalibrationCreate) sqlSave(ctx context.Context) (*Calibration, error) {
+	if err := cc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := cc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, cc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	cc.mutation.id = &_node.ID
+	cc.mutation.done = true
+	return _node, nil
+}
+
+func (cc *CalibrationCreate) createSpec() (*Calibration, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Calibration{config: cc.config}
+		_spec = sqlgraph.NewCreateSpec(calibration.Table, sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt))
+	)
+	if value, ok := cc.mutation.Channel(); ok {
+		_spec.SetField(calibration.FieldChannel, field.TypeString, value)
+		_node.Channel = value
+	}
+	if value, ok := cc.mutation.Offset(); ok {
+		_spec.SetField(calibration.FieldOffset, field.TypeFloat64, value)
+		_node.Offset = value
+	}
+	if value, ok := cc.mutation.Gain(); ok {
+		_spec.SetField(calibration.FieldGain, field.TypeFloat64, value)
+		_node.Gain = value
+	}
+	if value, ok := cc.mutation.Coefficients(); ok {
+		_spec.SetField(calibration.FieldCoefficients, field.TypeJSON, value)
+		_node.Coefficients = value
+	}
+	if value, ok := cc.mutation.ValidFrom(); ok {
+		_spec.SetField(calibration.FieldValidFrom, field.TypeTime, value)
+		_node.ValidFrom = value
+	}
+	if value, ok := cc.mutation.ValidTo(); ok {
+		_spec.SetField(calibration.FieldValidTo, field.TypeTime, value)
+		_node.ValidTo = &value
+	}
+	if value, ok := cc.mutation.Certificate(); ok {
+		_spec.SetField(calibration.FieldCertificate, field.TypeString, value)
+		_node.Certificate = value
+	}
+	if value, ok := cc.mutation.CreatedAt(); ok {
+		_spec.SetField(calibration.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := cc.mutation.UpdatedAt(); ok {
+		_spec.SetField(calibration.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := cc.mutation.SensorIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   calibration.SensorTable,
+			Columns: []string{calibration.SensorColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(sensor.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SensorID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CalibrationCreateBulk is the builder for creating many Calibration entities in bulk.
+type CalibrationCreateBulk struct {
+	config
+	err      error
+	builders []*CalibrationCreate
+}
+
+// Save creates the Calibration entities in the database.
+func (ccb *CalibrationCreateBulk) Save(ctx context.Context) ([]*Calibration, error) {
+	if ccb.err != nil {
+		return nil, ccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ccb.builders))
+	nodes := make([]*Calibration, len(ccb.builders))
+	mutators := make([]Mutator, len(ccb.builders))
+	for i := range ccb.builders {
+		func(i int, root context.Context) {
+			builder := ccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CalibrationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ccb *CalibrationCreateBulk) SaveX(ctx context.Context) []*Calibration {
+	v, err := ccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ccb *CalibrationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ccb *CalibrationCreateBulk) ExecX(ctx context.Context) {
+	if err := ccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
+)
+
+// CalibrationDelete is the builder for deleting a Calibration entity.
+type CalibrationDelete struct {
+	config
+	hooks    []Hook
+	mutation *CalibrationMutation
+}
+
+// Where appends a list predicates to the CalibrationDelete builder.
+func (cd *CalibrationDelete) Where(ps ...predicate.Calibration) *CalibrationDelete {
+	cd.mutation.Where(ps...)
+	return cd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (cd *CalibrationDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, cd.sqlExec, cd.mutation, cd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cd *CalibrationDelete) ExecX(ctx context.Context) int {
+	n, err := cd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (cd *CalibrationDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(calibration.Table, sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt))
+	if ps := cd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, cd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	cd.mutation.done = true
+	return affected, err
+}
+
+// CalibrationDeleteOne is the builder for deleting a single Calibration entity.
+type CalibrationDeleteOne struct {
+	cd *CalibrationDelete
+}
+
+// Where appends a list predicates to the CalibrationDelete builder.
+func (cdo *CalibrationDeleteOne) Where(ps ...predicate.Calibration) *CalibrationDeleteOne {
+	cdo.cd.mutation.Where(ps...)
+	return cdo
+}
+
+// Exec executes the deletion query.
+func (cdo *CalibrationDeleteOne) Exec(ctx context.Context) error {
+	n, err := cdo.cd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{calibration.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cdo *CalibrationDeleteOne) ExecX(ctx context.Context) {
+	if err := cdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
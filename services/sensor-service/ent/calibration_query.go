@@ -0,0 +1,606 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
+)
+
+// CalibrationQuery is the builder for querying Calibration entities.
+type CalibrationQuery struct {
+	config
+	ctx        *QueryContext
+	order      []calibration.OrderOption
+	inters     []Interceptor
+	predicates []predicate.Calibration
+	withSensor *SensorQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the CalibrationQuery builder.
+func (cq *CalibrationQuery) Where(ps ...predicate.Calibration) *CalibrationQuery {
+	cq.predicates = append(cq.predicates, ps...)
+	return cq
+}
+
+// Limit the number of records to be returned by this query.
+func (cq *CalibrationQuery) Limit(limit int) *CalibrationQuery {
+	cq.ctx.Limit = &limit
+	return cq
+}
+
+// Offset to start from.
+func (cq *CalibrationQuery) Offset(offset int) *CalibrationQuery {
+	cq.ctx.Offset = &offset
+	return cq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (cq *CalibrationQuery) Unique(unique bool) *CalibrationQuery {
+	cq.ctx.Unique = &unique
+	return cq
+}
+
+// Order specifies how the records should be ordered.
+func (cq *CalibrationQuery) Order(o ...calibration.OrderOption) *CalibrationQuery {
+	cq.order = append(cq.order, o...)
+	return cq
+}
+
+// QuerySensor chains the current query on the "sensor" edge.
+func (cq *CalibrationQuery) QuerySensor() *SensorQuery {
+	query := (&SensorClient{config: cq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := cq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := cq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(calibration.Table, calibration.FieldID, selector),
+			sqlgraph.To(sensor.Table, sensor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, calibration.SensorTable, calibration.SensorColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(cq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Calibration entity from the query.
+// Returns a *NotFoundError when no Calibration was found.
+func (cq *CalibrationQuery) First(ctx context.Context) (*Calibration, error) {
+	nodes, err := cq.Limit(1).All(setContextOp(ctx, cq.ctx, ent.OpQueryFirst))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{calibration.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (cq *CalibrationQuery) FirstX(ctx context.Context) *Calibration {
+	node, err := cq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Calibration ID from the query.
+// Returns a *NotFoundError when no Calibration ID was found.
+func (cq *CalibrationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cq.Limit(1).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryFirstID)); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{calibration.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (cq *CalibrationQuery) FirstIDX(ctx context.Context) int {
+	id, err := cq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Calibration entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Calibration entity is found.
+// Returns a *NotFoundError when no Calibration entities are found.
+func (cq *CalibrationQuery) Only(ctx context.Context) (*Calibration, error) {
+	nodes, err := cq.Limit(2).All(setContextOp(ctx, cq.ctx, ent.OpQueryOnly))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{calibration.Label}
+	default:
+		return nil, &NotSingularError{calibration.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (cq *CalibrationQuery) OnlyX(ctx context.Context) *Calibration {
+	node, err := cq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Calibration ID in the query.
+// Returns a *NotSingularError when more than one Calibration ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (cq *CalibrationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = cq.Limit(2).IDs(setContextOp(ctx, cq.ctx, ent.OpQueryOnlyID)); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{calibration.Label}
+	default:
+		err = &NotSingularError{calibration.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (cq *CalibrationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := cq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Calibrations.
+func (cq *CalibrationQuery) All(ctx context.Context) ([]*Calibration, error) {
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryAll)
+	if err := cq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Calibration, *CalibrationQuery]()
+	return withInterceptors[[]*Calibration](ctx, cq, qr, cq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (cq *CalibrationQuery) AllX(ctx context.Context) []*Calibration {
+	nodes, err := cq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Calibration IDs.
+func (cq *CalibrationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if cq.ctx.Unique == nil && cq.path != nil {
+		cq.Unique(true)
+	}
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryIDs)
+	if err = cq.Select(calibration.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (cq *CalibrationQuery) IDsX(ctx context.Context) []int {
+	ids, err := cq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (cq *CalibrationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryCount)
+	if err := cq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, cq, querierCount[*CalibrationQuery](), cq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (cq *CalibrationQuery) CountX(ctx context.Context) int {
+	count, err := cq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (cq *CalibrationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, cq.ctx, ent.OpQueryExist)
+	switch _, err := cq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (cq *CalibrationQuery) ExistX(ctx context.Context) bool {
+	exist, err := cq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the CalibrationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (cq *CalibrationQuery) Clone() *CalibrationQuery {
+	if cq == nil {
+		return nil
+	}
+	return &CalibrationQuery{
+		config:     cq.config,
+		ctx:        cq.ctx.Clone(),
+		order:      append([]calibration.OrderOption{}, cq.order...),
+		inters:     append([]Interceptor{}, cq.inters...),
+		predicates: append([]predicate.Calibration{}, cq.predicates...),
+		withSensor: cq.withSensor.Clone(),
+		// clone intermediate query.
+		sql:  cq.sql.Clone(),
+		path: cq.path,
+	}
+}
+
+// WithSensor tells the query-builder to eager-load the nodes that are connected to
+// the "sensor" edge. The optional arguments are used to configure the query builder of the edge.
+func (cq *CalibrationQuery) WithSensor(opts ...func(*SensorQuery)) *CalibrationQuery {
+	query := (&SensorClient{config: cq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	cq.withSensor = query
+	return cq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		SensorID int `json:"sensor_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Calibration.Query().
+//		GroupBy(calibration.FieldSensorID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (cq *CalibrationQuery) GroupBy(field string, fields ...string) *CalibrationGroupBy {
+	cq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &CalibrationGroupBy{build: cq}
+	grbuild.flds = &cq.ctx.Fields
+	grbuild.label = calibration.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		SensorID int `json:"sensor_id,omitempty"`
+//	}
+//
+//	client.Calibration.Query().
+//		Select(calibration.FieldSensorID).
+//		Scan(ctx, &v)
+func (cq *CalibrationQuery) Select(fields ...string) *CalibrationSelect {
+	cq.ctx.Fields = append(cq.ctx.Fields, fields...)
+	sbuild := &CalibrationSelect{CalibrationQuery: cq}
+	sbuild.label = calibration.Label
+	sbuild.flds, sbuild.scan = &cq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a CalibrationSelect configured with the given aggregations.
+func (cq *CalibrationQuery) Aggregate(fns ...AggregateFunc) *CalibrationSelect {
+	return cq.Select().Aggregate(fns...)
+}
+
+func (cq *CalibrationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range cq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, cq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range cq.ctx.Fields {
+		if !calibration.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if cq.path != nil {
+		prev, err := cq.path(ctx)
+		if err != nil {
+			return err
+		}
+		cq.sql = prev
+	}
+	return nil
+}
+
+func (cq *CalibrationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Calibration, error) {
+	var (
+		nodes       = []*Calibration{}
+		_spec       = cq.querySpec()
+		loadedTypes = [1]bool{
+			cq.withSensor != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Calibration).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Calibration{config: cq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, cq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := cq.withSensor; query != nil {
+		if err := cq.loadSensor(ctx, query, nodes, nil,
+			func(n *Calibration, e *Sensor) { n.Edges.Sensor = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (cq *CalibrationQuery) loadSensor(ctx context.Context, query *SensorQuery, nodes []*Calibration, init func(*Calibration), assign func(*Calibration, *Sensor)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Calibration)
+	for i := range nodes {
+		fk := nodes[i].SensorID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(sensor.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "sensor_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (cq *CalibrationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := cq.querySpec()
+	_spec.Node.Columns = cq.ctx.Fields
+	if len(cq.ctx.Fields) > 0 {
+		_spec.Unique = cq.ctx.Unique != nil && *cq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, cq.driver, _spec)
+}
+
+func (cq *CalibrationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(calibration.Table, calibration.Columns, sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt))
+	_spec.From = cq.sql
+	if unique := cq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if cq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := cq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, calibration.FieldID)
+		for i := range fields {
+			if fields[i] != calibration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if cq.withSensor != nil {
+			_spec.Node.AddColumnOnce(calibration.FieldSensorID)
+		}
+	}
+	if ps := cq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := cq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := cq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := cq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (cq *CalibrationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(cq.driver.Dialect())
+	t1 := builder.Table(calibration.Table)
+	columns := cq.ctx.Fields
+	if len(columns) == 0 {
+		columns = calibration.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if cq.sql != nil {
+		selector = cq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if cq.ctx.Unique != nil && *cq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range cq.predicates {
+		p(selector)
+	}
+	for _, p := range cq.order {
+		p(selector)
+	}
+	if offset := cq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := cq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// CalibrationGroupBy is the group-by builder for Calibration entities.
+type CalibrationGroupBy struct {
+	selector
+	build *CalibrationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (cgb *CalibrationGroupBy) Aggregate(fns ...AggregateFunc) *CalibrationGroupBy {
+	cgb.fns = append(cgb.fns, fns...)
+	return cgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cgb *CalibrationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cgb.build.ctx, ent.OpQueryGroupBy)
+	if err := cgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CalibrationQuery, *CalibrationGroupBy](ctx, cgb.build, cgb, cgb.build.inters, v)
+}
+
+func (cgb *CalibrationGroupBy) sqlScan(ctx context.Context, root *CalibrationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(cgb.fns))
+	for _, fn := range cgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*cgb.flds)+len(cgb.fns))
+		for _, f := range *cgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*cgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// CalibrationSelect is the builder for selecting fields of Calibration entities.
+type CalibrationSelect struct {
+	*CalibrationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (cs *CalibrationSelect) Aggregate(fns ...AggregateFunc) *CalibrationSelect {
+	cs.fns = append(cs.fns, fns...)
+	return cs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (cs *CalibrationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, cs.ctx, ent.OpQuerySelect)
+	if err := cs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*CalibrationQuery, *CalibrationSelect](ctx, cs.CalibrationQuery, cs, cs.inters, v)
+}
+
+func (cs *CalibrationSelect) sqlScan(ctx context.Context, root *CalibrationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(cs.fns))
+	for _, fn := range cs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*cs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := cs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
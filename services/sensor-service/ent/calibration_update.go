@@ -0,0 +1,591 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
+)
+
+// CalibrationUpdate is the builder for updating Calibration entities.
+type CalibrationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CalibrationMutation
+}
+
+// Where appends a list predicates to the CalibrationUpdate builder.
+func (cu *CalibrationUpdate) Where(ps ...predicate.Calibration) *CalibrationUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetChannel sets the "channel" field.
+func (cu *CalibrationUpdate) SetChannel(s string) *CalibrationUpdate {
+	cu.mutation.SetChannel(s)
+	return cu
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (cu *CalibrationUpdate) SetNillableChannel(s *string) *CalibrationUpdate {
+	if s != nil {
+		cu.SetChannel(*s)
+	}
+	return cu
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (cu *CalibrationUpdate) ClearChannel() *CalibrationUpdate {
+	cu.mutation.ClearChannel()
+	return cu
+}
+
+// SetOffset sets the "offset" field.
+func (cu *CalibrationUpdate) SetOffset(f float64) *CalibrationUpdate {
+	cu.mutation.ResetOffset()
+	cu.mutation.SetOffset(f)
+	return cu
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (cu *CalibrationUpdate) SetNillableOffset(f *float64) *CalibrationUpdate {
+	if f != nil {
+		cu.SetOffset(*f)
+	}
+	return cu
+}
+
+// AddOffset adds f to the "offset" field.
+func (cu *CalibrationUpdate) AddOffset(f float64) *CalibrationUpdate {
+	cu.mutation.AddOffset(f)
+	return cu
+}
+
+// SetGain sets the "gain" field.
+func (cu *CalibrationUpdate) SetGain(f float64) *CalibrationUpdate {
+	cu.mutation.ResetGain()
+	cu.mutation.SetGain(f)
+	return cu
+}
+
+// SetNillableGain sets the "gain" field if the given value is not nil.
+func (cu *CalibrationUpdate) SetNillableGain(f *float64) *CalibrationUpdate {
+	if f != nil {
+		cu.SetGain(*f)
+	}
+	return cu
+}
+
+// AddGain adds f to the "gain" field.
+func (cu *CalibrationUpdate) AddGain(f float64) *CalibrationUpdate {
+	cu.mutation.AddGain(f)
+	return cu
+}
+
+// SetCoefficients sets the "coefficients" field.
+func (cu *CalibrationUpdate) SetCoefficients(f []float64) *CalibrationUpdate {
+	cu.mutation.SetCoefficients(f)
+	return cu
+}
+
+// AppendCoefficients appends f to the "coefficients" field.
+func (cu *CalibrationUpdate) AppendCoefficients(f []float64) *CalibrationUpdate {
+	cu.mutation.AppendCoefficients(f)
+	return cu
+}
+
+// ClearCoefficients clears the value of the "coefficients" field.
+func (cu *CalibrationUpdate) ClearCoefficients() *CalibrationUpdate {
+	cu.mutation.ClearCoefficients()
+	return cu
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (cu *CalibrationUpdate) SetValidFrom(t time.Time) *CalibrationUpdate {
+	cu.mutation.SetValidFrom(t)
+	return cu
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (cu *CalibrationUpdate) SetNillableValidFrom(t *time.Time) *CalibrationUpdate {
+	if t != nil {
+		cu.SetValidFrom(*t)
+	}
+	return cu
+}
+
+// SetValidTo sets the "valid_to" field.
+func (cu *CalibrationUpdate) SetValidTo(t time.Time) *CalibrationUpdate {
+	cu.mutation.SetValidTo(t)
+	return cu
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (cu *CalibrationUpdate) SetNillableValidTo(t *time.Time) *CalibrationUpdate {
+	if t != nil {
+		cu.SetValidTo(*t)
+	}
+	return cu
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (cu *CalibrationUpdate) ClearValidTo() *CalibrationUpdate {
+	cu.mutation.ClearValidTo()
+	return cu
+}
+
+// SetCertificate sets the "certificate" field.
+func (cu *CalibrationUpdate) SetCertificate(s string) *CalibrationUpdate {
+	cu.mutation.SetCertificate(s)
+	return cu
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (cu *CalibrationUpdate) SetNillableCertificate(s *string) *CalibrationUpdate {
+	if s != nil {
+		cu.SetCertificate(*s)
+	}
+	return cu
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (cu *CalibrationUpdate) ClearCertificate() *CalibrationUpdate {
+	cu.mutation.ClearCertificate()
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CalibrationUpdate) SetUpdatedAt(t time.Time) *CalibrationUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// Mutation returns the CalibrationMutation object of the builder.
+func (cu *CalibrationUpdate) Mutation() *CalibrationMutation {
+	return cu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CalibrationUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CalibrationUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CalibrationUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CalibrationUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CalibrationUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := calibration.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cu *CalibrationUpdate) check() error {
+	if cu.mutation.SensorCleared() && len(cu.mutation.SensorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Calibration.sensor"`)
+	}
+	return nil
+}
+
+func (cu *CalibrationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := cu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(calibration.Table, calibration.Columns, sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.Channel(); ok {
+		_spec.SetField(calibration.FieldChannel, field.TypeString, value)
+	}
+	if cu.mutation.ChannelCleared() {
+		_spec.ClearField(calibration.FieldChannel, field.TypeString)
+	}
+	if value, ok := cu.mutation.Offset(); ok {
+		_spec.SetField(calibration.FieldOffset, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedOffset(); ok {
+		_spec.AddField(calibration.FieldOffset, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.Gain(); ok {
+		_spec.SetField(calibration.FieldGain, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.AddedGain(); ok {
+		_spec.AddField(calibration.FieldGain, field.TypeFloat64, value)
+	}
+	if value, ok := cu.mutation.Coefficients(); ok {
+		_spec.SetField(calibration.FieldCoefficients, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedCoefficients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, calibration.FieldCoefficients, value)
+		})
+	}
+	if cu.mutation.CoefficientsCleared() {
+		_spec.ClearField(calibration.FieldCoefficients, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.ValidFrom(); ok {
+		_spec.SetField(calibration.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.ValidTo(); ok {
+		_spec.SetField(calibration.FieldValidTo, field.TypeTime, value)
+	}
+	if cu.mutation.ValidToCleared() {
+		_spec.ClearField(calibration.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := cu.mutation.Certificate(); ok {
+		_spec.SetField(calibration.FieldCertificate, field.TypeString, value)
+	}
+	if cu.mutation.CertificateCleared() {
+		_spec.ClearField(calibration.FieldCertificate, field.TypeString)
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(calibration.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{calibration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CalibrationUpdateOne is the builder for updating a single Calibration entity.
+type CalibrationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CalibrationMutation
+}
+
+// SetChannel sets the "channel" field.
+func (cuo *CalibrationUpdateOne) SetChannel(s string) *CalibrationUpdateOne {
+	cuo.mutation.SetChannel(s)
+	return cuo
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (cuo *CalibrationUpdateOne) SetNillableChannel(s *string) *CalibrationUpdateOne {
+	if s != nil {
+		cuo.SetChannel(*s)
+	}
+	return cuo
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (cuo *CalibrationUpdateOne) ClearChannel() *CalibrationUpdateOne {
+	cuo.mutation.ClearChannel()
+	return cuo
+}
+
+// SetOffset sets the "offset" field.
+func (cuo *CalibrationUpdateOne) SetOffset(f float64) *CalibrationUpdateOne {
+	cuo.mutation.ResetOffset()
+	cuo.mutation.SetOffset(f)
+	return cuo
+}
+
+// SetNillableOffset sets the "offset" field if the given value is not nil.
+func (cuo *CalibrationUpdateOne) SetNillableOffset(f *float64) *CalibrationUpdateOne {
+	if f != nil {
+		cuo.SetOffset(*f)
+	}
+	return cuo
+}
+
+// AddOffset adds f to the "offset" field.
+func (cuo *CalibrationUpdateOne) AddOffset(f float64) *CalibrationUpdateOne {
+	cuo.mutation.AddOffset(f)
+	return cuo
+}
+
+// SetGain sets the "gain" field.
+func (cuo *CalibrationUpdateOne) SetGain(f float64) *CalibrationUpdateOne {
+	cuo.mutation.ResetGain()
+	cuo.mutation.SetGain(f)
+	return cuo
+}
+
+// SetNillableGain sets the "gain" field if the given value is not nil.
+func (cuo *CalibrationUpdateOne) SetNillableGain(f *float64) *CalibrationUpdateOne {
+	if f != nil {
+		cuo.SetGain(*f)
+	}
+	return cuo
+}
+
+// AddGain adds f to the "gain" field.
+func (cuo *CalibrationUpdateOne) AddGain(f float64) *CalibrationUpdateOne {
+	cuo.mutation.AddGain(f)
+	return cuo
+}
+
+// SetCoefficients sets the "coefficients" field.
+func (cuo *CalibrationUpdateOne) SetCoefficients(f []float64) *CalibrationUpdateOne {
+	cuo.mutation.SetCoefficients(f)
+	return cuo
+}
+
+// AppendCoefficients appends f to the "coefficients" field.
+func (cuo *CalibrationUpdateOne) AppendCoefficients(f []float64) *CalibrationUpdateOne {
+	cuo.mutation.AppendCoefficients(f)
+	return cuo
+}
+
+// ClearCoefficients clears the value of the "coefficients" field.
+func (cuo *CalibrationUpdateOne) ClearCoefficients() *CalibrationUpdateOne {
+	cuo.mutation.ClearCoefficients()
+	return cuo
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (cuo *CalibrationUpdateOne) SetValidFrom(t time.Time) *CalibrationUpdateOne {
+	cuo.mutation.SetValidFrom(t)
+	return cuo
+}
+
+// SetNillableValidFrom sets the "valid_from" field if the given value is not nil.
+func (cuo *CalibrationUpdateOne) SetNillableValidFrom(t *time.Time) *CalibrationUpdateOne {
+	if t != nil {
+		cuo.SetValidFrom(*t)
+	}
+	return cuo
+}
+
+// SetValidTo sets the "valid_to" field.
+func (cuo *CalibrationUpdateOne) SetValidTo(t time.Time) *CalibrationUpdateOne {
+	cuo.mutation.SetValidTo(t)
+	return cuo
+}
+
+// SetNillableValidTo sets the "valid_to" field if the given value is not nil.
+func (cuo *CalibrationUpdateOne) SetNillableValidTo(t *time.Time) *CalibrationUpdateOne {
+	if t != nil {
+		cuo.SetValidTo(*t)
+	}
+	return cuo
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (cuo *CalibrationUpdateOne) ClearValidTo() *CalibrationUpdateOne {
+	cuo.mutation.ClearValidTo()
+	return cuo
+}
+
+// SetCertificate sets the "certificate" field.
+func (cuo *CalibrationUpdateOne) SetCertificate(s string) *CalibrationUpdateOne {
+	cuo.mutation.SetCertificate(s)
+	return cuo
+}
+
+// SetNillableCertificate sets the "certificate" field if the given value is not nil.
+func (cuo *CalibrationUpdateOne) SetNillableCertificate(s *string) *CalibrationUpdateOne {
+	if s != nil {
+		cuo.SetCertificate(*s)
+	}
+	return cuo
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (cuo *CalibrationUpdateOne) ClearCertificate() *CalibrationUpdateOne {
+	cuo.mutation.ClearCertificate()
+	return cuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CalibrationUpdateOne) SetUpdatedAt(t time.Time) *CalibrationUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// Mutation returns the CalibrationMutation object of the builder.
+func (cuo *CalibrationUpdateOne) Mutation() *CalibrationMutation {
+	return cuo.mutation
+}
+
+// Where appends a list predicates to the CalibrationUpdate builder.
+func (cuo *CalibrationUpdateOne) Where(ps ...predicate.Calibration) *CalibrationUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CalibrationUpdateOne) Select(field string, fields ...string) *CalibrationUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Calibration entity.
+func (cuo *CalibrationUpdateOne) Save(ctx context.Context) (*Calibration, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CalibrationUpdateOne) SaveX(ctx context.Context) *Calibration {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CalibrationUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CalibrationUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CalibrationUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := calibration.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (cuo *CalibrationUpdateOne) check() error {
+	if cuo.mutation.SensorCleared() && len(cuo.mutation.SensorIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Calibration.sensor"`)
+	}
+	return nil
+}
+
+func (cuo *CalibrationUpdateOne) sqlSave(ctx context.Context) (_node *Calibration, err error) {
+	if err := cuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(calibration.Table, calibration.Columns, sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Calibration.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, calibration.FieldID)
+		for _, f := range fields {
+			if !calibration.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != calibration.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.Channel(); ok {
+		_spec.SetField(calibration.FieldChannel, field.TypeString, value)
+	}
+	if cuo.mutation.ChannelCleared() {
+		_spec.ClearField(calibration.FieldChannel, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Offset(); ok {
+		_spec.SetField(calibration.FieldOffset, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedOffset(); ok {
+		_spec.AddField(calibration.FieldOffset, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.Gain(); ok {
+		_spec.SetField(calibration.FieldGain, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.AddedGain(); ok {
+		_spec.AddField(calibration.FieldGain, field.TypeFloat64, value)
+	}
+	if value, ok := cuo.mutation.Coefficients(); ok {
+		_spec.SetField(calibration.FieldCoefficients, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedCoefficients(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, calibration.FieldCoefficients, value)
+		})
+	}
+	if cuo.mutation.CoefficientsCleared() {
+		_spec.ClearField(calibration.FieldCoefficients, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.ValidFrom(); ok {
+		_spec.SetField(calibration.FieldValidFrom, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.ValidTo(); ok {
+		_spec.SetField(calibration.FieldValidTo, field.TypeTime, value)
+	}
+	if cuo.mutation.ValidToCleared() {
+		_spec.ClearField(calibration.FieldValidTo, field.TypeTime)
+	}
+	if value, ok := cuo.mutation.Certificate(); ok {
+		_spec.SetField(calibration.FieldCertificate, field.TypeString, value)
+	}
+	if cuo.mutation.CertificateCleared() {
+		_spec.ClearField(calibration.FieldCertificate, field.TypeString)
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(calibration.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Calibration{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{calibration.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
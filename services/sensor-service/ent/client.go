@@ -15,6 +15,8 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/outboxevent"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensorgroup"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
@@ -25,6 +27,10 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// Calibration is the client for interacting with the Calibration builders.
+	Calibration *CalibrationClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Sensor is the client for interacting with the Sensor builders.
 	Sensor *SensorClient
 	// SensorGroup is the client for interacting with the SensorGroup builders.
@@ -42,6 +48,8 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.Calibration = NewCalibrationClient(c.config)
+	c.OutboxEvent = NewOutboxEventClient(c.config)
 	c.Sensor = NewSensorClient(c.config)
 	c.SensorGroup = NewSensorGroupClient(c.config)
 	c.SensorType = NewSensorTypeClient(c.config)
@@ -137,6 +145,8 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:         ctx,
 		config:      cfg,
+		Calibration: NewCalibrationClient(cfg),
+		OutboxEvent: NewOutboxEventClient(cfg),
 		Sensor:      NewSensorClient(cfg),
 		SensorGroup: NewSensorGroupClient(cfg),
 		SensorType:  NewSensorTypeClient(cfg),
@@ -159,6 +169,8 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:         ctx,
 		config:      cfg,
+		Calibration: NewCalibrationClient(cfg),
+		OutboxEvent: NewOutboxEventClient(cfg),
 		Sensor:      NewSensorClient(cfg),
 		SensorGroup: NewSensorGroupClient(cfg),
 		SensorType:  NewSensorTypeClient(cfg),
@@ -168,7 +180,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Sensor.
+//		Calibration.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -190,6 +202,8 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
+	c.Calibration.Use(hooks...)
+	c.OutboxEvent.Use(hooks...)
 	c.Sensor.Use(hooks...)
 	c.SensorGroup.Use(hooks...)
 	c.SensorType.Use(hooks...)
@@ -198,6 +212,8 @@ func (c *Client) Use(hooks ...Hook) {
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
+	c.Calibration.Intercept(interceptors...)
+	c.OutboxEvent.Intercept(interceptors...)
 	c.Sensor.Intercept(interceptors...)
 	c.SensorGroup.Intercept(interceptors...)
 	c.SensorType.Intercept(interceptors...)
@@ -206,6 +222,10 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *CalibrationMutation:
+		return c.Calibration.mutate(ctx, m)
+	case *OutboxEventMutation:
+		return c.OutboxEvent.mutate(ctx, m)
 	case *SensorMutation:
 		return c.Sensor.mutate(ctx, m)
 	case *SensorGroupMutation:
@@ -217,6 +237,288 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// CalibrationClient is a client for the Calibration schema.
+type CalibrationClient struct {
+	config
+}
+
+// NewCalibrationClient returns a client for the Calibration from the given config.
+func NewCalibrationClient(c config) *CalibrationClient {
+	return &CalibrationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `calibration.Hooks(f(g(h())))`.
+func (c *CalibrationClient) Use(hooks ...Hook) {
+	c.hooks.Calibration = append(c.hooks.Calibration, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `calibration.Intercept(f(g(h())))`.
+func (c *CalibrationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Calibration = append(c.inters.Calibration, interceptors...)
+}
+
+// Create returns a builder for creating a Calibration entity.
+func (c *CalibrationClient) Create() *CalibrationCreate {
+	mutation := newCalibrationMutation(c.config, OpCreate)
+	return &CalibrationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Calibration entities.
+func (c *CalibrationClient) CreateBulk(builders ...*CalibrationCreate) *CalibrationCreateBulk {
+	return &CalibrationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CalibrationClient) MapCreateBulk(slice any, setFunc func(*CalibrationCreate, int)) *CalibrationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CalibrationCreateBulk{err: fmt.Errorf("calling to CalibrationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CalibrationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CalibrationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Calibration.
+func (c *CalibrationClient) Update() *CalibrationUpdate {
+	mutation := newCalibrationMutation(c.config, OpUpdate)
+	return &CalibrationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CalibrationClient) UpdateOne(ca *Calibration) *CalibrationUpdateOne {
+	mutation := newCalibrationMutation(c.config, OpUpdateOne, withCalibration(ca))
+	return &CalibrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CalibrationClient) UpdateOneID(id int) *CalibrationUpdateOne {
+	mutation := newCalibrationMutation(c.config, OpUpdateOne, withCalibrationID(id))
+	return &CalibrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Calibration.
+func (c *CalibrationClient) Delete() *CalibrationDelete {
+	mutation := newCalibrationMutation(c.config, OpDelete)
+	return &CalibrationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CalibrationClient) DeleteOne(ca *Calibration) *CalibrationDeleteOne {
+	return c.DeleteOneID(ca.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CalibrationClient) DeleteOneID(id int) *CalibrationDeleteOne {
+	builder := c.Delete().Where(calibration.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CalibrationDeleteOne{builder}
+}
+
+// Query returns a query builder for Calibration.
+func (c *CalibrationClient) Query() *CalibrationQuery {
+	return &CalibrationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCalibration},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Calibration entity by its id.
+func (c *CalibrationClient) Get(ctx context.Context, id int) (*Calibration, error) {
+	return c.Query().Where(calibration.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CalibrationClient) GetX(ctx context.Context, id int) *Calibration {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QuerySensor queries the sensor edge of a Calibration.
+func (c *CalibrationClient) QuerySensor(ca *Calibration) *SensorQuery {
+	query := (&SensorClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := ca.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(calibration.Table, calibration.FieldID, id),
+			sqlgraph.To(sensor.Table, sensor.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, calibration.SensorTable, calibration.SensorColumn),
+		)
+		fromV = sqlgraph.Neighbors(ca.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *CalibrationClient) Hooks() []Hook {
+	return c.hooks.Calibration
+}
+
+// Interceptors returns the client interceptors.
+func (c *CalibrationClient) Interceptors() []Interceptor {
+	return c.inters.Calibration
+}
+
+func (c *CalibrationClient) mutate(ctx context.Context, m *CalibrationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CalibrationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CalibrationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CalibrationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CalibrationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Calibration mutation op: %q", m.Op())
+	}
+}
+
+// OutboxEventClient is a client for the OutboxEvent schema.
+type OutboxEventClient struct {
+	config
+}
+
+// NewOutboxEventClient returns a client for the OutboxEvent from the given config.
+func NewOutboxEventClient(c config) *OutboxEventClient {
+	return &OutboxEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `outboxevent.Hooks(f(g(h())))`.
+func (c *OutboxEventClient) Use(hooks ...Hook) {
+	c.hooks.OutboxEvent = append(c.hooks.OutboxEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `outboxevent.Intercept(f(g(h())))`.
+func (c *OutboxEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.OutboxEvent = append(c.inters.OutboxEvent, interceptors...)
+}
+
+// Create returns a builder for creating a OutboxEvent entity.
+func (c *OutboxEventClient) Create() *OutboxEventCreate {
+	mutation := newOutboxEventMutation(c.config, OpCreate)
+	return &OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of OutboxEvent entities.
+func (c *OutboxEventClient) CreateBulk(builders ...*OutboxEventCreate) *OutboxEventCreateBulk {
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OutboxEventClient) MapCreateBulk(slice any, setFunc func(*OutboxEventCreate, int)) *OutboxEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OutboxEventCreateBulk{err: fmt.Errorf("calling to OutboxEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OutboxEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OutboxEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for OutboxEvent.
+func (c *OutboxEventClient) Update() *OutboxEventUpdate {
+	mutation := newOutboxEventMutation(c.config, OpUpdate)
+	return &OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OutboxEventClient) UpdateOne(oe *OutboxEvent) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEvent(oe))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OutboxEventClient) UpdateOneID(id int) *OutboxEventUpdateOne {
+	mutation := newOutboxEventMutation(c.config, OpUpdateOne, withOutboxEventID(id))
+	return &OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for OutboxEvent.
+func (c *OutboxEventClient) Delete() *OutboxEventDelete {
+	mutation := newOutboxEventMutation(c.config, OpDelete)
+	return &OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OutboxEventClient) DeleteOne(oe *OutboxEvent) *OutboxEventDeleteOne {
+	return c.DeleteOneID(oe.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OutboxEventClient) DeleteOneID(id int) *OutboxEventDeleteOne {
+	builder := c.Delete().Where(outboxevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OutboxEventDeleteOne{builder}
+}
+
+// Query returns a query builder for OutboxEvent.
+func (c *OutboxEventClient) Query() *OutboxEventQuery {
+	return &OutboxEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOutboxEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a OutboxEvent entity by its id.
+func (c *OutboxEventClient) Get(ctx context.Context, id int) (*OutboxEvent, error) {
+	return c.Query().Where(outboxevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OutboxEventClient) GetX(ctx context.Context, id int) *OutboxEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *OutboxEventClient) Hooks() []Hook {
+	return c.hooks.OutboxEvent
+}
+
+// Interceptors returns the client interceptors.
+func (c *OutboxEventClient) Interceptors() []Interceptor {
+	return c.inters.OutboxEvent
+}
+
+func (c *OutboxEventClient) mutate(ctx context.Context, m *OutboxEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OutboxEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OutboxEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OutboxEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OutboxEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown OutboxEvent mutation op: %q", m.Op())
+	}
+}
+
 // SensorClient is a client for the Sensor schema.
 type SensorClient struct {
 	config
@@ -357,6 +659,22 @@ func (c *SensorClient) QueryGroups(s *Sensor) *SensorGroupQuery {
 	return query
 }
 
+// QueryCalibrations queries the calibrations edge of a Sensor.
+func (c *SensorClient) QueryCalibrations(s *Sensor) *CalibrationQuery {
+	query := (&CalibrationClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := s.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sensor.Table, sensor.FieldID, id),
+			sqlgraph.To(calibration.Table, calibration.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sensor.CalibrationsTable, sensor.CalibrationsColumn),
+		)
+		fromV = sqlgraph.Neighbors(s.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *SensorClient) Hooks() []Hook {
 	return c.hooks.Sensor
@@ -683,9 +1001,9 @@ func (c *SensorTypeClient) mutate(ctx context.Context, m *SensorTypeMutation) (V
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Sensor, SensorGroup, SensorType []ent.Hook
+		Calibration, OutboxEvent, Sensor, SensorGroup, SensorType []ent.Hook
 	}
 	inters struct {
-		Sensor, SensorGroup, SensorType []ent.Interceptor
+		Calibration, OutboxEvent, Sensor, SensorGroup, SensorType []ent.Interceptor
 	}
 )
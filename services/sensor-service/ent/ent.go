@@ -12,6 +12,8 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/outboxevent"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensorgroup"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
@@ -75,6 +77,8 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			calibration.Table: calibration.ValidColumn,
+			outboxevent.Table: outboxevent.ValidColumn,
 			sensor.Table:      sensor.ValidColumn,
 			sensorgroup.Table: sensorgroup.ValidColumn,
 			sensortype.Table:  sensortype.ValidColumn,
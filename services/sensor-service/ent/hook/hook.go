@@ -9,6 +9,30 @@ import (
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent"
 )
 
+// The CalibrationFunc type is an adapter to allow the use of ordinary
+// function as Calibration mutator.
+type CalibrationFunc func(context.Context, *ent.CalibrationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CalibrationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CalibrationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CalibrationMutation", m)
+}
+
+// The OutboxEventFunc type is an adapter to allow the use of ordinary
+// function as OutboxEvent mutator.
+type OutboxEventFunc func(context.Context, *ent.OutboxEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OutboxEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OutboxEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OutboxEventMutation", m)
+}
+
 // The SensorFunc type is an adapter to allow the use of ordinary
 // function as Sensor mutator.
 type SensorFunc func(context.Context, *ent.SensorMutation) (ent.Value, error)
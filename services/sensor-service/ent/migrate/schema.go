@@ -8,6 +8,66 @@ import (
 )
 
 var (
+	// CalibrationsColumns holds the columns for the "calibrations" table.
+	CalibrationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "channel", Type: field.TypeString, Nullable: true},
+		{Name: "offset", Type: field.TypeFloat64, Default: 0},
+		{Name: "gain", Type: field.TypeFloat64, Default: 1},
+		{Name: "coefficients", Type: field.TypeJSON, Nullable: true},
+		{Name: "valid_from", Type: field.TypeTime},
+		{Name: "valid_to", Type: field.TypeTime, Nullable: true},
+		{Name: "certificate", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "sensor_id", Type: field.TypeInt},
+	}
+	// CalibrationsTable holds the schema information for the "calibrations" table.
+	CalibrationsTable = &schema.Table{
+		Name:       "calibrations",
+		Columns:    CalibrationsColumns,
+		PrimaryKey: []*schema.Column{CalibrationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "calibrations_sensors_calibrations",
+				Columns:    []*schema.Column{CalibrationsColumns[10]},
+				RefColumns: []*schema.Column{SensorsColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "calibration_sensor_id_valid_from",
+				Unique:  false,
+				Columns: []*schema.Column{CalibrationsColumns[10], CalibrationsColumns[5]},
+			},
+		},
+	}
+	// OutboxEventsColumns holds the columns for the "outbox_events" table.
+	OutboxEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "exchange", Type: field.TypeString},
+		{Name: "routing_key", Type: field.TypeString, Default: ""},
+		{Name: "payload", Type: field.TypeBytes},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "next_attempt_at", Type: field.TypeTime},
+		{Name: "claim_token", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// OutboxEventsTable holds the schema information for the "outbox_events" table.
+	OutboxEventsTable = &schema.Table{
+		Name:       "outbox_events",
+		Columns:    OutboxEventsColumns,
+		PrimaryKey: []*schema.Column{OutboxEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "outboxevent_next_attempt_at",
+				Unique:  false,
+				Columns: []*schema.Column{OutboxEventsColumns[6]},
+			},
+		},
+	}
 	// SensorsColumns holds the columns for the "sensors" table.
 	SensorsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -17,6 +77,8 @@ var (
 		{Name: "active", Type: field.TypeBool, Default: true},
 		{Name: "last_updated", Type: field.TypeTime, Nullable: true},
 		{Name: "user_id", Type: field.TypeInt64},
+		{Name: "kind", Type: field.TypeString, Default: "physical"},
+		{Name: "expression", Type: field.TypeString, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "sensor_type", Type: field.TypeInt},
@@ -30,13 +92,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "sensors_sensor_types_type",
-				Columns:    []*schema.Column{SensorsColumns[9]},
+				Columns:    []*schema.Column{SensorsColumns[11]},
 				RefColumns: []*schema.Column{SensorTypesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "sensors_sensor_types_sensors",
-				Columns:    []*schema.Column{SensorsColumns[10]},
+				Columns:    []*schema.Column{SensorsColumns[12]},
 				RefColumns: []*schema.Column{SensorTypesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
@@ -69,6 +131,8 @@ var (
 		{Name: "unit", Type: field.TypeString, Nullable: true},
 		{Name: "min_value", Type: field.TypeFloat64, Nullable: true},
 		{Name: "max_value", Type: field.TypeFloat64, Nullable: true},
+		{Name: "out_of_range_policy", Type: field.TypeString, Default: "flag"},
+		{Name: "channels", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 	}
 	// SensorTypesTable holds the schema information for the "sensor_types" table.
@@ -104,6 +168,8 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		CalibrationsTable,
+		OutboxEventsTable,
 		SensorsTable,
 		SensorGroupsTable,
 		SensorTypesTable,
@@ -112,6 +178,7 @@ var (
 )
 
 func init() {
+	CalibrationsTable.ForeignKeys[0].RefTable = SensorsTable
 	SensorsTable.ForeignKeys[0].RefTable = SensorTypesTable
 	SensorsTable.ForeignKeys[1].RefTable = SensorTypesTable
 	SensorGroupSensorsTable.ForeignKeys[0].RefTable = SensorGroupsTable
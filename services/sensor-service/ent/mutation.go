@@ -11,7 +11,10 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/outboxevent"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/schema"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensorgroup"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
@@ -26,35 +29,1854 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeCalibration = "Calibration"
+	TypeOutboxEvent = "OutboxEvent"
 	TypeSensor      = "Sensor"
 	TypeSensorGroup = "SensorGroup"
 	TypeSensorType  = "SensorType"
 )
 
+// CalibrationMutation represents an operation that mutates the Calibration nodes in the graph.
+type CalibrationMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	channel            *string
+	_offset            *float64
+	add_offset         *float64
+	gain               *float64
+	addgain            *float64
+	coefficients       *[]float64
+	appendcoefficients []float64
+	valid_from         *time.Time
+	valid_to           *time.Time
+	certificate        *string
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	sensor             *int
+	clearedsensor      bool
+	done               bool
+	oldValue           func(context.Context) (*Calibration, error)
+	predicates         []predicate.Calibration
+}
+
+var _ ent.Mutation = (*CalibrationMutation)(nil)
+
+// calibrationOption allows management of the mutation configuration using functional options.
+type calibrationOption func(*CalibrationMutation)
+
+// newCalibrationMutation creates new mutation for the Calibration entity.
+func newCalibrationMutation(c config, op Op, opts ...calibrationOption) *CalibrationMutation {
+	m := &CalibrationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCalibration,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCalibrationID sets the ID field of the mutation.
+func withCalibrationID(id int) calibrationOption {
+	return func(m *CalibrationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Calibration
+		)
+		m.oldValue = func(ctx context.Context) (*Calibration, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Calibration.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCalibration sets the old Calibration of the mutation.
+func withCalibration(node *Calibration) calibrationOption {
+	return func(m *CalibrationMutation) {
+		m.oldValue = func(context.Context) (*Calibration, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CalibrationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CalibrationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CalibrationMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CalibrationMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Calibration.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSensorID sets the "sensor_id" field.
+func (m *CalibrationMutation) SetSensorID(i int) {
+	m.sensor = &i
+}
+
+// SensorID returns the value of the "sensor_id" field in the mutation.
+func (m *CalibrationMutation) SensorID() (r int, exists bool) {
+	v := m.sensor
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSensorID returns the old "sensor_id" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldSensorID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSensorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSensorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSensorID: %w", err)
+	}
+	return oldValue.SensorID, nil
+}
+
+// ResetSensorID resets all changes to the "sensor_id" field.
+func (m *CalibrationMutation) ResetSensorID() {
+	m.sensor = nil
+}
+
+// SetChannel sets the "channel" field.
+func (m *CalibrationMutation) SetChannel(s string) {
+	m.channel = &s
+}
+
+// Channel returns the value of the "channel" field in the mutation.
+func (m *CalibrationMutation) Channel() (r string, exists bool) {
+	v := m.channel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannel returns the old "channel" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldChannel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
+	}
+	return oldValue.Channel, nil
+}
+
+// ClearChannel clears the value of the "channel" field.
+func (m *CalibrationMutation) ClearChannel() {
+	m.channel = nil
+	m.clearedFields[calibration.FieldChannel] = struct{}{}
+}
+
+// ChannelCleared returns if the "channel" field was cleared in this mutation.
+func (m *CalibrationMutation) ChannelCleared() bool {
+	_, ok := m.clearedFields[calibration.FieldChannel]
+	return ok
+}
+
+// ResetChannel resets all changes to the "channel" field.
+func (m *CalibrationMutation) ResetChannel() {
+	m.channel = nil
+	delete(m.clearedFields, calibration.FieldChannel)
+}
+
+// SetOffset sets the "offset" field.
+func (m *CalibrationMutation) SetOffset(f float64) {
+	m._offset = &f
+	m.add_offset = nil
+}
+
+// Offset returns the value of the "offset" field in the mutation.
+func (m *CalibrationMutation) Offset() (r float64, exists bool) {
+	v := m._offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOffset returns the old "offset" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldOffset(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOffset is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOffset requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOffset: %w", err)
+	}
+	return oldValue.Offset, nil
+}
+
+// AddOffset adds f to the "offset" field.
+func (m *CalibrationMutation) AddOffset(f float64) {
+	if m.add_offset != nil {
+		*m.add_offset += f
+	} else {
+		m.add_offset = &f
+	}
+}
+
+// AddedOffset returns the value that was added to the "offset" field in this mutation.
+func (m *CalibrationMutation) AddedOffset() (r float64, exists bool) {
+	v := m.add_offset
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOffset resets all changes to the "offset" field.
+func (m *CalibrationMutation) ResetOffset() {
+	m._offset = nil
+	m.add_offset = nil
+}
+
+// SetGain sets the "gain" field.
+func (m *CalibrationMutation) SetGain(f float64) {
+	m.gain = &f
+	m.addgain = nil
+}
+
+// Gain returns the value of the "gain" field in the mutation.
+func (m *CalibrationMutation) Gain() (r float64, exists bool) {
+	v := m.gain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGain returns the old "gain" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldGain(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGain is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGain requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGain: %w", err)
+	}
+	return oldValue.Gain, nil
+}
+
+// AddGain adds f to the "gain" field.
+func (m *CalibrationMutation) AddGain(f float64) {
+	if m.addgain != nil {
+		*m.addgain += f
+	} else {
+		m.addgain = &f
+	}
+}
+
+// AddedGain returns the value that was added to the "gain" field in this mutation.
+func (m *CalibrationMutation) AddedGain() (r float64, exists bool) {
+	v := m.addgain
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetGain resets all changes to the "gain" field.
+func (m *CalibrationMutation) ResetGain() {
+	m.gain = nil
+	m.addgain = nil
+}
+
+// SetCoefficients sets the "coefficients" field.
+func (m *CalibrationMutation) SetCoefficients(f []float64) {
+	m.coefficients = &f
+	m.appendcoefficients = nil
+}
+
+// Coefficients returns the value of the "coefficients" field in the mutation.
+func (m *CalibrationMutation) Coefficients() (r []float64, exists bool) {
+	v := m.coefficients
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCoefficients returns the old "coefficients" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldCoefficients(ctx context.Context) (v []float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCoefficients is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCoefficients requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCoefficients: %w", err)
+	}
+	return oldValue.Coefficients, nil
+}
+
+// AppendCoefficients adds f to the "coefficients" field.
+func (m *CalibrationMutation) AppendCoefficients(f []float64) {
+	m.appendcoefficients = append(m.appendcoefficients, f...)
+}
+
+// AppendedCoefficients returns the list of values that were appended to the "coefficients" field in this mutation.
+func (m *CalibrationMutation) AppendedCoefficients() ([]float64, bool) {
+	if len(m.appendcoefficients) == 0 {
+		return nil, false
+	}
+	return m.appendcoefficients, true
+}
+
+// ClearCoefficients clears the value of the "coefficients" field.
+func (m *CalibrationMutation) ClearCoefficients() {
+	m.coefficients = nil
+	m.appendcoefficients = nil
+	m.clearedFields[calibration.FieldCoefficients] = struct{}{}
+}
+
+// CoefficientsCleared returns if the "coefficients" field was cleared in this mutation.
+func (m *CalibrationMutation) CoefficientsCleared() bool {
+	_, ok := m.clearedFields[calibration.FieldCoefficients]
+	return ok
+}
+
+// ResetCoefficients resets all changes to the "coefficients" field.
+func (m *CalibrationMutation) ResetCoefficients() {
+	m.coefficients = nil
+	m.appendcoefficients = nil
+	delete(m.clearedFields, calibration.FieldCoefficients)
+}
+
+// SetValidFrom sets the "valid_from" field.
+func (m *CalibrationMutation) SetValidFrom(t time.Time) {
+	m.valid_from = &t
+}
+
+// ValidFrom returns the value of the "valid_from" field in the mutation.
+func (m *CalibrationMutation) ValidFrom() (r time.Time, exists bool) {
+	v := m.valid_from
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidFrom returns the old "valid_from" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldValidFrom(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidFrom is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidFrom requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidFrom: %w", err)
+	}
+	return oldValue.ValidFrom, nil
+}
+
+// ResetValidFrom resets all changes to the "valid_from" field.
+func (m *CalibrationMutation) ResetValidFrom() {
+	m.valid_from = nil
+}
+
+// SetValidTo sets the "valid_to" field.
+func (m *CalibrationMutation) SetValidTo(t time.Time) {
+	m.valid_to = &t
+}
+
+// ValidTo returns the value of the "valid_to" field in the mutation.
+func (m *CalibrationMutation) ValidTo() (r time.Time, exists bool) {
+	v := m.valid_to
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValidTo returns the old "valid_to" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldValidTo(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValidTo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValidTo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValidTo: %w", err)
+	}
+	return oldValue.ValidTo, nil
+}
+
+// ClearValidTo clears the value of the "valid_to" field.
+func (m *CalibrationMutation) ClearValidTo() {
+	m.valid_to = nil
+	m.clearedFields[calibration.FieldValidTo] = struct{}{}
+}
+
+// ValidToCleared returns if the "valid_to" field was cleared in this mutation.
+func (m *CalibrationMutation) ValidToCleared() bool {
+	_, ok := m.clearedFields[calibration.FieldValidTo]
+	return ok
+}
+
+// ResetValidTo resets all changes to the "valid_to" field.
+func (m *CalibrationMutation) ResetValidTo() {
+	m.valid_to = nil
+	delete(m.clearedFields, calibration.FieldValidTo)
+}
+
+// SetCertificate sets the "certificate" field.
+func (m *CalibrationMutation) SetCertificate(s string) {
+	m.certificate = &s
+}
+
+// Certificate returns the value of the "certificate" field in the mutation.
+func (m *CalibrationMutation) Certificate() (r string, exists bool) {
+	v := m.certificate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCertificate returns the old "certificate" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldCertificate(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCertificate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCertificate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCertificate: %w", err)
+	}
+	return oldValue.Certificate, nil
+}
+
+// ClearCertificate clears the value of the "certificate" field.
+func (m *CalibrationMutation) ClearCertificate() {
+	m.certificate = nil
+	m.clearedFields[calibration.FieldCertificate] = struct{}{}
+}
+
+// CertificateCleared returns if the "certificate" field was cleared in this mutation.
+func (m *CalibrationMutation) CertificateCleared() bool {
+	_, ok := m.clearedFields[calibration.FieldCertificate]
+	return ok
+}
+
+// ResetCertificate resets all changes to the "certificate" field.
+func (m *CalibrationMutation) ResetCertificate() {
+	m.certificate = nil
+	delete(m.clearedFields, calibration.FieldCertificate)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CalibrationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CalibrationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CalibrationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CalibrationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CalibrationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Calibration entity.
+// If the Calibration object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CalibrationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CalibrationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearSensor clears the "sensor" edge to the Sensor entity.
+func (m *CalibrationMutation) ClearSensor() {
+	m.clearedsensor = true
+	m.clearedFields[calibration.FieldSensorID] = struct{}{}
+}
+
+// SensorCleared reports if the "sensor" edge to the Sensor entity was cleared.
+func (m *CalibrationMutation) SensorCleared() bool {
+	return m.clearedsensor
+}
+
+// SensorIDs returns the "sensor" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SensorID instead. It exists only for internal usage by the builders.
+func (m *CalibrationMutation) SensorIDs() (ids []int) {
+	if id := m.sensor; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSensor resets all changes to the "sensor" edge.
+func (m *CalibrationMutation) ResetSensor() {
+	m.sensor = nil
+	m.clearedsensor = false
+}
+
+// Where appends a list predicates to the CalibrationMutation builder.
+func (m *CalibrationMutation) Where(ps ...predicate.Calibration) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CalibrationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CalibrationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Calibration, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CalibrationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CalibrationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Calibration).
+func (m *CalibrationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CalibrationMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.sensor != nil {
+		fields = append(fields, calibration.FieldSensorID)
+	}
+	if m.channel != nil {
+		fields = append(fields, calibration.FieldChannel)
+	}
+	if m._offset != nil {
+		fields = append(fields, calibration.FieldOffset)
+	}
+	if m.gain != nil {
+		fields = append(fields, calibration.FieldGain)
+	}
+	if m.coefficients != nil {
+		fields = append(fields, calibration.FieldCoefficients)
+	}
+	if m.valid_from != nil {
+		fields = append(fields, calibration.FieldValidFrom)
+	}
+	if m.valid_to != nil {
+		fields = append(fields, calibration.FieldValidTo)
+	}
+	if m.certificate != nil {
+		fields = append(fields, calibration.FieldCertificate)
+	}
+	if m.created_at != nil {
+		fields = append(fields, calibration.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, calibration.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CalibrationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case calibration.FieldSensorID:
+		return m.SensorID()
+	case calibration.FieldChannel:
+		return m.Channel()
+	case calibration.FieldOffset:
+		return m.Offset()
+	case calibration.FieldGain:
+		return m.Gain()
+	case calibration.FieldCoefficients:
+		return m.Coefficients()
+	case calibration.FieldValidFrom:
+		return m.ValidFrom()
+	case calibration.FieldValidTo:
+		return m.ValidTo()
+	case calibration.FieldCertificate:
+		return m.Certificate()
+	case calibration.FieldCreatedAt:
+		return m.CreatedAt()
+	case calibration.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CalibrationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case calibration.FieldSensorID:
+		return m.OldSensorID(ctx)
+	case calibration.FieldChannel:
+		return m.OldChannel(ctx)
+	case calibration.FieldOffset:
+		return m.OldOffset(ctx)
+	case calibration.FieldGain:
+		return m.OldGain(ctx)
+	case calibration.FieldCoefficients:
+		return m.OldCoefficients(ctx)
+	case calibration.FieldValidFrom:
+		return m.OldValidFrom(ctx)
+	case calibration.FieldValidTo:
+		return m.OldValidTo(ctx)
+	case calibration.FieldCertificate:
+		return m.OldCertificate(ctx)
+	case calibration.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case calibration.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Calibration field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CalibrationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case calibration.FieldSensorID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSensorID(v)
+		return nil
+	case calibration.FieldChannel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannel(v)
+		return nil
+	case calibration.FieldOffset:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOffset(v)
+		return nil
+	case calibration.FieldGain:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGain(v)
+		return nil
+	case calibration.FieldCoefficients:
+		v, ok := value.([]float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCoefficients(v)
+		return nil
+	case calibration.FieldValidFrom:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidFrom(v)
+		return nil
+	case calibration.FieldValidTo:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValidTo(v)
+		return nil
+	case calibration.FieldCertificate:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCertificate(v)
+		return nil
+	case calibration.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case calibration.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Calibration field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CalibrationMutation) AddedFields() []string {
+	var fields []string
+	if m.add_offset != nil {
+		fields = append(fields, calibration.FieldOffset)
+	}
+	if m.addgain != nil {
+		fields = append(fields, calibration.FieldGain)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CalibrationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case calibration.FieldOffset:
+		return m.AddedOffset()
+	case calibration.FieldGain:
+		return m.AddedGain()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CalibrationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case calibration.FieldOffset:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOffset(v)
+		return nil
+	case calibration.FieldGain:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddGain(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Calibration numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CalibrationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(calibration.FieldChannel) {
+		fields = append(fields, calibration.FieldChannel)
+	}
+	if m.FieldCleared(calibration.FieldCoefficients) {
+		fields = append(fields, calibration.FieldCoefficients)
+	}
+	if m.FieldCleared(calibration.FieldValidTo) {
+		fields = append(fields, calibration.FieldValidTo)
+	}
+	if m.FieldCleared(calibration.FieldCertificate) {
+		fields = append(fields, calibration.FieldCertificate)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CalibrationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CalibrationMutation) ClearField(name string) error {
+	switch name {
+	case calibration.FieldChannel:
+		m.ClearChannel()
+		return nil
+	case calibration.FieldCoefficients:
+		m.ClearCoefficients()
+		return nil
+	case calibration.FieldValidTo:
+		m.ClearValidTo()
+		return nil
+	case calibration.FieldCertificate:
+		m.ClearCertificate()
+		return nil
+	}
+	return fmt.Errorf("unknown Calibration nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CalibrationMutation) ResetField(name string) error {
+	switch name {
+	case calibration.FieldSensorID:
+		m.ResetSensorID()
+		return nil
+	case calibration.FieldChannel:
+		m.ResetChannel()
+		return nil
+	case calibration.FieldOffset:
+		m.ResetOffset()
+		return nil
+	case calibration.FieldGain:
+		m.ResetGain()
+		return nil
+	case calibration.FieldCoefficients:
+		m.ResetCoefficients()
+		return nil
+	case calibration.FieldValidFrom:
+		m.ResetValidFrom()
+		return nil
+	case calibration.FieldValidTo:
+		m.ResetValidTo()
+		return nil
+	case calibration.FieldCertificate:
+		m.ResetCertificate()
+		return nil
+	case calibration.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case calibration.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Calibration field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CalibrationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.sensor != nil {
+		edges = append(edges, calibration.EdgeSensor)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CalibrationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case calibration.EdgeSensor:
+		if id := m.sensor; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CalibrationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CalibrationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CalibrationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsensor {
+		edges = append(edges, calibration.EdgeSensor)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CalibrationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case calibration.EdgeSensor:
+		return m.clearedsensor
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CalibrationMutation) ClearEdge(name string) error {
+	switch name {
+	case calibration.EdgeSensor:
+		m.ClearSensor()
+		return nil
+	}
+	return fmt.Errorf("unknown Calibration unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CalibrationMutation) ResetEdge(name string) error {
+	switch name {
+	case calibration.EdgeSensor:
+		m.ResetSensor()
+		return nil
+	}
+	return fmt.Errorf("unknown Calibration edge %s", name)
+}
+
+// OutboxEventMutation represents an operation that mutates the OutboxEvent nodes in the graph.
+type OutboxEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int
+	exchange        *string
+	routing_key     *string
+	payload         *[]byte
+	attempts        *int
+	addattempts     *int
+	last_error      *string
+	next_attempt_at *time.Time
+	claim_token     *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*OutboxEvent, error)
+	predicates      []predicate.OutboxEvent
+}
+
+var _ ent.Mutation = (*OutboxEventMutation)(nil)
+
+// outboxeventOption allows management of the mutation configuration using functional options.
+type outboxeventOption func(*OutboxEventMutation)
+
+// newOutboxEventMutation creates new mutation for the OutboxEvent entity.
+func newOutboxEventMutation(c config, op Op, opts ...outboxeventOption) *OutboxEventMutation {
+	m := &OutboxEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOutboxEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOutboxEventID sets the ID field of the mutation.
+func withOutboxEventID(id int) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OutboxEvent
+		)
+		m.oldValue = func(ctx context.Context) (*OutboxEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OutboxEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOutboxEvent sets the old OutboxEvent of the mutation.
+func withOutboxEvent(node *OutboxEvent) outboxeventOption {
+	return func(m *OutboxEventMutation) {
+		m.oldValue = func(context.Context) (*OutboxEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OutboxEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OutboxEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OutboxEventMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OutboxEventMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OutboxEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetExchange sets the "exchange" field.
+func (m *OutboxEventMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *OutboxEventMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *OutboxEventMutation) ResetExchange() {
+	m.exchange = nil
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (m *OutboxEventMutation) SetRoutingKey(s string) {
+	m.routing_key = &s
+}
+
+// RoutingKey returns the value of the "routing_key" field in the mutation.
+func (m *OutboxEventMutation) RoutingKey() (r string, exists bool) {
+	v := m.routing_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRoutingKey returns the old "routing_key" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldRoutingKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRoutingKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRoutingKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRoutingKey: %w", err)
+	}
+	return oldValue.RoutingKey, nil
+}
+
+// ResetRoutingKey resets all changes to the "routing_key" field.
+func (m *OutboxEventMutation) ResetRoutingKey() {
+	m.routing_key = nil
+}
+
+// SetPayload sets the "payload" field.
+func (m *OutboxEventMutation) SetPayload(b []byte) {
+	m.payload = &b
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *OutboxEventMutation) Payload() (r []byte, exists bool) {
+	v := m.payload
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPayload returns the old "payload" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldPayload(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
+}
+
+// ResetPayload resets all changes to the "payload" field.
+func (m *OutboxEventMutation) ResetPayload() {
+	m.payload = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *OutboxEventMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *OutboxEventMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *OutboxEventMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *OutboxEventMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *OutboxEventMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *OutboxEventMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *OutboxEventMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *OutboxEventMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[outboxevent.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *OutboxEventMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *OutboxEventMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, outboxevent.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *OutboxEventMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *OutboxEventMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldNextAttemptAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *OutboxEventMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (m *OutboxEventMutation) SetClaimToken(s string) {
+	m.claim_token = &s
+}
+
+// ClaimToken returns the value of the "claim_token" field in the mutation.
+func (m *OutboxEventMutation) ClaimToken() (r string, exists bool) {
+	v := m.claim_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClaimToken returns the old "claim_token" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldClaimToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClaimToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClaimToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClaimToken: %w", err)
+	}
+	return oldValue.ClaimToken, nil
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (m *OutboxEventMutation) ClearClaimToken() {
+	m.claim_token = nil
+	m.clearedFields[outboxevent.FieldClaimToken] = struct{}{}
+}
+
+// ClaimTokenCleared returns if the "claim_token" field was cleared in this mutation.
+func (m *OutboxEventMutation) ClaimTokenCleared() bool {
+	_, ok := m.clearedFields[outboxevent.FieldClaimToken]
+	return ok
+}
+
+// ResetClaimToken resets all changes to the "claim_token" field.
+func (m *OutboxEventMutation) ResetClaimToken() {
+	m.claim_token = nil
+	delete(m.clearedFields, outboxevent.FieldClaimToken)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OutboxEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OutboxEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OutboxEvent entity.
+// If the OutboxEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OutboxEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OutboxEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the OutboxEventMutation builder.
+func (m *OutboxEventMutation) Where(ps ...predicate.OutboxEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OutboxEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OutboxEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OutboxEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OutboxEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OutboxEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OutboxEvent).
+func (m *OutboxEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OutboxEventMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.exchange != nil {
+		fields = append(fields, outboxevent.FieldExchange)
+	}
+	if m.routing_key != nil {
+		fields = append(fields, outboxevent.FieldRoutingKey)
+	}
+	if m.payload != nil {
+		fields = append(fields, outboxevent.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, outboxevent.FieldNextAttemptAt)
+	}
+	if m.claim_token != nil {
+		fields = append(fields, outboxevent.FieldClaimToken)
+	}
+	if m.created_at != nil {
+		fields = append(fields, outboxevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OutboxEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldExchange:
+		return m.Exchange()
+	case outboxevent.FieldRoutingKey:
+		return m.RoutingKey()
+	case outboxevent.FieldPayload:
+		return m.Payload()
+	case outboxevent.FieldAttempts:
+		return m.Attempts()
+	case outboxevent.FieldLastError:
+		return m.LastError()
+	case outboxevent.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case outboxevent.FieldClaimToken:
+		return m.ClaimToken()
+	case outboxevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OutboxEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case outboxevent.FieldExchange:
+		return m.OldExchange(ctx)
+	case outboxevent.FieldRoutingKey:
+		return m.OldRoutingKey(ctx)
+	case outboxevent.FieldPayload:
+		return m.OldPayload(ctx)
+	case outboxevent.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case outboxevent.FieldLastError:
+		return m.OldLastError(ctx)
+	case outboxevent.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case outboxevent.FieldClaimToken:
+		return m.OldClaimToken(ctx)
+	case outboxevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case outboxevent.FieldRoutingKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRoutingKey(v)
+		return nil
+	case outboxevent.FieldPayload:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case outboxevent.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case outboxevent.FieldClaimToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClaimToken(v)
+		return nil
+	case outboxevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OutboxEventMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, outboxevent.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OutboxEventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case outboxevent.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OutboxEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case outboxevent.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OutboxEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(outboxevent.FieldLastError) {
+		fields = append(fields, outboxevent.FieldLastError)
+	}
+	if m.FieldCleared(outboxevent.FieldClaimToken) {
+		fields = append(fields, outboxevent.FieldClaimToken)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OutboxEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ClearField(name string) error {
+	switch name {
+	case outboxevent.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case outboxevent.FieldClaimToken:
+		m.ClearClaimToken()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OutboxEventMutation) ResetField(name string) error {
+	switch name {
+	case outboxevent.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case outboxevent.FieldRoutingKey:
+		m.ResetRoutingKey()
+		return nil
+	case outboxevent.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case outboxevent.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case outboxevent.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case outboxevent.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case outboxevent.FieldClaimToken:
+		m.ResetClaimToken()
+		return nil
+	case outboxevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OutboxEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OutboxEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OutboxEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OutboxEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OutboxEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OutboxEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OutboxEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OutboxEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OutboxEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown OutboxEvent edge %s", name)
+}
+
 // SensorMutation represents an operation that mutates the Sensor nodes in the graph.
 type SensorMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *int
-	name          *string
-	location      *string
-	description   *string
-	active        *bool
-	last_updated  *time.Time
-	user_id       *int64
-	adduser_id    *int64
-	created_at    *time.Time
-	updated_at    *time.Time
-	clearedFields map[string]struct{}
-	_type         *int
-	cleared_type  bool
-	groups        map[int]struct{}
-	removedgroups map[int]struct{}
-	clearedgroups bool
-	done          bool
-	oldValue      func(context.Context) (*Sensor, error)
-	predicates    []predicate.Sensor
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	location            *string
+	description         *string
+	active              *bool
+	last_updated        *time.Time
+	user_id             *int64
+	adduser_id          *int64
+	kind                *string
+	expression          *string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	_type               *int
+	cleared_type        bool
+	groups              map[int]struct{}
+	removedgroups       map[int]struct{}
+	clearedgroups       bool
+	calibrations        map[int]struct{}
+	removedcalibrations map[int]struct{}
+	clearedcalibrations bool
+	done                bool
+	oldValue            func(context.Context) (*Sensor, error)
+	predicates          []predicate.Sensor
 }
 
 var _ ent.Mutation = (*SensorMutation)(nil)
@@ -430,6 +2252,91 @@ func (m *SensorMutation) ResetUserID() {
 	m.adduser_id = nil
 }
 
+// SetKind sets the "kind" field.
+func (m *SensorMutation) SetKind(s string) {
+	m.kind = &s
+}
+
+// Kind returns the value of the "kind" field in the mutation.
+func (m *SensorMutation) Kind() (r string, exists bool) {
+	v := m.kind
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKind returns the old "kind" field's value of the Sensor entity.
+// If the Sensor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SensorMutation) OldKind(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKind is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKind requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKind: %w", err)
+	}
+	return oldValue.Kind, nil
+}
+
+// ResetKind resets all changes to the "kind" field.
+func (m *SensorMutation) ResetKind() {
+	m.kind = nil
+}
+
+// SetExpression sets the "expression" field.
+func (m *SensorMutation) SetExpression(s string) {
+	m.expression = &s
+}
+
+// Expression returns the value of the "expression" field in the mutation.
+func (m *SensorMutation) Expression() (r string, exists bool) {
+	v := m.expression
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpression returns the old "expression" field's value of the Sensor entity.
+// If the Sensor object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SensorMutation) OldExpression(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpression is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpression requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpression: %w", err)
+	}
+	return oldValue.Expression, nil
+}
+
+// ClearExpression clears the value of the "expression" field.
+func (m *SensorMutation) ClearExpression() {
+	m.expression = nil
+	m.clearedFields[sensor.FieldExpression] = struct{}{}
+}
+
+// ExpressionCleared returns if the "expression" field was cleared in this mutation.
+func (m *SensorMutation) ExpressionCleared() bool {
+	_, ok := m.clearedFields[sensor.FieldExpression]
+	return ok
+}
+
+// ResetExpression resets all changes to the "expression" field.
+func (m *SensorMutation) ResetExpression() {
+	m.expression = nil
+	delete(m.clearedFields, sensor.FieldExpression)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *SensorMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -595,6 +2502,60 @@ func (m *SensorMutation) ResetGroups() {
 	m.removedgroups = nil
 }
 
+// AddCalibrationIDs adds the "calibrations" edge to the Calibration entity by ids.
+func (m *SensorMutation) AddCalibrationIDs(ids ...int) {
+	if m.calibrations == nil {
+		m.calibrations = make(map[int]struct{})
+	}
+	for i := range ids {
+		m.calibrations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearCalibrations clears the "calibrations" edge to the Calibration entity.
+func (m *SensorMutation) ClearCalibrations() {
+	m.clearedcalibrations = true
+}
+
+// CalibrationsCleared reports if the "calibrations" edge to the Calibration entity was cleared.
+func (m *SensorMutation) CalibrationsCleared() bool {
+	return m.clearedcalibrations
+}
+
+// RemoveCalibrationIDs removes the "calibrations" edge to the Calibration entity by IDs.
+func (m *SensorMutation) RemoveCalibrationIDs(ids ...int) {
+	if m.removedcalibrations == nil {
+		m.removedcalibrations = make(map[int]struct{})
+	}
+	for i := range ids {
+		delete(m.calibrations, ids[i])
+		m.removedcalibrations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedCalibrations returns the removed IDs of the "calibrations" edge to the Calibration entity.
+func (m *SensorMutation) RemovedCalibrationsIDs() (ids []int) {
+	for id := range m.removedcalibrations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// CalibrationsIDs returns the "calibrations" edge IDs in the mutation.
+func (m *SensorMutation) CalibrationsIDs() (ids []int) {
+	for id := range m.calibrations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetCalibrations resets all changes to the "calibrations" edge.
+func (m *SensorMutation) ResetCalibrations() {
+	m.calibrations = nil
+	m.clearedcalibrations = false
+	m.removedcalibrations = nil
+}
+
 // Where appends a list predicates to the SensorMutation builder.
 func (m *SensorMutation) Where(ps ...predicate.Sensor) {
 	m.predicates = append(m.predicates, ps...)
@@ -629,7 +2590,7 @@ func (m *SensorMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SensorMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 10)
 	if m.name != nil {
 		fields = append(fields, sensor.FieldName)
 	}
@@ -648,6 +2609,12 @@ func (m *SensorMutation) Fields() []string {
 	if m.user_id != nil {
 		fields = append(fields, sensor.FieldUserID)
 	}
+	if m.kind != nil {
+		fields = append(fields, sensor.FieldKind)
+	}
+	if m.expression != nil {
+		fields = append(fields, sensor.FieldExpression)
+	}
 	if m.created_at != nil {
 		fields = append(fields, sensor.FieldCreatedAt)
 	}
@@ -674,6 +2641,10 @@ func (m *SensorMutation) Field(name string) (ent.Value, bool) {
 		return m.LastUpdated()
 	case sensor.FieldUserID:
 		return m.UserID()
+	case sensor.FieldKind:
+		return m.Kind()
+	case sensor.FieldExpression:
+		return m.Expression()
 	case sensor.FieldCreatedAt:
 		return m.CreatedAt()
 	case sensor.FieldUpdatedAt:
@@ -699,6 +2670,10 @@ func (m *SensorMutation) OldField(ctx context.Context, name string) (ent.Value,
 		return m.OldLastUpdated(ctx)
 	case sensor.FieldUserID:
 		return m.OldUserID(ctx)
+	case sensor.FieldKind:
+		return m.OldKind(ctx)
+	case sensor.FieldExpression:
+		return m.OldExpression(ctx)
 	case sensor.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case sensor.FieldUpdatedAt:
@@ -754,6 +2729,20 @@ func (m *SensorMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetUserID(v)
 		return nil
+	case sensor.FieldKind:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKind(v)
+		return nil
+	case sensor.FieldExpression:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpression(v)
+		return nil
 	case sensor.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -822,6 +2811,9 @@ func (m *SensorMutation) ClearedFields() []string {
 	if m.FieldCleared(sensor.FieldLastUpdated) {
 		fields = append(fields, sensor.FieldLastUpdated)
 	}
+	if m.FieldCleared(sensor.FieldExpression) {
+		fields = append(fields, sensor.FieldExpression)
+	}
 	return fields
 }
 
@@ -845,6 +2837,9 @@ func (m *SensorMutation) ClearField(name string) error {
 	case sensor.FieldLastUpdated:
 		m.ClearLastUpdated()
 		return nil
+	case sensor.FieldExpression:
+		m.ClearExpression()
+		return nil
 	}
 	return fmt.Errorf("unknown Sensor nullable field %s", name)
 }
@@ -871,6 +2866,12 @@ func (m *SensorMutation) ResetField(name string) error {
 	case sensor.FieldUserID:
 		m.ResetUserID()
 		return nil
+	case sensor.FieldKind:
+		m.ResetKind()
+		return nil
+	case sensor.FieldExpression:
+		m.ResetExpression()
+		return nil
 	case sensor.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -883,13 +2884,16 @@ func (m *SensorMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *SensorMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m._type != nil {
 		edges = append(edges, sensor.EdgeType)
 	}
 	if m.groups != nil {
 		edges = append(edges, sensor.EdgeGroups)
 	}
+	if m.calibrations != nil {
+		edges = append(edges, sensor.EdgeCalibrations)
+	}
 	return edges
 }
 
@@ -907,16 +2911,25 @@ func (m *SensorMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case sensor.EdgeCalibrations:
+		ids := make([]ent.Value, 0, len(m.calibrations))
+		for id := range m.calibrations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *SensorMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removedgroups != nil {
 		edges = append(edges, sensor.EdgeGroups)
 	}
+	if m.removedcalibrations != nil {
+		edges = append(edges, sensor.EdgeCalibrations)
+	}
 	return edges
 }
 
@@ -930,19 +2943,28 @@ func (m *SensorMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case sensor.EdgeCalibrations:
+		ids := make([]ent.Value, 0, len(m.removedcalibrations))
+		for id := range m.removedcalibrations {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *SensorMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.cleared_type {
 		edges = append(edges, sensor.EdgeType)
 	}
 	if m.clearedgroups {
 		edges = append(edges, sensor.EdgeGroups)
 	}
+	if m.clearedcalibrations {
+		edges = append(edges, sensor.EdgeCalibrations)
+	}
 	return edges
 }
 
@@ -954,6 +2976,8 @@ func (m *SensorMutation) EdgeCleared(name string) bool {
 		return m.cleared_type
 	case sensor.EdgeGroups:
 		return m.clearedgroups
+	case sensor.EdgeCalibrations:
+		return m.clearedcalibrations
 	}
 	return false
 }
@@ -979,6 +3003,9 @@ func (m *SensorMutation) ResetEdge(name string) error {
 	case sensor.EdgeGroups:
 		m.ResetGroups()
 		return nil
+	case sensor.EdgeCalibrations:
+		m.ResetCalibrations()
+		return nil
 	}
 	return fmt.Errorf("unknown Sensor edge %s", name)
 }
@@ -1825,26 +3852,29 @@ func (m *SensorGroupMutation) ResetEdge(name string) error {
 // SensorTypeMutation represents an operation that mutates the SensorType nodes in the graph.
 type SensorTypeMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *int
-	name           *string
-	model          *string
-	manufacturer   *string
-	description    *string
-	unit           *string
-	min_value      *float64
-	addmin_value   *float64
-	max_value      *float64
-	addmax_value   *float64
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	sensors        map[int]struct{}
-	removedsensors map[int]struct{}
-	clearedsensors bool
-	done           bool
-	oldValue       func(context.Context) (*SensorType, error)
-	predicates     []predicate.SensorType
+	op                  Op
+	typ                 string
+	id                  *int
+	name                *string
+	model               *string
+	manufacturer        *string
+	description         *string
+	unit                *string
+	min_value           *float64
+	addmin_value        *float64
+	max_value           *float64
+	addmax_value        *float64
+	out_of_range_policy *string
+	channels            *[]schema.Channel
+	appendchannels      []schema.Channel
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	sensors             map[int]struct{}
+	removedsensors      map[int]struct{}
+	clearedsensors      bool
+	done                bool
+	oldValue            func(context.Context) (*SensorType, error)
+	predicates          []predicate.SensorType
 }
 
 var _ ent.Mutation = (*SensorTypeMutation)(nil)
@@ -2304,6 +4334,107 @@ func (m *SensorTypeMutation) ResetMaxValue() {
 	delete(m.clearedFields, sensortype.FieldMaxValue)
 }
 
+// SetOutOfRangePolicy sets the "out_of_range_policy" field.
+func (m *SensorTypeMutation) SetOutOfRangePolicy(s string) {
+	m.out_of_range_policy = &s
+}
+
+// OutOfRangePolicy returns the value of the "out_of_range_policy" field in the mutation.
+func (m *SensorTypeMutation) OutOfRangePolicy() (r string, exists bool) {
+	v := m.out_of_range_policy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutOfRangePolicy returns the old "out_of_range_policy" field's value of the SensorType entity.
+// If the SensorType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SensorTypeMutation) OldOutOfRangePolicy(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutOfRangePolicy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutOfRangePolicy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutOfRangePolicy: %w", err)
+	}
+	return oldValue.OutOfRangePolicy, nil
+}
+
+// ResetOutOfRangePolicy resets all changes to the "out_of_range_policy" field.
+func (m *SensorTypeMutation) ResetOutOfRangePolicy() {
+	m.out_of_range_policy = nil
+}
+
+// SetChannels sets the "channels" field.
+func (m *SensorTypeMutation) SetChannels(s []schema.Channel) {
+	m.channels = &s
+	m.appendchannels = nil
+}
+
+// Channels returns the value of the "channels" field in the mutation.
+func (m *SensorTypeMutation) Channels() (r []schema.Channel, exists bool) {
+	v := m.channels
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannels returns the old "channels" field's value of the SensorType entity.
+// If the SensorType object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SensorTypeMutation) OldChannels(ctx context.Context) (v []schema.Channel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannels is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannels requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannels: %w", err)
+	}
+	return oldValue.Channels, nil
+}
+
+// AppendChannels adds s to the "channels" field.
+func (m *SensorTypeMutation) AppendChannels(s []schema.Channel) {
+	m.appendchannels = append(m.appendchannels, s...)
+}
+
+// AppendedChannels returns the list of values that were appended to the "channels" field in this mutation.
+func (m *SensorTypeMutation) AppendedChannels() ([]schema.Channel, bool) {
+	if len(m.appendchannels) == 0 {
+		return nil, false
+	}
+	return m.appendchannels, true
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (m *SensorTypeMutation) ClearChannels() {
+	m.channels = nil
+	m.appendchannels = nil
+	m.clearedFields[sensortype.FieldChannels] = struct{}{}
+}
+
+// ChannelsCleared returns if the "channels" field was cleared in this mutation.
+func (m *SensorTypeMutation) ChannelsCleared() bool {
+	_, ok := m.clearedFields[sensortype.FieldChannels]
+	return ok
+}
+
+// ResetChannels resets all changes to the "channels" field.
+func (m *SensorTypeMutation) ResetChannels() {
+	m.channels = nil
+	m.appendchannels = nil
+	delete(m.clearedFields, sensortype.FieldChannels)
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *SensorTypeMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -2428,7 +4559,7 @@ func (m *SensorTypeMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SensorTypeMutation) Fields() []string {
-	fields := make([]string, 0, 8)
+	fields := make([]string, 0, 10)
 	if m.name != nil {
 		fields = append(fields, sensortype.FieldName)
 	}
@@ -2450,6 +4581,12 @@ func (m *SensorTypeMutation) Fields() []string {
 	if m.max_value != nil {
 		fields = append(fields, sensortype.FieldMaxValue)
 	}
+	if m.out_of_range_policy != nil {
+		fields = append(fields, sensortype.FieldOutOfRangePolicy)
+	}
+	if m.channels != nil {
+		fields = append(fields, sensortype.FieldChannels)
+	}
 	if m.created_at != nil {
 		fields = append(fields, sensortype.FieldCreatedAt)
 	}
@@ -2475,6 +4612,10 @@ func (m *SensorTypeMutation) Field(name string) (ent.Value, bool) {
 		return m.MinValue()
 	case sensortype.FieldMaxValue:
 		return m.MaxValue()
+	case sensortype.FieldOutOfRangePolicy:
+		return m.OutOfRangePolicy()
+	case sensortype.FieldChannels:
+		return m.Channels()
 	case sensortype.FieldCreatedAt:
 		return m.CreatedAt()
 	}
@@ -2500,6 +4641,10 @@ func (m *SensorTypeMutation) OldField(ctx context.Context, name string) (ent.Val
 		return m.OldMinValue(ctx)
 	case sensortype.FieldMaxValue:
 		return m.OldMaxValue(ctx)
+	case sensortype.FieldOutOfRangePolicy:
+		return m.OldOutOfRangePolicy(ctx)
+	case sensortype.FieldChannels:
+		return m.OldChannels(ctx)
 	case sensortype.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
@@ -2560,6 +4705,20 @@ func (m *SensorTypeMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetMaxValue(v)
 		return nil
+	case sensortype.FieldOutOfRangePolicy:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutOfRangePolicy(v)
+		return nil
+	case sensortype.FieldChannels:
+		v, ok := value.([]schema.Channel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannels(v)
+		return nil
 	case sensortype.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -2639,6 +4798,9 @@ func (m *SensorTypeMutation) ClearedFields() []string {
 	if m.FieldCleared(sensortype.FieldMaxValue) {
 		fields = append(fields, sensortype.FieldMaxValue)
 	}
+	if m.FieldCleared(sensortype.FieldChannels) {
+		fields = append(fields, sensortype.FieldChannels)
+	}
 	return fields
 }
 
@@ -2668,6 +4830,9 @@ func (m *SensorTypeMutation) ClearField(name string) error {
 	case sensortype.FieldMaxValue:
 		m.ClearMaxValue()
 		return nil
+	case sensortype.FieldChannels:
+		m.ClearChannels()
+		return nil
 	}
 	return fmt.Errorf("unknown SensorType nullable field %s", name)
 }
@@ -2697,6 +4862,12 @@ func (m *SensorTypeMutation) ResetField(name string) error {
 	case sensortype.FieldMaxValue:
 		m.ResetMaxValue()
 		return nil
+	case sensortype.FieldOutOfRangePolicy:
+		m.ResetOutOfRangePolicy()
+		return nil
+	case sensortype.FieldChannels:
+		m.ResetChannels()
+		return nil
 	case sensortype.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -0,0 +1,185 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/outboxevent"
+)
+
+// OutboxEvent is the model entity for the OutboxEvent schema.
+type OutboxEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// RoutingKey holds the value of the "routing_key" field.
+	RoutingKey string `json:"routing_key,omitempty"`
+	// Payload holds the value of the "payload" field.
+	Payload []byte `json:"payload,omitempty"`
+	// Attempts holds the value of the "attempts" field.
+	Attempts int `json:"attempts,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// NextAttemptAt holds the value of the "next_attempt_at" field.
+	NextAttemptAt time.Time `json:"next_attempt_at,omitempty"`
+	// ClaimToken holds the value of the "claim_token" field.
+	ClaimToken string `json:"claim_token,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OutboxEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldPayload:
+			values[i] = new([]byte)
+		case outboxevent.FieldID, outboxevent.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case outboxevent.FieldExchange, outboxevent.FieldRoutingKey, outboxevent.FieldLastError, outboxevent.FieldClaimToken:
+			values[i] = new(sql.NullString)
+		case outboxevent.FieldNextAttemptAt, outboxevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OutboxEvent fields.
+func (oe *OutboxEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outboxevent.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			oe.ID = int(value.Int64)
+		case outboxevent.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				oe.Exchange = value.String
+			}
+		case outboxevent.FieldRoutingKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field routing_key", values[i])
+			} else if value.Valid {
+				oe.RoutingKey = value.String
+			}
+		case outboxevent.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				oe.Payload = *value
+			}
+		case outboxevent.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				oe.Attempts = int(value.Int64)
+			}
+		case outboxevent.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				oe.LastError = value.String
+			}
+		case outboxevent.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				oe.NextAttemptAt = value.Time
+			}
+		case outboxevent.FieldClaimToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field claim_token", values[i])
+			} else if value.Valid {
+				oe.ClaimToken = value.String
+			}
+		case outboxevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				oe.CreatedAt = value.Time
+			}
+		default:
+			oe.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OutboxEvent.
+// This includes values selected through modifiers, order, etc.
+func (oe *OutboxEvent) Value(name string) (ent.Value, error) {
+	return oe.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this OutboxEvent.
+// Note that you need to call OutboxEvent.Unwrap() before calling this method if this OutboxEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (oe *OutboxEvent) Update() *OutboxEventUpdateOne {
+	return NewOutboxEventClient(oe.config).UpdateOne(oe)
+}
+
+// Unwrap unwraps the OutboxEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (oe *OutboxEvent) Unwrap() *OutboxEvent {
+	_tx, ok := oe.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OutboxEvent is not a transactional entity")
+	}
+	oe.config.driver = _tx.drv
+	return oe
+}
+
+// String implements the fmt.Stringer.
+func (oe *OutboxEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("OutboxEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", oe.ID))
+	builder.WriteString("exchange=")
+	builder.WriteString(oe.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("routing_key=")
+	builder.WriteString(oe.RoutingKey)
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", oe.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(oe.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("next_attempt_at=")
+	builder.WriteString(oe.NextAttemptAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("claim_token=")
+	builder.WriteString(oe.ClaimToken)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(oe.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OutboxEvents is a parsable slice of OutboxEvent.
+type OutboxEvents []*OutboxEvent
@@ -0,0 +1,454 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/outboxevent"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
+)
+
+// OutboxEventUpdate is the builder for updating OutboxEvent entities.
+type OutboxEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeu *OutboxEventUpdate) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdate {
+	oeu.mutation.Where(ps...)
+	return oeu
+}
+
+// SetExchange sets the "exchange" field.
+func (oeu *OutboxEventUpdate) SetExchange(s string) *OutboxEventUpdate {
+	oeu.mutation.SetExchange(s)
+	return oeu
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableExchange(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetExchange(*s)
+	}
+	return oeu
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (oeu *OutboxEventUpdate) SetRoutingKey(s string) *OutboxEventUpdate {
+	oeu.mutation.SetRoutingKey(s)
+	return oeu
+}
+
+// SetNillableRoutingKey sets the "routing_key" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableRoutingKey(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetRoutingKey(*s)
+	}
+	return oeu
+}
+
+// SetPayload sets the "payload" field.
+func (oeu *OutboxEventUpdate) SetPayload(b []byte) *OutboxEventUpdate {
+	oeu.mutation.SetPayload(b)
+	return oeu
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeu *OutboxEventUpdate) SetAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.ResetAttempts()
+	oeu.mutation.SetAttempts(i)
+	return oeu
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableAttempts(i *int) *OutboxEventUpdate {
+	if i != nil {
+		oeu.SetAttempts(*i)
+	}
+	return oeu
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeu *OutboxEventUpdate) AddAttempts(i int) *OutboxEventUpdate {
+	oeu.mutation.AddAttempts(i)
+	return oeu
+}
+
+// SetLastError sets the "last_error" field.
+func (oeu *OutboxEventUpdate) SetLastError(s string) *OutboxEventUpdate {
+	oeu.mutation.SetLastError(s)
+	return oeu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableLastError(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetLastError(*s)
+	}
+	return oeu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (oeu *OutboxEventUpdate) ClearLastError() *OutboxEventUpdate {
+	oeu.mutation.ClearLastError()
+	return oeu
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeu *OutboxEventUpdate) SetNextAttemptAt(t time.Time) *OutboxEventUpdate {
+	oeu.mutation.SetNextAttemptAt(t)
+	return oeu
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableNextAttemptAt(t *time.Time) *OutboxEventUpdate {
+	if t != nil {
+		oeu.SetNextAttemptAt(*t)
+	}
+	return oeu
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (oeu *OutboxEventUpdate) SetClaimToken(s string) *OutboxEventUpdate {
+	oeu.mutation.SetClaimToken(s)
+	return oeu
+}
+
+// SetNillableClaimToken sets the "claim_token" field if the given value is not nil.
+func (oeu *OutboxEventUpdate) SetNillableClaimToken(s *string) *OutboxEventUpdate {
+	if s != nil {
+		oeu.SetClaimToken(*s)
+	}
+	return oeu
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (oeu *OutboxEventUpdate) ClearClaimToken() *OutboxEventUpdate {
+	oeu.mutation.ClearClaimToken()
+	return oeu
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeu *OutboxEventUpdate) Mutation() *OutboxEventMutation {
+	return oeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (oeu *OutboxEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, oeu.sqlSave, oeu.mutation, oeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := oeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (oeu *OutboxEventUpdate) Exec(ctx context.Context) error {
+	_, err := oeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeu *OutboxEventUpdate) ExecX(ctx context.Context) {
+	if err := oeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oeu *OutboxEventUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	if ps := oeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeu.mutation.Exchange(); ok {
+		_spec.SetField(outboxevent.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.RoutingKey(); ok {
+		_spec.SetField(outboxevent.FieldRoutingKey, field.TypeString, value)
+	}
+	if value, ok := oeu.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeu.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeu.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if oeu.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	if value, ok := oeu.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := oeu.mutation.ClaimToken(); ok {
+		_spec.SetField(outboxevent.FieldClaimToken, field.TypeString, value)
+	}
+	if oeu.mutation.ClaimTokenCleared() {
+		_spec.ClearField(outboxevent.FieldClaimToken, field.TypeString)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, oeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	oeu.mutation.done = true
+	return n, nil
+}
+
+// OutboxEventUpdateOne is the builder for updating a single OutboxEvent entity.
+type OutboxEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OutboxEventMutation
+}
+
+// SetExchange sets the "exchange" field.
+func (oeuo *OutboxEventUpdateOne) SetExchange(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetExchange(s)
+	return oeuo
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableExchange(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetExchange(*s)
+	}
+	return oeuo
+}
+
+// SetRoutingKey sets the "routing_key" field.
+func (oeuo *OutboxEventUpdateOne) SetRoutingKey(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetRoutingKey(s)
+	return oeuo
+}
+
+// SetNillableRoutingKey sets the "routing_key" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableRoutingKey(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetRoutingKey(*s)
+	}
+	return oeuo
+}
+
+// SetPayload sets the "payload" field.
+func (oeuo *OutboxEventUpdateOne) SetPayload(b []byte) *OutboxEventUpdateOne {
+	oeuo.mutation.SetPayload(b)
+	return oeuo
+}
+
+// SetAttempts sets the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) SetAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.ResetAttempts()
+	oeuo.mutation.SetAttempts(i)
+	return oeuo
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableAttempts(i *int) *OutboxEventUpdateOne {
+	if i != nil {
+		oeuo.SetAttempts(*i)
+	}
+	return oeuo
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (oeuo *OutboxEventUpdateOne) AddAttempts(i int) *OutboxEventUpdateOne {
+	oeuo.mutation.AddAttempts(i)
+	return oeuo
+}
+
+// SetLastError sets the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) SetLastError(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetLastError(s)
+	return oeuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableLastError(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetLastError(*s)
+	}
+	return oeuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (oeuo *OutboxEventUpdateOne) ClearLastError() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearLastError()
+	return oeuo
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (oeuo *OutboxEventUpdateOne) SetNextAttemptAt(t time.Time) *OutboxEventUpdateOne {
+	oeuo.mutation.SetNextAttemptAt(t)
+	return oeuo
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableNextAttemptAt(t *time.Time) *OutboxEventUpdateOne {
+	if t != nil {
+		oeuo.SetNextAttemptAt(*t)
+	}
+	return oeuo
+}
+
+// SetClaimToken sets the "claim_token" field.
+func (oeuo *OutboxEventUpdateOne) SetClaimToken(s string) *OutboxEventUpdateOne {
+	oeuo.mutation.SetClaimToken(s)
+	return oeuo
+}
+
+// SetNillableClaimToken sets the "claim_token" field if the given value is not nil.
+func (oeuo *OutboxEventUpdateOne) SetNillableClaimToken(s *string) *OutboxEventUpdateOne {
+	if s != nil {
+		oeuo.SetClaimToken(*s)
+	}
+	return oeuo
+}
+
+// ClearClaimToken clears the value of the "claim_token" field.
+func (oeuo *OutboxEventUpdateOne) ClearClaimToken() *OutboxEventUpdateOne {
+	oeuo.mutation.ClearClaimToken()
+	return oeuo
+}
+
+// Mutation returns the OutboxEventMutation object of the builder.
+func (oeuo *OutboxEventUpdateOne) Mutation() *OutboxEventMutation {
+	return oeuo.mutation
+}
+
+// Where appends a list predicates to the OutboxEventUpdate builder.
+func (oeuo *OutboxEventUpdateOne) Where(ps ...predicate.OutboxEvent) *OutboxEventUpdateOne {
+	oeuo.mutation.Where(ps...)
+	return oeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (oeuo *OutboxEventUpdateOne) Select(field string, fields ...string) *OutboxEventUpdateOne {
+	oeuo.fields = append([]string{field}, fields...)
+	return oeuo
+}
+
+// Save executes the query and returns the updated OutboxEvent entity.
+func (oeuo *OutboxEventUpdateOne) Save(ctx context.Context) (*OutboxEvent, error) {
+	return withHooks(ctx, oeuo.sqlSave, oeuo.mutation, oeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) SaveX(ctx context.Context) *OutboxEvent {
+	node, err := oeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (oeuo *OutboxEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := oeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oeuo *OutboxEventUpdateOne) ExecX(ctx context.Context) {
+	if err := oeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (oeuo *OutboxEventUpdateOne) sqlSave(ctx context.Context) (_node *OutboxEvent, err error) {
+	_spec := sqlgraph.NewUpdateSpec(outboxevent.Table, outboxevent.Columns, sqlgraph.NewFieldSpec(outboxevent.FieldID, field.TypeInt))
+	id, ok := oeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OutboxEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := oeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, outboxevent.FieldID)
+		for _, f := range fields {
+			if !outboxevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != outboxevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := oeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := oeuo.mutation.Exchange(); ok {
+		_spec.SetField(outboxevent.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.RoutingKey(); ok {
+		_spec.SetField(outboxevent.FieldRoutingKey, field.TypeString, value)
+	}
+	if value, ok := oeuo.mutation.Payload(); ok {
+		_spec.SetField(outboxevent.FieldPayload, field.TypeBytes, value)
+	}
+	if value, ok := oeuo.mutation.Attempts(); ok {
+		_spec.SetField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.AddedAttempts(); ok {
+		_spec.AddField(outboxevent.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := oeuo.mutation.LastError(); ok {
+		_spec.SetField(outboxevent.FieldLastError, field.TypeString, value)
+	}
+	if oeuo.mutation.LastErrorCleared() {
+		_spec.ClearField(outboxevent.FieldLastError, field.TypeString)
+	}
+	if value, ok := oeuo.mutation.NextAttemptAt(); ok {
+		_spec.SetField(outboxevent.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if value, ok := oeuo.mutation.ClaimToken(); ok {
+		_spec.SetField(outboxevent.FieldClaimToken, field.TypeString, value)
+	}
+	if oeuo.mutation.ClaimTokenCleared() {
+		_spec.ClearField(outboxevent.FieldClaimToken, field.TypeString)
+	}
+	_node = &OutboxEvent{config: oeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, oeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{outboxevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	oeuo.mutation.done = true
+	return _node, nil
+}
@@ -6,6 +6,12 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// Calibration is the predicate function for calibration builders.
+type Calibration func(*sql.Selector)
+
+// OutboxEvent is the predicate function for outboxevent builders.
+type OutboxEvent func(*sql.Selector)
+
 // Sensor is the predicate function for sensor builders.
 type Sensor func(*sql.Selector)
 
@@ -5,6 +5,8 @@ package ent
 import (
 	"time"
 
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/outboxevent"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/schema"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensorgroup"
@@ -15,6 +17,44 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	calibrationFields := schema.Calibration{}.Fields()
+	_ = calibrationFields
+	// calibrationDescOffset is the schema descriptor for offset field.
+	calibrationDescOffset := calibrationFields[2].Descriptor()
+	// calibration.DefaultOffset holds the default value on creation for the offset field.
+	calibration.DefaultOffset = calibrationDescOffset.Default.(float64)
+	// calibrationDescGain is the schema descriptor for gain field.
+	calibrationDescGain := calibrationFields[3].Descriptor()
+	// calibration.DefaultGain holds the default value on creation for the gain field.
+	calibration.DefaultGain = calibrationDescGain.Default.(float64)
+	// calibrationDescCreatedAt is the schema descriptor for created_at field.
+	calibrationDescCreatedAt := calibrationFields[8].Descriptor()
+	// calibration.DefaultCreatedAt holds the default value on creation for the created_at field.
+	calibration.DefaultCreatedAt = calibrationDescCreatedAt.Default.(func() time.Time)
+	// calibrationDescUpdatedAt is the schema descriptor for updated_at field.
+	calibrationDescUpdatedAt := calibrationFields[9].Descriptor()
+	// calibration.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	calibration.DefaultUpdatedAt = calibrationDescUpdatedAt.Default.(func() time.Time)
+	// calibration.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	calibration.UpdateDefaultUpdatedAt = calibrationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	outboxeventFields := schema.OutboxEvent{}.Fields()
+	_ = outboxeventFields
+	// outboxeventDescRoutingKey is the schema descriptor for routing_key field.
+	outboxeventDescRoutingKey := outboxeventFields[1].Descriptor()
+	// outboxevent.DefaultRoutingKey holds the default value on creation for the routing_key field.
+	outboxevent.DefaultRoutingKey = outboxeventDescRoutingKey.Default.(string)
+	// outboxeventDescAttempts is the schema descriptor for attempts field.
+	outboxeventDescAttempts := outboxeventFields[3].Descriptor()
+	// outboxevent.DefaultAttempts holds the default value on creation for the attempts field.
+	outboxevent.DefaultAttempts = outboxeventDescAttempts.Default.(int)
+	// outboxeventDescNextAttemptAt is the schema descriptor for next_attempt_at field.
+	outboxeventDescNextAttemptAt := outboxeventFields[5].Descriptor()
+	// outboxevent.DefaultNextAttemptAt holds the default value on creation for the next_attempt_at field.
+	outboxevent.DefaultNextAttemptAt = outboxeventDescNextAttemptAt.Default.(func() time.Time)
+	// outboxeventDescCreatedAt is the schema descriptor for created_at field.
+	outboxeventDescCreatedAt := outboxeventFields[7].Descriptor()
+	// outboxevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	outboxevent.DefaultCreatedAt = outboxeventDescCreatedAt.Default.(func() time.Time)
 	sensorFields := schema.Sensor{}.Fields()
 	_ = sensorFields
 	// sensorDescName is the schema descriptor for name field.
@@ -25,12 +65,16 @@ func init() {
 	sensorDescActive := sensorFields[3].Descriptor()
 	// sensor.DefaultActive holds the default value on creation for the active field.
 	sensor.DefaultActive = sensorDescActive.Default.(bool)
+	// sensorDescKind is the schema descriptor for kind field.
+	sensorDescKind := sensorFields[6].Descriptor()
+	// sensor.DefaultKind holds the default value on creation for the kind field.
+	sensor.DefaultKind = sensorDescKind.Default.(string)
 	// sensorDescCreatedAt is the schema descriptor for created_at field.
-	sensorDescCreatedAt := sensorFields[6].Descriptor()
+	sensorDescCreatedAt := sensorFields[8].Descriptor()
 	// sensor.DefaultCreatedAt holds the default value on creation for the created_at field.
 	sensor.DefaultCreatedAt = sensorDescCreatedAt.Default.(func() time.Time)
 	// sensorDescUpdatedAt is the schema descriptor for updated_at field.
-	sensorDescUpdatedAt := sensorFields[7].Descriptor()
+	sensorDescUpdatedAt := sensorFields[9].Descriptor()
 	// sensor.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	sensor.DefaultUpdatedAt = sensorDescUpdatedAt.Default.(func() time.Time)
 	// sensor.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -65,8 +109,12 @@ func init() {
 	sensortypeDescModel := sensortypeFields[1].Descriptor()
 	// sensortype.ModelValidator is a validator for the "model" field. It is called by the builders before save.
 	sensortype.ModelValidator = sensortypeDescModel.Validators[0].(func(string) error)
+	// sensortypeDescOutOfRangePolicy is the schema descriptor for out_of_range_policy field.
+	sensortypeDescOutOfRangePolicy := sensortypeFields[7].Descriptor()
+	// sensortype.DefaultOutOfRangePolicy holds the default value on creation for the out_of_range_policy field.
+	sensortype.DefaultOutOfRangePolicy = sensortypeDescOutOfRangePolicy.Default.(string)
 	// sensortypeDescCreatedAt is the schema descriptor for created_at field.
-	sensortypeDescCreatedAt := sensortypeFields[7].Descriptor()
+	sensortypeDescCreatedAt := sensortypeFields[9].Descriptor()
 	// sensortype.DefaultCreatedAt holds the default value on creation for the created_at field.
 	sensortype.DefaultCreatedAt = sensortypeDescCreatedAt.Default.(func() time.Time)
 }
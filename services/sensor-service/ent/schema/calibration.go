@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Calibration holds the schema definition for the Calibration entity.
+type Calibration struct {
+	ent.Schema
+}
+
+// Fields of the Calibration. A raw value x is calibrated as
+// gain * (c0 + c1*x + c2*x^2 + ...) + offset, where c are the polynomial
+// coefficients; without coefficients the polynomial is x itself.
+func (Calibration) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("sensor_id").
+			Immutable().
+			Comment("ID of the calibrated sensor"),
+		field.String("channel").
+			Optional().
+			Comment("Channel the calibration applies to; empty for the reading value"),
+		field.Float("offset").
+			Default(0),
+		field.Float("gain").
+			Default(1),
+		field.JSON("coefficients", []float64{}).
+			Optional().
+			Comment("Polynomial coefficients in ascending order of degree"),
+		field.Time("valid_from").
+			Comment("Start of the validity period, inclusive"),
+		field.Time("valid_to").
+			Optional().
+			Nillable().
+			Comment("End of the validity period, exclusive; open-ended when unset"),
+		field.String("certificate").
+			Optional().
+			Comment("Reference of the calibration certificate"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Calibration.
+func (Calibration) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("sensor", Sensor.Type).
+			Ref("calibrations").
+			Field("sensor_id").
+			Unique().
+			Required().
+			Immutable().
+			Comment("The calibrated sensor"),
+	}
+}
+
+// Indexes of the Calibration.
+func (Calibration) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("sensor_id", "valid_from"),
+	}
+}
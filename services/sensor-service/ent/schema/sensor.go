@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 )
@@ -33,6 +34,13 @@ func (Sensor) Fields() []ent.Field {
 			Comment("Last time the sensor value was updated"),
 		field.Int64("user_id").
 			Comment("ID of the user who owns the sensor"),
+		field.String("kind").
+			Default("physical").
+			Immutable().
+			Comment("physical for sensors that report readings, virtual for sensors computed from an expression"),
+		field.String("expression").
+			Optional().
+			Comment("Expression over other sensors that computes the readings of a virtual sensor"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -53,5 +61,8 @@ func (Sensor) Edges() []ent.Edge {
 		edge.From("groups", SensorGroup.Type).
 			Ref("sensors").
 			Comment("Groups this sensor belongs to"),
+		edge.To("calibrations", Calibration.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)).
+			Comment("Calibrations applied to the readings of this sensor"),
 	}
 }
@@ -32,10 +32,25 @@ func (SensorType) Fields() []ent.Field {
 		field.String("unit").Optional(),
 		field.Float("min_value").Optional(),
 		field.Float("max_value").Optional(),
+		field.String("out_of_range_policy").
+			Default("flag").
+			Comment("What ingestion does with values outside min/max: flag, clamp or reject"),
+		field.JSON("channels", []Channel{}).
+			Optional().
+			Comment("Named values reported in every sample; the first one is the primary channel"),
 		field.Time("created_at").Default(time.Now),
 	}
 }
 
+// Channel is one named value of a multi-channel sensor type. A range with
+// MinValue >= MaxValue is not enforced.
+type Channel struct {
+	Name     string  `json:"name"`
+	Unit     string  `json:"unit,omitempty"`
+	MinValue float64 `json:"min_value"`
+	MaxValue float64 `json:"max_value"`
+}
+
 // Edges of the SensorType.
 func (SensorType) Edges() []ent.Edge {
 	return []ent.Edge{
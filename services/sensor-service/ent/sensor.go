@@ -30,6 +30,10 @@ type Sensor struct {
 	LastUpdated time.Time `json:"last_updated,omitempty"`
 	// ID of the user who owns the sensor
 	UserID int64 `json:"user_id,omitempty"`
+	// physical for sensors that report readings, virtual for sensors computed from an expression
+	Kind string `json:"kind,omitempty"`
+	// Expression over other sensors that computes the readings of a virtual sensor
+	Expression string `json:"expression,omitempty"`
 	// Time when the sensor was created
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -48,9 +52,11 @@ type SensorEdges struct {
 	Type *SensorType `json:"type,omitempty"`
 	// Groups this sensor belongs to
 	Groups []*SensorGroup `json:"groups,omitempty"`
+	// Calibrations applied to the readings of this sensor
+	Calibrations []*Calibration `json:"calibrations,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // TypeOrErr returns the Type value or an error if the edge
@@ -73,6 +79,15 @@ func (e SensorEdges) GroupsOrErr() ([]*SensorGroup, error) {
 	return nil, &NotLoadedError{edge: "groups"}
 }
 
+// CalibrationsOrErr returns the Calibrations value or an error if the edge
+// was not loaded in eager-loading.
+func (e SensorEdges) CalibrationsOrErr() ([]*Calibration, error) {
+	if e.loadedTypes[2] {
+		return e.Calibrations, nil
+	}
+	return nil, &NotLoadedError{edge: "calibrations"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Sensor) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -82,7 +97,7 @@ func (*Sensor) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullBool)
 		case sensor.FieldID, sensor.FieldUserID:
 			values[i] = new(sql.NullInt64)
-		case sensor.FieldName, sensor.FieldLocation, sensor.FieldDescription:
+		case sensor.FieldName, sensor.FieldLocation, sensor.FieldDescription, sensor.FieldKind, sensor.FieldExpression:
 			values[i] = new(sql.NullString)
 		case sensor.FieldLastUpdated, sensor.FieldCreatedAt, sensor.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -147,6 +162,18 @@ func (s *Sensor) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				s.UserID = value.Int64
 			}
+		case sensor.FieldKind:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field kind", values[i])
+			} else if value.Valid {
+				s.Kind = value.String
+			}
+		case sensor.FieldExpression:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field expression", values[i])
+			} else if value.Valid {
+				s.Expression = value.String
+			}
 		case sensor.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -196,6 +223,11 @@ func (s *Sensor) QueryGroups() *SensorGroupQuery {
 	return NewSensorClient(s.config).QueryGroups(s)
 }
 
+// QueryCalibrations queries the "calibrations" edge of the Sensor entity.
+func (s *Sensor) QueryCalibrations() *CalibrationQuery {
+	return NewSensorClient(s.config).QueryCalibrations(s)
+}
+
 // Update returns a builder for updating this Sensor.
 // Note that you need to call Sensor.Unwrap() before calling this method if this Sensor
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -237,6 +269,12 @@ func (s *Sensor) String() string {
 	builder.WriteString("user_id=")
 	builder.WriteString(fmt.Sprintf("%v", s.UserID))
 	builder.WriteString(", ")
+	builder.WriteString("kind=")
+	builder.WriteString(s.Kind)
+	builder.WriteString(", ")
+	builder.WriteString("expression=")
+	builder.WriteString(s.Expression)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -26,6 +26,10 @@ const (
 	FieldLastUpdated = "last_updated"
 	// FieldUserID holds the string denoting the user_id field in the database.
 	FieldUserID = "user_id"
+	// FieldKind holds the string denoting the kind field in the database.
+	FieldKind = "kind"
+	// FieldExpression holds the string denoting the expression field in the database.
+	FieldExpression = "expression"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -34,6 +38,8 @@ const (
 	EdgeType = "type"
 	// EdgeGroups holds the string denoting the groups edge name in mutations.
 	EdgeGroups = "groups"
+	// EdgeCalibrations holds the string denoting the calibrations edge name in mutations.
+	EdgeCalibrations = "calibrations"
 	// Table holds the table name of the sensor in the database.
 	Table = "sensors"
 	// TypeTable is the table that holds the type relation/edge.
@@ -48,6 +54,13 @@ const (
 	// GroupsInverseTable is the table name for the SensorGroup entity.
 	// It exists in this package in order to avoid circular dependency with the "sensorgroup" package.
 	GroupsInverseTable = "sensor_groups"
+	// CalibrationsTable is the table that holds the calibrations relation/edge.
+	CalibrationsTable = "calibrations"
+	// CalibrationsInverseTable is the table name for the Calibration entity.
+	// It exists in this package in order to avoid circular dependency with the "calibration" package.
+	CalibrationsInverseTable = "calibrations"
+	// CalibrationsColumn is the table column denoting the calibrations relation/edge.
+	CalibrationsColumn = "sensor_id"
 )
 
 // Columns holds all SQL columns for sensor fields.
@@ -59,6 +72,8 @@ var Columns = []string{
 	FieldActive,
 	FieldLastUpdated,
 	FieldUserID,
+	FieldKind,
+	FieldExpression,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -96,6 +111,8 @@ var (
 	NameValidator func(string) error
 	// DefaultActive holds the default value on creation for the "active" field.
 	DefaultActive bool
+	// DefaultKind holds the default value on creation for the "kind" field.
+	DefaultKind string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -142,6 +159,16 @@ func ByUserID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldUserID, opts...).ToFunc()
 }
 
+// ByKind orders the results by the kind field.
+func ByKind(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKind, opts...).ToFunc()
+}
+
+// ByExpression orders the results by the expression field.
+func ByExpression(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExpression, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -172,6 +199,20 @@ func ByGroups(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newGroupsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByCalibrationsCount orders the results by calibrations count.
+func ByCalibrationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newCalibrationsStep(), opts...)
+	}
+}
+
+// ByCalibrations orders the results by calibrations terms.
+func ByCalibrations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCalibrationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newTypeStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -186,3 +227,10 @@ func newGroupsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2M, true, GroupsTable, GroupsPrimaryKey...),
 	)
 }
+func newCalibrationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CalibrationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, CalibrationsTable, CalibrationsColumn),
+	)
+}
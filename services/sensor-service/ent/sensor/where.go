@@ -85,6 +85,16 @@ func UserID(v int64) predicate.Sensor {
 	return predicate.Sensor(sql.FieldEQ(FieldUserID, v))
 }
 
+// Kind applies equality check predicate on the "kind" field. It's identical to KindEQ.
+func Kind(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldEQ(FieldKind, v))
+}
+
+// Expression applies equality check predicate on the "expression" field. It's identical to ExpressionEQ.
+func Expression(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldEQ(FieldExpression, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Sensor {
 	return predicate.Sensor(sql.FieldEQ(FieldCreatedAt, v))
@@ -410,6 +420,146 @@ func UserIDLTE(v int64) predicate.Sensor {
 	return predicate.Sensor(sql.FieldLTE(FieldUserID, v))
 }
 
+// KindEQ applies the EQ predicate on the "kind" field.
+func KindEQ(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldEQ(FieldKind, v))
+}
+
+// KindNEQ applies the NEQ predicate on the "kind" field.
+func KindNEQ(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldNEQ(FieldKind, v))
+}
+
+// KindIn applies the In predicate on the "kind" field.
+func KindIn(vs ...string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldIn(FieldKind, vs...))
+}
+
+// KindNotIn applies the NotIn predicate on the "kind" field.
+func KindNotIn(vs ...string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldNotIn(FieldKind, vs...))
+}
+
+// KindGT applies the GT predicate on the "kind" field.
+func KindGT(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldGT(FieldKind, v))
+}
+
+// KindGTE applies the GTE predicate on the "kind" field.
+func KindGTE(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldGTE(FieldKind, v))
+}
+
+// KindLT applies the LT predicate on the "kind" field.
+func KindLT(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldLT(FieldKind, v))
+}
+
+// KindLTE applies the LTE predicate on the "kind" field.
+func KindLTE(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldLTE(FieldKind, v))
+}
+
+// KindContains applies the Contains predicate on the "kind" field.
+func KindContains(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldContains(FieldKind, v))
+}
+
+// KindHasPrefix applies the HasPrefix predicate on the "kind" field.
+func KindHasPrefix(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldHasPrefix(FieldKind, v))
+}
+
+// KindHasSuffix applies the HasSuffix predicate on the "kind" field.
+func KindHasSuffix(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldHasSuffix(FieldKind, v))
+}
+
+// KindEqualFold applies the EqualFold predicate on the "kind" field.
+func KindEqualFold(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldEqualFold(FieldKind, v))
+}
+
+// KindContainsFold applies the ContainsFold predicate on the "kind" field.
+func KindContainsFold(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldContainsFold(FieldKind, v))
+}
+
+// ExpressionEQ applies the EQ predicate on the "expression" field.
+func ExpressionEQ(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldEQ(FieldExpression, v))
+}
+
+// ExpressionNEQ applies the NEQ predicate on the "expression" field.
+func ExpressionNEQ(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldNEQ(FieldExpression, v))
+}
+
+// ExpressionIn applies the In predicate on the "expression" field.
+func ExpressionIn(vs ...string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldIn(FieldExpression, vs...))
+}
+
+// ExpressionNotIn applies the NotIn predicate on the "expression" field.
+func ExpressionNotIn(vs ...string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldNotIn(FieldExpression, vs...))
+}
+
+// ExpressionGT applies the GT predicate on the "expression" field.
+func ExpressionGT(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldGT(FieldExpression, v))
+}
+
+// ExpressionGTE applies the GTE predicate on the "expression" field.
+func ExpressionGTE(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldGTE(FieldExpression, v))
+}
+
+// ExpressionLT applies the LT predicate on the "expression" field.
+func ExpressionLT(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldLT(FieldExpression, v))
+}
+
+// ExpressionLTE applies the LTE predicate on the "expression" field.
+func ExpressionLTE(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldLTE(FieldExpression, v))
+}
+
+// ExpressionContains applies the Contains predicate on the "expression" field.
+func ExpressionContains(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldContains(FieldExpression, v))
+}
+
+// ExpressionHasPrefix applies the HasPrefix predicate on the "expression" field.
+func ExpressionHasPrefix(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldHasPrefix(FieldExpression, v))
+}
+
+// ExpressionHasSuffix applies the HasSuffix predicate on the "expression" field.
+func ExpressionHasSuffix(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldHasSuffix(FieldExpression, v))
+}
+
+// ExpressionIsNil applies the IsNil predicate on the "expression" field.
+func ExpressionIsNil() predicate.Sensor {
+	return predicate.Sensor(sql.FieldIsNull(FieldExpression))
+}
+
+// ExpressionNotNil applies the NotNil predicate on the "expression" field.
+func ExpressionNotNil() predicate.Sensor {
+	return predicate.Sensor(sql.FieldNotNull(FieldExpression))
+}
+
+// ExpressionEqualFold applies the EqualFold predicate on the "expression" field.
+func ExpressionEqualFold(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldEqualFold(FieldExpression, v))
+}
+
+// ExpressionContainsFold applies the ContainsFold predicate on the "expression" field.
+func ExpressionContainsFold(v string) predicate.Sensor {
+	return predicate.Sensor(sql.FieldContainsFold(FieldExpression, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Sensor {
 	return predicate.Sensor(sql.FieldEQ(FieldCreatedAt, v))
@@ -536,6 +686,29 @@ func HasGroupsWith(preds ...predicate.SensorGroup) predicate.Sensor {
 	})
 }
 
+// HasCalibrations applies the HasEdge predicate on the "calibrations" edge.
+func HasCalibrations() predicate.Sensor {
+	return predicate.Sensor(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, CalibrationsTable, CalibrationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasCalibrationsWith applies the HasEdge predicate on the "calibrations" edge with a given conditions (other predicates).
+func HasCalibrationsWith(preds ...predicate.Calibration) predicate.Sensor {
+	return predicate.Sensor(func(s *sql.Selector) {
+		step := newCalibrationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Sensor) predicate.Sensor {
 	return predicate.Sensor(sql.AndPredicates(predicates...))
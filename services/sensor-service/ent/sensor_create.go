@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensorgroup"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
@@ -90,6 +91,34 @@ func (sc *SensorCreate) SetUserID(i int64) *SensorCreate {
 	return sc
 }
 
+// SetKind sets the "kind" field.
+func (sc *SensorCreate) SetKind(s string) *SensorCreate {
+	sc.mutation.SetKind(s)
+	return sc
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (sc *SensorCreate) SetNillableKind(s *string) *SensorCreate {
+	if s != nil {
+		sc.SetKind(*s)
+	}
+	return sc
+}
+
+// SetExpression sets the "expression" field.
+func (sc *SensorCreate) SetExpression(s string) *SensorCreate {
+	sc.mutation.SetExpression(s)
+	return sc
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (sc *SensorCreate) SetNillableExpression(s *string) *SensorCreate {
+	if s != nil {
+		sc.SetExpression(*s)
+	}
+	return sc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (sc *SensorCreate) SetCreatedAt(t time.Time) *SensorCreate {
 	sc.mutation.SetCreatedAt(t)
@@ -144,6 +173,21 @@ func (sc *SensorCreate) AddGroups(s ...*SensorGroup) *SensorCreate {
 	return sc.AddGroupIDs(ids...)
 }
 
+// AddCalibrationIDs adds the "calibrations" edge to the Calibration entity by IDs.
+func (sc *SensorCreate) AddCalibrationIDs(ids ...int) *SensorCreate {
+	sc.mutation.AddCalibrationIDs(ids...)
+	return sc
+}
+
+// AddCalibrations adds the "calibrations" edges to the Calibration entity.
+func (sc *SensorCreate) AddCalibrations(c ...*Calibration) *SensorCreate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return sc.AddCalibrationIDs(ids...)
+}
+
 // Mutation returns the SensorMutation object of the builder.
 func (sc *SensorCreate) Mutation() *SensorMutation {
 	return sc.mutation
@@ -183,6 +227,10 @@ func (sc *SensorCreate) defaults() {
 		v := sensor.DefaultActive
 		sc.mutation.SetActive(v)
 	}
+	if _, ok := sc.mutation.Kind(); !ok {
+		v := sensor.DefaultKind
+		sc.mutation.SetKind(v)
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		v := sensor.DefaultCreatedAt()
 		sc.mutation.SetCreatedAt(v)
@@ -209,6 +257,9 @@ func (sc *SensorCreate) check() error {
 	if _, ok := sc.mutation.UserID(); !ok {
 		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Sensor.user_id"`)}
 	}
+	if _, ok := sc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Sensor.kind"`)}
+	}
 	if _, ok := sc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Sensor.created_at"`)}
 	}
@@ -268,6 +319,14 @@ func (sc *SensorCreate) createSpec() (*Sensor, *sqlgraph.CreateSpec) {
 		_spec.SetField(sensor.FieldUserID, field.TypeInt64, value)
 		_node.UserID = value
 	}
+	if value, ok := sc.mutation.Kind(); ok {
+		_spec.SetField(sensor.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := sc.mutation.Expression(); ok {
+		_spec.SetField(sensor.FieldExpression, field.TypeString, value)
+		_node.Expression = value
+	}
 	if value, ok := sc.mutation.CreatedAt(); ok {
 		_spec.SetField(sensor.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -309,6 +368,22 @@ func (sc *SensorCreate) createSpec() (*Sensor, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := sc.mutation.CalibrationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sensor.CalibrationsTable,
+			Columns: []string{sensor.CalibrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
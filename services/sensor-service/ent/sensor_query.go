@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensorgroup"
@@ -21,13 +22,14 @@ import (
 // SensorQuery is the builder for querying Sensor entities.
 type SensorQuery struct {
 	config
-	ctx        *QueryContext
-	order      []sensor.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Sensor
-	withType   *SensorTypeQuery
-	withGroups *SensorGroupQuery
-	withFKs    bool
+	ctx              *QueryContext
+	order            []sensor.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Sensor
+	withType         *SensorTypeQuery
+	withGroups       *SensorGroupQuery
+	withCalibrations *CalibrationQuery
+	withFKs          bool
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -108,6 +110,28 @@ func (sq *SensorQuery) QueryGroups() *SensorGroupQuery {
 	return query
 }
 
+// QueryCalibrations chains the current query on the "calibrations" edge.
+func (sq *SensorQuery) QueryCalibrations() *CalibrationQuery {
+	query := (&CalibrationClient{config: sq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := sq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := sq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(sensor.Table, sensor.FieldID, selector),
+			sqlgraph.To(calibration.Table, calibration.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, sensor.CalibrationsTable, sensor.CalibrationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(sq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Sensor entity from the query.
 // Returns a *NotFoundError when no Sensor was found.
 func (sq *SensorQuery) First(ctx context.Context) (*Sensor, error) {
@@ -295,13 +319,14 @@ func (sq *SensorQuery) Clone() *SensorQuery {
 		return nil
 	}
 	return &SensorQuery{
-		config:     sq.config,
-		ctx:        sq.ctx.Clone(),
-		order:      append([]sensor.OrderOption{}, sq.order...),
-		inters:     append([]Interceptor{}, sq.inters...),
-		predicates: append([]predicate.Sensor{}, sq.predicates...),
-		withType:   sq.withType.Clone(),
-		withGroups: sq.withGroups.Clone(),
+		config:           sq.config,
+		ctx:              sq.ctx.Clone(),
+		order:            append([]sensor.OrderOption{}, sq.order...),
+		inters:           append([]Interceptor{}, sq.inters...),
+		predicates:       append([]predicate.Sensor{}, sq.predicates...),
+		withType:         sq.withType.Clone(),
+		withGroups:       sq.withGroups.Clone(),
+		withCalibrations: sq.withCalibrations.Clone(),
 		// clone intermediate query.
 		sql:  sq.sql.Clone(),
 		path: sq.path,
@@ -330,6 +355,17 @@ func (sq *SensorQuery) WithGroups(opts ...func(*SensorGroupQuery)) *SensorQuery
 	return sq
 }
 
+// WithCalibrations tells the query-builder to eager-load the nodes that are connected to
+// the "calibrations" edge. The optional arguments are used to configure the query builder of the edge.
+func (sq *SensorQuery) WithCalibrations(opts ...func(*CalibrationQuery)) *SensorQuery {
+	query := (&CalibrationClient{config: sq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	sq.withCalibrations = query
+	return sq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -409,9 +445,10 @@ func (sq *SensorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Senso
 		nodes       = []*Sensor{}
 		withFKs     = sq.withFKs
 		_spec       = sq.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			sq.withType != nil,
 			sq.withGroups != nil,
+			sq.withCalibrations != nil,
 		}
 	)
 	if sq.withType != nil {
@@ -451,6 +488,13 @@ func (sq *SensorQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Senso
 			return nil, err
 		}
 	}
+	if query := sq.withCalibrations; query != nil {
+		if err := sq.loadCalibrations(ctx, query, nodes,
+			func(n *Sensor) { n.Edges.Calibrations = []*Calibration{} },
+			func(n *Sensor, e *Calibration) { n.Edges.Calibrations = append(n.Edges.Calibrations, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -547,6 +591,36 @@ func (sq *SensorQuery) loadGroups(ctx context.Context, query *SensorGroupQuery,
 	}
 	return nil
 }
+func (sq *SensorQuery) loadCalibrations(ctx context.Context, query *CalibrationQuery, nodes []*Sensor, init func(*Sensor), assign func(*Sensor, *Calibration)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*Sensor)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(calibration.FieldSensorID)
+	}
+	query.Where(predicate.Calibration(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(sensor.CalibrationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.SensorID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "sensor_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (sq *SensorQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := sq.querySpec()
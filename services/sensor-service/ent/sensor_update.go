@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensorgroup"
@@ -139,6 +140,26 @@ func (su *SensorUpdate) AddUserID(i int64) *SensorUpdate {
 	return su
 }
 
+// SetExpression sets the "expression" field.
+func (su *SensorUpdate) SetExpression(s string) *SensorUpdate {
+	su.mutation.SetExpression(s)
+	return su
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (su *SensorUpdate) SetNillableExpression(s *string) *SensorUpdate {
+	if s != nil {
+		su.SetExpression(*s)
+	}
+	return su
+}
+
+// ClearExpression clears the value of the "expression" field.
+func (su *SensorUpdate) ClearExpression() *SensorUpdate {
+	su.mutation.ClearExpression()
+	return su
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (su *SensorUpdate) SetUpdatedAt(t time.Time) *SensorUpdate {
 	su.mutation.SetUpdatedAt(t)
@@ -171,6 +192,21 @@ func (su *SensorUpdate) AddGroups(s ...*SensorGroup) *SensorUpdate {
 	return su.AddGroupIDs(ids...)
 }
 
+// AddCalibrationIDs adds the "calibrations" edge to the Calibration entity by IDs.
+func (su *SensorUpdate) AddCalibrationIDs(ids ...int) *SensorUpdate {
+	su.mutation.AddCalibrationIDs(ids...)
+	return su
+}
+
+// AddCalibrations adds the "calibrations" edges to the Calibration entity.
+func (su *SensorUpdate) AddCalibrations(c ...*Calibration) *SensorUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return su.AddCalibrationIDs(ids...)
+}
+
 // Mutation returns the SensorMutation object of the builder.
 func (su *SensorUpdate) Mutation() *SensorMutation {
 	return su.mutation
@@ -203,6 +239,27 @@ func (su *SensorUpdate) RemoveGroups(s ...*SensorGroup) *SensorUpdate {
 	return su.RemoveGroupIDs(ids...)
 }
 
+// ClearCalibrations clears all "calibrations" edges to the Calibration entity.
+func (su *SensorUpdate) ClearCalibrations() *SensorUpdate {
+	su.mutation.ClearCalibrations()
+	return su
+}
+
+// RemoveCalibrationIDs removes the "calibrations" edge to Calibration entities by IDs.
+func (su *SensorUpdate) RemoveCalibrationIDs(ids ...int) *SensorUpdate {
+	su.mutation.RemoveCalibrationIDs(ids...)
+	return su
+}
+
+// RemoveCalibrations removes "calibrations" edges to Calibration entities.
+func (su *SensorUpdate) RemoveCalibrations(c ...*Calibration) *SensorUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return su.RemoveCalibrationIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (su *SensorUpdate) Save(ctx context.Context) (int, error) {
 	su.defaults()
@@ -294,6 +351,12 @@ func (su *SensorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := su.mutation.AddedUserID(); ok {
 		_spec.AddField(sensor.FieldUserID, field.TypeInt64, value)
 	}
+	if value, ok := su.mutation.Expression(); ok {
+		_spec.SetField(sensor.FieldExpression, field.TypeString, value)
+	}
+	if su.mutation.ExpressionCleared() {
+		_spec.ClearField(sensor.FieldExpression, field.TypeString)
+	}
 	if value, ok := su.mutation.UpdatedAt(); ok {
 		_spec.SetField(sensor.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -371,6 +434,51 @@ func (su *SensorUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if su.mutation.CalibrationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sensor.CalibrationsTable,
+			Columns: []string{sensor.CalibrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.RemovedCalibrationsIDs(); len(nodes) > 0 && !su.mutation.CalibrationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sensor.CalibrationsTable,
+			Columns: []string{sensor.CalibrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := su.mutation.CalibrationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sensor.CalibrationsTable,
+			Columns: []string{sensor.CalibrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, su.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{sensor.Label}
@@ -500,6 +608,26 @@ func (suo *SensorUpdateOne) AddUserID(i int64) *SensorUpdateOne {
 	return suo
 }
 
+// SetExpression sets the "expression" field.
+func (suo *SensorUpdateOne) SetExpression(s string) *SensorUpdateOne {
+	suo.mutation.SetExpression(s)
+	return suo
+}
+
+// SetNillableExpression sets the "expression" field if the given value is not nil.
+func (suo *SensorUpdateOne) SetNillableExpression(s *string) *SensorUpdateOne {
+	if s != nil {
+		suo.SetExpression(*s)
+	}
+	return suo
+}
+
+// ClearExpression clears the value of the "expression" field.
+func (suo *SensorUpdateOne) ClearExpression() *SensorUpdateOne {
+	suo.mutation.ClearExpression()
+	return suo
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (suo *SensorUpdateOne) SetUpdatedAt(t time.Time) *SensorUpdateOne {
 	suo.mutation.SetUpdatedAt(t)
@@ -532,6 +660,21 @@ func (suo *SensorUpdateOne) AddGroups(s ...*SensorGroup) *SensorUpdateOne {
 	return suo.AddGroupIDs(ids...)
 }
 
+// AddCalibrationIDs adds the "calibrations" edge to the Calibration entity by IDs.
+func (suo *SensorUpdateOne) AddCalibrationIDs(ids ...int) *SensorUpdateOne {
+	suo.mutation.AddCalibrationIDs(ids...)
+	return suo
+}
+
+// AddCalibrations adds the "calibrations" edges to the Calibration entity.
+func (suo *SensorUpdateOne) AddCalibrations(c ...*Calibration) *SensorUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return suo.AddCalibrationIDs(ids...)
+}
+
 // Mutation returns the SensorMutation object of the builder.
 func (suo *SensorUpdateOne) Mutation() *SensorMutation {
 	return suo.mutation
@@ -564,6 +707,27 @@ func (suo *SensorUpdateOne) RemoveGroups(s ...*SensorGroup) *SensorUpdateOne {
 	return suo.RemoveGroupIDs(ids...)
 }
 
+// ClearCalibrations clears all "calibrations" edges to the Calibration entity.
+func (suo *SensorUpdateOne) ClearCalibrations() *SensorUpdateOne {
+	suo.mutation.ClearCalibrations()
+	return suo
+}
+
+// RemoveCalibrationIDs removes the "calibrations" edge to Calibration entities by IDs.
+func (suo *SensorUpdateOne) RemoveCalibrationIDs(ids ...int) *SensorUpdateOne {
+	suo.mutation.RemoveCalibrationIDs(ids...)
+	return suo
+}
+
+// RemoveCalibrations removes "calibrations" edges to Calibration entities.
+func (suo *SensorUpdateOne) RemoveCalibrations(c ...*Calibration) *SensorUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return suo.RemoveCalibrationIDs(ids...)
+}
+
 // Where appends a list predicates to the SensorUpdate builder.
 func (suo *SensorUpdateOne) Where(ps ...predicate.Sensor) *SensorUpdateOne {
 	suo.mutation.Where(ps...)
@@ -685,6 +849,12 @@ func (suo *SensorUpdateOne) sqlSave(ctx context.Context) (_node *Sensor, err err
 	if value, ok := suo.mutation.AddedUserID(); ok {
 		_spec.AddField(sensor.FieldUserID, field.TypeInt64, value)
 	}
+	if value, ok := suo.mutation.Expression(); ok {
+		_spec.SetField(sensor.FieldExpression, field.TypeString, value)
+	}
+	if suo.mutation.ExpressionCleared() {
+		_spec.ClearField(sensor.FieldExpression, field.TypeString)
+	}
 	if value, ok := suo.mutation.UpdatedAt(); ok {
 		_spec.SetField(sensor.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -762,6 +932,51 @@ func (suo *SensorUpdateOne) sqlSave(ctx context.Context) (_node *Sensor, err err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if suo.mutation.CalibrationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sensor.CalibrationsTable,
+			Columns: []string{sensor.CalibrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.RemovedCalibrationsIDs(); len(nodes) > 0 && !suo.mutation.CalibrationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sensor.CalibrationsTable,
+			Columns: []string{sensor.CalibrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := suo.mutation.CalibrationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   sensor.CalibrationsTable,
+			Columns: []string{sensor.CalibrationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(calibration.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Sensor{config: suo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
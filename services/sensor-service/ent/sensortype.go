@@ -3,12 +3,14 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/schema"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
 )
 
@@ -31,6 +33,10 @@ type SensorType struct {
 	MinValue float64 `json:"min_value,omitempty"`
 	// MaxValue holds the value of the "max_value" field.
 	MaxValue float64 `json:"max_value,omitempty"`
+	// What ingestion does with values outside min/max: flag, clamp or reject
+	OutOfRangePolicy string `json:"out_of_range_policy,omitempty"`
+	// Named values reported in every sample; the first one is the primary channel
+	Channels []schema.Channel `json:"channels,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -62,11 +68,13 @@ func (*SensorType) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case sensortype.FieldChannels:
+			values[i] = new([]byte)
 		case sensortype.FieldMinValue, sensortype.FieldMaxValue:
 			values[i] = new(sql.NullFloat64)
 		case sensortype.FieldID:
 			values[i] = new(sql.NullInt64)
-		case sensortype.FieldName, sensortype.FieldModel, sensortype.FieldManufacturer, sensortype.FieldDescription, sensortype.FieldUnit:
+		case sensortype.FieldName, sensortype.FieldModel, sensortype.FieldManufacturer, sensortype.FieldDescription, sensortype.FieldUnit, sensortype.FieldOutOfRangePolicy:
 			values[i] = new(sql.NullString)
 		case sensortype.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
@@ -133,6 +141,20 @@ func (st *SensorType) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				st.MaxValue = value.Float64
 			}
+		case sensortype.FieldOutOfRangePolicy:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field out_of_range_policy", values[i])
+			} else if value.Valid {
+				st.OutOfRangePolicy = value.String
+			}
+		case sensortype.FieldChannels:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field channels", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &st.Channels); err != nil {
+					return fmt.Errorf("unmarshal field channels: %w", err)
+				}
+			}
 		case sensortype.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -201,6 +223,12 @@ func (st *SensorType) String() string {
 	builder.WriteString("max_value=")
 	builder.WriteString(fmt.Sprintf("%v", st.MaxValue))
 	builder.WriteString(", ")
+	builder.WriteString("out_of_range_policy=")
+	builder.WriteString(st.OutOfRangePolicy)
+	builder.WriteString(", ")
+	builder.WriteString("channels=")
+	builder.WriteString(fmt.Sprintf("%v", st.Channels))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(st.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
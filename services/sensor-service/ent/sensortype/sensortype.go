@@ -28,6 +28,10 @@ const (
 	FieldMinValue = "min_value"
 	// FieldMaxValue holds the string denoting the max_value field in the database.
 	FieldMaxValue = "max_value"
+	// FieldOutOfRangePolicy holds the string denoting the out_of_range_policy field in the database.
+	FieldOutOfRangePolicy = "out_of_range_policy"
+	// FieldChannels holds the string denoting the channels field in the database.
+	FieldChannels = "channels"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeSensors holds the string denoting the sensors edge name in mutations.
@@ -53,6 +57,8 @@ var Columns = []string{
 	FieldUnit,
 	FieldMinValue,
 	FieldMaxValue,
+	FieldOutOfRangePolicy,
+	FieldChannels,
 	FieldCreatedAt,
 }
 
@@ -71,6 +77,8 @@ var (
 	NameValidator func(string) error
 	// ModelValidator is a validator for the "model" field. It is called by the builders before save.
 	ModelValidator func(string) error
+	// DefaultOutOfRangePolicy holds the default value on creation for the "out_of_range_policy" field.
+	DefaultOutOfRangePolicy string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 )
@@ -118,6 +126,11 @@ func ByMaxValue(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldMaxValue, opts...).ToFunc()
 }
 
+// ByOutOfRangePolicy orders the results by the out_of_range_policy field.
+func ByOutOfRangePolicy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOutOfRangePolicy, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
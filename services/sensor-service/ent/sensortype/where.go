@@ -90,6 +90,11 @@ func MaxValue(v float64) predicate.SensorType {
 	return predicate.SensorType(sql.FieldEQ(FieldMaxValue, v))
 }
 
+// OutOfRangePolicy applies equality check predicate on the "out_of_range_policy" field. It's identical to OutOfRangePolicyEQ.
+func OutOfRangePolicy(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldEQ(FieldOutOfRangePolicy, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.SensorType {
 	return predicate.SensorType(sql.FieldEQ(FieldCreatedAt, v))
@@ -550,6 +555,81 @@ func MaxValueNotNil() predicate.SensorType {
 	return predicate.SensorType(sql.FieldNotNull(FieldMaxValue))
 }
 
+// OutOfRangePolicyEQ applies the EQ predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyEQ(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldEQ(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyNEQ applies the NEQ predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyNEQ(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldNEQ(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyIn applies the In predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyIn(vs ...string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldIn(FieldOutOfRangePolicy, vs...))
+}
+
+// OutOfRangePolicyNotIn applies the NotIn predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyNotIn(vs ...string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldNotIn(FieldOutOfRangePolicy, vs...))
+}
+
+// OutOfRangePolicyGT applies the GT predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyGT(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldGT(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyGTE applies the GTE predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyGTE(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldGTE(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyLT applies the LT predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyLT(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldLT(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyLTE applies the LTE predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyLTE(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldLTE(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyContains applies the Contains predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyContains(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldContains(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyHasPrefix applies the HasPrefix predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyHasPrefix(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldHasPrefix(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyHasSuffix applies the HasSuffix predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyHasSuffix(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldHasSuffix(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyEqualFold applies the EqualFold predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyEqualFold(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldEqualFold(FieldOutOfRangePolicy, v))
+}
+
+// OutOfRangePolicyContainsFold applies the ContainsFold predicate on the "out_of_range_policy" field.
+func OutOfRangePolicyContainsFold(v string) predicate.SensorType {
+	return predicate.SensorType(sql.FieldContainsFold(FieldOutOfRangePolicy, v))
+}
+
+// ChannelsIsNil applies the IsNil predicate on the "channels" field.
+func ChannelsIsNil() predicate.SensorType {
+	return predicate.SensorType(sql.FieldIsNull(FieldChannels))
+}
+
+// ChannelsNotNil applies the NotNil predicate on the "channels" field.
+func ChannelsNotNil() predicate.SensorType {
+	return predicate.SensorType(sql.FieldNotNull(FieldChannels))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.SensorType {
 	return predicate.SensorType(sql.FieldEQ(FieldCreatedAt, v))
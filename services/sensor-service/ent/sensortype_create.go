@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/schema"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
 )
@@ -103,6 +104,26 @@ func (stc *SensorTypeCreate) SetNillableMaxValue(f *float64) *SensorTypeCreate {
 	return stc
 }
 
+// SetOutOfRangePolicy sets the "out_of_range_policy" field.
+func (stc *SensorTypeCreate) SetOutOfRangePolicy(s string) *SensorTypeCreate {
+	stc.mutation.SetOutOfRangePolicy(s)
+	return stc
+}
+
+// SetNillableOutOfRangePolicy sets the "out_of_range_policy" field if the given value is not nil.
+func (stc *SensorTypeCreate) SetNillableOutOfRangePolicy(s *string) *SensorTypeCreate {
+	if s != nil {
+		stc.SetOutOfRangePolicy(*s)
+	}
+	return stc
+}
+
+// SetChannels sets the "channels" field.
+func (stc *SensorTypeCreate) SetChannels(s []schema.Channel) *SensorTypeCreate {
+	stc.mutation.SetChannels(s)
+	return stc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (stc *SensorTypeCreate) SetCreatedAt(t time.Time) *SensorTypeCreate {
 	stc.mutation.SetCreatedAt(t)
@@ -167,6 +188,10 @@ func (stc *SensorTypeCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (stc *SensorTypeCreate) defaults() {
+	if _, ok := stc.mutation.OutOfRangePolicy(); !ok {
+		v := sensortype.DefaultOutOfRangePolicy
+		stc.mutation.SetOutOfRangePolicy(v)
+	}
 	if _, ok := stc.mutation.CreatedAt(); !ok {
 		v := sensortype.DefaultCreatedAt()
 		stc.mutation.SetCreatedAt(v)
@@ -191,6 +216,9 @@ func (stc *SensorTypeCreate) check() error {
 			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "SensorType.model": %w`, err)}
 		}
 	}
+	if _, ok := stc.mutation.OutOfRangePolicy(); !ok {
+		return &ValidationError{Name: "out_of_range_policy", err: errors.New(`ent: missing required field "SensorType.out_of_range_policy"`)}
+	}
 	if _, ok := stc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "SensorType.created_at"`)}
 	}
@@ -248,6 +276,14 @@ func (stc *SensorTypeCreate) createSpec() (*SensorType, *sqlgraph.CreateSpec) {
 		_spec.SetField(sensortype.FieldMaxValue, field.TypeFloat64, value)
 		_node.MaxValue = value
 	}
+	if value, ok := stc.mutation.OutOfRangePolicy(); ok {
+		_spec.SetField(sensortype.FieldOutOfRangePolicy, field.TypeString, value)
+		_node.OutOfRangePolicy = value
+	}
+	if value, ok := stc.mutation.Channels(); ok {
+		_spec.SetField(sensortype.FieldChannels, field.TypeJSON, value)
+		_node.Channels = value
+	}
 	if value, ok := stc.mutation.CreatedAt(); ok {
 		_spec.SetField(sensortype.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
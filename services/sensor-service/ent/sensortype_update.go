@@ -10,8 +10,10 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/predicate"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/schema"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
 )
@@ -171,6 +173,38 @@ func (stu *SensorTypeUpdate) ClearMaxValue() *SensorTypeUpdate {
 	return stu
 }
 
+// SetOutOfRangePolicy sets the "out_of_range_policy" field.
+func (stu *SensorTypeUpdate) SetOutOfRangePolicy(s string) *SensorTypeUpdate {
+	stu.mutation.SetOutOfRangePolicy(s)
+	return stu
+}
+
+// SetNillableOutOfRangePolicy sets the "out_of_range_policy" field if the given value is not nil.
+func (stu *SensorTypeUpdate) SetNillableOutOfRangePolicy(s *string) *SensorTypeUpdate {
+	if s != nil {
+		stu.SetOutOfRangePolicy(*s)
+	}
+	return stu
+}
+
+// SetChannels sets the "channels" field.
+func (stu *SensorTypeUpdate) SetChannels(s []schema.Channel) *SensorTypeUpdate {
+	stu.mutation.SetChannels(s)
+	return stu
+}
+
+// AppendChannels appends s to the "channels" field.
+func (stu *SensorTypeUpdate) AppendChannels(s []schema.Channel) *SensorTypeUpdate {
+	stu.mutation.AppendChannels(s)
+	return stu
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (stu *SensorTypeUpdate) ClearChannels() *SensorTypeUpdate {
+	stu.mutation.ClearChannels()
+	return stu
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (stu *SensorTypeUpdate) SetCreatedAt(t time.Time) *SensorTypeUpdate {
 	stu.mutation.SetCreatedAt(t)
@@ -322,6 +356,20 @@ func (stu *SensorTypeUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if stu.mutation.MaxValueCleared() {
 		_spec.ClearField(sensortype.FieldMaxValue, field.TypeFloat64)
 	}
+	if value, ok := stu.mutation.OutOfRangePolicy(); ok {
+		_spec.SetField(sensortype.FieldOutOfRangePolicy, field.TypeString, value)
+	}
+	if value, ok := stu.mutation.Channels(); ok {
+		_spec.SetField(sensortype.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := stu.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sensortype.FieldChannels, value)
+		})
+	}
+	if stu.mutation.ChannelsCleared() {
+		_spec.ClearField(sensortype.FieldChannels, field.TypeJSON)
+	}
 	if value, ok := stu.mutation.CreatedAt(); ok {
 		_spec.SetField(sensortype.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -532,6 +580,38 @@ func (stuo *SensorTypeUpdateOne) ClearMaxValue() *SensorTypeUpdateOne {
 	return stuo
 }
 
+// SetOutOfRangePolicy sets the "out_of_range_policy" field.
+func (stuo *SensorTypeUpdateOne) SetOutOfRangePolicy(s string) *SensorTypeUpdateOne {
+	stuo.mutation.SetOutOfRangePolicy(s)
+	return stuo
+}
+
+// SetNillableOutOfRangePolicy sets the "out_of_range_policy" field if the given value is not nil.
+func (stuo *SensorTypeUpdateOne) SetNillableOutOfRangePolicy(s *string) *SensorTypeUpdateOne {
+	if s != nil {
+		stuo.SetOutOfRangePolicy(*s)
+	}
+	return stuo
+}
+
+// SetChannels sets the "channels" field.
+func (stuo *SensorTypeUpdateOne) SetChannels(s []schema.Channel) *SensorTypeUpdateOne {
+	stuo.mutation.SetChannels(s)
+	return stuo
+}
+
+// AppendChannels appends s to the "channels" field.
+func (stuo *SensorTypeUpdateOne) AppendChannels(s []schema.Channel) *SensorTypeUpdateOne {
+	stuo.mutation.AppendChannels(s)
+	return stuo
+}
+
+// ClearChannels clears the value of the "channels" field.
+func (stuo *SensorTypeUpdateOne) ClearChannels() *SensorTypeUpdateOne {
+	stuo.mutation.ClearChannels()
+	return stuo
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (stuo *SensorTypeUpdateOne) SetCreatedAt(t time.Time) *SensorTypeUpdateOne {
 	stuo.mutation.SetCreatedAt(t)
@@ -713,6 +793,20 @@ func (stuo *SensorTypeUpdateOne) sqlSave(ctx context.Context) (_node *SensorType
 	if stuo.mutation.MaxValueCleared() {
 		_spec.ClearField(sensortype.FieldMaxValue, field.TypeFloat64)
 	}
+	if value, ok := stuo.mutation.OutOfRangePolicy(); ok {
+		_spec.SetField(sensortype.FieldOutOfRangePolicy, field.TypeString, value)
+	}
+	if value, ok := stuo.mutation.Channels(); ok {
+		_spec.SetField(sensortype.FieldChannels, field.TypeJSON, value)
+	}
+	if value, ok := stuo.mutation.AppendedChannels(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, sensortype.FieldChannels, value)
+		})
+	}
+	if stuo.mutation.ChannelsCleared() {
+		_spec.ClearField(sensortype.FieldChannels, field.TypeJSON)
+	}
 	if value, ok := stuo.mutation.CreatedAt(); ok {
 		_spec.SetField(sensortype.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -12,6 +12,10 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// Calibration is the client for interacting with the Calibration builders.
+	Calibration *CalibrationClient
+	// OutboxEvent is the client for interacting with the OutboxEvent builders.
+	OutboxEvent *OutboxEventClient
 	// Sensor is the client for interacting with the Sensor builders.
 	Sensor *SensorClient
 	// SensorGroup is the client for interacting with the SensorGroup builders.
@@ -149,6 +153,8 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.Calibration = NewCalibrationClient(tx.config)
+	tx.OutboxEvent = NewOutboxEventClient(tx.config)
 	tx.Sensor = NewSensorClient(tx.config)
 	tx.SensorGroup = NewSensorGroupClient(tx.config)
 	tx.SensorType = NewSensorTypeClient(tx.config)
@@ -161,7 +167,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Sensor.QueryXXX(), the query will be executed
+// applies a query, for example: Calibration.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -0,0 +1,240 @@
+package handlers
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent"
+)
+
+// maxCalibrationCoefficients bounds the polynomial of a calibration to degree 7.
+const maxCalibrationCoefficients = 8
+
+// CreateCalibration implements api.SensorServiceServer.
+func (h *SensorsGrpcHandler) CreateCalibration(ctx context.Context, req *pb.CreateCalibrationRequest) (*pb.CreateCalibrationResponse, error) {
+	if req.SensorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "sensor id must be a positive integer")
+	}
+
+	sensor, err := h.sensorsService.GetSensor(ctx, int(req.SensorId))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "sensor not found")
+	}
+
+	c := &ent.Calibration{
+		SensorID:     sensor.ID,
+		Channel:      req.Channel,
+		Offset:       req.Offset,
+		Gain:         req.Gain,
+		Coefficients: req.Coefficients,
+		Certificate:  req.Certificate,
+	}
+	if err := h.validateCalibration(ctx, sensor, c, req.ValidFrom, req.ValidTo); err != nil {
+		return nil, err
+	}
+
+	created, err := h.calibrationService.CreateCalibration(ctx, c)
+	if err != nil {
+		logger.Error("Failed to create calibration", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to create calibration")
+	}
+
+	return &pb.CreateCalibrationResponse{
+		Calibration: convertCalibrationToProto(created),
+	}, nil
+}
+
+// ListCalibrations implements api.SensorServiceServer.
+func (h *SensorsGrpcHandler) ListCalibrations(ctx context.Context, req *pb.ListCalibrationsRequest) (*pb.ListCalibrationsResponse, error) {
+	if req.SensorId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "sensor id must be a positive integer")
+	}
+
+	calibrations, err := h.calibrationService.ListCalibrations(ctx, int(req.SensorId))
+	if err != nil {
+		logger.Error("Failed to list calibrations", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to list calibrations")
+	}
+
+	return &pb.ListCalibrationsResponse{
+		Calibrations: convertCalibrationsToProto(calibrations),
+	}, nil
+}
+
+// UpdateCalibration implements api.SensorServiceServer.
+func (h *SensorsGrpcHandler) UpdateCalibration(ctx context.Context, req *pb.UpdateCalibrationRequest) (*pb.UpdateCalibrationResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "calibration id must be a positive integer")
+	}
+
+	existing, err := h.calibrationService.GetCalibration(ctx, int(req.Id))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "calibration not found")
+	}
+
+	sensor, err := h.sensorsService.GetSensor(ctx, existing.SensorID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "sensor not found")
+	}
+
+	existing.Channel = req.Channel
+	existing.Offset = req.Offset
+	existing.Gain = req.Gain
+	existing.Coefficients = req.Coefficients
+	existing.Certificate = req.Certificate
+	if err := h.validateCalibration(ctx, sensor, existing, req.ValidFrom, req.ValidTo); err != nil {
+		return nil, err
+	}
+
+	updated, err := h.calibrationService.UpdateCalibration(ctx, existing)
+	if err != nil {
+		logger.Error("Failed to update calibration", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to update calibration")
+	}
+
+	return &pb.UpdateCalibrationResponse{
+		Calibration: convertCalibrationToProto(updated),
+	}, nil
+}
+
+// DeleteCalibration implements api.SensorServiceServer.
+func (h *SensorsGrpcHandler) DeleteCalibration(ctx context.Context, req *pb.DeleteCalibrationRequest) (*pb.DeleteCalibrationResponse, error) {
+	if req.Id <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "calibration id must be a positive integer")
+	}
+
+	_, err := h.calibrationService.GetCalibration(ctx, int(req.Id))
+	if err != nil {
+		return nil, status.Error(codes.NotFound, "calibration not found")
+	}
+
+	if err := h.calibrationService.DeleteCalibration(ctx, int(req.Id)); err != nil {
+		logger.Error("Failed to delete calibration", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete calibration")
+	}
+
+	return &pb.DeleteCalibrationResponse{}, nil
+}
+
+// validateCalibration checks c against its sensor and sets its validity period. Virtual
+// sensors cannot be calibrated. Multi-channel sensors are calibrated per declared channel,
+// other sensors on their value. The validity period must not overlap another calibration
+// of the same channel, so at most one calibration applies to any reading.
+func (h *SensorsGrpcHandler) validateCalibration(ctx context.Context, sensor *ent.Sensor, c *ent.Calibration, validFrom, validTo *timestamppb.Timestamp) error {
+	if sensor.Kind == sensorKindVirtual {
+		return status.Error(codes.InvalidArgument, "virtual sensors cannot be calibrated")
+	}
+
+	var declared []string
+	if sensor.Edges.Type != nil {
+		for _, ch := range sensor.Edges.Type.Channels {
+			declared = append(declared, ch.Name)
+		}
+	}
+	switch {
+	case len(declared) == 0 && c.Channel != "":
+		return status.Errorf(codes.InvalidArgument, "sensor has no channel %q", c.Channel)
+	case len(declared) > 0 && c.Channel == "":
+		return status.Error(codes.InvalidArgument, "channel is required for multi-channel sensors")
+	case len(declared) > 0:
+		found := false
+		for _, name := range declared {
+			found = found || name == c.Channel
+		}
+		if !found {
+			return status.Errorf(codes.InvalidArgument, "sensor has no channel %q", c.Channel)
+		}
+	}
+
+	if c.Gain == 0 {
+		c.Gain = 1
+	}
+	if !isFinite(c.Offset) || !isFinite(c.Gain) {
+		return status.Error(codes.InvalidArgument, "offset and gain must be finite numbers")
+	}
+	if len(c.Coefficients) > maxCalibrationCoefficients {
+		return status.Errorf(codes.InvalidArgument, "a calibration can have at most %d coefficients", maxCalibrationCoefficients)
+	}
+	for _, coefficient := range c.Coefficients {
+		if !isFinite(coefficient) {
+			return status.Error(codes.InvalidArgument, "coefficients must be finite numbers")
+		}
+	}
+
+	if validFrom == nil {
+		return status.Error(codes.InvalidArgument, "valid_from is required")
+	}
+	c.ValidFrom = validFrom.AsTime()
+	c.ValidTo = nil
+	if validTo != nil {
+		to := validTo.AsTime()
+		if !to.After(c.ValidFrom) {
+			return status.Error(codes.InvalidArgument, "valid_to must be after valid_from")
+		}
+		c.ValidTo = &to
+	}
+
+	existing, err := h.calibrationService.ListCalibrations(ctx, c.SensorID)
+	if err != nil {
+		logger.Error("Failed to list calibrations", zap.Error(err))
+		return status.Error(codes.Internal, "failed to validate calibration")
+	}
+	for _, other := range existing {
+		if other.ID != c.ID && other.Channel == c.Channel && periodsOverlap(c, other) {
+			return status.Errorf(codes.FailedPrecondition, "validity period overlaps calibration %d", other.ID)
+		}
+	}
+	return nil
+}
+
+// periodsOverlap reports whether the validity periods of two calibrations intersect.
+func periodsOverlap(a, b *ent.Calibration) bool {
+	return endsAfter(a.ValidTo, b.ValidFrom) && endsAfter(b.ValidTo, a.ValidFrom)
+}
+
+// endsAfter reports whether a period ending at end, or never when nil, ends after t.
+func endsAfter(end *time.Time, t time.Time) bool {
+	return end == nil || end.After(t)
+}
+
+func isFinite(v float64) bool {
+	return !math.IsNaN(v) && !math.IsInf(v, 0)
+}
+
+func convertCalibrationToProto(c *ent.Calibration) *pb.Calibration {
+	if c == nil {
+		return nil
+	}
+
+	calibrationProto := &pb.Calibration{
+		Id:           int64(c.ID),
+		SensorId:     int64(c.SensorID),
+		Channel:      c.Channel,
+		Offset:       c.Offset,
+		Gain:         c.Gain,
+		Coefficients: c.Coefficients,
+		ValidFrom:    timestamppb.New(c.ValidFrom),
+		Certificate:  c.Certificate,
+		CreatedAt:    timestamppb.New(c.CreatedAt),
+	}
+	if c.ValidTo != nil {
+		calibrationProto.ValidTo = timestamppb.New(*c.ValidTo)
+	}
+	return calibrationProto
+}
+
+func convertCalibrationsToProto(calibrations []*ent.Calibration) []*pb.Calibration {
+	var result []*pb.Calibration
+	for _, c := range calibrations {
+		result = append(result, convertCalibrationToProto(c))
+	}
+	return result
+}
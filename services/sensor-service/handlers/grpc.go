@@ -2,6 +2,8 @@ package handlers
 
 import (
 	"context"
+	"fmt"
+	"regexp"
 
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
@@ -9,9 +11,11 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	pb_data "github.com/skni-kod/iot-monitor-backend/internal/proto/data_service"
 	pb "github.com/skni-kod/iot-monitor-backend/internal/proto/sensor_service"
 	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/schema"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/services"
 )
 
@@ -20,10 +24,14 @@ type SensorsGrpcHandler struct {
 	sensorsService      services.ISensorService
 	sensorsTypeService  services.ISensorTypeService
 	sensorsGroupService services.ISensorGroupService
+	calibrationService  services.ICalibrationService
+	// dataClient deletes the readings of deleted sensors. It is nil when the data
+	// service is not configured.
+	dataClient pb_data.DataServiceClient
 }
 
-func NewGrpcHandler(s *grpc.Server, sensorsService services.ISensorService, sensorsTypeService services.ISensorTypeService, sensorsGroupService services.ISensorGroupService) {
-	handler := &SensorsGrpcHandler{sensorsService: sensorsService, sensorsTypeService: sensorsTypeService, sensorsGroupService: sensorsGroupService}
+func NewGrpcHandler(s *grpc.Server, sensorsService services.ISensorService, sensorsTypeService services.ISensorTypeService, sensorsGroupService services.ISensorGroupService, calibrationService services.ICalibrationService, dataClient pb_data.DataServiceClient) {
+	handler := &SensorsGrpcHandler{sensorsService: sensorsService, sensorsTypeService: sensorsTypeService, sensorsGroupService: sensorsGroupService, calibrationService: calibrationService, dataClient: dataClient}
 	pb.RegisterSensorServiceServer(s, handler)
 }
 
@@ -37,17 +45,39 @@ func (h *SensorsGrpcHandler) CreateSensor(ctx context.Context, req *pb.CreateSen
 		return nil, status.Error(codes.InvalidArgument, "sensor_type_id must be a positive integer")
 	}
 
+	kind := req.Kind
+	if kind == "" {
+		kind = sensorKindPhysical
+	}
+	if !isValidSensorKind(kind) {
+		return nil, status.Error(codes.InvalidArgument, "kind must be one of physical, virtual")
+	}
+	if kind == sensorKindPhysical && req.Expression != "" {
+		return nil, status.Error(codes.InvalidArgument, "only virtual sensors have an expression")
+	}
+	if kind == sensorKindVirtual && req.Expression == "" {
+		return nil, status.Error(codes.InvalidArgument, "virtual sensors require an expression")
+	}
+
 	_, err := h.sensorsTypeService.GetSensorType(ctx, int(req.SensorTypeId))
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "sensor type not found")
 	}
 
+	if kind == sensorKindVirtual {
+		if err := h.validateExpression(ctx, req.UserId, req.Expression); err != nil {
+			return nil, err
+		}
+	}
+
 	sensor, err := h.sensorsService.CreateSensor(ctx, &ent.Sensor{
 		Name:        req.Name,
 		Location:    req.Location,
 		Description: req.Description,
 		Active:      req.Active,
 		UserID:      req.UserId,
+		Kind:        kind,
+		Expression:  req.Expression,
 		Edges: ent.SensorEdges{
 			Type: &ent.SensorType{ID: int(req.SensorTypeId)},
 		},
@@ -69,14 +99,29 @@ func (h *SensorsGrpcHandler) CreateSensorType(ctx context.Context, req *pb.Creat
 		return nil, status.Error(codes.InvalidArgument, "name and model are required fields")
 	}
 
+	policy := req.OutOfRangePolicy
+	if policy == "" {
+		policy = "flag"
+	}
+	if !isValidOutOfRangePolicy(policy) {
+		return nil, status.Error(codes.InvalidArgument, "out_of_range_policy must be one of flag, clamp, reject")
+	}
+
+	channels, err := convertChannelsFromProto(req.Channels)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	sensorType, err := h.sensorsTypeService.CreateSensorType(ctx, &ent.SensorType{
-		Name:         req.Name,
-		Model:        req.Model,
-		Manufacturer: req.Manufacturer,
-		Description:  req.Description,
-		Unit:         req.Unit,
-		MinValue:     float64(req.MinValue),
-		MaxValue:     float64(req.MaxValue),
+		Name:             req.Name,
+		Model:            req.Model,
+		Manufacturer:     req.Manufacturer,
+		Description:      req.Description,
+		Unit:             req.Unit,
+		MinValue:         float64(req.MinValue),
+		MaxValue:         float64(req.MaxValue),
+		OutOfRangePolicy: policy,
+		Channels:         channels,
 	})
 	if err != nil {
 		logger.Error("Failed to create sensor type", zap.Error(err))
@@ -94,10 +139,40 @@ func (h *SensorsGrpcHandler) DeleteSensor(ctx context.Context, req *pb.DeleteSen
 		return nil, status.Error(codes.InvalidArgument, "sensor id must be a positive integer")
 	}
 
-	_, err := h.sensorsService.GetSensor(ctx, int(req.Id))
+	sensor, err := h.sensorsService.GetSensor(ctx, int(req.Id))
 	if err != nil {
 		return nil, status.Error(codes.NotFound, "sensor not found")
 	}
+	if req.DeleteReadings && sensor.UserID != req.UserId {
+		return nil, status.Error(codes.PermissionDenied, "only the owner can delete the readings of a sensor")
+	}
+
+	dependents, err := h.virtualDependents(ctx, req.Id)
+	if err != nil {
+		logger.Error("Failed to check virtual sensors", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to delete sensor")
+	}
+	if len(dependents) > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "sensor is an input of virtual sensors %v", dependents)
+	}
+
+	// Readings go first: once the sensor is gone, nobody can prove ownership of them.
+	res := &pb.DeleteSensorResponse{}
+	if req.DeleteReadings {
+		if h.dataClient == nil {
+			return nil, status.Error(codes.FailedPrecondition, "deleting readings requires the data service, DATA_SERVICE_GRPC_ADDR is not set")
+		}
+		deleted, err := h.dataClient.DeleteReadings(ctx, &pb_data.DeleteReadingsRequest{
+			SensorId: req.Id,
+			UserId:   req.UserId,
+			Reason:   "sensor deleted",
+		})
+		if err != nil {
+			logger.Error("Failed to delete readings of sensor", zap.Int64("sensor_id", req.Id), zap.Error(err))
+			return nil, status.Error(codes.Unavailable, "failed to delete readings of sensor")
+		}
+		res.DeletedReadings = deleted.Deleted
+	}
 
 	err = h.sensorsService.DeleteSensor(ctx, int(req.Id))
 	if err != nil {
@@ -105,7 +180,7 @@ func (h *SensorsGrpcHandler) DeleteSensor(ctx context.Context, req *pb.DeleteSen
 		return nil, status.Error(codes.Internal, "failed to delete sensor")
 	}
 
-	return &pb.DeleteSensorResponse{}, nil
+	return res, nil
 }
 
 // GetSensor implements api.SensorServiceServer.
@@ -120,6 +195,41 @@ func (h *SensorsGrpcHandler) GetSensor(ctx context.Context, req *pb.GetSensorReq
 	}, nil
 }
 
+// maxGetSensors bounds the ids of a GetSensors request.
+const maxGetSensors = 1000
+
+// GetSensors implements api.SensorServiceServer.
+func (h *SensorsGrpcHandler) GetSensors(ctx context.Context, req *pb.GetSensorsRequest) (*pb.GetSensorsResponse, error) {
+	if len(req.Ids) > maxGetSensors {
+		return nil, status.Errorf(codes.InvalidArgument, "at most %d ids can be requested at once", maxGetSensors)
+	}
+
+	ids := make([]int, 0, len(req.Ids))
+	for _, id := range req.Ids {
+		if id <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "sensor ids must be positive integers")
+		}
+		ids = append(ids, int(id))
+	}
+
+	res := &pb.GetSensorsResponse{}
+	if len(ids) == 0 {
+		return res, nil
+	}
+
+	sensors, err := h.sensorsService.GetSensors(ctx, ids)
+	if err != nil {
+		logger.Error("Failed to get sensors", zap.Error(err))
+		return nil, status.Error(codes.Internal, "failed to get sensors")
+	}
+
+	res.Sensors = make([]*pb.Sensor, 0, len(sensors))
+	for _, sensor := range sensors {
+		res.Sensors = append(res.Sensors, convertSensorToProto(sensor))
+	}
+	return res, nil
+}
+
 // GetSensorType implements api.SensorServiceServer.
 // Subtle: this method shadows the method (UnimplementedSensorServiceServer).GetSensorType of SensorsGrpcHandler.UnimplementedSensorServiceServer.
 func (h *SensorsGrpcHandler) GetSensorType(ctx context.Context, req *pb.GetSensorTypeRequest) (*pb.GetSensorTypeResponse, error) {
@@ -185,6 +295,19 @@ func (h *SensorsGrpcHandler) UpdateSensorType(ctx context.Context, req *pb.Updat
 		return nil, status.Error(codes.InvalidArgument, "name and model are required fields")
 	}
 
+	if req.OutOfRangePolicy != "" && !isValidOutOfRangePolicy(req.OutOfRangePolicy) {
+		return nil, status.Error(codes.InvalidArgument, "out_of_range_policy must be one of flag, clamp, reject")
+	}
+
+	if req.ClearChannels && len(req.Channels) > 0 {
+		return nil, status.Error(codes.InvalidArgument, "channels cannot be set together with clear_channels")
+	}
+
+	channels, err := convertChannelsFromProto(req.Channels)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	existingST.Name = req.Name
 	existingST.Model = req.Model
 	existingST.Manufacturer = req.Manufacturer
@@ -192,6 +315,12 @@ func (h *SensorsGrpcHandler) UpdateSensorType(ctx context.Context, req *pb.Updat
 	existingST.Unit = req.Unit
 	existingST.MinValue = float64(req.MinValue)
 	existingST.MaxValue = float64(req.MaxValue)
+	if req.OutOfRangePolicy != "" {
+		existingST.OutOfRangePolicy = req.OutOfRangePolicy
+	}
+	if len(channels) > 0 || req.ClearChannels {
+		existingST.Channels = channels
+	}
 
 	updatedST, err := h.sensorsTypeService.UpdateSensorType(ctx, int(req.Id), existingST)
 	if err != nil {
@@ -206,7 +335,18 @@ func (h *SensorsGrpcHandler) UpdateSensorType(ctx context.Context, req *pb.Updat
 
 // ListSensors implements api.SensorServiceServer.
 func (h *SensorsGrpcHandler) ListSensors(ctx context.Context, req *pb.ListSensorsRequest) (*pb.ListSensorsResponse, error) {
-	sensors, err := h.sensorsService.ListSensors(ctx, req.UserId)
+	var sensors []*ent.Sensor
+	var err error
+	if req.Kind != "" {
+		if !isValidSensorKind(req.Kind) {
+			return nil, status.Error(codes.InvalidArgument, "kind must be one of physical, virtual")
+		}
+		sensors, err = h.sensorsService.ListSensorsByKind(ctx, req.Kind, req.UserId)
+	} else if req.SensorTypeId > 0 {
+		sensors, err = h.sensorsService.ListSensorsByType(ctx, int(req.SensorTypeId), req.UserId)
+	} else {
+		sensors, err = h.sensorsService.ListSensors(ctx, req.UserId)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -237,6 +377,16 @@ func (h *SensorsGrpcHandler) UpdateSensor(ctx context.Context, req *pb.UpdateSen
 	existingSensor.Description = req.Description
 	existingSensor.Active = req.Active
 
+	if req.Expression != "" {
+		if existingSensor.Kind != sensorKindVirtual {
+			return nil, status.Error(codes.InvalidArgument, "only virtual sensors have an expression")
+		}
+		if err := h.validateExpression(ctx, existingSensor.UserID, req.Expression); err != nil {
+			return nil, err
+		}
+		existingSensor.Expression = req.Expression
+	}
+
 	if req.SensorTypeId > 0 {
 		_, err := h.sensorsTypeService.GetSensorType(ctx, int(req.SensorTypeId))
 		if err != nil {
@@ -455,7 +605,11 @@ func convertSensorToProto(s *ent.Sensor) *pb.Sensor {
 		Active:      s.Active,
 		CreatedAt:   timestamppb.New(s.CreatedAt),
 		UpdatedAt:   timestamppb.New(s.UpdatedAt),
+		UserId:      s.UserID,
+		Kind:        s.Kind,
+		Expression:  s.Expression,
 	}
+	sensorProto.InputSensorIds = inputSensorIDs(s.Kind, s.Expression)
 
 	if !s.LastUpdated.IsZero() {
 		sensorProto.LastUpdated = timestamppb.New(s.LastUpdated)
@@ -466,6 +620,8 @@ func convertSensorToProto(s *ent.Sensor) *pb.Sensor {
 		sensorProto.SensorType = convertSensorTypeToProto(s.Edges.Type)
 	}
 
+	sensorProto.Calibrations = convertCalibrationsToProto(s.Edges.Calibrations)
+
 	return sensorProto
 }
 
@@ -475,16 +631,65 @@ func convertSensorTypeToProto(st *ent.SensorType) *pb.SensorType {
 	}
 
 	sensorTypeProto := &pb.SensorType{
-		Id:           int64(st.ID),
-		Name:         st.Name,
-		Model:        st.Model,
-		Manufacturer: st.Manufacturer,
-		Description:  st.Description,
-		Unit:         st.Unit,
-		MinValue:     float32(st.MinValue),
-		MaxValue:     float32(st.MaxValue),
-		CreatedAt:    timestamppb.New(st.CreatedAt),
+		Id:               int64(st.ID),
+		Name:             st.Name,
+		Model:            st.Model,
+		Manufacturer:     st.Manufacturer,
+		Description:      st.Description,
+		Unit:             st.Unit,
+		MinValue:         float32(st.MinValue),
+		MaxValue:         float32(st.MaxValue),
+		CreatedAt:        timestamppb.New(st.CreatedAt),
+		OutOfRangePolicy: st.OutOfRangePolicy,
+	}
+
+	for _, c := range st.Channels {
+		sensorTypeProto.Channels = append(sensorTypeProto.Channels, &pb.SensorChannel{
+			Name:     c.Name,
+			Unit:     c.Unit,
+			MinValue: float32(c.MinValue),
+			MaxValue: float32(c.MaxValue),
+		})
 	}
 
 	return sensorTypeProto
 }
+
+// maxChannels bounds the channels a sensor type may declare.
+const maxChannels = 16
+
+var channelNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+func convertChannelsFromProto(channels []*pb.SensorChannel) ([]schema.Channel, error) {
+	if len(channels) > maxChannels {
+		return nil, fmt.Errorf("a sensor type can declare at most %d channels", maxChannels)
+	}
+
+	seen := make(map[string]bool, len(channels))
+	result := make([]schema.Channel, 0, len(channels))
+	for _, c := range channels {
+		if !channelNamePattern.MatchString(c.Name) {
+			return nil, fmt.Errorf("invalid channel name %q, expected lowercase letters, digits and underscores", c.Name)
+		}
+		if seen[c.Name] {
+			return nil, fmt.Errorf("duplicate channel %q", c.Name)
+		}
+		seen[c.Name] = true
+
+		result = append(result, schema.Channel{
+			Name:     c.Name,
+			Unit:     c.Unit,
+			MinValue: float64(c.MinValue),
+			MaxValue: float64(c.MaxValue),
+		})
+	}
+	return result, nil
+}
+
+func isValidOutOfRangePolicy(policy string) bool {
+	switch policy {
+	case "flag", "clamp", "reject":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,92 @@
+package handlers
+
+import (
+	"context"
+	"slices"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/expr"
+	"github.com/skni-kod/iot-monitor-backend/pkg/logger"
+)
+
+const (
+	// sensorKindPhysical sensors report their own readings.
+	sensorKindPhysical = "physical"
+	// sensorKindVirtual sensors get readings computed by the data service from an
+	// expression over physical sensors.
+	sensorKindVirtual = "virtual"
+)
+
+func isValidSensorKind(kind string) bool {
+	return kind == sensorKindPhysical || kind == sensorKindVirtual
+}
+
+// validateExpression parses the expression of a virtual sensor owned by userID. Every
+// input has to be a physical sensor of the same user, and channels have to be declared
+// by the type of their sensor. Virtual inputs are rejected, which also rules out cycles.
+func (h *SensorsGrpcHandler) validateExpression(ctx context.Context, userID int64, src string) error {
+	e, err := expr.Parse(src)
+	if err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid expression: %v", err)
+	}
+
+	for _, in := range e.Inputs() {
+		input, err := h.sensorsService.GetSensor(ctx, int(in.SensorID))
+		if err != nil || input.UserID != userID {
+			return status.Errorf(codes.InvalidArgument, "invalid expression: sensor %d not found", in.SensorID)
+		}
+		if input.Kind == sensorKindVirtual {
+			return status.Errorf(codes.InvalidArgument, "invalid expression: sensor %d is virtual, inputs must be physical sensors", in.SensorID)
+		}
+		if in.Channel == "" {
+			continue
+		}
+
+		declared := false
+		if input.Edges.Type != nil {
+			for _, c := range input.Edges.Type.Channels {
+				declared = declared || c.Name == in.Channel
+			}
+		}
+		if !declared {
+			return status.Errorf(codes.InvalidArgument, "invalid expression: sensor %d has no channel %q", in.SensorID, in.Channel)
+		}
+	}
+	return nil
+}
+
+// virtualDependents returns the IDs of the virtual sensors whose expression reads the sensor.
+func (h *SensorsGrpcHandler) virtualDependents(ctx context.Context, sensorID int64) ([]int64, error) {
+	sensors, err := h.sensorsService.ListSensorsByKind(ctx, sensorKindVirtual, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var dependents []int64
+	for _, s := range sensors {
+		e, err := expr.Parse(s.Expression)
+		if err != nil {
+			logger.Warn("Stored expression of virtual sensor does not parse", zap.Int("sensor_id", s.ID), zap.Error(err))
+			continue
+		}
+		if slices.Contains(e.SensorIDs(), sensorID) {
+			dependents = append(dependents, int64(s.ID))
+		}
+	}
+	return dependents, nil
+}
+
+// inputSensorIDs returns the sensors a virtual sensor reads, or nil for other sensors.
+func inputSensorIDs(kind, expression string) []int64 {
+	if kind != sensorKindVirtual {
+		return nil
+	}
+	e, err := expr.Parse(expression)
+	if err != nil {
+		return nil
+	}
+	return e.SensorIDs()
+}
@@ -0,0 +1,44 @@
+package services
+
+import (
+	"context"
+
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/storage"
+)
+
+type ICalibrationService interface {
+	GetCalibration(ctx context.Context, id int) (*ent.Calibration, error)
+	ListCalibrations(ctx context.Context, sensorID int) ([]*ent.Calibration, error)
+	CreateCalibration(ctx context.Context, calibration *ent.Calibration) (*ent.Calibration, error)
+	UpdateCalibration(ctx context.Context, calibration *ent.Calibration) (*ent.Calibration, error)
+	DeleteCalibration(ctx context.Context, id int) error
+}
+
+type CalibrationService struct {
+	store storage.ICalibrationStorage
+}
+
+func NewCalibrationService(store storage.ICalibrationStorage) ICalibrationService {
+	return &CalibrationService{store: store}
+}
+
+func (s *CalibrationService) GetCalibration(ctx context.Context, id int) (*ent.Calibration, error) {
+	return s.store.Get(ctx, id)
+}
+
+func (s *CalibrationService) ListCalibrations(ctx context.Context, sensorID int) ([]*ent.Calibration, error) {
+	return s.store.ListBySensor(ctx, sensorID)
+}
+
+func (s *CalibrationService) CreateCalibration(ctx context.Context, calibration *ent.Calibration) (*ent.Calibration, error) {
+	return s.store.Create(ctx, calibration)
+}
+
+func (s *CalibrationService) UpdateCalibration(ctx context.Context, calibration *ent.Calibration) (*ent.Calibration, error) {
+	return s.store.Update(ctx, calibration)
+}
+
+func (s *CalibrationService) DeleteCalibration(ctx context.Context, id int) error {
+	return s.store.Delete(ctx, id)
+}
@@ -9,12 +9,15 @@ import (
 
 type ISensorService interface {
 	GetSensor(ctx context.Context, id int) (*ent.Sensor, error)
+	GetSensors(ctx context.Context, ids []int) ([]*ent.Sensor, error)
 	ListSensors(ctx context.Context, userID int64) ([]*ent.Sensor, error)
 	CreateSensor(ctx context.Context, sensor *ent.Sensor) (*ent.Sensor, error)
 	UpdateSensor(ctx context.Context, sensor *ent.Sensor) (*ent.Sensor, error)
 	DeleteSensor(ctx context.Context, id int) error
 	SetSensorActive(ctx context.Context, id int) (*ent.Sensor, error)
 	ListActiveSensors(ctx context.Context) ([]*ent.Sensor, error)
+	ListSensorsByType(ctx context.Context, typeID int, userID int64) ([]*ent.Sensor, error)
+	ListSensorsByKind(ctx context.Context, kind string, userID int64) ([]*ent.Sensor, error)
 }
 
 type SensorService struct {
@@ -37,6 +40,10 @@ func (s *SensorService) GetSensor(ctx context.Context, id int) (*ent.Sensor, err
 	return s.store.Get(ctx, id)
 }
 
+func (s *SensorService) GetSensors(ctx context.Context, ids []int) ([]*ent.Sensor, error) {
+	return s.store.GetMany(ctx, ids)
+}
+
 func (s *SensorService) ListSensors(ctx context.Context, userID int64) ([]*ent.Sensor, error) {
 	return s.store.List(ctx, userID)
 }
@@ -52,3 +59,11 @@ func (s *SensorService) SetSensorActive(ctx context.Context, id int) (*ent.Senso
 func (s *SensorService) ListActiveSensors(ctx context.Context) ([]*ent.Sensor, error) {
 	return s.store.ListActive(ctx)
 }
+
+func (s *SensorService) ListSensorsByType(ctx context.Context, typeID int, userID int64) ([]*ent.Sensor, error) {
+	return s.store.ListByType(ctx, typeID, userID)
+}
+
+func (s *SensorService) ListSensorsByKind(ctx context.Context, kind string, userID int64) ([]*ent.Sensor, error) {
+	return s.store.ListByKind(ctx, kind, userID)
+}
@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
+)
+
+type ICalibrationStorage interface {
+	Get(ctx context.Context, id int) (*ent.Calibration, error)
+	ListBySensor(ctx context.Context, sensorID int) ([]*ent.Calibration, error)
+	Create(ctx context.Context, calibration *ent.Calibration) (*ent.Calibration, error)
+	Update(ctx context.Context, calibration *ent.Calibration) (*ent.Calibration, error)
+	Delete(ctx context.Context, id int) error
+}
+
+type CalibrationStorage struct {
+	client *ent.Client
+}
+
+func NewCalibrationStorage(client *ent.Client) ICalibrationStorage {
+	return &CalibrationStorage{client: client}
+}
+
+func (s *CalibrationStorage) Get(ctx context.Context, id int) (*ent.Calibration, error) {
+	return s.client.Calibration.Get(ctx, id)
+}
+
+// ListBySensor returns the calibrations of a sensor ordered by channel and start of validity.
+func (s *CalibrationStorage) ListBySensor(ctx context.Context, sensorID int) ([]*ent.Calibration, error) {
+	return s.client.Calibration.Query().
+		Where(calibration.SensorID(sensorID)).
+		Order(ent.Asc(calibration.FieldChannel), ent.Asc(calibration.FieldValidFrom)).
+		All(ctx)
+}
+
+// Create stores c. Like Update and Delete, it queues a sensors_exchange event for the
+// calibrated sensor, whose cached copies carry its calibrations.
+func (s *CalibrationStorage) Create(ctx context.Context, c *ent.Calibration) (*ent.Calibration, error) {
+	var created *ent.Calibration
+	err := withTx(ctx, s.client, func(client *ent.Client) error {
+		var err error
+		created, err = client.Calibration.Create().
+			SetSensorID(c.SensorID).
+			SetChannel(c.Channel).
+			SetOffset(c.Offset).
+			SetGain(c.Gain).
+			SetCoefficients(c.Coefficients).
+			SetValidFrom(c.ValidFrom).
+			SetNillableValidTo(c.ValidTo).
+			SetCertificate(c.Certificate).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to create calibration: %w", err)
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorID: int64(c.SensorID)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (s *CalibrationStorage) Update(ctx context.Context, c *ent.Calibration) (*ent.Calibration, error) {
+	var updated *ent.Calibration
+	err := withTx(ctx, s.client, func(client *ent.Client) error {
+		var err error
+		updated, err = s.update(ctx, client, c)
+		if err != nil {
+			return err
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorID: int64(updated.SensorID)})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (s *CalibrationStorage) update(ctx context.Context, client *ent.Client, c *ent.Calibration) (*ent.Calibration, error) {
+	query := client.Calibration.UpdateOneID(c.ID).
+		SetChannel(c.Channel).
+		SetOffset(c.Offset).
+		SetGain(c.Gain).
+		SetCoefficients(c.Coefficients).
+		SetValidFrom(c.ValidFrom).
+		SetCertificate(c.Certificate)
+	if c.ValidTo != nil {
+		query = query.SetValidTo(*c.ValidTo)
+	} else {
+		query = query.ClearValidTo()
+	}
+
+	updated, err := query.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update calibration: %w", err)
+	}
+	return updated, nil
+}
+
+func (s *CalibrationStorage) Delete(ctx context.Context, id int) error {
+	return withTx(ctx, s.client, func(client *ent.Client) error {
+		c, err := client.Calibration.Get(ctx, id)
+		if err != nil {
+			return fmt.Errorf("failed to get calibration: %w", err)
+		}
+		if err := client.Calibration.DeleteOneID(id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete calibration: %w", err)
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorID: int64(c.SensorID)})
+	})
+}
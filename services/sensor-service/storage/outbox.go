@@ -0,0 +1,117 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/skni-kod/iot-monitor-backend/pkg/outbox"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/outboxevent"
+)
+
+// SensorsExchange receives an event whenever a sensor, its type or its calibrations
+// change, so services that cache sensors can drop stale copies.
+const SensorsExchange = "sensors_exchange"
+
+// SensorEvent names the sensor, or the sensor type whose sensors, changed.
+type SensorEvent struct {
+	SensorID     int64 `json:"sensor_id,omitempty"`
+	SensorTypeID int64 `json:"sensor_type_id,omitempty"`
+	Deleted      bool  `json:"deleted,omitempty"`
+}
+
+type OutboxStorage struct {
+	client *ent.Client
+}
+
+func NewOutboxStorage(client *ent.Client) outbox.Store {
+	return &OutboxStorage{client: client}
+}
+
+func (s *OutboxStorage) Claim(ctx context.Context, limit int, lease time.Duration) ([]outbox.Message, error) {
+	now := time.Now()
+	ids, err := s.client.OutboxEvent.Query().
+		Where(outboxevent.NextAttemptAtLTE(now)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return nil, err
+	}
+
+	// The update only takes rows that are still due, so rows another relay claimed
+	// since the query are left to it.
+	token := outbox.NewClaimToken()
+	err = s.client.OutboxEvent.Update().
+		Where(outboxevent.IDIn(ids...), outboxevent.NextAttemptAtLTE(now)).
+		SetClaimToken(token).
+		SetNextAttemptAt(now.Add(lease)).
+		Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.client.OutboxEvent.Query().
+		Where(outboxevent.ClaimToken(token)).
+		Order(ent.Asc(outboxevent.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]outbox.Message, 0, len(events))
+	for _, e := range events {
+		msgs = append(msgs, outbox.Message{
+			ID:         int64(e.ID),
+			Exchange:   e.Exchange,
+			RoutingKey: e.RoutingKey,
+			Payload:    e.Payload,
+			Attempts:   e.Attempts,
+		})
+	}
+	return msgs, nil
+}
+
+func (s *OutboxStorage) MarkPublished(ctx context.Context, id int64) error {
+	_, err := s.client.OutboxEvent.Delete().Where(outboxevent.ID(int(id))).Exec(ctx)
+	return err
+}
+
+func (s *OutboxStorage) MarkFailed(ctx context.Context, id int64, retryAt time.Time, reason string) error {
+	return s.client.OutboxEvent.UpdateOneID(int(id)).
+		AddAttempts(1).
+		SetLastError(reason).
+		SetNextAttemptAt(retryAt).
+		Exec(ctx)
+}
+
+// writeSensorEvent queues event on sensors_exchange. client should be bound to the
+// transaction of the change the event describes.
+func writeSensorEvent(ctx context.Context, client *ent.Client, event SensorEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal sensor event: %w", err)
+	}
+	if err := client.OutboxEvent.Create().SetExchange(SensorsExchange).SetPayload(body).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to queue sensor event: %w", err)
+	}
+	return nil
+}
+
+// withTx runs fn with a client bound to a new transaction, which is committed when fn
+// succeeds and rolled back otherwise.
+func withTx(ctx context.Context, client *ent.Client, fn func(client *ent.Client) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+	if err := fn(tx.Client()); err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+		}
+		return err
+	}
+	return tx.Commit()
+}
@@ -5,17 +5,22 @@ import (
 	"fmt"
 
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/calibration"
 	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensor"
+	"github.com/skni-kod/iot-monitor-backend/services/sensor-service/ent/sensortype"
 )
 
 type ISensorStorage interface {
 	Get(ctx context.Context, id int) (*ent.Sensor, error)
+	GetMany(ctx context.Context, ids []int) ([]*ent.Sensor, error)
 	List(ctx context.Context, userID int64) ([]*ent.Sensor, error)
 	Create(ctx context.Context, sensor *ent.Sensor) (*ent.Sensor, error)
 	Update(ctx context.Context, sensor *ent.Sensor) (*ent.Sensor, error)
 	Delete(ctx context.Context, id int) error
 	SetActive(ctx context.Context, id int) (*ent.Sensor, error)
 	ListActive(ctx context.Context) ([]*ent.Sensor, error)
+	ListByType(ctx context.Context, typeID int, userID int64) ([]*ent.Sensor, error)
+	ListByKind(ctx context.Context, kind string, userID int64) ([]*ent.Sensor, error)
 }
 
 type SensorStorage struct {
@@ -33,7 +38,12 @@ func (s *SensorStorage) Create(ctx context.Context, sensorData *ent.Sensor) (*en
 		SetNillableLocation(&sensorData.Location).
 		SetNillableDescription(&sensorData.Description).
 		SetActive(sensorData.Active).
-		SetUserID(sensorData.UserID)
+		SetUserID(sensorData.UserID).
+		SetNillableExpression(&sensorData.Expression)
+
+	if sensorData.Kind != "" {
+		query = query.SetKind(sensorData.Kind)
+	}
 
 	if sensorData.Edges.Type != nil && sensorData.Edges.Type.ID != 0 {
 		query = query.SetTypeID(sensorData.Edges.Type.ID)
@@ -68,17 +78,36 @@ func (s *SensorStorage) Delete(ctx context.Context, id int) error {
 		return fmt.Errorf("sensor with ID %d does not exist", id)
 	}
 
-	err = s.client.Sensor.DeleteOneID(id).Exec(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to delete sensor: %w", err)
-	}
-
-	return nil
+	return withTx(ctx, s.client, func(client *ent.Client) error {
+		if err := client.Sensor.DeleteOneID(id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete sensor: %w", err)
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorID: int64(id), Deleted: true})
+	})
 }
 
-// Get implements ISensorStorage.
+// Get implements ISensorStorage. The sensor is loaded with its type and calibrations.
 func (s *SensorStorage) Get(ctx context.Context, id int) (*ent.Sensor, error) {
-	return s.client.Sensor.Query().Where(sensor.ID(id)).WithType().Only(ctx)
+	return s.client.Sensor.Query().
+		Where(sensor.ID(id)).
+		WithType().
+		WithCalibrations(func(q *ent.CalibrationQuery) {
+			q.Order(ent.Asc(calibration.FieldChannel), ent.Asc(calibration.FieldValidFrom))
+		}).
+		Only(ctx)
+}
+
+// GetMany implements ISensorStorage. Sensors are loaded like Get; ids that do not
+// exist are skipped.
+func (s *SensorStorage) GetMany(ctx context.Context, ids []int) ([]*ent.Sensor, error) {
+	return s.client.Sensor.Query().
+		Where(sensor.IDIn(ids...)).
+		WithType().
+		WithCalibrations(func(q *ent.CalibrationQuery) {
+			q.Order(ent.Asc(calibration.FieldChannel), ent.Asc(calibration.FieldValidFrom))
+		}).
+		Order(ent.Asc(sensor.FieldID)).
+		All(ctx)
 }
 
 // List implements ISensorStorage.
@@ -88,14 +117,40 @@ func (s *SensorStorage) List(ctx context.Context, userID int64) ([]*ent.Sensor,
 
 // Update implements ISensorStorage.
 func (s *SensorStorage) Update(ctx context.Context, sensorData *ent.Sensor) (*ent.Sensor, error) {
-	query := s.client.Sensor.UpdateOneID(sensorData.ID).
+	var updatedSensor *ent.Sensor
+	err := withTx(ctx, s.client, func(client *ent.Client) error {
+		var err error
+		updatedSensor, err = s.update(ctx, client, sensorData)
+		if err != nil {
+			return err
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorID: int64(sensorData.ID)})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sensorWithType, err := s.client.Sensor.Query().
+		Where(sensor.ID(updatedSensor.ID)).
+		WithType().
+		Only(ctx)
+	if err != nil {
+		return updatedSensor, nil
+	}
+
+	return sensorWithType, nil
+}
+
+func (s *SensorStorage) update(ctx context.Context, client *ent.Client, sensorData *ent.Sensor) (*ent.Sensor, error) {
+	query := client.Sensor.UpdateOneID(sensorData.ID).
 		SetName(sensorData.Name).
 		SetNillableLocation(&sensorData.Location).
 		SetNillableDescription(&sensorData.Description).
-		SetActive(sensorData.Active)
+		SetActive(sensorData.Active).
+		SetNillableExpression(&sensorData.Expression)
 
 	if sensorData.Edges.Type != nil && sensorData.Edges.Type.ID != 0 {
-		currentSensor, err := s.client.Sensor.Query().
+		currentSensor, err := client.Sensor.Query().
 			Where(sensor.ID(sensorData.ID)).
 			WithType().
 			Only(ctx)
@@ -112,22 +167,22 @@ func (s *SensorStorage) Update(ctx context.Context, sensorData *ent.Sensor) (*en
 	if err != nil {
 		return nil, fmt.Errorf("failed to update sensor: %w", err)
 	}
-
-	sensorWithType, err := s.client.Sensor.Query().
-		Where(sensor.ID(updatedSensor.ID)).
-		WithType().
-		Only(ctx)
-	if err != nil {
-		return updatedSensor, nil
-	}
-
-	return sensorWithType, nil
+	return updatedSensor, nil
 }
 
 func (s *SensorStorage) SetActive(ctx context.Context, id int) (*ent.Sensor, error) {
-	return s.client.Sensor.UpdateOneID(id).
-		SetActive(true).
-		Save(ctx)
+	var updated *ent.Sensor
+	err := withTx(ctx, s.client, func(client *ent.Client) error {
+		var err error
+		updated, err = client.Sensor.UpdateOneID(id).
+			SetActive(true).
+			Save(ctx)
+		if err != nil {
+			return err
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorID: int64(id)})
+	})
+	return updated, err
 }
 
 func (s *SensorStorage) ListActive(ctx context.Context) ([]*ent.Sensor, error) {
@@ -135,3 +190,23 @@ func (s *SensorStorage) ListActive(ctx context.Context) ([]*ent.Sensor, error) {
 		Where(sensor.Active(true)).WithType().
 		All(ctx)
 }
+
+// ListByType returns sensors of the given type. A zero userID matches sensors of every user.
+func (s *SensorStorage) ListByType(ctx context.Context, typeID int, userID int64) ([]*ent.Sensor, error) {
+	query := s.client.Sensor.Query().
+		Where(sensor.HasTypeWith(sensortype.ID(typeID)))
+	if userID > 0 {
+		query = query.Where(sensor.UserID(userID))
+	}
+	return query.WithType().All(ctx)
+}
+
+// ListByKind returns sensors of the given kind. A zero userID matches sensors of every user.
+func (s *SensorStorage) ListByKind(ctx context.Context, kind string, userID int64) ([]*ent.Sensor, error) {
+	query := s.client.Sensor.Query().
+		Where(sensor.Kind(kind))
+	if userID > 0 {
+		query = query.Where(sensor.UserID(userID))
+	}
+	return query.WithType().All(ctx)
+}
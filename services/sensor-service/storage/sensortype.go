@@ -36,16 +36,34 @@ func (s *SensorTypeStorage) Delete(ctx context.Context, id int) error {
 		return fmt.Errorf("sensor type with id %d does not exist", id)
 	}
 
-	err = s.client.SensorType.DeleteOneID(id).Exec(ctx)
+	return withTx(ctx, s.client, func(client *ent.Client) error {
+		if err := client.SensorType.DeleteOneID(id).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to delete sensor type: %w", err)
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorTypeID: int64(id), Deleted: true})
+	})
+}
+
+// Update implements ISensorTypeStorage. The sensors of the type change with it, so
+// one event covers all of them.
+func (s *SensorTypeStorage) Update(ctx context.Context, id int, sensorType *ent.SensorType) (*ent.SensorType, error) {
+	var updatedST *ent.SensorType
+	err := withTx(ctx, s.client, func(client *ent.Client) error {
+		var err error
+		updatedST, err = s.update(ctx, client, id, sensorType)
+		if err != nil {
+			return err
+		}
+		return writeSensorEvent(ctx, client, SensorEvent{SensorTypeID: int64(id)})
+	})
 	if err != nil {
-		return fmt.Errorf("failed to delete sensor type: %w", err)
+		return nil, err
 	}
-	return nil
+	return updatedST, nil
 }
 
-// Update implements ISensorTypeStorage.
-func (s *SensorTypeStorage) Update(ctx context.Context, id int, sensorType *ent.SensorType) (*ent.SensorType, error) {
-	st := s.client.SensorType.UpdateOneID(id).
+func (s *SensorTypeStorage) update(ctx context.Context, client *ent.Client, id int, sensorType *ent.SensorType) (*ent.SensorType, error) {
+	st := client.SensorType.UpdateOneID(id).
 		SetName(sensorType.Name).
 		SetModel(sensorType.Model).
 		SetNillableManufacturer(&sensorType.Manufacturer).
@@ -54,6 +72,16 @@ func (s *SensorTypeStorage) Update(ctx context.Context, id int, sensorType *ent.
 		SetMinValue(sensorType.MinValue).
 		SetMaxValue(sensorType.MaxValue)
 
+	if sensorType.OutOfRangePolicy != "" {
+		st = st.SetOutOfRangePolicy(sensorType.OutOfRangePolicy)
+	}
+
+	if len(sensorType.Channels) > 0 {
+		st = st.SetChannels(sensorType.Channels)
+	} else {
+		st = st.ClearChannels()
+	}
+
 	updatedST, err := st.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update sensor type: %w", err)
@@ -74,15 +102,24 @@ func (s *SensorTypeStorage) Create(ctx context.Context, sensorType *ent.SensorTy
 		return nil, fmt.Errorf("sensor type with name '%s' already exists", sensorType.Name)
 	}
 
-	createdSensorType, err := s.client.SensorType.Create().
+	query := s.client.SensorType.Create().
 		SetName(sensorType.Name).
 		SetModel(sensorType.Model).
 		SetNillableManufacturer(&sensorType.Manufacturer).
 		SetNillableDescription(&sensorType.Description).
 		SetNillableUnit(&sensorType.Unit).
 		SetMinValue(sensorType.MinValue).
-		SetMaxValue(sensorType.MaxValue).
-		Save(ctx)
+		SetMaxValue(sensorType.MaxValue)
+
+	if sensorType.OutOfRangePolicy != "" {
+		query = query.SetOutOfRangePolicy(sensorType.OutOfRangePolicy)
+	}
+
+	if len(sensorType.Channels) > 0 {
+		query = query.SetChannels(sensorType.Channels)
+	}
+
+	createdSensorType, err := query.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create sensor type: %w", err)
 	}